@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"net"
+	"net/http"
 	"time"
 
 	"github.com/caarlos0/env/v8"
@@ -14,12 +16,45 @@ import (
 
 	grpccontroller "game/internal/controllers/grpc"
 	"game/internal/domain"
+	redisinvalidator "game/internal/invalidators/redis"
 	bcryptpasswordhasher "game/internal/passwordhashers/bcrypt"
+	achievement "game/internal/proto/achievement/proto"
+	audit "game/internal/proto/audit/proto"
+	clan "game/internal/proto/clan/proto"
+	event "game/internal/proto/event/proto"
+	gameserver "game/internal/proto/gameserver/proto"
 	leaderboard "game/internal/proto/leaderboard/proto"
+	matchmaking "game/internal/proto/matchmaking/proto"
+	moderation "game/internal/proto/moderation/proto"
+	privacy "game/internal/proto/privacy/proto"
+	rating "game/internal/proto/rating/proto"
+	reward "game/internal/proto/reward/proto"
+	social "game/internal/proto/social/proto"
+	storage "game/internal/proto/storage/proto"
+	tournament "game/internal/proto/tournament/proto"
 	user "game/internal/proto/user/proto"
+	wallet "game/internal/proto/wallet/proto"
+	redisratelimiter "game/internal/ratelimiters/redis"
+	achievementmongo "game/internal/repositories/achievement/mongo"
+	auditlogmongo "game/internal/repositories/auditlog/mongo"
+	clanmongo "game/internal/repositories/clan/mongo"
+	erasurerecordmongo "game/internal/repositories/erasurerecord/mongo"
+	eventmongo "game/internal/repositories/event/mongo"
+	friendshipmongo "game/internal/repositories/friendship/mongo"
+	gameserverkeymongo "game/internal/repositories/gameserverkey/mongo"
+	matchmakingredis "game/internal/repositories/matchmaking/redis"
+	nonceredis "game/internal/repositories/nonce/redis"
+	quarantinedscoremongo "game/internal/repositories/quarantinedscore/mongo"
+	rewardgrantmongo "game/internal/repositories/rewardgrant/mongo"
+	scoresubmissionredis "game/internal/repositories/scoresubmission/redis"
+	tokenversionredis "game/internal/repositories/tokenversion/redis"
+	tournamentmongo "game/internal/repositories/tournament/mongo"
 	usermongo "game/internal/repositories/user/mongo"
 	userscoreredis "game/internal/repositories/userscore/redis"
+	walletmongo "game/internal/repositories/wallet/mongo"
 	service "game/internal/services"
+	lruusercache "game/internal/usercaches/lru"
+	redisusercache "game/internal/usercaches/redis"
 
 	jwttokenmanager "game/internal/tokenmanagers/jwt"
 )
@@ -32,6 +67,89 @@ type EnvironmentVariables struct {
 	RedisAddr                string `env:"REDIS_ADDR,required"`
 	GrpcServerPort           string `env:"GRPC_SERVER_PORT,required"`
 	JWTTokenTTLInHours       int    `env:"JWT_TOKEN_TTL_IN_HOURS,required"`
+
+	RateLimitCapacity                          int64         `env:"RATE_LIMIT_CAPACITY" envDefault:"60"`
+	RateLimitRefillInterval                    time.Duration `env:"RATE_LIMIT_REFILL_INTERVAL" envDefault:"1s"`
+	SubmitUserScoreRateLimitCapacity           int64         `env:"SUBMIT_USER_SCORE_RATE_LIMIT_CAPACITY" envDefault:"5"`
+	SubmitUserScoreRateLimitRefillInterval     time.Duration `env:"SUBMIT_USER_SCORE_RATE_LIMIT_REFILL_INTERVAL" envDefault:"10s"`
+	SubmitVerifiedScoreRateLimitCapacity       int64         `env:"SUBMIT_VERIFIED_SCORE_RATE_LIMIT_CAPACITY" envDefault:"600"`
+	SubmitVerifiedScoreRateLimitRefillInterval time.Duration `env:"SUBMIT_VERIFIED_SCORE_RATE_LIMIT_REFILL_INTERVAL" envDefault:"100ms"`
+
+	MongoQuarantinedScoresCollectionName string        `env:"MONGO_QUARANTINED_SCORES_COLLECTION_NAME" envDefault:"quarantined_scores"`
+	ScoreMin                             float64       `env:"SCORE_MIN" envDefault:"0"`
+	ScoreMax                             float64       `env:"SCORE_MAX" envDefault:"1000000"`
+	ScoreMinSubmissionInterval           time.Duration `env:"SCORE_MIN_SUBMISSION_INTERVAL" envDefault:"5s"`
+	ScoreMaxGain                         float64       `env:"SCORE_MAX_GAIN" envDefault:"10000"`
+	ScoreGainInterval                    time.Duration `env:"SCORE_GAIN_INTERVAL" envDefault:"1m"`
+	ScoreOutlierZScore                   float64       `env:"SCORE_OUTLIER_Z_SCORE" envDefault:"4"`
+	ScoreOutlierMinSamples               int64         `env:"SCORE_OUTLIER_MIN_SAMPLES" envDefault:"100"`
+
+	AdminAPIKey                       string        `env:"ADMIN_API_KEY,required"`
+	MongoGameServerKeysCollectionName string        `env:"MONGO_GAME_SERVER_KEYS_COLLECTION_NAME" envDefault:"game_server_keys"`
+	SignedScoreMaxAge                 time.Duration `env:"SIGNED_SCORE_MAX_AGE" envDefault:"5m"`
+
+	MongoAuditEventsCollectionName string `env:"MONGO_AUDIT_EVENTS_COLLECTION_NAME" envDefault:"audit_events"`
+
+	UserCacheSize int           `env:"USER_CACHE_SIZE" envDefault:"10000"`
+	UserCacheTTL  time.Duration `env:"USER_CACHE_TTL" envDefault:"1m"`
+
+	LeaderboardReconcileInterval time.Duration `env:"LEADERBOARD_RECONCILE_INTERVAL" envDefault:"10m"`
+	MetricsServerPort            string        `env:"METRICS_SERVER_PORT" envDefault:"9090"`
+
+	LeaderboardSnapshotSize int           `env:"LEADERBOARD_SNAPSHOT_SIZE" envDefault:"100"`
+	LeaderboardSnapshotTTL  time.Duration `env:"LEADERBOARD_SNAPSHOT_TTL" envDefault:"5s"`
+	LeaderboardTieBreak     string        `env:"LEADERBOARD_TIE_BREAK" envDefault:"earliest"`
+
+	LeaderboardRankMode        string `env:"LEADERBOARD_RANK_MODE" envDefault:"standard"`
+	CountryLeaderboardRankMode string `env:"COUNTRY_LEADERBOARD_RANK_MODE" envDefault:"standard"`
+	FriendsLeaderboardRankMode string `env:"FRIENDS_LEADERBOARD_RANK_MODE" envDefault:"standard"`
+
+	MongoErasureRecordsCollectionName string `env:"MONGO_ERASURE_RECORDS_COLLECTION_NAME" envDefault:"erasure_records"`
+	ErasureRecordSigningKey           string `env:"ERASURE_RECORD_SIGNING_KEY,required"`
+	ErasureRecordSubjectHashKey       string `env:"ERASURE_RECORD_SUBJECT_HASH_KEY,required"`
+
+	MongoFriendshipsCollectionName string `env:"MONGO_FRIENDSHIPS_COLLECTION_NAME" envDefault:"friendships"`
+	MaxFriends                     int    `env:"MAX_FRIENDS" envDefault:"500"`
+	MongoClansCollectionName       string `env:"MONGO_CLANS_COLLECTION_NAME" envDefault:"clans"`
+	MongoClanMembersCollectionName string `env:"MONGO_CLAN_MEMBERS_COLLECTION_NAME" envDefault:"clan_members"`
+	MaxClanMembers                 int    `env:"MAX_CLAN_MEMBERS" envDefault:"50"`
+
+	MongoRewardGrantsCollectionName string   `env:"MONGO_REWARD_GRANTS_COLLECTION_NAME" envDefault:"reward_grants"`
+	SeasonRewardRules               []string `env:"SEASON_REWARD_RULES" envDefault:"champion:rank:10:champion_chest,gold:percentile:99:gold_chest,silver:percentile:90:silver_chest,bronze:percentile:75:bronze_chest"`
+
+	MongoUserAchievementsCollectionName    string   `env:"MONGO_USER_ACHIEVEMENTS_COLLECTION_NAME" envDefault:"user_achievements"`
+	MongoAchievementProgressCollectionName string   `env:"MONGO_ACHIEVEMENT_PROGRESS_COLLECTION_NAME" envDefault:"achievement_progress"`
+	Achievements                           []string `env:"ACHIEVEMENTS" envDefault:"score_10000:score:10000:Ten Thousand Club,top_10:rank:10:Top Ten,matches_100:submissions:100:Veteran"`
+
+	RatingModes           []string `env:"RATING_MODES" envDefault:"duel,free_for_all"`
+	RatingTau             float64  `env:"RATING_TAU" envDefault:"0.5"`
+	RatingLeaderboardSize int64    `env:"RATING_LEADERBOARD_SIZE" envDefault:"100"`
+
+	MatchmakingRegions            []string      `env:"MATCHMAKING_REGIONS" envDefault:"eu,na,asia"`
+	MatchmakingMaxMatchSize       int64         `env:"MATCHMAKING_MAX_MATCH_SIZE" envDefault:"8"`
+	MatchmakingInitialRatingRange float64       `env:"MATCHMAKING_INITIAL_RATING_RANGE" envDefault:"100"`
+	MatchmakingRatingRangeGrowth  float64       `env:"MATCHMAKING_RATING_RANGE_GROWTH" envDefault:"10"`
+	MatchmakingMaxRatingRange     float64       `env:"MATCHMAKING_MAX_RATING_RANGE" envDefault:"500"`
+	MatchmakingQueueTimeout       time.Duration `env:"MATCHMAKING_QUEUE_TIMEOUT" envDefault:"5m"`
+	MatchmakingPollInterval       time.Duration `env:"MATCHMAKING_POLL_INTERVAL" envDefault:"1s"`
+	MatchTicketTTL                time.Duration `env:"MATCH_TICKET_TTL" envDefault:"2m"`
+	MatchTicketSigningKey         string        `env:"MATCH_TICKET_SIGNING_KEY,required"`
+
+	MongoTournamentsCollectionName string        `env:"MONGO_TOURNAMENTS_COLLECTION_NAME" envDefault:"tournaments"`
+	TournamentMaxPlayers           int64         `env:"TOURNAMENT_MAX_PLAYERS" envDefault:"1024"`
+	TournamentSchedulerInterval    time.Duration `env:"TOURNAMENT_SCHEDULER_INTERVAL" envDefault:"30s"`
+
+	MongoEventsCollectionName string        `env:"MONGO_EVENTS_COLLECTION_NAME" envDefault:"events"`
+	EventLeaderboardSize      int64         `env:"EVENT_LEADERBOARD_SIZE" envDefault:"100"`
+	EventFreezeInterval       time.Duration `env:"EVENT_FREEZE_INTERVAL" envDefault:"30s"`
+
+	MongoWalletsCollectionName       string   `env:"MONGO_WALLETS_COLLECTION_NAME" envDefault:"wallets"`
+	MongoLedgerEntriesCollectionName string   `env:"MONGO_LEDGER_ENTRIES_COLLECTION_NAME" envDefault:"ledger_entries"`
+	WalletCurrencies                 []string `env:"WALLET_CURRENCIES" envDefault:"coins,gems"`
+
+	MongoStorageObjectsCollectionName string `env:"MONGO_STORAGE_OBJECTS_COLLECTION_NAME" envDefault:"storage_objects"`
+	StorageMaxValueSize               int    `env:"STORAGE_MAX_VALUE_SIZE" envDefault:"16384"`
+	StorageMaxObjectsPerUser          int64  `env:"STORAGE_MAX_OBJECTS_PER_USER" envDefault:"100"`
 }
 
 func main() {
@@ -45,10 +163,101 @@ func main() {
 		logger.Fatal("failed to parse environment variables", err)
 	}
 
-	jwtTokenManager := jwttokenmanager.NewJWTTokenManager(jwttokenmanager.JWTTokenCreatorDependencies{
-		SecretKey: environments.JWTSecretKey,
-		TokenTTL:  time.Duration(environments.JWTTokenTTLInHours) * time.Hour,
-	})
+	if environments.LeaderboardTieBreak != domain.TieBreakEarliest && environments.LeaderboardTieBreak != domain.TieBreakLatest {
+		logger.Fatal("invalid leaderboard tie break: ", environments.LeaderboardTieBreak)
+	}
+
+	if environments.LeaderboardReconcileInterval <= 0 {
+		logger.Fatal("invalid leaderboard reconcile interval: ", environments.LeaderboardReconcileInterval)
+	}
+
+	for _, refillInterval := range []time.Duration{
+		environments.RateLimitRefillInterval,
+		environments.SubmitUserScoreRateLimitRefillInterval,
+		environments.SubmitVerifiedScoreRateLimitRefillInterval,
+	} {
+		// the buckets are refilled in whole milliseconds.
+		if refillInterval < time.Millisecond {
+			logger.Fatal("invalid rate limit refill interval: ", refillInterval)
+		}
+	}
+
+	if environments.LeaderboardSnapshotTTL <= 0 {
+		logger.Fatal("invalid leaderboard snapshot ttl: ", environments.LeaderboardSnapshotTTL)
+	}
+
+	for _, rankMode := range []string{
+		environments.LeaderboardRankMode,
+		environments.CountryLeaderboardRankMode,
+		environments.FriendsLeaderboardRankMode,
+	} {
+		if !domain.IsRankMode(rankMode) {
+			logger.Fatal("invalid leaderboard rank mode: ", rankMode)
+		}
+	}
+
+	seasonRewardRules, err := service.ParseRewardRules(environments.SeasonRewardRules)
+	if err != nil {
+		logger.Fatal("failed to parse season reward rules: ", err)
+	}
+
+	achievements, err := service.ParseAchievements(environments.Achievements)
+	if err != nil {
+		logger.Fatal("failed to parse achievements: ", err)
+	}
+
+	if err := service.ValidateRatingModes(environments.RatingModes); err != nil {
+		logger.Fatal("invalid rating modes: ", err)
+	}
+
+	if environments.RatingTau <= 0 {
+		logger.Fatal("invalid rating tau: ", environments.RatingTau)
+	}
+
+	if err := service.ValidateMatchmakingRegions(environments.MatchmakingRegions); err != nil {
+		logger.Fatal("invalid matchmaking regions: ", err)
+	}
+
+	if environments.MatchmakingMaxMatchSize < 2 {
+		logger.Fatal("invalid matchmaking max match size: ", environments.MatchmakingMaxMatchSize)
+	}
+
+	if environments.MatchmakingPollInterval <= 0 {
+		logger.Fatal("invalid matchmaking poll interval: ", environments.MatchmakingPollInterval)
+	}
+
+	matchTicketSigningKey, err := service.ParseTicketSigningKey(environments.MatchTicketSigningKey)
+	if err != nil {
+		logger.Fatal("invalid match ticket signing key: ", err)
+	}
+
+	if environments.TournamentMaxPlayers < 2 {
+		logger.Fatal("invalid tournament max players: ", environments.TournamentMaxPlayers)
+	}
+
+	if environments.TournamentSchedulerInterval <= 0 {
+		logger.Fatal("invalid tournament scheduler interval: ", environments.TournamentSchedulerInterval)
+	}
+
+	if environments.EventLeaderboardSize <= 0 {
+		logger.Fatal("invalid event leaderboard size: ", environments.EventLeaderboardSize)
+	}
+
+	if environments.EventFreezeInterval <= 0 {
+		logger.Fatal("invalid event freeze interval: ", environments.EventFreezeInterval)
+	}
+
+	if err := service.ValidateWalletCurrencies(environments.WalletCurrencies); err != nil {
+		logger.Fatal("invalid wallet currencies: ", err)
+	}
+
+	if environments.StorageMaxValueSize <= 0 {
+		logger.Fatal("invalid storage max value size: ", environments.StorageMaxValueSize)
+	}
+
+	if environments.StorageMaxObjectsPerUser <= 0 {
+		logger.Fatal("invalid storage max objects per user: ", environments.StorageMaxObjectsPerUser)
+	}
 
 	bcryptPasswordHasher := bcryptpasswordhasher.NewBcryptPasswordHasher()
 
@@ -63,23 +272,152 @@ func main() {
 		}
 	}()
 
-	usersCollection := mongoClient.
-		Database(environments.MongoDatabaseName).
-		Collection(environments.MongoUsersCollectionName)
+	database := mongoClient.Database(environments.MongoDatabaseName)
+
+	usersCollection := database.Collection(environments.MongoUsersCollectionName)
 
 	mongoUserRepository := usermongo.NewMongoUserRepository(usermongo.MongoUserRepositoryDependencies{
 		UsersCollection: usersCollection,
 	})
 
-	redisUserScoreRepository, err := createRedisUserScoreRepository(environments.RedisAddr, mongoUserRepository)
+	redisClient, err := connectToRedis(environments.RedisAddr)
 	if err != nil {
-		logger.Fatal("failed to create redis user score repository", err)
+		logger.Fatal("failed to connect to redis", err)
 	}
 
+	jwtTokenManager := jwttokenmanager.NewJWTTokenManager(jwttokenmanager.JWTTokenCreatorDependencies{
+		SecretKey: environments.JWTSecretKey,
+		TokenTTL:  time.Duration(environments.JWTTokenTTLInHours) * time.Hour,
+		TokenVersionRepository: tokenversionredis.NewRedisTokenVersionRepository(tokenversionredis.RedisTokenVersionRepositoryDependencies{
+			Client: redisClient,
+		}),
+	})
+
+	redisUserCache := redisusercache.NewRedisUserCache(redisusercache.RedisUserCacheDependencies{
+		Client: redisClient,
+	})
+
+	userCache := lruusercache.NewLRUUserCache(lruusercache.LRUUserCacheDependencies{
+		Next: redisUserCache,
+		Size: environments.UserCacheSize,
+		TTL:  environments.UserCacheTTL,
+	})
+
+	redisUserScoreRepository := userscoreredis.NewRedisUserScoreRepository(
+		userscoreredis.RedisUserScoreRepositoryDependencies{
+			Client:         redisClient,
+			UserRepository: mongoUserRepository,
+			UserCache:      userCache,
+			TieBreak:       environments.LeaderboardTieBreak,
+		},
+	)
+
+	redisScoreSubmissionRepository := scoresubmissionredis.NewRedisScoreSubmissionRepository(
+		scoresubmissionredis.RedisScoreSubmissionRepositoryDependencies{
+			Client: redisClient,
+		},
+	)
+
+	mongoQuarantinedScoreRepository := quarantinedscoremongo.NewMongoQuarantinedScoreRepository(
+		quarantinedscoremongo.MongoQuarantinedScoreRepositoryDependencies{
+			QuarantinedScoresCollection: database.Collection(environments.MongoQuarantinedScoresCollectionName),
+		},
+	)
+
+	mongoGameServerKeyRepository := gameserverkeymongo.NewMongoGameServerKeyRepository(
+		gameserverkeymongo.MongoGameServerKeyRepositoryDependencies{
+			GameServerKeysCollection: database.Collection(environments.MongoGameServerKeysCollectionName),
+		},
+	)
+
+	redisNonceRepository := nonceredis.NewRedisNonceRepository(nonceredis.RedisNonceRepositoryDependencies{
+		Client: redisClient,
+	})
+
+	redisMatchmakingRepository := matchmakingredis.NewRedisMatchmakingRepository(matchmakingredis.RedisMatchmakingRepositoryDependencies{
+		Client: redisClient,
+	})
+
+	mongoAuditLog := auditlogmongo.NewMongoAuditLog(auditlogmongo.MongoAuditLogDependencies{
+		AuditEventsCollection: database.Collection(environments.MongoAuditEventsCollectionName),
+	})
+
+	mongoFriendshipRepository := friendshipmongo.NewMongoFriendshipRepository(friendshipmongo.MongoFriendshipRepositoryDependencies{
+		FriendshipsCollection: database.Collection(environments.MongoFriendshipsCollectionName),
+	})
+
+	mongoClanRepository := clanmongo.NewMongoClanRepository(clanmongo.MongoClanRepositoryDependencies{
+		ClansCollection:       database.Collection(environments.MongoClansCollectionName),
+		ClanMembersCollection: database.Collection(environments.MongoClanMembersCollectionName),
+	})
+
+	err = mongoClanRepository.CreateIndexes(context.Background())
+	if err != nil {
+		logger.Fatal("failed to create the clan indexes", err)
+	}
+
+	mongoRewardGrantRepository := rewardgrantmongo.NewMongoRewardGrantRepository(rewardgrantmongo.MongoRewardGrantRepositoryDependencies{
+		RewardGrantsCollection: database.Collection(environments.MongoRewardGrantsCollectionName),
+	})
+
+	mongoAchievementRepository := achievementmongo.NewMongoAchievementRepository(achievementmongo.MongoAchievementRepositoryDependencies{
+		UserAchievementsCollection:    database.Collection(environments.MongoUserAchievementsCollectionName),
+		AchievementProgressCollection: database.Collection(environments.MongoAchievementProgressCollectionName),
+	})
+
+	mongoTournamentRepository := tournamentmongo.NewMongoTournamentRepository(tournamentmongo.MongoTournamentRepositoryDependencies{
+		TournamentsCollection: database.Collection(environments.MongoTournamentsCollectionName),
+	})
+
+	mongoEventRepository := eventmongo.NewMongoEventRepository(eventmongo.MongoEventRepositoryDependencies{
+		EventsCollection: database.Collection(environments.MongoEventsCollectionName),
+	})
+
+	mongoWalletRepository := walletmongo.NewMongoWalletRepository(walletmongo.MongoWalletRepositoryDependencies{
+		Client:                  mongoClient,
+		WalletsCollection:       database.Collection(environments.MongoWalletsCollectionName),
+		LedgerEntriesCollection: database.Collection(environments.MongoLedgerEntriesCollectionName),
+	})
+
+	mongoStorageRepository := usermongo.NewMongoStorageRepository(usermongo.MongoStorageRepositoryDependencies{
+		StorageObjectsCollection: database.Collection(environments.MongoStorageObjectsCollectionName),
+	})
+
+	redisRateLimiter := redisratelimiter.NewRedisRateLimiter(redisratelimiter.RedisRateLimiterDependencies{
+		Client: redisClient,
+	})
+
+	auditMetrics := expvar.NewMap("audit_log")
+
+	userDataEraser := service.NewUserDataEraser(service.UserDataEraserDependencies{
+		UserRepository:             mongoUserRepository,
+		UserScoreRepository:        redisUserScoreRepository,
+		ScoreSubmissionRepository:  redisScoreSubmissionRepository,
+		QuarantinedScoreRepository: mongoQuarantinedScoreRepository,
+		FriendshipRepository:       mongoFriendshipRepository,
+		ClanRepository:             mongoClanRepository,
+		RewardGrantRepository:      mongoRewardGrantRepository,
+		AchievementRepository:      mongoAchievementRepository,
+		RatingRepository:           redisUserScoreRepository,
+		TournamentRepository:       mongoTournamentRepository,
+		EventBoardRepository:       redisUserScoreRepository,
+		WalletRepository:           mongoWalletRepository,
+		StorageRepository:          mongoStorageRepository,
+		AuditLog:                   mongoAuditLog,
+		TokenManager:               jwtTokenManager,
+		UserCache:                  userCache,
+	})
+
 	userService := service.NewUserService(service.UserServiceDependencies{
-		UserRepository: mongoUserRepository,
-		TokenManager:   jwtTokenManager,
-		PasswordHasher: bcryptPasswordHasher,
+		UserRepository:      mongoUserRepository,
+		UserScoreRepository: redisUserScoreRepository,
+		TokenManager:        jwtTokenManager,
+		PasswordHasher:      bcryptPasswordHasher,
+		AuditLog:            mongoAuditLog,
+		AuditMetrics:        auditMetrics,
+		Logger:              logger,
+		UserCache:           userCache,
+		UserDataEraser:      userDataEraser,
 	})
 
 	userController := grpccontroller.NewUserController(grpccontroller.UserControllerDependencies{
@@ -88,29 +426,456 @@ func main() {
 	})
 
 	leaderboardService := service.NewLeaderboardService(service.LeaderboardServiceDependencies{
+		UserRepository:             mongoUserRepository,
+		UserScoreRepository:        redisUserScoreRepository,
+		ScoreSubmissionRepository:  redisScoreSubmissionRepository,
+		QuarantinedScoreRepository: mongoQuarantinedScoreRepository,
+		GameServerKeyRepository:    mongoGameServerKeyRepository,
+		NonceRepository:            redisNonceRepository,
+		AchievementRepository:      mongoAchievementRepository,
+		EventRepository:            mongoEventRepository,
+		EventBoardRepository:       redisUserScoreRepository,
+		AuditLog:                   mongoAuditLog,
+		AuditMetrics:               auditMetrics,
+		Logger:                     logger,
+		ScoreValidationRules: domain.ScoreValidationRules{
+			MinScore:              environments.ScoreMin,
+			MaxScore:              environments.ScoreMax,
+			MinSubmissionInterval: environments.ScoreMinSubmissionInterval,
+			MaxScoreGain:          environments.ScoreMaxGain,
+			ScoreGainInterval:     environments.ScoreGainInterval,
+			OutlierZScore:         environments.ScoreOutlierZScore,
+			OutlierMinSamples:     environments.ScoreOutlierMinSamples,
+		},
+		SignedScoreMaxAge: environments.SignedScoreMaxAge,
+		RankMode:          environments.LeaderboardRankMode,
+		CountryRankMode:   environments.CountryLeaderboardRankMode,
+		Achievements:      achievements,
+	})
+
+	redisLeaderboardInvalidator := redisinvalidator.NewRedisLeaderboardInvalidator(redisinvalidator.RedisLeaderboardInvalidatorDependencies{
+		Client: redisClient,
+	})
+
+	cachedLeaderboardService := service.NewCachedLeaderboardService(service.CachedLeaderboardServiceDependencies{
+		Next:                   leaderboardService,
+		UserScoreRepository:    redisUserScoreRepository,
+		LeaderboardInvalidator: redisLeaderboardInvalidator,
+		Size:                   environments.LeaderboardSnapshotSize,
+		TTL:                    environments.LeaderboardSnapshotTTL,
+		Logger:                 logger,
+	})
+
+	go cachedLeaderboardService.Run(context.Background())
+
+	leaderboardController := grpccontroller.NewLeaderboardController(grpccontroller.LeaderboardControllerDependencies{
+		LeaderboardService: cachedLeaderboardService,
+		Logger:             logger,
+	})
+
+	gameServerKeyService := service.NewGameServerKeyService(service.GameServerKeyServiceDependencies{
+		GameServerKeyRepository: mongoGameServerKeyRepository,
+	})
+
+	gameServerController := grpccontroller.NewGameServerController(grpccontroller.GameServerControllerDependencies{
+		GameServerKeyService: gameServerKeyService,
+		Logger:               logger,
+	})
+
+	leaderboardModerationService := service.NewLeaderboardModerationService(service.LeaderboardModerationServiceDependencies{
+		UserRepository:         mongoUserRepository,
+		UserScoreRepository:    redisUserScoreRepository,
+		AchievementRepository:  mongoAchievementRepository,
+		AuditLog:               mongoAuditLog,
+		UserCache:              userCache,
+		LeaderboardInvalidator: redisLeaderboardInvalidator,
+		Achievements:           achievements,
+		AuditMetrics:           auditMetrics,
+		Logger:                 logger,
+	})
+
+	moderationController := grpccontroller.NewModerationController(grpccontroller.ModerationControllerDependencies{
+		LeaderboardModerationService: leaderboardModerationService,
+		Logger:                       logger,
+	})
+
+	auditLogService := service.NewAuditLogService(service.AuditLogServiceDependencies{
+		AuditLog: mongoAuditLog,
+	})
+
+	auditLogController := grpccontroller.NewAuditLogController(grpccontroller.AuditLogControllerDependencies{
+		AuditLogService: auditLogService,
+		Logger:          logger,
+	})
+
+	privacyService := service.NewPrivacyService(service.PrivacyServiceDependencies{
+		UserRepository:             mongoUserRepository,
+		UserScoreRepository:        redisUserScoreRepository,
+		ScoreSubmissionRepository:  redisScoreSubmissionRepository,
+		QuarantinedScoreRepository: mongoQuarantinedScoreRepository,
+		ErasureRecordRepository: erasurerecordmongo.NewMongoErasureRecordRepository(erasurerecordmongo.MongoErasureRecordRepositoryDependencies{
+			ErasureRecordsCollection: database.Collection(environments.MongoErasureRecordsCollectionName),
+		}),
+		FriendshipRepository:  mongoFriendshipRepository,
+		ClanRepository:        mongoClanRepository,
+		RewardGrantRepository: mongoRewardGrantRepository,
+		AchievementRepository: mongoAchievementRepository,
+		RatingRepository:      redisUserScoreRepository,
+		TournamentRepository:  mongoTournamentRepository,
+		EventBoardRepository:  redisUserScoreRepository,
+		WalletRepository:      mongoWalletRepository,
+		StorageRepository:     mongoStorageRepository,
+		AuditLog:              mongoAuditLog,
+		UserDataEraser:        userDataEraser,
+		SigningKey:            []byte(environments.ErasureRecordSigningKey),
+		SubjectHashKey:        []byte(environments.ErasureRecordSubjectHashKey),
+	})
+
+	privacyController := grpccontroller.NewPrivacyController(grpccontroller.PrivacyControllerDependencies{
+		PrivacyService: privacyService,
+		Logger:         logger,
+	})
+
+	socialService := service.NewSocialService(service.SocialServiceDependencies{
+		UserRepository:       mongoUserRepository,
+		UserScoreRepository:  redisUserScoreRepository,
+		FriendshipRepository: mongoFriendshipRepository,
+		MaxFriends:           environments.MaxFriends,
+		RankMode:             environments.FriendsLeaderboardRankMode,
+	})
+
+	socialController := grpccontroller.NewSocialController(grpccontroller.SocialControllerDependencies{
+		SocialService: socialService,
+		Logger:        logger,
+	})
+
+	clanService := service.NewClanService(service.ClanServiceDependencies{
 		UserRepository:      mongoUserRepository,
 		UserScoreRepository: redisUserScoreRepository,
+		ClanRepository:      mongoClanRepository,
+		MaxMembers:          environments.MaxClanMembers,
 	})
 
-	leaderboardController := grpccontroller.NewLeaderboardController(grpccontroller.LeaderboardControllerDependencies{
-		LeaderboardService: leaderboardService,
+	clanController := grpccontroller.NewClanController(grpccontroller.ClanControllerDependencies{
+		ClanService: clanService,
+		Logger:      logger,
+	})
+
+	walletService := service.NewWalletService(service.WalletServiceDependencies{
+		WalletRepository: mongoWalletRepository,
+		Currencies:       environments.WalletCurrencies,
+	})
+
+	walletController := grpccontroller.NewWalletController(grpccontroller.WalletControllerDependencies{
+		WalletService: walletService,
+		Logger:        logger,
+	})
+
+	walletAdminController := grpccontroller.NewWalletAdminController(grpccontroller.WalletAdminControllerDependencies{
+		WalletService: walletService,
+		Logger:        logger,
+	})
+
+	// the rewards are placed on the uncached leaderboard, so the final
+	// standings of a season are never stale.
+	rewardService := service.NewRewardService(service.RewardServiceDependencies{
+		LeaderboardService:    leaderboardService,
+		RewardGrantRepository: mongoRewardGrantRepository,
+		WalletService:         walletService,
+		Rules:                 seasonRewardRules,
+	})
+
+	rewardController := grpccontroller.NewRewardController(grpccontroller.RewardControllerDependencies{
+		RewardService: rewardService,
+		Logger:        logger,
+	})
+
+	rewardAdminController := grpccontroller.NewRewardAdminController(grpccontroller.RewardAdminControllerDependencies{
+		RewardService: rewardService,
+		Logger:        logger,
+	})
+
+	achievementService := service.NewAchievementService(service.AchievementServiceDependencies{
+		UserRepository:        mongoUserRepository,
+		AchievementRepository: mongoAchievementRepository,
+		Achievements:          achievements,
+	})
+
+	achievementController := grpccontroller.NewAchievementController(grpccontroller.AchievementControllerDependencies{
+		AchievementService: achievementService,
 		Logger:             logger,
 	})
 
+	ratingService := service.NewRatingService(service.RatingServiceDependencies{
+		UserRepository:   mongoUserRepository,
+		RatingRepository: redisUserScoreRepository,
+		Modes:            environments.RatingModes,
+		Tau:              environments.RatingTau,
+		LeaderboardSize:  environments.RatingLeaderboardSize,
+		RankMode:         environments.LeaderboardRankMode,
+	})
+
+	ratingController := grpccontroller.NewRatingController(grpccontroller.RatingControllerDependencies{
+		RatingService: ratingService,
+		Logger:        logger,
+	})
+
+	ratingAdminController := grpccontroller.NewRatingAdminController(grpccontroller.RatingAdminControllerDependencies{
+		RatingService: ratingService,
+		Logger:        logger,
+	})
+
+	matchmakingService := service.NewMatchmakingService(service.MatchmakingServiceDependencies{
+		RatingRepository:      redisUserScoreRepository,
+		MatchmakingRepository: redisMatchmakingRepository,
+		Modes:                 environments.RatingModes,
+		Regions:               environments.MatchmakingRegions,
+		MaxMatchSize:          environments.MatchmakingMaxMatchSize,
+		InitialRatingRange:    environments.MatchmakingInitialRatingRange,
+		RatingRangeGrowth:     environments.MatchmakingRatingRangeGrowth,
+		MaxRatingRange:        environments.MatchmakingMaxRatingRange,
+		QueueTimeout:          environments.MatchmakingQueueTimeout,
+		PollInterval:          environments.MatchmakingPollInterval,
+		TicketTTL:             environments.MatchTicketTTL,
+		TicketSigningKey:      matchTicketSigningKey,
+	})
+
+	matchmakingController := grpccontroller.NewMatchmakingController(grpccontroller.MatchmakingControllerDependencies{
+		MatchmakingService: matchmakingService,
+		Logger:             logger,
+	})
+
+	tournamentService := service.NewTournamentService(service.TournamentServiceDependencies{
+		TournamentRepository: mongoTournamentRepository,
+		UserScoreRepository:  redisUserScoreRepository,
+		MaxPlayers:           environments.TournamentMaxPlayers,
+	})
+
+	tournamentController := grpccontroller.NewTournamentController(grpccontroller.TournamentControllerDependencies{
+		TournamentService: tournamentService,
+		Logger:            logger,
+	})
+
+	tournamentAdminController := grpccontroller.NewTournamentAdminController(grpccontroller.TournamentAdminControllerDependencies{
+		TournamentService: tournamentService,
+		Logger:            logger,
+	})
+
+	eventService := service.NewEventService(service.EventServiceDependencies{
+		EventRepository:      mongoEventRepository,
+		EventBoardRepository: redisUserScoreRepository,
+		Modes:                environments.RatingModes,
+		LeaderboardSize:      environments.EventLeaderboardSize,
+		RankMode:             environments.LeaderboardRankMode,
+	})
+
+	eventController := grpccontroller.NewEventController(grpccontroller.EventControllerDependencies{
+		EventService: eventService,
+		Logger:       logger,
+	})
+
+	eventAdminController := grpccontroller.NewEventAdminController(grpccontroller.EventAdminControllerDependencies{
+		EventService: eventService,
+		Logger:       logger,
+	})
+
+	storageService := service.NewStorageService(service.StorageServiceDependencies{
+		StorageRepository: mongoStorageRepository,
+		MaxValueSize:      environments.StorageMaxValueSize,
+		MaxObjectsPerUser: environments.StorageMaxObjectsPerUser,
+	})
+
+	storageController := grpccontroller.NewStorageController(grpccontroller.StorageControllerDependencies{
+		StorageService: storageService,
+		Logger:         logger,
+	})
+
+	requestInfoInterceptor := grpccontroller.NewRequestInfoInterceptor()
+
+	adminInterceptor := grpccontroller.NewAdminInterceptor(grpccontroller.AdminInterceptorDependencies{
+		AdminAPIKey: environments.AdminAPIKey,
+		AdminMethodNames: []string{
+			"/gameserver.GameServerAdminService/CreateGameServerKey",
+			"/gameserver.GameServerAdminService/ListGameServerKeys",
+			"/gameserver.GameServerAdminService/RevokeGameServerKey",
+			"/moderation.LeaderboardModerationService/RemoveScore",
+			"/moderation.LeaderboardModerationService/SetScore",
+			"/moderation.LeaderboardModerationService/BanUser",
+			"/moderation.LeaderboardModerationService/UnbanUser",
+			"/audit.AuditLogService/QueryAuditLog",
+			"/privacy.PrivacyService/ExportUserData",
+			"/privacy.PrivacyService/EraseUserData",
+			"/privacy.PrivacyService/VerifyErasureRecord",
+			"/reward.RewardAdminService/EndSeason",
+			"/rating.RatingAdminService/ReportMatchResult",
+			"/tournament.TournamentAdminService/CreateTournament",
+			"/tournament.TournamentAdminService/RecordMatchResult",
+			"/tournament.TournamentAdminService/RecordScore",
+			"/event.EventAdminService/CreateEvent",
+			"/wallet.WalletAdminService/Grant",
+		},
+	})
+
 	unaryInterceptor := grpccontroller.NewUnaryInterceptor(grpccontroller.UnaryInterceptorDependencies{
 		TokenManager: jwtTokenManager,
 		AuthorizedMethodNames: []string{
 			"/leaderboard.LeaderboardService/SubmitUserScore",
+			"/leaderboard.LeaderboardService/GetUserRank",
 			"/leaderboard.LeaderboardService/GetLeaderboard",
+			"/user.UserService/GetProfile",
+			"/user.UserService/UpdateUsername",
+			"/user.UserService/UpdateProfile",
+			"/user.UserService/ChangePassword",
+			"/user.UserService/DeleteAccount",
+			"/social.SocialService/SendFriendRequest",
+			"/social.SocialService/AcceptFriendRequest",
+			"/social.SocialService/DeclineFriendRequest",
+			"/social.SocialService/RemoveFriend",
+			"/social.SocialService/BlockUser",
+			"/social.SocialService/UnblockUser",
+			"/social.SocialService/ListFriends",
+			"/social.SocialService/ListFriendRequests",
+			"/social.SocialService/GetFriendsLeaderboard",
+			"/clan.ClanService/CreateClan",
+			"/clan.ClanService/GetClan",
+			"/clan.ClanService/JoinClan",
+			"/clan.ClanService/LeaveClan",
+			"/clan.ClanService/KickMember",
+			"/clan.ClanService/SetMemberRole",
+			"/clan.ClanService/GetClanLeaderboard",
+			"/reward.RewardService/ListRewards",
+			"/reward.RewardService/ClaimRewards",
+			"/achievement.AchievementService/ListAchievements",
+			"/achievement.AchievementService/GetUserAchievements",
+			"/rating.RatingService/GetRatingLeaderboard",
+			"/rating.RatingService/GetUserRating",
+			"/matchmaking.MatchmakingService/JoinQueue",
+			"/matchmaking.MatchmakingService/LeaveQueue",
+			"/matchmaking.MatchmakingService/WaitForMatch",
+			"/tournament.TournamentService/GetTournament",
+			"/tournament.TournamentService/ListTournaments",
+			"/tournament.TournamentService/RegisterForTournament",
+			"/event.EventService/ListEvents",
+			"/event.EventService/GetEvent",
+			"/event.EventService/GetEventLeaderboard",
+			"/wallet.WalletService/GetBalance",
+			"/wallet.WalletService/Spend",
+			"/storage.StorageService/Put",
+			"/storage.StorageService/Get",
+			"/storage.StorageService/List",
+			"/storage.StorageService/Delete",
+		},
+	})
+
+	defaultRateLimit := domain.RateLimit{
+		Capacity:       environments.RateLimitCapacity,
+		RefillInterval: environments.RateLimitRefillInterval,
+	}
+
+	submitUserScoreRateLimit := domain.RateLimit{
+		Capacity:       environments.SubmitUserScoreRateLimitCapacity,
+		RefillInterval: environments.SubmitUserScoreRateLimitRefillInterval,
+	}
+
+	submitVerifiedScoreRateLimit := domain.RateLimit{
+		Capacity:       environments.SubmitVerifiedScoreRateLimitCapacity,
+		RefillInterval: environments.SubmitVerifiedScoreRateLimitRefillInterval,
+	}
+
+	rateLimitInterceptor := grpccontroller.NewRateLimitInterceptor(grpccontroller.RateLimitInterceptorDependencies{
+		RateLimiter: redisRateLimiter,
+		MethodRateLimits: map[string]grpccontroller.MethodRateLimit{
+			"/leaderboard.LeaderboardService/SubmitUserScore": {
+				PerUser: submitUserScoreRateLimit,
+				PerIP:   defaultRateLimit,
+			},
+			// the game servers submit the scores of all their players from
+			// a few ips and are not users, so they only have a larger limit
+			// per ip.
+			"/leaderboard.LeaderboardService/SubmitVerifiedScore": {
+				PerIP: submitVerifiedScoreRateLimit,
+			},
+		},
+		DefaultRateLimit: grpccontroller.MethodRateLimit{
+			PerUser: defaultRateLimit,
+			PerIP:   defaultRateLimit,
 		},
+		Logger: logger,
 	})
 
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor.Intercept),
+		grpc.ChainUnaryInterceptor(
+			requestInfoInterceptor.Intercept,
+			rateLimitInterceptor.InterceptIP,
+			adminInterceptor.Intercept,
+			unaryInterceptor.Intercept,
+			rateLimitInterceptor.Intercept,
+		),
+		grpc.ChainStreamInterceptor(
+			requestInfoInterceptor.InterceptStream,
+			rateLimitInterceptor.InterceptIPStream,
+			unaryInterceptor.InterceptStream,
+			rateLimitInterceptor.InterceptStream,
+		),
 	)
 
+	leaderboardReconciler := service.NewLeaderboardReconciler(service.LeaderboardReconcilerDependencies{
+		UserRepository:      mongoUserRepository,
+		UserScoreRepository: redisUserScoreRepository,
+		Interval:            environments.LeaderboardReconcileInterval,
+		Metrics:             expvar.NewMap("leaderboard_reconciler"),
+		Logger:              logger,
+	})
+
+	go leaderboardReconciler.Run(context.Background())
+
+	tournamentScheduler := service.NewTournamentScheduler(service.TournamentSchedulerDependencies{
+		TournamentService: tournamentService,
+		Interval:          environments.TournamentSchedulerInterval,
+		Metrics:           expvar.NewMap("tournament_scheduler"),
+		Logger:            logger,
+	})
+
+	go tournamentScheduler.Run(context.Background())
+
+	eventFreezer := service.NewEventFreezer(service.EventFreezerDependencies{
+		EventService: eventService,
+		Interval:     environments.EventFreezeInterval,
+		Metrics:      expvar.NewMap("event_freezer"),
+		Logger:       logger,
+	})
+
+	go eventFreezer.Run(context.Background())
+
+	// the metrics are published with expvar and served as json.
+	go func() {
+		err := http.ListenAndServe(":"+environments.MetricsServerPort, expvar.Handler())
+		if err != nil {
+			logger.Error("failed to serve metrics", err)
+		}
+	}()
+
 	user.RegisterUserServiceServer(server, userController)
 	leaderboard.RegisterLeaderboardServiceServer(server, leaderboardController)
+	gameserver.RegisterGameServerAdminServiceServer(server, gameServerController)
+	moderation.RegisterLeaderboardModerationServiceServer(server, moderationController)
+	audit.RegisterAuditLogServiceServer(server, auditLogController)
+	privacy.RegisterPrivacyServiceServer(server, privacyController)
+	social.RegisterSocialServiceServer(server, socialController)
+	clan.RegisterClanServiceServer(server, clanController)
+	reward.RegisterRewardServiceServer(server, rewardController)
+	reward.RegisterRewardAdminServiceServer(server, rewardAdminController)
+	achievement.RegisterAchievementServiceServer(server, achievementController)
+	rating.RegisterRatingServiceServer(server, ratingController)
+	rating.RegisterRatingAdminServiceServer(server, ratingAdminController)
+	matchmaking.RegisterMatchmakingServiceServer(server, matchmakingController)
+	tournament.RegisterTournamentServiceServer(server, tournamentController)
+	tournament.RegisterTournamentAdminServiceServer(server, tournamentAdminController)
+	event.RegisterEventServiceServer(server, eventController)
+	event.RegisterEventAdminServiceServer(server, eventAdminController)
+	wallet.RegisterWalletServiceServer(server, walletController)
+	wallet.RegisterWalletAdminServiceServer(server, walletAdminController)
+	storage.RegisterStorageServiceServer(server, storageController)
 
 	listener, err := net.Listen("tcp", ":"+environments.GrpcServerPort)
 	if err != nil {
@@ -144,10 +909,9 @@ func connectToMongoDB(
 	return client, nil
 }
 
-func createRedisUserScoreRepository(
+func connectToRedis(
 	redisAddr string,
-	userRepository domain.UserRepository,
-) (domain.UserScoreRepository, error) {
+) (*redis.Client, error) {
 	client := redis.NewClient(&redis.Options{
 		Addr: redisAddr,
 	})
@@ -157,10 +921,5 @@ func createRedisUserScoreRepository(
 		return nil, err
 	}
 
-	return userscoreredis.NewRedisUserScoreRepository(
-		userscoreredis.RedisUserScoreRepositoryDependencies{
-			Client:         client,
-			UserRepository: userRepository,
-		},
-	), nil
+	return client, nil
 }
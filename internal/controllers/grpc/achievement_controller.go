@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	achievementpb "game/internal/proto/achievement/proto"
+	"game/internal/services"
+)
+
+type AchievementControllerDependencies struct {
+	AchievementService services.AchievementService
+
+	Logger *logrus.Logger
+}
+
+type achievementController struct {
+	achievementpb.UnimplementedAchievementServiceServer
+
+	achievementService services.AchievementService
+
+	logger *logrus.Logger
+}
+
+func NewAchievementController(deps AchievementControllerDependencies) *achievementController {
+	return &achievementController{
+		achievementService: deps.AchievementService,
+		logger:             deps.Logger,
+	}
+}
+
+func (controller *achievementController) ListAchievements(ctx context.Context, request *achievementpb.ListAchievementsRequest) (*achievementpb.ListAchievementsResponse, error) {
+	controller.logger.Info("list achievements request has been received")
+
+	achievements, err := controller.achievementService.ListAchievements(ctx)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			Error("failed to list achievements")
+
+		return nil, ErrInternal
+	}
+
+	var responses []*achievementpb.Achievement
+
+	for _, achievement := range achievements {
+		responses = append(responses, toAchievementResponse(achievement))
+	}
+
+	return &achievementpb.ListAchievementsResponse{
+		Status:       StatusSuccess,
+		Timestamp:    time.Now().Unix(),
+		Achievements: responses,
+	}, nil
+}
+
+func (controller *achievementController) GetUserAchievements(ctx context.Context, request *achievementpb.GetUserAchievementsRequest) (*achievementpb.GetUserAchievementsResponse, error) {
+	controller.logger.
+		WithField("user_id", request.UserID).
+		Info("get user achievements request has been received")
+
+	userID := request.UserID
+
+	if userID == "" {
+		var ok bool
+
+		userID, ok = ctx.Value(ContextKeyUserID).(string)
+		if !ok {
+			return nil, ErrInvalidUserID
+		}
+	}
+
+	achievements, err := controller.achievementService.GetUserAchievements(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to get user achievements")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	var responses []*achievementpb.UserAchievement
+
+	for _, achievement := range achievements {
+		responses = append(responses, &achievementpb.UserAchievement{
+			Achievement: toAchievementResponse(achievement.Achievement),
+			UnlockedAt:  achievement.UserAchievement.UnlockedAt.Unix(),
+		})
+	}
+
+	return &achievementpb.GetUserAchievementsResponse{
+		Status:       StatusSuccess,
+		Timestamp:    time.Now().Unix(),
+		Achievements: responses,
+	}, nil
+}
+
+func toAchievementResponse(achievement domain.Achievement) *achievementpb.Achievement {
+	return &achievementpb.Achievement{
+		Id:        achievement.ID,
+		Name:      achievement.Name,
+		Kind:      achievement.Kind,
+		Threshold: achievement.Threshold,
+	}
+}
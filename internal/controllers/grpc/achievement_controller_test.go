@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	achievementpb "game/internal/proto/achievement/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type AchievementControllerTestSuite struct {
+	suite.Suite
+
+	controller *achievementController
+
+	mockAchievementService *mocks.MockAchievementService
+}
+
+func TestAchievementControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AchievementControllerTestSuite))
+}
+
+func (suite *AchievementControllerTestSuite) SetupTest() {
+	suite.mockAchievementService = mocks.NewMockAchievementService(suite.T())
+
+	suite.controller = NewAchievementController(AchievementControllerDependencies{
+		AchievementService: suite.mockAchievementService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *AchievementControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *AchievementControllerTestSuite) TestListAchievements() {
+	suite.mockAchievementService.
+		EXPECT().
+		ListAchievements(mock.Anything).
+		Return([]domain.Achievement{
+			{ID: "top_10", Name: "Top Ten", Kind: domain.AchievementRank, Threshold: 10},
+		}, nil)
+
+	result, err := suite.controller.ListAchievements(suite.userContext(), &achievementpb.ListAchievementsRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Len(result.Achievements, 1)
+	suite.Equal("top_10", result.Achievements[0].Id)
+	suite.Equal("Top Ten", result.Achievements[0].Name)
+	suite.Equal(domain.AchievementRank, result.Achievements[0].Kind)
+	suite.Equal(float64(10), result.Achievements[0].Threshold)
+}
+
+func (suite *AchievementControllerTestSuite) TestGetUserAchievements_LoggedInUser() {
+	unlockedAt := time.Unix(1700000000, 0)
+
+	suite.mockAchievementService.
+		EXPECT().
+		GetUserAchievements(mock.Anything, "user-id").
+		Return([]services.UnlockedAchievement{
+			{
+				Achievement:     domain.Achievement{ID: "top_10", Name: "Top Ten"},
+				UserAchievement: domain.UserAchievement{UserID: "user-id", AchievementID: "top_10", UnlockedAt: unlockedAt},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetUserAchievements(suite.userContext(), &achievementpb.GetUserAchievementsRequest{})
+	suite.NoError(err)
+
+	suite.Len(result.Achievements, 1)
+	suite.Equal("top_10", result.Achievements[0].Achievement.Id)
+	suite.Equal(unlockedAt.Unix(), result.Achievements[0].UnlockedAt)
+}
+
+func (suite *AchievementControllerTestSuite) TestGetUserAchievements_OtherUser() {
+	suite.mockAchievementService.
+		EXPECT().
+		GetUserAchievements(mock.Anything, "user-id-2").
+		Return([]services.UnlockedAchievement{}, nil)
+
+	result, err := suite.controller.GetUserAchievements(suite.userContext(), &achievementpb.GetUserAchievementsRequest{
+		UserID: "user-id-2",
+	})
+	suite.NoError(err)
+	suite.Empty(result.Achievements)
+}
+
+func (suite *AchievementControllerTestSuite) TestGetUserAchievements_NoUserID() {
+	result, err := suite.controller.GetUserAchievements(context.Background(), &achievementpb.GetUserAchievementsRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *AchievementControllerTestSuite) TestGetUserAchievements_UserNotFound() {
+	suite.mockAchievementService.
+		EXPECT().
+		GetUserAchievements(mock.Anything, "user-id-2").
+		Return(nil, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetUserAchievements(suite.userContext(), &achievementpb.GetUserAchievementsRequest{
+		UserID: "user-id-2",
+	})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
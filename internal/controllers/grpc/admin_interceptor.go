@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const HeaderAdminAPIKey = "x-admin-api-key"
+
+var (
+	ErrPermissionDenied = status.New(codes.PermissionDenied, "permission denied").Err()
+)
+
+type AdminInterceptorDependencies struct {
+	AdminAPIKey      string
+	AdminMethodNames []string
+}
+
+// AdminInterceptor guards the administrative methods with a static API key
+// that is sent in the x-admin-api-key metadata.
+type AdminInterceptor struct {
+	adminAPIKey      []byte
+	adminMethodNames map[string]struct{}
+}
+
+func NewAdminInterceptor(deps AdminInterceptorDependencies) *AdminInterceptor {
+	adminMethodNames := make(map[string]struct{})
+
+	for _, methodName := range deps.AdminMethodNames {
+		adminMethodNames[methodName] = struct{}{}
+	}
+
+	return &AdminInterceptor{
+		adminAPIKey:      []byte(deps.AdminAPIKey),
+		adminMethodNames: adminMethodNames,
+	}
+}
+
+func (interceptor *AdminInterceptor) Intercept(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if _, ok := interceptor.adminMethodNames[info.FullMethod]; ok {
+		err := interceptor.authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (interceptor *AdminInterceptor) authorize(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrInvalidMetadata
+	}
+
+	values := md[HeaderAdminAPIKey]
+	if len(values) == 0 || len(interceptor.adminAPIKey) == 0 {
+		return ErrPermissionDenied
+	}
+
+	if subtle.ConstantTimeCompare([]byte(values[0]), interceptor.adminAPIKey) != 1 {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type AdminInterceptorTestSuite struct {
+	suite.Suite
+
+	interceptor *AdminInterceptor
+}
+
+func TestAdminInterceptorTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminInterceptorTestSuite))
+}
+
+func (suite *AdminInterceptorTestSuite) SetupTest() {
+	suite.interceptor = NewAdminInterceptor(AdminInterceptorDependencies{
+		AdminAPIKey: "admin-api-key",
+		AdminMethodNames: []string{
+			"admin-method",
+		},
+	})
+}
+
+func (suite *AdminInterceptorTestSuite) intercept(ctx context.Context, method string) (bool, error) {
+	handlerCalled := false
+
+	_, err := suite.interceptor.Intercept(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: method,
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return nil, nil
+	})
+
+	return handlerCalled, err
+}
+
+func (suite *AdminInterceptorTestSuite) TestAdminInterceptor() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		HeaderAdminAPIKey: "admin-api-key",
+	}))
+
+	handlerCalled, err := suite.intercept(ctx, "admin-method")
+	suite.NoError(err)
+	suite.True(handlerCalled)
+}
+
+func (suite *AdminInterceptorTestSuite) TestAdminInterceptor_WrongKey() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		HeaderAdminAPIKey: "wrong-key",
+	}))
+
+	handlerCalled, err := suite.intercept(ctx, "admin-method")
+	suite.ErrorIs(err, ErrPermissionDenied)
+	suite.False(handlerCalled)
+}
+
+func (suite *AdminInterceptorTestSuite) TestAdminInterceptor_NoMetadata() {
+	handlerCalled, err := suite.intercept(context.Background(), "admin-method")
+	suite.ErrorIs(err, ErrInvalidMetadata)
+	suite.False(handlerCalled)
+}
+
+func (suite *AdminInterceptorTestSuite) TestAdminInterceptor_NotAdminMethod() {
+	handlerCalled, err := suite.intercept(context.Background(), "some-method")
+	suite.NoError(err)
+	suite.True(handlerCalled)
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	auditpb "game/internal/proto/audit/proto"
+	"game/internal/services"
+)
+
+type AuditLogControllerDependencies struct {
+	AuditLogService services.AuditLogService
+
+	Logger *logrus.Logger
+}
+
+type auditLogController struct {
+	auditpb.UnimplementedAuditLogServiceServer
+
+	auditLogService services.AuditLogService
+
+	logger *logrus.Logger
+}
+
+func NewAuditLogController(deps AuditLogControllerDependencies) *auditLogController {
+	return &auditLogController{
+		auditLogService: deps.AuditLogService,
+		logger:          deps.Logger,
+	}
+}
+
+func (controller *auditLogController) QueryAuditLog(ctx context.Context, request *auditpb.QueryAuditLogRequest) (*auditpb.QueryAuditLogResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"user_id": request.UserID,
+			"type":    request.Type,
+		}).
+		Info("query audit log request has been received")
+
+	filter := domain.AuditLogFilter{
+		UserID: request.UserID,
+		Type:   request.Type,
+		Limit:  request.Limit,
+	}
+
+	if request.From > 0 {
+		filter.From = time.Unix(request.From, 0)
+	}
+
+	if request.To > 0 {
+		filter.To = time.Unix(request.To, 0)
+	}
+
+	events, err := controller.auditLogService.Query(ctx, filter)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			Error("failed to query audit log")
+
+		return nil, ErrInternal
+	}
+
+	var results []*auditpb.AuditEvent
+
+	for _, event := range events {
+		results = append(results, &auditpb.AuditEvent{
+			Id:        event.ID,
+			Type:      event.Type,
+			Actor:     event.Actor,
+			UserID:    event.UserID,
+			PeerIP:    event.PeerIP,
+			RequestID: event.RequestID,
+			OldScore:  event.OldScore,
+			NewScore:  event.NewScore,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	}
+
+	return &auditpb.QueryAuditLogResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Results:   results,
+	}, nil
+}
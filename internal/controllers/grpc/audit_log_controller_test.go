@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	auditpb "game/internal/proto/audit/proto"
+	"game/internal/services/mocks"
+)
+
+type AuditLogControllerTestSuite struct {
+	suite.Suite
+
+	controller *auditLogController
+
+	mockAuditLogService *mocks.MockAuditLogService
+}
+
+func TestAuditLogControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogControllerTestSuite))
+}
+
+func (suite *AuditLogControllerTestSuite) SetupTest() {
+	suite.mockAuditLogService = mocks.NewMockAuditLogService(suite.T())
+
+	suite.controller = NewAuditLogController(AuditLogControllerDependencies{
+		AuditLogService: suite.mockAuditLogService,
+		Logger:          logrus.New(),
+	})
+}
+
+func (suite *AuditLogControllerTestSuite) TestQueryAuditLog() {
+	createdAt := time.Unix(1700000000, 0)
+	score := float64(100)
+
+	suite.mockAuditLogService.
+		EXPECT().
+		Query(mock.Anything, domain.AuditLogFilter{
+			UserID: "user-id",
+			Type:   domain.AuditEventScoreSubmitted,
+			From:   time.Unix(1600000000, 0),
+			Limit:  10,
+		}).
+		Return([]domain.AuditEvent{
+			{
+				ID:        "event-id",
+				Type:      domain.AuditEventScoreSubmitted,
+				Actor:     "user-id",
+				UserID:    "user-id",
+				PeerIP:    "10.0.0.1",
+				RequestID: "request-id",
+				NewScore:  &score,
+				CreatedAt: createdAt,
+			},
+		}, nil)
+
+	result, err := suite.controller.QueryAuditLog(context.Background(), &auditpb.QueryAuditLogRequest{
+		UserID: "user-id",
+		Type:   domain.AuditEventScoreSubmitted,
+		From:   1600000000,
+		Limit:  10,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal([]*auditpb.AuditEvent{
+		{
+			Id:        "event-id",
+			Type:      domain.AuditEventScoreSubmitted,
+			Actor:     "user-id",
+			UserID:    "user-id",
+			PeerIP:    "10.0.0.1",
+			RequestID: "request-id",
+			NewScore:  &score,
+			CreatedAt: createdAt.Unix(),
+		},
+	}, result.Results)
+}
+
+func (suite *AuditLogControllerTestSuite) TestQueryAuditLog_ServiceFailed() {
+	suite.mockAuditLogService.
+		EXPECT().
+		Query(mock.Anything, domain.AuditLogFilter{}).
+		Return(nil, domain.ErrInternal)
+
+	result, err := suite.controller.QueryAuditLog(context.Background(), &auditpb.QueryAuditLogRequest{})
+	suite.ErrorIs(err, ErrInternal)
+	suite.Empty(result)
+}
@@ -0,0 +1,326 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	clanpb "game/internal/proto/clan/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrClanIDMissing        = status.New(codes.InvalidArgument, "clan id is required").Err()
+	ErrMemberIDMissing      = status.New(codes.InvalidArgument, "user id of the member is required").Err()
+	ErrInvalidClanName      = status.New(codes.InvalidArgument, "invalid clan name").Err()
+	ErrInvalidClanRole      = status.New(codes.InvalidArgument, "invalid clan role").Err()
+	ErrInvalidClanAggregate = status.New(codes.InvalidArgument, "invalid clan aggregate").Err()
+	ErrClanNotFound         = status.New(codes.NotFound, "clan not found").Err()
+	ErrClanNameExists       = status.New(codes.AlreadyExists, "clan name exists").Err()
+	ErrAlreadyInClan        = status.New(codes.FailedPrecondition, "already in a clan").Err()
+	ErrNotInClan            = status.New(codes.FailedPrecondition, "not in a clan").Err()
+	ErrNotClanMember        = status.New(codes.NotFound, "user is not a member of the clan").Err()
+	ErrClanFull             = status.New(codes.ResourceExhausted, "clan full").Err()
+	ErrClanPermissionDenied = status.New(codes.PermissionDenied, "clan permission denied").Err()
+)
+
+type ClanControllerDependencies struct {
+	ClanService services.ClanService
+
+	Logger *logrus.Logger
+}
+
+type clanController struct {
+	clanpb.UnimplementedClanServiceServer
+
+	clanService services.ClanService
+
+	logger *logrus.Logger
+}
+
+func NewClanController(deps ClanControllerDependencies) *clanController {
+	return &clanController{
+		clanService: deps.ClanService,
+		logger:      deps.Logger,
+	}
+}
+
+func (controller *clanController) CreateClan(ctx context.Context, request *clanpb.CreateClanRequest) (*clanpb.CreateClanResponse, error) {
+	controller.logger.
+		WithField("name", request.Name).
+		Info("create clan request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	clan, err := controller.clanService.CreateClan(ctx, userID, request.Name)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id": userID,
+				"name":    request.Name,
+			}).
+			Error("failed to create clan")
+
+		return nil, controller.clanError(err)
+	}
+
+	return &clanpb.CreateClanResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Clan:      toClanResponse(clan),
+	}, nil
+}
+
+func (controller *clanController) GetClan(ctx context.Context, request *clanpb.GetClanRequest) (*clanpb.GetClanResponse, error) {
+	controller.logger.
+		WithField("clan_id", request.ClanID).
+		Info("get clan request has been received")
+
+	if request.ClanID == "" {
+		return nil, ErrClanIDMissing
+	}
+
+	details, err := controller.clanService.GetClan(ctx, request.ClanID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("clan_id", request.ClanID).
+			Error("failed to get clan")
+
+		return nil, controller.clanError(err)
+	}
+
+	var members []*clanpb.ClanMember
+
+	for _, member := range details.Members {
+		members = append(members, &clanpb.ClanMember{
+			UserID:      member.Member.UserID,
+			Username:    member.User.Name,
+			DisplayName: member.User.Profile.DisplayName,
+			CountryCode: member.User.Profile.CountryCode,
+			AvatarURL:   member.User.Profile.AvatarURL,
+			Role:        member.Member.Role,
+			JoinedAt:    member.Member.JoinedAt.Unix(),
+		})
+	}
+
+	return &clanpb.GetClanResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Clan:      toClanResponse(details.Clan),
+		Members:   members,
+	}, nil
+}
+
+func (controller *clanController) JoinClan(ctx context.Context, request *clanpb.JoinClanRequest) (*clanpb.JoinClanResponse, error) {
+	controller.logger.
+		WithField("clan_id", request.ClanID).
+		Info("join clan request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.ClanID == "" {
+		return nil, ErrClanIDMissing
+	}
+
+	err := controller.clanService.JoinClan(ctx, userID, request.ClanID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id": userID,
+				"clan_id": request.ClanID,
+			}).
+			Error("failed to join clan")
+
+		return nil, controller.clanError(err)
+	}
+
+	return &clanpb.JoinClanResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *clanController) LeaveClan(ctx context.Context, request *clanpb.LeaveClanRequest) (*clanpb.LeaveClanResponse, error) {
+	controller.logger.Info("leave clan request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	err := controller.clanService.LeaveClan(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to leave clan")
+
+		return nil, controller.clanError(err)
+	}
+
+	return &clanpb.LeaveClanResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *clanController) KickMember(ctx context.Context, request *clanpb.KickMemberRequest) (*clanpb.KickMemberResponse, error) {
+	controller.logger.
+		WithField("member_id", request.UserID).
+		Info("kick member request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrMemberIDMissing
+	}
+
+	err := controller.clanService.KickMember(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"member_id": request.UserID,
+			}).
+			Error("failed to kick member")
+
+		return nil, controller.clanError(err)
+	}
+
+	return &clanpb.KickMemberResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *clanController) SetMemberRole(ctx context.Context, request *clanpb.SetMemberRoleRequest) (*clanpb.SetMemberRoleResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"member_id": request.UserID,
+			"role":      request.Role,
+		}).
+		Info("set member role request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrMemberIDMissing
+	}
+
+	err := controller.clanService.SetMemberRole(ctx, userID, request.UserID, request.Role)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"member_id": request.UserID,
+				"role":      request.Role,
+			}).
+			Error("failed to set member role")
+
+		return nil, controller.clanError(err)
+	}
+
+	return &clanpb.SetMemberRoleResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *clanController) GetClanLeaderboard(ctx context.Context, request *clanpb.GetClanLeaderboardRequest) (*clanpb.GetClanLeaderboardResponse, error) {
+	controller.logger.
+		WithField("aggregate", request.Aggregate).
+		Info("get clan leaderboard request has been received")
+
+	aggregate := request.Aggregate
+	if aggregate == "" {
+		aggregate = domain.ClanAggregateSum
+	}
+
+	leaderboard, err := controller.clanService.GetClanLeaderboard(ctx, aggregate)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("aggregate", aggregate).
+			Error("failed to get clan leaderboard")
+
+		return nil, controller.clanError(err)
+	}
+
+	var results []*clanpb.ClanScore
+
+	for _, clanScore := range leaderboard.ClanScores {
+		results = append(results, &clanpb.ClanScore{
+			ClanID:        clanScore.ClanID,
+			Name:          clanScore.Name,
+			Score:         clanScore.Score,
+			ScoredMembers: clanScore.ScoredMembers,
+		})
+	}
+
+	return &clanpb.GetClanLeaderboardResponse{
+		Status:      StatusSuccess,
+		Timestamp:   time.Now().Unix(),
+		Aggregate:   leaderboard.Aggregate,
+		Results:     results,
+		GeneratedAt: leaderboard.GeneratedAt.Unix(),
+	}, nil
+}
+
+func (controller *clanController) clanError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrSameUser):
+		return ErrSameUser
+	case errors.Is(err, services.ErrInvalidClanName):
+		return ErrInvalidClanName
+	case errors.Is(err, services.ErrInvalidClanRole):
+		return ErrInvalidClanRole
+	case errors.Is(err, services.ErrInvalidClanAggregate):
+		return ErrInvalidClanAggregate
+	case errors.Is(err, services.ErrClanNameExists):
+		return ErrClanNameExists
+	case errors.Is(err, services.ErrAlreadyInClan):
+		return ErrAlreadyInClan
+	case errors.Is(err, services.ErrNotInClan):
+		return ErrNotInClan
+	case errors.Is(err, services.ErrNotClanMember):
+		return ErrNotClanMember
+	case errors.Is(err, services.ErrClanFull):
+		return ErrClanFull
+	case errors.Is(err, services.ErrClanPermissionDenied):
+		return ErrClanPermissionDenied
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrClanNotFound
+	default:
+		return ErrInternal
+	}
+}
+
+func toClanResponse(clan domain.Clan) *clanpb.Clan {
+	return &clanpb.Clan{
+		Id:        clan.ID,
+		Name:      clan.Name,
+		CreatedAt: clan.CreatedAt.Unix(),
+	}
+}
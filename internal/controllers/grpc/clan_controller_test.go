@@ -0,0 +1,241 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	clanpb "game/internal/proto/clan/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type ClanControllerTestSuite struct {
+	suite.Suite
+
+	controller *clanController
+
+	mockClanService *mocks.MockClanService
+}
+
+func TestClanControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ClanControllerTestSuite))
+}
+
+func (suite *ClanControllerTestSuite) SetupTest() {
+	suite.mockClanService = mocks.NewMockClanService(suite.T())
+
+	suite.controller = NewClanController(ClanControllerDependencies{
+		ClanService: suite.mockClanService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *ClanControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *ClanControllerTestSuite) TestCreateClan() {
+	createdAt := time.Unix(1700000000, 0)
+
+	suite.mockClanService.
+		EXPECT().
+		CreateClan(mock.Anything, "user-id", "The Clan").
+		Return(domain.Clan{ID: "clan-id", Name: "The Clan", CreatedAt: createdAt}, nil)
+
+	result, err := suite.controller.CreateClan(suite.userContext(), &clanpb.CreateClanRequest{
+		Name: "The Clan",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("clan-id", result.Clan.Id)
+	suite.Equal("The Clan", result.Clan.Name)
+	suite.Equal(createdAt.Unix(), result.Clan.CreatedAt)
+}
+
+func (suite *ClanControllerTestSuite) TestCreateClan_NoUserID() {
+	result, err := suite.controller.CreateClan(context.Background(), &clanpb.CreateClanRequest{
+		Name: "The Clan",
+	})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *ClanControllerTestSuite) TestCreateClan_InvalidName() {
+	suite.mockClanService.
+		EXPECT().
+		CreateClan(mock.Anything, "user-id", "ab").
+		Return(domain.Clan{}, fmt.Errorf("%w, name is too short", services.ErrInvalidClanName))
+
+	_, err := suite.controller.CreateClan(suite.userContext(), &clanpb.CreateClanRequest{
+		Name: "ab",
+	})
+	suite.ErrorIs(err, ErrInvalidClanName)
+}
+
+func (suite *ClanControllerTestSuite) TestCreateClan_NameExists() {
+	suite.mockClanService.
+		EXPECT().
+		CreateClan(mock.Anything, "user-id", "The Clan").
+		Return(domain.Clan{}, services.ErrClanNameExists)
+
+	_, err := suite.controller.CreateClan(suite.userContext(), &clanpb.CreateClanRequest{
+		Name: "The Clan",
+	})
+	suite.ErrorIs(err, ErrClanNameExists)
+}
+
+func (suite *ClanControllerTestSuite) TestGetClan() {
+	suite.mockClanService.
+		EXPECT().
+		GetClan(mock.Anything, "clan-id").
+		Return(services.ClanDetails{
+			Clan: domain.Clan{ID: "clan-id", Name: "The Clan"},
+			Members: []services.ClanMemberDetails{
+				{
+					Member: domain.ClanMember{UserID: "user-id", Role: domain.ClanRoleLeader},
+					User: domain.PublicUser{
+						Name:    "user",
+						Profile: domain.UserProfile{DisplayName: "User", CountryCode: "DE"},
+					},
+				},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetClan(context.Background(), &clanpb.GetClanRequest{
+		ClanID: "clan-id",
+	})
+	suite.NoError(err)
+
+	suite.Equal("The Clan", result.Clan.Name)
+	suite.Len(result.Members, 1)
+	suite.Equal("user-id", result.Members[0].UserID)
+	suite.Equal("user", result.Members[0].Username)
+	suite.Equal("User", result.Members[0].DisplayName)
+	suite.Equal("DE", result.Members[0].CountryCode)
+	suite.Equal(domain.ClanRoleLeader, result.Members[0].Role)
+}
+
+func (suite *ClanControllerTestSuite) TestGetClan_NoClanID() {
+	_, err := suite.controller.GetClan(context.Background(), &clanpb.GetClanRequest{})
+	suite.ErrorIs(err, ErrClanIDMissing)
+}
+
+func (suite *ClanControllerTestSuite) TestGetClan_NotFound() {
+	suite.mockClanService.
+		EXPECT().
+		GetClan(mock.Anything, "clan-id").
+		Return(services.ClanDetails{}, domain.ErrResourceNotFound)
+
+	_, err := suite.controller.GetClan(context.Background(), &clanpb.GetClanRequest{
+		ClanID: "clan-id",
+	})
+	suite.ErrorIs(err, ErrClanNotFound)
+}
+
+func (suite *ClanControllerTestSuite) TestJoinClan() {
+	suite.mockClanService.
+		EXPECT().
+		JoinClan(mock.Anything, "user-id", "clan-id").
+		Return(nil)
+
+	result, err := suite.controller.JoinClan(suite.userContext(), &clanpb.JoinClanRequest{
+		ClanID: "clan-id",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *ClanControllerTestSuite) TestJoinClan_Full() {
+	suite.mockClanService.
+		EXPECT().
+		JoinClan(mock.Anything, "user-id", "clan-id").
+		Return(services.ErrClanFull)
+
+	_, err := suite.controller.JoinClan(suite.userContext(), &clanpb.JoinClanRequest{
+		ClanID: "clan-id",
+	})
+	suite.ErrorIs(err, ErrClanFull)
+}
+
+func (suite *ClanControllerTestSuite) TestLeaveClan_NotInClan() {
+	suite.mockClanService.
+		EXPECT().
+		LeaveClan(mock.Anything, "user-id").
+		Return(services.ErrNotInClan)
+
+	_, err := suite.controller.LeaveClan(suite.userContext(), &clanpb.LeaveClanRequest{})
+	suite.ErrorIs(err, ErrNotInClan)
+}
+
+func (suite *ClanControllerTestSuite) TestKickMember_PermissionDenied() {
+	suite.mockClanService.
+		EXPECT().
+		KickMember(mock.Anything, "user-id", "member-id").
+		Return(services.ErrClanPermissionDenied)
+
+	_, err := suite.controller.KickMember(suite.userContext(), &clanpb.KickMemberRequest{
+		UserID: "member-id",
+	})
+	suite.ErrorIs(err, ErrClanPermissionDenied)
+}
+
+func (suite *ClanControllerTestSuite) TestKickMember_NoMemberID() {
+	_, err := suite.controller.KickMember(suite.userContext(), &clanpb.KickMemberRequest{})
+	suite.ErrorIs(err, ErrMemberIDMissing)
+}
+
+func (suite *ClanControllerTestSuite) TestSetMemberRole() {
+	suite.mockClanService.
+		EXPECT().
+		SetMemberRole(mock.Anything, "user-id", "member-id", domain.ClanRoleOfficer).
+		Return(nil)
+
+	result, err := suite.controller.SetMemberRole(suite.userContext(), &clanpb.SetMemberRoleRequest{
+		UserID: "member-id",
+		Role:   domain.ClanRoleOfficer,
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *ClanControllerTestSuite) TestGetClanLeaderboard() {
+	suite.mockClanService.
+		EXPECT().
+		GetClanLeaderboard(mock.Anything, domain.ClanAggregateSum).
+		Return(domain.ClanLeaderboard{
+			Aggregate: domain.ClanAggregateSum,
+			ClanScores: []domain.ClanScore{
+				{ClanID: "clan-id", Name: "The Clan", Score: 900, ScoredMembers: 2},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetClanLeaderboard(context.Background(), &clanpb.GetClanLeaderboardRequest{})
+	suite.NoError(err)
+
+	suite.Equal(domain.ClanAggregateSum, result.Aggregate)
+	suite.Len(result.Results, 1)
+	suite.Equal("The Clan", result.Results[0].Name)
+	suite.Equal(float64(900), result.Results[0].Score)
+	suite.Equal(int64(2), result.Results[0].ScoredMembers)
+}
+
+func (suite *ClanControllerTestSuite) TestGetClanLeaderboard_InvalidAggregate() {
+	suite.mockClanService.
+		EXPECT().
+		GetClanLeaderboard(mock.Anything, "median").
+		Return(domain.ClanLeaderboard{}, services.ErrInvalidClanAggregate)
+
+	_, err := suite.controller.GetClanLeaderboard(context.Background(), &clanpb.GetClanLeaderboardRequest{
+		Aggregate: "median",
+	})
+	suite.ErrorIs(err, ErrInvalidClanAggregate)
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	eventpb "game/internal/proto/event/proto"
+	"game/internal/services"
+)
+
+type EventAdminControllerDependencies struct {
+	EventService services.EventService
+
+	Logger *logrus.Logger
+}
+
+type eventAdminController struct {
+	eventpb.UnimplementedEventAdminServiceServer
+
+	eventService services.EventService
+
+	logger *logrus.Logger
+}
+
+func NewEventAdminController(deps EventAdminControllerDependencies) *eventAdminController {
+	return &eventAdminController{
+		eventService: deps.EventService,
+		logger:       deps.Logger,
+	}
+}
+
+func (controller *eventAdminController) CreateEvent(ctx context.Context, request *eventpb.CreateEventRequest) (*eventpb.CreateEventResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"name": request.Name,
+			"mode": request.Mode,
+		}).
+		Info("create event request has been received")
+
+	event, err := controller.eventService.CreateEvent(ctx, domain.Event{
+		Name:        request.Name,
+		Mode:        request.Mode,
+		StartsAt:    time.Unix(request.StartsAt, 0),
+		EndsAt:      time.Unix(request.EndsAt, 0),
+		MaxAttempts: request.MaxAttempts,
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("name", request.Name).
+			Error("failed to create event")
+
+		return nil, eventError(err)
+	}
+
+	controller.logger.
+		WithField("event_id", event.ID).
+		Info("event has been created")
+
+	return &eventpb.CreateEventResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Event:     toEventResponse(event),
+	}, nil
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	eventpb "game/internal/proto/event/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type EventAdminControllerTestSuite struct {
+	suite.Suite
+
+	controller *eventAdminController
+
+	mockEventService *mocks.MockEventService
+}
+
+func TestEventAdminControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(EventAdminControllerTestSuite))
+}
+
+func (suite *EventAdminControllerTestSuite) SetupTest() {
+	suite.mockEventService = mocks.NewMockEventService(suite.T())
+
+	suite.controller = NewEventAdminController(EventAdminControllerDependencies{
+		EventService: suite.mockEventService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *EventAdminControllerTestSuite) TestCreateEvent() {
+	suite.mockEventService.
+		EXPECT().
+		CreateEvent(mock.Anything, domain.Event{
+			Name:        "Weekend Rush",
+			Mode:        "duel",
+			StartsAt:    time.Unix(1700000000, 0),
+			EndsAt:      time.Unix(1700172800, 0),
+			MaxAttempts: 5,
+		}).
+		Return(domain.Event{
+			ID:          "event-id",
+			Name:        "Weekend Rush",
+			Mode:        "duel",
+			StartsAt:    time.Unix(1700000000, 0),
+			EndsAt:      time.Unix(1700172800, 0),
+			MaxAttempts: 5,
+			CreatedAt:   time.Unix(1690000000, 0),
+		}, nil)
+
+	result, err := suite.controller.CreateEvent(context.Background(), &eventpb.CreateEventRequest{
+		Name:        "Weekend Rush",
+		Mode:        "duel",
+		StartsAt:    1700000000,
+		EndsAt:      1700172800,
+		MaxAttempts: 5,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("event-id", result.Event.Id)
+	suite.Equal(int64(1690000000), result.Event.CreatedAt)
+}
+
+func (suite *EventAdminControllerTestSuite) TestCreateEvent_Invalid() {
+	suite.mockEventService.
+		EXPECT().
+		CreateEvent(mock.Anything, mock.Anything).
+		Return(domain.Event{}, services.ErrInvalidEvent)
+
+	result, err := suite.controller.CreateEvent(context.Background(), &eventpb.CreateEventRequest{
+		Mode: "battle_royale",
+	})
+	suite.ErrorIs(err, ErrInvalidEvent)
+	suite.Empty(result)
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	eventpb "game/internal/proto/event/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrEventIDMissing  = status.New(codes.InvalidArgument, "event id is required").Err()
+	ErrInvalidEvent    = status.New(codes.InvalidArgument, "invalid event").Err()
+	ErrIneligibleMode  = status.New(codes.InvalidArgument, "ineligible mode").Err()
+	ErrEventNotFound   = status.New(codes.NotFound, "event not found").Err()
+	ErrEventNotStarted = status.New(codes.FailedPrecondition, "event not started").Err()
+	ErrEventClosed     = status.New(codes.FailedPrecondition, "event closed").Err()
+	ErrNoAttemptsLeft  = status.New(codes.ResourceExhausted, "no attempts left").Err()
+)
+
+type EventControllerDependencies struct {
+	EventService services.EventService
+
+	Logger *logrus.Logger
+}
+
+type eventController struct {
+	eventpb.UnimplementedEventServiceServer
+
+	eventService services.EventService
+
+	logger *logrus.Logger
+}
+
+func NewEventController(deps EventControllerDependencies) *eventController {
+	return &eventController{
+		eventService: deps.EventService,
+		logger:       deps.Logger,
+	}
+}
+
+func (controller *eventController) ListEvents(ctx context.Context, request *eventpb.ListEventsRequest) (*eventpb.ListEventsResponse, error) {
+	controller.logger.Info("list events request has been received")
+
+	events, err := controller.eventService.ListEvents(ctx)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			Error("failed to list events")
+
+		return nil, eventError(err)
+	}
+
+	var results []*eventpb.Event
+
+	for _, event := range events {
+		results = append(results, toEventResponse(event))
+	}
+
+	return &eventpb.ListEventsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result:    results,
+	}, nil
+}
+
+func (controller *eventController) GetEvent(ctx context.Context, request *eventpb.GetEventRequest) (*eventpb.GetEventResponse, error) {
+	controller.logger.
+		WithField("event_id", request.Id).
+		Info("get event request has been received")
+
+	if request.Id == "" {
+		return nil, ErrEventIDMissing
+	}
+
+	event, err := controller.eventService.GetEvent(ctx, request.Id)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("event_id", request.Id).
+			Error("failed to get event")
+
+		return nil, eventError(err)
+	}
+
+	return &eventpb.GetEventResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Event:     toEventResponse(event),
+	}, nil
+}
+
+func (controller *eventController) GetEventLeaderboard(ctx context.Context, request *eventpb.GetEventLeaderboardRequest) (*eventpb.GetEventLeaderboardResponse, error) {
+	controller.logger.
+		WithField("event_id", request.Id).
+		Info("get event leaderboard request has been received")
+
+	if request.Id == "" {
+		return nil, ErrEventIDMissing
+	}
+
+	leaderboard, err := controller.eventService.GetEventLeaderboard(ctx, request.Id)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("event_id", request.Id).
+			Error("failed to get event leaderboard")
+
+		return nil, eventError(err)
+	}
+
+	var results []*eventpb.EventUserScore
+
+	for _, userScore := range leaderboard.UserScores {
+		results = append(results, &eventpb.EventUserScore{
+			UserID:      userScore.UserID,
+			Username:    userScore.Username,
+			Score:       userScore.Score,
+			Missing:     userScore.Missing,
+			DisplayName: userScore.Profile.DisplayName,
+			CountryCode: userScore.Profile.CountryCode,
+			AvatarURL:   userScore.Profile.AvatarURL,
+			Rank:        userScore.Rank,
+			Percentile:  userScore.Percentile,
+		})
+	}
+
+	return &eventpb.GetEventLeaderboardResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result:    results,
+	}, nil
+}
+
+func eventError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidEvent):
+		return ErrInvalidEvent
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrEventNotFound
+	default:
+		return ErrInternal
+	}
+}
+
+func toEventResponse(event domain.Event) *eventpb.Event {
+	response := &eventpb.Event{
+		Id:          event.ID,
+		Name:        event.Name,
+		Mode:        event.Mode,
+		StartsAt:    event.StartsAt.Unix(),
+		EndsAt:      event.EndsAt.Unix(),
+		MaxAttempts: event.MaxAttempts,
+		CreatedAt:   event.CreatedAt.Unix(),
+	}
+
+	if !event.FrozenAt.IsZero() {
+		response.FrozenAt = event.FrozenAt.Unix()
+	}
+
+	return response
+}
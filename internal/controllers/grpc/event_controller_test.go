@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	eventpb "game/internal/proto/event/proto"
+	"game/internal/services/mocks"
+)
+
+type EventControllerTestSuite struct {
+	suite.Suite
+
+	controller *eventController
+
+	mockEventService *mocks.MockEventService
+}
+
+func TestEventControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(EventControllerTestSuite))
+}
+
+func (suite *EventControllerTestSuite) SetupTest() {
+	suite.mockEventService = mocks.NewMockEventService(suite.T())
+
+	suite.controller = NewEventController(EventControllerDependencies{
+		EventService: suite.mockEventService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *EventControllerTestSuite) TestListEvents() {
+	suite.mockEventService.
+		EXPECT().
+		ListEvents(mock.Anything).
+		Return([]domain.Event{
+			{ID: "event-id-1", Name: "Weekend Rush", Mode: "duel", StartsAt: time.Unix(1700000000, 0), EndsAt: time.Unix(1700172800, 0), MaxAttempts: 5},
+			{ID: "event-id-2", FrozenAt: time.Unix(1700172900, 0)},
+		}, nil)
+
+	result, err := suite.controller.ListEvents(context.Background(), &eventpb.ListEventsRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Len(result.Result, 2)
+	suite.Equal(int64(1700172800), result.Result[0].EndsAt)
+	suite.Equal(int64(5), result.Result[0].MaxAttempts)
+	suite.Zero(result.Result[0].FrozenAt)
+	suite.Equal(int64(1700172900), result.Result[1].FrozenAt)
+}
+
+func (suite *EventControllerTestSuite) TestGetEvent() {
+	suite.mockEventService.
+		EXPECT().
+		GetEvent(mock.Anything, "event-id").
+		Return(domain.Event{ID: "event-id", Mode: "duel"}, nil)
+
+	result, err := suite.controller.GetEvent(context.Background(), &eventpb.GetEventRequest{Id: "event-id"})
+	suite.NoError(err)
+
+	suite.Equal("event-id", result.Event.Id)
+	suite.Equal("duel", result.Event.Mode)
+}
+
+func (suite *EventControllerTestSuite) TestGetEvent_NotFound() {
+	suite.mockEventService.
+		EXPECT().
+		GetEvent(mock.Anything, "event-id").
+		Return(domain.Event{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetEvent(context.Background(), &eventpb.GetEventRequest{Id: "event-id"})
+	suite.ErrorIs(err, ErrEventNotFound)
+	suite.Empty(result)
+}
+
+func (suite *EventControllerTestSuite) TestGetEvent_IDMissing() {
+	result, err := suite.controller.GetEvent(context.Background(), &eventpb.GetEventRequest{})
+	suite.ErrorIs(err, ErrEventIDMissing)
+	suite.Empty(result)
+}
+
+func (suite *EventControllerTestSuite) TestGetEventLeaderboard() {
+	suite.mockEventService.
+		EXPECT().
+		GetEventLeaderboard(mock.Anything, "event-id").
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-1", Username: "user-1", Score: 900, Rank: 1, Percentile: 100},
+				{UserID: "user-id-2", Username: "user-2", Score: 800, Rank: 2, Percentile: 50},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetEventLeaderboard(context.Background(), &eventpb.GetEventLeaderboardRequest{Id: "event-id"})
+	suite.NoError(err)
+
+	suite.Len(result.Result, 2)
+	suite.Equal("user-2", result.Result[1].Username)
+	suite.Equal(int64(2), result.Result[1].Rank)
+}
+
+func (suite *EventControllerTestSuite) TestGetEventLeaderboard_NotFound() {
+	suite.mockEventService.
+		EXPECT().
+		GetEventLeaderboard(mock.Anything, "event-id").
+		Return(domain.Leaderboard{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetEventLeaderboard(context.Background(), &eventpb.GetEventLeaderboardRequest{Id: "event-id"})
+	suite.ErrorIs(err, ErrEventNotFound)
+	suite.Empty(result)
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	gameserverpb "game/internal/proto/gameserver/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrServerIDRequired      = status.New(codes.InvalidArgument, "server id is required").Err()
+	ErrInvalidGameServerKey  = status.New(codes.InvalidArgument, "invalid game server key").Err()
+	ErrGameServerKeyExists   = status.New(codes.AlreadyExists, "game server key exists").Err()
+	ErrGameServerKeyNotFound = status.New(codes.NotFound, "game server key not found").Err()
+)
+
+type GameServerControllerDependencies struct {
+	GameServerKeyService services.GameServerKeyService
+
+	Logger *logrus.Logger
+}
+
+type gameServerController struct {
+	gameserverpb.UnimplementedGameServerAdminServiceServer
+
+	gameServerKeyService services.GameServerKeyService
+
+	logger *logrus.Logger
+}
+
+func NewGameServerController(deps GameServerControllerDependencies) *gameServerController {
+	return &gameServerController{
+		gameServerKeyService: deps.GameServerKeyService,
+		logger:               deps.Logger,
+	}
+}
+
+func (controller *gameServerController) CreateGameServerKey(ctx context.Context, request *gameserverpb.CreateGameServerKeyRequest) (*gameserverpb.CreateGameServerKeyResponse, error) {
+	controller.logger.
+		WithField("server_id", request.ServerID).
+		Info("create game server key request has been received")
+
+	if request.ServerID == "" {
+		return nil, ErrServerIDRequired
+	}
+
+	key, err := controller.gameServerKeyService.CreateKey(ctx, request.ServerID, request.Algorithm, request.PublicKey)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("server_id", request.ServerID).
+			Error("failed to create game server key")
+
+		if errors.Is(err, services.ErrInvalidGameServerKey) {
+			return nil, ErrInvalidGameServerKey
+		}
+
+		if errors.Is(err, services.ErrGameServerKeyExists) {
+			return nil, ErrGameServerKeyExists
+		}
+
+		return nil, ErrInternal
+	}
+
+	// the secret of an HMAC key is only ever returned here.
+	return &gameserverpb.CreateGameServerKeyResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result: &gameserverpb.GameServerKey{
+			ServerID:  key.ServerID,
+			Algorithm: key.Algorithm,
+			Key:       key.Key,
+			CreatedAt: key.CreatedAt.Unix(),
+		},
+	}, nil
+}
+
+func (controller *gameServerController) ListGameServerKeys(ctx context.Context, request *gameserverpb.ListGameServerKeysRequest) (*gameserverpb.ListGameServerKeysResponse, error) {
+	controller.logger.Info("list game server keys request has been received")
+
+	keys, err := controller.gameServerKeyService.ListKeys(ctx)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			Error("failed to list game server keys")
+
+		return nil, ErrInternal
+	}
+
+	var results []*gameserverpb.GameServerKey
+
+	for _, key := range keys {
+		result := &gameserverpb.GameServerKey{
+			ServerID:  key.ServerID,
+			Algorithm: key.Algorithm,
+			CreatedAt: key.CreatedAt.Unix(),
+		}
+
+		if key.Algorithm == domain.GameServerKeyAlgorithmEd25519 {
+			result.Key = key.Key
+		}
+
+		results = append(results, result)
+	}
+
+	return &gameserverpb.ListGameServerKeysResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Results:   results,
+	}, nil
+}
+
+func (controller *gameServerController) RevokeGameServerKey(ctx context.Context, request *gameserverpb.RevokeGameServerKeyRequest) (*gameserverpb.RevokeGameServerKeyResponse, error) {
+	controller.logger.
+		WithField("server_id", request.ServerID).
+		Info("revoke game server key request has been received")
+
+	if request.ServerID == "" {
+		return nil, ErrServerIDRequired
+	}
+
+	err := controller.gameServerKeyService.RevokeKey(ctx, request.ServerID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("server_id", request.ServerID).
+			Error("failed to revoke game server key")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrGameServerKeyNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &gameserverpb.RevokeGameServerKeyResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
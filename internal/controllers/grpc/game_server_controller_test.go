@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	gameserverpb "game/internal/proto/gameserver/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type GameServerControllerTestSuite struct {
+	suite.Suite
+
+	controller *gameServerController
+
+	mockGameServerKeyService *mocks.MockGameServerKeyService
+}
+
+func TestGameServerControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(GameServerControllerTestSuite))
+}
+
+func (suite *GameServerControllerTestSuite) SetupTest() {
+	suite.mockGameServerKeyService = mocks.NewMockGameServerKeyService(suite.T())
+
+	suite.controller = NewGameServerController(GameServerControllerDependencies{
+		GameServerKeyService: suite.mockGameServerKeyService,
+		Logger:               logrus.New(),
+	})
+}
+
+func (suite *GameServerControllerTestSuite) TestCreateGameServerKey() {
+	createdAt := time.Now()
+
+	suite.mockGameServerKeyService.
+		EXPECT().
+		CreateKey(mock.Anything, "server-id", domain.GameServerKeyAlgorithmHMACSHA256, []byte(nil)).
+		Return(domain.GameServerKey{
+			ServerID:  "server-id",
+			Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+			Key:       []byte("secret"),
+			CreatedAt: createdAt,
+		}, nil)
+
+	result, err := suite.controller.CreateGameServerKey(context.Background(), &gameserverpb.CreateGameServerKeyRequest{
+		ServerID:  "server-id",
+		Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(&gameserverpb.GameServerKey{
+		ServerID:  "server-id",
+		Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+		Key:       []byte("secret"),
+		CreatedAt: createdAt.Unix(),
+	}, result.Result)
+}
+
+func (suite *GameServerControllerTestSuite) TestCreateGameServerKey_NoServerID() {
+	result, err := suite.controller.CreateGameServerKey(context.Background(), &gameserverpb.CreateGameServerKeyRequest{})
+	suite.ErrorIs(err, ErrServerIDRequired)
+	suite.Empty(result)
+}
+
+func (suite *GameServerControllerTestSuite) TestCreateGameServerKey_InvalidKey() {
+	suite.mockGameServerKeyService.
+		EXPECT().
+		CreateKey(mock.Anything, "server-id", "rsa", []byte(nil)).
+		Return(domain.GameServerKey{}, services.ErrInvalidGameServerKey)
+
+	result, err := suite.controller.CreateGameServerKey(context.Background(), &gameserverpb.CreateGameServerKeyRequest{
+		ServerID:  "server-id",
+		Algorithm: "rsa",
+	})
+	suite.ErrorIs(err, ErrInvalidGameServerKey)
+	suite.Empty(result)
+}
+
+func (suite *GameServerControllerTestSuite) TestListGameServerKeys_HidesHMACSecrets() {
+	suite.mockGameServerKeyService.
+		EXPECT().
+		ListKeys(mock.Anything).
+		Return([]domain.GameServerKey{
+			{
+				ServerID:  "server-id-1",
+				Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+				Key:       []byte("secret"),
+			},
+			{
+				ServerID:  "server-id-2",
+				Algorithm: domain.GameServerKeyAlgorithmEd25519,
+				Key:       []byte("public-key"),
+			},
+		}, nil)
+
+	result, err := suite.controller.ListGameServerKeys(context.Background(), &gameserverpb.ListGameServerKeysRequest{})
+	suite.NoError(err)
+
+	suite.Len(result.Results, 2)
+	suite.Empty(result.Results[0].Key)
+	suite.Equal([]byte("public-key"), result.Results[1].Key)
+}
+
+func (suite *GameServerControllerTestSuite) TestRevokeGameServerKey_NotFound() {
+	suite.mockGameServerKeyService.
+		EXPECT().
+		RevokeKey(mock.Anything, "server-id").
+		Return(domain.ErrResourceNotFound)
+
+	result, err := suite.controller.RevokeGameServerKey(context.Background(), &gameserverpb.RevokeGameServerKeyRequest{
+		ServerID: "server-id",
+	})
+	suite.ErrorIs(err, ErrGameServerKeyNotFound)
+	suite.Empty(result)
+}
@@ -15,8 +15,21 @@ import (
 )
 
 var (
-	ErrInvalidUserID = status.New(codes.InvalidArgument, "invalid user id").Err()
-	ErrInvalidScore  = status.New(codes.InvalidArgument, "invalid score").Err()
+	ErrInvalidUserID         = status.New(codes.InvalidArgument, "invalid user id").Err()
+	ErrInvalidScore          = status.New(codes.InvalidArgument, "invalid score").Err()
+	ErrSubmissionTooFrequent = status.New(codes.ResourceExhausted, "submission too frequent").Err()
+	ErrInvalidSignature      = status.New(codes.Unauthenticated, "invalid signature").Err()
+	ErrStaleScore            = status.New(codes.FailedPrecondition, "stale score").Err()
+	ErrReplayedNonce         = status.New(codes.AlreadyExists, "replayed nonce").Err()
+	ErrInvalidRequest        = status.New(codes.InvalidArgument, "invalid request").Err()
+	ErrUserBanned            = status.New(codes.PermissionDenied, "user banned").Err()
+	ErrInvalidCountry        = status.New(codes.InvalidArgument, "invalid country").Err()
+	ErrUserNotRanked         = status.New(codes.NotFound, "user not ranked").Err()
+)
+
+const (
+	defaultUserRankCount = 5
+	maxUserRankCount     = 50
 )
 
 type LeaderboardControllerDependencies struct {
@@ -41,36 +54,90 @@ func NewLeaderboardController(deps LeaderboardControllerDependencies) *leaderboa
 }
 
 func (controller *leaderboardController) GetLeaderboard(ctx context.Context, request *leaderboardpb.GetLeaderboardRequest) (*leaderboardpb.GetLeaderboardResponse, error) {
-	controller.logger.Info("get leaderboard request has been received")
+	controller.logger.
+		WithField("country_code", request.CountryCode).
+		Info("get leaderboard request has been received")
+
+	var (
+		leaderboard domain.Leaderboard
+		err         error
+	)
+
+	if request.CountryCode == "" {
+		leaderboard, err = controller.leaderboardService.GetLeaderboard(ctx)
+	} else {
+		leaderboard, err = controller.leaderboardService.GetCountryLeaderboard(ctx, request.CountryCode)
+	}
 
-	leaderboard, err := controller.leaderboardService.GetLeaderboard(ctx)
 	if err != nil {
 		controller.logger.
 			WithError(err).
 			Error("failed to get leaderboard")
 
+		if errors.Is(err, services.ErrInvalidCountry) {
+			return nil, ErrInvalidCountry
+		}
+
 		return nil, ErrInternal
 	}
 
-	var results []*leaderboardpb.UserScore
+	return &leaderboardpb.GetLeaderboardResponse{
+		Status:      StatusSuccess,
+		Timestamp:   time.Now().Unix(),
+		Results:     toUserScoreResponses(leaderboard.UserScores),
+		GeneratedAt: leaderboard.GeneratedAt.Unix(),
+	}, nil
+}
 
-	for _, userScore := range leaderboard.UserScores {
-		results = append(results, &leaderboardpb.UserScore{
-			Username: userScore.Username,
-			Score:    userScore.Score,
-			UserID:   userScore.UserID,
-		})
+func (controller *leaderboardController) GetUserRank(ctx context.Context, request *leaderboardpb.GetUserRankRequest) (*leaderboardpb.GetUserRankResponse, error) {
+	controller.logger.Info("get user rank request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
 	}
 
-	return &leaderboardpb.GetLeaderboardResponse{
-		Status:    StatusSuccess,
-		Timestamp: time.Now().Unix(),
-		Results:   results,
+	count := request.Count
+
+	switch {
+	case count < 0:
+		return nil, ErrInvalidRequest
+	case count == 0:
+		count = defaultUserRankCount
+	case count > maxUserRankCount:
+		count = maxUserRankCount
+	}
+
+	userRank, err := controller.leaderboardService.GetUserRank(ctx, userID, count)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to get user rank")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotRanked
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &leaderboardpb.GetUserRankResponse{
+		Status:              StatusSuccess,
+		Timestamp:           time.Now().Unix(),
+		Score:               userRank.Score,
+		Rank:                userRank.Rank,
+		CountryCode:         userRank.CountryCode,
+		CountryRank:         userRank.CountryRank,
+		Neighbours:          toUserScoreResponses(userRank.Neighbours),
+		NeighboursStartRank: userRank.NeighboursStartRank,
 	}, nil
 }
 
 func (controller *leaderboardController) SubmitUserScore(ctx context.Context, request *leaderboardpb.SubmitUserScoreRequest) (*leaderboardpb.SubmitUserScoreResponse, error) {
-	controller.logger.Info("submit user score request has been received")
+	controller.logger.
+		WithField("event_id", request.EventID).
+		Info("submit user score request has been received")
 
 	if request.Score <= 0 {
 		return nil, ErrInvalidScore
@@ -81,17 +148,52 @@ func (controller *leaderboardController) SubmitUserScore(ctx context.Context, re
 		return nil, ErrInvalidUserID
 	}
 
-	err := controller.leaderboardService.SubmitUserScore(ctx, userID, request.Score)
+	err := controller.leaderboardService.SubmitUserScore(ctx, userID, request.Score, request.EventID, request.Mode)
 	if err != nil {
 		controller.logger.
 			WithError(err).
-			WithField("user_id", userID).
+			WithFields(logrus.Fields{
+				"user_id":  userID,
+				"event_id": request.EventID,
+			}).
 			Error("failed to submit user score")
 
+		if errors.Is(err, services.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+
+		if errors.Is(err, services.ErrIneligibleMode) {
+			return nil, ErrIneligibleMode
+		}
+
+		if errors.Is(err, services.ErrEventNotStarted) {
+			return nil, ErrEventNotStarted
+		}
+
+		if errors.Is(err, services.ErrEventClosed) {
+			return nil, ErrEventClosed
+		}
+
+		if errors.Is(err, services.ErrNoAttemptsLeft) {
+			return nil, ErrNoAttemptsLeft
+		}
+
 		if errors.Is(err, domain.ErrResourceNotFound) {
 			return nil, ErrUserNotFound
 		}
 
+		if errors.Is(err, services.ErrInvalidScore) {
+			return nil, ErrInvalidScore
+		}
+
+		if errors.Is(err, services.ErrSubmissionTooFrequent) {
+			return nil, ErrSubmissionTooFrequent
+		}
+
+		if errors.Is(err, services.ErrUserBanned) {
+			return nil, ErrUserBanned
+		}
+
 		return nil, ErrInternal
 	}
 
@@ -100,3 +202,85 @@ func (controller *leaderboardController) SubmitUserScore(ctx context.Context, re
 		Timestamp: time.Now().Unix(),
 	}, nil
 }
+
+func (controller *leaderboardController) SubmitVerifiedScore(ctx context.Context, request *leaderboardpb.SubmitVerifiedScoreRequest) (*leaderboardpb.SubmitVerifiedScoreResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"server_id": request.ServerID,
+			"match_id":  request.MatchID,
+		}).
+		Info("submit verified score request has been received")
+
+	if request.ServerID == "" || request.UserID == "" || request.Nonce == "" || len(request.Signature) == 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	if request.Score <= 0 {
+		return nil, ErrInvalidScore
+	}
+
+	err := controller.leaderboardService.SubmitVerifiedScore(ctx, domain.SignedScore{
+		ServerID:  request.ServerID,
+		MatchID:   request.MatchID,
+		UserID:    request.UserID,
+		Score:     request.Score,
+		Timestamp: time.Unix(request.Timestamp, 0),
+		Nonce:     request.Nonce,
+		Signature: request.Signature,
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"server_id": request.ServerID,
+				"match_id":  request.MatchID,
+				"user_id":   request.UserID,
+			}).
+			Error("failed to submit verified score")
+
+		switch {
+		case errors.Is(err, services.ErrInvalidSignature):
+			return nil, ErrInvalidSignature
+		case errors.Is(err, services.ErrStaleScore):
+			return nil, ErrStaleScore
+		case errors.Is(err, services.ErrReplayedNonce):
+			return nil, ErrReplayedNonce
+		case errors.Is(err, domain.ErrResourceNotFound):
+			return nil, ErrUserNotFound
+		case errors.Is(err, services.ErrInvalidScore):
+			return nil, ErrInvalidScore
+		case errors.Is(err, services.ErrSubmissionTooFrequent):
+			return nil, ErrSubmissionTooFrequent
+		case errors.Is(err, services.ErrUserBanned):
+			return nil, ErrUserBanned
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &leaderboardpb.SubmitVerifiedScoreResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func toUserScoreResponses(userScores []domain.UserScore) []*leaderboardpb.UserScore {
+	var results []*leaderboardpb.UserScore
+
+	for _, userScore := range userScores {
+		results = append(results, &leaderboardpb.UserScore{
+			Username:    userScore.Username,
+			Score:       userScore.Score,
+			UserID:      userScore.UserID,
+			Missing:     userScore.Missing,
+			DisplayName: userScore.Profile.DisplayName,
+			CountryCode: userScore.Profile.CountryCode,
+			AvatarURL:   userScore.Profile.AvatarURL,
+			Metadata:    userScore.Profile.Metadata,
+			Rank:        userScore.Rank,
+			Percentile:  userScore.Percentile,
+		})
+	}
+
+	return results
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -10,6 +11,7 @@ import (
 
 	"game/internal/domain"
 	leaderboardpb "game/internal/proto/leaderboard/proto"
+	"game/internal/services"
 	"game/internal/services/mocks"
 )
 
@@ -36,6 +38,8 @@ func (suite *LeaderboardControllerTestSuite) SetupTest() {
 }
 
 func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard() {
+	generatedAt := time.Now().Add(-time.Second)
+
 	suite.mockLeaderboardService.
 		EXPECT().
 		GetLeaderboard(mock.Anything).
@@ -45,13 +49,23 @@ func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard() {
 					UserID:   "user-id",
 					Username: "username",
 					Score:    86,
+					Profile: domain.UserProfile{
+						DisplayName: "Player One",
+						CountryCode: "DE",
+						AvatarURL:   "https://example.com/avatar.png",
+					},
+					Rank:       1,
+					Percentile: 100,
 				},
 				{
-					UserID:   "user-id-2",
-					Username: "username-2",
-					Score:    82,
+					UserID:     "user-id-2",
+					Username:   "username-2",
+					Score:      82,
+					Rank:       2,
+					Percentile: 50,
 				},
 			},
+			GeneratedAt: generatedAt,
 		}, nil)
 
 	result, err := suite.controller.GetLeaderboard(context.Background(), &leaderboardpb.GetLeaderboardRequest{})
@@ -61,20 +75,28 @@ func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard() {
 		Status: StatusSuccess,
 		Results: []*leaderboardpb.UserScore{
 			{
-				UserID:   "user-id",
-				Username: "username",
-				Score:    86,
+				UserID:      "user-id",
+				Username:    "username",
+				Score:       86,
+				DisplayName: "Player One",
+				CountryCode: "DE",
+				AvatarURL:   "https://example.com/avatar.png",
+				Rank:        1,
+				Percentile:  100,
 			},
 			{
-				UserID:   "user-id-2",
-				Username: "username-2",
-				Score:    82,
+				UserID:     "user-id-2",
+				Username:   "username-2",
+				Score:      82,
+				Rank:       2,
+				Percentile: 50,
 			},
 		},
 	}
 
 	suite.Equal(expectedResult.Status, result.Status)
 	suite.Equal(expectedResult.Results, result.Results)
+	suite.Equal(generatedAt.Unix(), result.GeneratedAt)
 	suite.NotEmpty(result.Timestamp)
 }
 
@@ -92,7 +114,7 @@ func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard_ServiceFailed()
 func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore() {
 	suite.mockLeaderboardService.
 		EXPECT().
-		SubmitUserScore(mock.Anything, "user-id", float64(86)).
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "", "").
 		Return(nil)
 
 	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
@@ -110,10 +132,78 @@ func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore() {
 	suite.NotEmpty(result.Timestamp)
 }
 
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_Event() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "event-id", "duel").
+		Return(nil)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score:   86,
+		EventID: "event-id",
+		Mode:    "duel",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_EventClosed() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "event-id", "duel").
+		Return(services.ErrEventClosed)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score:   86,
+		EventID: "event-id",
+		Mode:    "duel",
+	})
+	suite.ErrorIs(err, ErrEventClosed)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_NoAttemptsLeft() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "event-id", "duel").
+		Return(services.ErrNoAttemptsLeft)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score:   86,
+		EventID: "event-id",
+		Mode:    "duel",
+	})
+	suite.ErrorIs(err, ErrNoAttemptsLeft)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_IneligibleMode() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "event-id", "free_for_all").
+		Return(services.ErrIneligibleMode)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score:   86,
+		EventID: "event-id",
+		Mode:    "free_for_all",
+	})
+	suite.ErrorIs(err, ErrIneligibleMode)
+	suite.Empty(result)
+}
+
 func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_ServiceFailed() {
 	suite.mockLeaderboardService.
 		EXPECT().
-		SubmitUserScore(mock.Anything, "user-id", float64(86)).
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "", "").
 		Return(domain.ErrInternal)
 
 	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
@@ -144,7 +234,7 @@ func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_InvalidScore()
 func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_ResourceNotFound() {
 	suite.mockLeaderboardService.
 		EXPECT().
-		SubmitUserScore(mock.Anything, "user-id", float64(86)).
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "", "").
 		Return(domain.ErrResourceNotFound)
 
 	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
@@ -155,3 +245,219 @@ func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_ResourceNotFoun
 	suite.ErrorIs(err, ErrUserNotFound)
 	suite.Empty(result)
 }
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_ServiceRejectedScore() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "", "").
+		Return(services.ErrInvalidScore)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score: 86,
+	})
+	suite.ErrorIs(err, ErrInvalidScore)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitUserScore_TooFrequent() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitUserScore(mock.Anything, "user-id", float64(86), "", "").
+		Return(services.ErrSubmissionTooFrequent)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.SubmitUserScore(ctx, &leaderboardpb.SubmitUserScoreRequest{
+		Score: 86,
+	})
+	suite.ErrorIs(err, ErrSubmissionTooFrequent)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitVerifiedScore() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitVerifiedScore(mock.Anything, domain.SignedScore{
+			ServerID:  "server-id",
+			MatchID:   "match-id",
+			UserID:    "user-id",
+			Score:     86,
+			Timestamp: time.Unix(1700000000, 0),
+			Nonce:     "nonce",
+			Signature: []byte("signature"),
+		}).
+		Return(nil)
+
+	result, err := suite.controller.SubmitVerifiedScore(context.Background(), &leaderboardpb.SubmitVerifiedScoreRequest{
+		ServerID:  "server-id",
+		MatchID:   "match-id",
+		UserID:    "user-id",
+		Score:     86,
+		Timestamp: 1700000000,
+		Nonce:     "nonce",
+		Signature: []byte("signature"),
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.NotEmpty(result.Timestamp)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitVerifiedScore_InvalidRequest() {
+	result, err := suite.controller.SubmitVerifiedScore(context.Background(), &leaderboardpb.SubmitVerifiedScoreRequest{
+		ServerID: "server-id",
+		Score:    86,
+	})
+	suite.ErrorIs(err, ErrInvalidRequest)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitVerifiedScore_InvalidSignature() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitVerifiedScore(mock.Anything, mock.Anything).
+		Return(services.ErrInvalidSignature)
+
+	result, err := suite.controller.SubmitVerifiedScore(context.Background(), &leaderboardpb.SubmitVerifiedScoreRequest{
+		ServerID:  "server-id",
+		UserID:    "user-id",
+		Score:     86,
+		Nonce:     "nonce",
+		Signature: []byte("signature"),
+	})
+	suite.ErrorIs(err, ErrInvalidSignature)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestSubmitVerifiedScore_ReplayedNonce() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		SubmitVerifiedScore(mock.Anything, mock.Anything).
+		Return(services.ErrReplayedNonce)
+
+	result, err := suite.controller.SubmitVerifiedScore(context.Background(), &leaderboardpb.SubmitVerifiedScoreRequest{
+		ServerID:  "server-id",
+		UserID:    "user-id",
+		Score:     86,
+		Nonce:     "nonce",
+		Signature: []byte("signature"),
+	})
+	suite.ErrorIs(err, ErrReplayedNonce)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard_Country() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		GetCountryLeaderboard(mock.Anything, "de").
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{
+					UserID:   "user-id",
+					Username: "username",
+					Score:    86,
+				},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetLeaderboard(context.Background(), &leaderboardpb.GetLeaderboardRequest{
+		CountryCode: "de",
+	})
+	suite.NoError(err)
+
+	suite.Equal([]*leaderboardpb.UserScore{
+		{
+			UserID:   "user-id",
+			Username: "username",
+			Score:    86,
+		},
+	}, result.Results)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetLeaderboard_InvalidCountry() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		GetCountryLeaderboard(mock.Anything, "xyz").
+		Return(domain.Leaderboard{}, services.ErrInvalidCountry)
+
+	result, err := suite.controller.GetLeaderboard(context.Background(), &leaderboardpb.GetLeaderboardRequest{
+		CountryCode: "xyz",
+	})
+	suite.ErrorIs(err, ErrInvalidCountry)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetUserRank() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		GetUserRank(mock.Anything, "user-id", int64(defaultUserRankCount)).
+		Return(domain.UserRank{
+			UserID:      "user-id",
+			Score:       82,
+			Rank:        2,
+			CountryCode: "DE",
+			CountryRank: 1,
+			Neighbours: []domain.UserScore{
+				{
+					UserID:   "user-id-2",
+					Username: "username-2",
+					Score:    86,
+				},
+				{
+					UserID:   "user-id",
+					Username: "username",
+					Score:    82,
+				},
+			},
+			NeighboursStartRank: 1,
+		}, nil)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.GetUserRank(ctx, &leaderboardpb.GetUserRankRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(float64(82), result.Score)
+	suite.Equal(int64(2), result.Rank)
+	suite.Equal("DE", result.CountryCode)
+	suite.Equal(int64(1), result.CountryRank)
+	suite.Len(result.Neighbours, 2)
+	suite.Equal(int64(1), result.NeighboursStartRank)
+	suite.NotEmpty(result.Timestamp)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetUserRank_CountCapped() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		GetUserRank(mock.Anything, "user-id", int64(maxUserRankCount)).
+		Return(domain.UserRank{UserID: "user-id", Rank: 1}, nil)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	_, err := suite.controller.GetUserRank(ctx, &leaderboardpb.GetUserRankRequest{
+		Count: 1000,
+	})
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetUserRank_NoUserID() {
+	result, err := suite.controller.GetUserRank(context.Background(), &leaderboardpb.GetUserRankRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *LeaderboardControllerTestSuite) TestGetUserRank_NotRanked() {
+	suite.mockLeaderboardService.
+		EXPECT().
+		GetUserRank(mock.Anything, "user-id", int64(defaultUserRankCount)).
+		Return(domain.UserRank{}, domain.ErrResourceNotFound)
+
+	ctx := context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+
+	result, err := suite.controller.GetUserRank(ctx, &leaderboardpb.GetUserRankRequest{})
+	suite.ErrorIs(err, ErrUserNotRanked)
+	suite.Empty(result)
+}
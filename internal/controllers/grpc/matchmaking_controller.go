@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	matchmakingpb "game/internal/proto/matchmaking/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrInvalidMatchQueue = status.New(codes.InvalidArgument, "invalid match queue").Err()
+	ErrAlreadyQueued     = status.New(codes.AlreadyExists, "already queued").Err()
+	ErrNotQueued         = status.New(codes.FailedPrecondition, "not queued").Err()
+	ErrQueueTimeout      = status.New(codes.DeadlineExceeded, "queue timeout").Err()
+)
+
+type MatchmakingControllerDependencies struct {
+	MatchmakingService services.MatchmakingService
+
+	Logger *logrus.Logger
+}
+
+type matchmakingController struct {
+	matchmakingpb.UnimplementedMatchmakingServiceServer
+
+	matchmakingService services.MatchmakingService
+
+	logger *logrus.Logger
+}
+
+func NewMatchmakingController(deps MatchmakingControllerDependencies) *matchmakingController {
+	return &matchmakingController{
+		matchmakingService: deps.MatchmakingService,
+		logger:             deps.Logger,
+	}
+}
+
+func (controller *matchmakingController) JoinQueue(ctx context.Context, request *matchmakingpb.JoinQueueRequest) (*matchmakingpb.JoinQueueResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"mode":       request.Mode,
+			"region":     request.Region,
+			"match_size": request.MatchSize,
+		}).
+		Info("join queue request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	entry, err := controller.matchmakingService.JoinQueue(ctx, userID, domain.MatchQueue{
+		Mode:      request.Mode,
+		Region:    request.Region,
+		MatchSize: request.MatchSize,
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to join queue")
+
+		switch {
+		case errors.Is(err, services.ErrInvalidMatchQueue):
+			return nil, ErrInvalidMatchQueue
+		case errors.Is(err, services.ErrAlreadyQueued):
+			return nil, ErrAlreadyQueued
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &matchmakingpb.JoinQueueResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Rating:    entry.Rating,
+		JoinedAt:  entry.JoinedAt.Unix(),
+	}, nil
+}
+
+func (controller *matchmakingController) LeaveQueue(ctx context.Context, request *matchmakingpb.LeaveQueueRequest) (*matchmakingpb.LeaveQueueResponse, error) {
+	controller.logger.Info("leave queue request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	err := controller.matchmakingService.LeaveQueue(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to leave queue")
+
+		if errors.Is(err, services.ErrNotQueued) {
+			return nil, ErrNotQueued
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &matchmakingpb.LeaveQueueResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *matchmakingController) WaitForMatch(request *matchmakingpb.WaitForMatchRequest, stream matchmakingpb.MatchmakingService_WaitForMatchServer) error {
+	controller.logger.Info("wait for match request has been received")
+
+	ctx := stream.Context()
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return ErrInvalidUserID
+	}
+
+	err := controller.matchmakingService.WaitForMatch(ctx, userID, func(update services.MatchmakingUpdate) error {
+		response := &matchmakingpb.WaitForMatchResponse{
+			Status:        StatusSuccess,
+			Timestamp:     time.Now().Unix(),
+			WaitedSeconds: int64(update.Waited / time.Second),
+			RatingRange:   update.RatingRange,
+		}
+
+		if update.Matched {
+			response.Ticket = &matchmakingpb.MatchTicket{
+				MatchID:   update.Ticket.MatchID,
+				Mode:      update.Ticket.Mode,
+				Region:    update.Ticket.Region,
+				UserIDs:   update.Ticket.UserIDs,
+				CreatedAt: update.Ticket.CreatedAt.Unix(),
+				ExpiresAt: update.Ticket.ExpiresAt.Unix(),
+				Signature: update.Ticket.Signature,
+			}
+		}
+
+		return stream.Send(response)
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			controller.logger.
+				WithField("user_id", userID).
+				Info("player has stopped waiting for a match")
+
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to wait for match")
+
+		switch {
+		case errors.Is(err, services.ErrNotQueued):
+			return ErrNotQueued
+		case errors.Is(err, services.ErrQueueTimeout):
+			return ErrQueueTimeout
+		}
+
+		return ErrInternal
+	}
+
+	controller.logger.
+		WithField("user_id", userID).
+		Info("player has been matched")
+
+	return nil
+}
+
+func (controller *matchmakingController) GetTicketPublicKey(ctx context.Context, request *matchmakingpb.GetTicketPublicKeyRequest) (*matchmakingpb.GetTicketPublicKeyResponse, error) {
+	controller.logger.Info("get ticket public key request has been received")
+
+	return &matchmakingpb.GetTicketPublicKeyResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		PublicKey: controller.matchmakingService.GetTicketPublicKey(ctx),
+	}, nil
+}
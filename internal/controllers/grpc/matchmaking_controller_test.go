@@ -0,0 +1,224 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	matchmakingpb "game/internal/proto/matchmaking/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+// fakeWaitForMatchServer records the responses sent on the stream.
+type fakeWaitForMatchServer struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses []*matchmakingpb.WaitForMatchResponse
+}
+
+func (stream *fakeWaitForMatchServer) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *fakeWaitForMatchServer) Send(response *matchmakingpb.WaitForMatchResponse) error {
+	stream.responses = append(stream.responses, response)
+
+	return nil
+}
+
+type MatchmakingControllerTestSuite struct {
+	suite.Suite
+
+	controller *matchmakingController
+
+	mockMatchmakingService *mocks.MockMatchmakingService
+}
+
+func TestMatchmakingControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(MatchmakingControllerTestSuite))
+}
+
+func (suite *MatchmakingControllerTestSuite) SetupTest() {
+	suite.mockMatchmakingService = mocks.NewMockMatchmakingService(suite.T())
+
+	suite.controller = NewMatchmakingController(MatchmakingControllerDependencies{
+		MatchmakingService: suite.mockMatchmakingService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *MatchmakingControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *MatchmakingControllerTestSuite) TestJoinQueue() {
+	joinedAt := time.Unix(1700000000, 0)
+	queue := domain.MatchQueue{Mode: "duel", Region: "eu", MatchSize: 2}
+
+	suite.mockMatchmakingService.
+		EXPECT().
+		JoinQueue(mock.Anything, "user-id", queue).
+		Return(domain.QueueEntry{UserID: "user-id", Queue: queue, Rating: 1620, JoinedAt: joinedAt}, nil)
+
+	result, err := suite.controller.JoinQueue(suite.userContext(), &matchmakingpb.JoinQueueRequest{
+		Mode:      "duel",
+		Region:    "eu",
+		MatchSize: 2,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(1620.0, result.Rating)
+	suite.Equal(joinedAt.Unix(), result.JoinedAt)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestJoinQueue_InvalidQueue() {
+	suite.mockMatchmakingService.
+		EXPECT().
+		JoinQueue(mock.Anything, "user-id", mock.Anything).
+		Return(domain.QueueEntry{}, services.ErrInvalidMatchQueue)
+
+	result, err := suite.controller.JoinQueue(suite.userContext(), &matchmakingpb.JoinQueueRequest{
+		Mode:      "chess",
+		Region:    "eu",
+		MatchSize: 2,
+	})
+	suite.ErrorIs(err, ErrInvalidMatchQueue)
+	suite.Empty(result)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestJoinQueue_AlreadyQueued() {
+	suite.mockMatchmakingService.
+		EXPECT().
+		JoinQueue(mock.Anything, "user-id", mock.Anything).
+		Return(domain.QueueEntry{}, services.ErrAlreadyQueued)
+
+	result, err := suite.controller.JoinQueue(suite.userContext(), &matchmakingpb.JoinQueueRequest{
+		Mode:      "duel",
+		Region:    "eu",
+		MatchSize: 2,
+	})
+	suite.ErrorIs(err, ErrAlreadyQueued)
+	suite.Empty(result)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestJoinQueue_NoUserID() {
+	result, err := suite.controller.JoinQueue(context.Background(), &matchmakingpb.JoinQueueRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestLeaveQueue() {
+	suite.mockMatchmakingService.
+		EXPECT().
+		LeaveQueue(mock.Anything, "user-id").
+		Return(nil)
+
+	result, err := suite.controller.LeaveQueue(suite.userContext(), &matchmakingpb.LeaveQueueRequest{})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestLeaveQueue_NotQueued() {
+	suite.mockMatchmakingService.
+		EXPECT().
+		LeaveQueue(mock.Anything, "user-id").
+		Return(services.ErrNotQueued)
+
+	result, err := suite.controller.LeaveQueue(suite.userContext(), &matchmakingpb.LeaveQueueRequest{})
+	suite.ErrorIs(err, ErrNotQueued)
+	suite.Empty(result)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestWaitForMatch() {
+	ticket := domain.MatchTicket{
+		MatchID:   "match-1",
+		Mode:      "duel",
+		Region:    "eu",
+		UserIDs:   []string{"user-id", "user-id-2"},
+		CreatedAt: time.Unix(1700000000, 0),
+		ExpiresAt: time.Unix(1700000120, 0),
+		Signature: []byte("signature"),
+	}
+
+	suite.mockMatchmakingService.
+		EXPECT().
+		WaitForMatch(mock.Anything, "user-id", mock.Anything).
+		RunAndReturn(func(ctx context.Context, userID string, send func(services.MatchmakingUpdate) error) error {
+			err := send(services.MatchmakingUpdate{Waited: 2 * time.Second, RatingRange: 120})
+			if err != nil {
+				return err
+			}
+
+			return send(services.MatchmakingUpdate{Waited: 3 * time.Second, Matched: true, Ticket: ticket})
+		})
+
+	stream := &fakeWaitForMatchServer{ctx: suite.userContext()}
+
+	err := suite.controller.WaitForMatch(&matchmakingpb.WaitForMatchRequest{}, stream)
+	suite.NoError(err)
+
+	suite.Len(stream.responses, 2)
+	suite.Equal(int64(2), stream.responses[0].WaitedSeconds)
+	suite.Equal(120.0, stream.responses[0].RatingRange)
+	suite.Nil(stream.responses[0].Ticket)
+
+	suite.Equal("match-1", stream.responses[1].Ticket.MatchID)
+	suite.Equal([]string{"user-id", "user-id-2"}, stream.responses[1].Ticket.UserIDs)
+	suite.Equal(int64(1700000120), stream.responses[1].Ticket.ExpiresAt)
+	suite.Equal([]byte("signature"), stream.responses[1].Ticket.Signature)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestWaitForMatch_Timeout() {
+	suite.mockMatchmakingService.
+		EXPECT().
+		WaitForMatch(mock.Anything, "user-id", mock.Anything).
+		Return(services.ErrQueueTimeout)
+
+	err := suite.controller.WaitForMatch(&matchmakingpb.WaitForMatchRequest{}, &fakeWaitForMatchServer{ctx: suite.userContext()})
+	suite.ErrorIs(err, ErrQueueTimeout)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestWaitForMatch_Canceled() {
+	ctx, cancel := context.WithCancel(suite.userContext())
+	cancel()
+
+	suite.mockMatchmakingService.
+		EXPECT().
+		WaitForMatch(mock.Anything, "user-id", mock.Anything).
+		Return(context.Canceled)
+
+	err := suite.controller.WaitForMatch(&matchmakingpb.WaitForMatchRequest{}, &fakeWaitForMatchServer{ctx: ctx})
+	suite.Equal(codes.Canceled, status.Code(err))
+}
+
+func (suite *MatchmakingControllerTestSuite) TestWaitForMatch_NoUserID() {
+	err := suite.controller.WaitForMatch(&matchmakingpb.WaitForMatchRequest{}, &fakeWaitForMatchServer{ctx: context.Background()})
+	suite.ErrorIs(err, ErrInvalidUserID)
+}
+
+func (suite *MatchmakingControllerTestSuite) TestGetTicketPublicKey() {
+	publicKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+
+	suite.mockMatchmakingService.
+		EXPECT().
+		GetTicketPublicKey(mock.Anything).
+		Return(publicKey)
+
+	result, err := suite.controller.GetTicketPublicKey(context.Background(), &matchmakingpb.GetTicketPublicKeyRequest{})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal([]byte(publicKey), result.PublicKey)
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	moderationpb "game/internal/proto/moderation/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrModeratorRequired = status.New(codes.InvalidArgument, "moderator is required").Err()
+)
+
+type ModerationControllerDependencies struct {
+	LeaderboardModerationService services.LeaderboardModerationService
+
+	Logger *logrus.Logger
+}
+
+type moderationController struct {
+	moderationpb.UnimplementedLeaderboardModerationServiceServer
+
+	leaderboardModerationService services.LeaderboardModerationService
+
+	logger *logrus.Logger
+}
+
+func NewModerationController(deps ModerationControllerDependencies) *moderationController {
+	return &moderationController{
+		leaderboardModerationService: deps.LeaderboardModerationService,
+		logger:                       deps.Logger,
+	}
+}
+
+func (controller *moderationController) RemoveScore(ctx context.Context, request *moderationpb.RemoveScoreRequest) (*moderationpb.ModerationResponse, error) {
+	return controller.moderate(ctx, "remove score", request.UserID, request.Moderator, request.Reason,
+		func(moderationRequest services.ModerationRequest) error {
+			return controller.leaderboardModerationService.RemoveScore(ctx, moderationRequest)
+		},
+	)
+}
+
+func (controller *moderationController) SetScore(ctx context.Context, request *moderationpb.SetScoreRequest) (*moderationpb.ModerationResponse, error) {
+	if request.Score < 0 || math.IsNaN(request.Score) || math.IsInf(request.Score, 0) {
+		return nil, ErrInvalidScore
+	}
+
+	return controller.moderate(ctx, "set score", request.UserID, request.Moderator, request.Reason,
+		func(moderationRequest services.ModerationRequest) error {
+			return controller.leaderboardModerationService.SetScore(ctx, moderationRequest, request.Score)
+		},
+	)
+}
+
+func (controller *moderationController) BanUser(ctx context.Context, request *moderationpb.BanUserRequest) (*moderationpb.ModerationResponse, error) {
+	return controller.moderate(ctx, "ban user", request.UserID, request.Moderator, request.Reason,
+		func(moderationRequest services.ModerationRequest) error {
+			return controller.leaderboardModerationService.BanUser(ctx, moderationRequest)
+		},
+	)
+}
+
+func (controller *moderationController) UnbanUser(ctx context.Context, request *moderationpb.UnbanUserRequest) (*moderationpb.ModerationResponse, error) {
+	return controller.moderate(ctx, "unban user", request.UserID, request.Moderator, request.Reason,
+		func(moderationRequest services.ModerationRequest) error {
+			return controller.leaderboardModerationService.UnbanUser(ctx, moderationRequest)
+		},
+	)
+}
+
+// moderate validates the fields every moderation request has in common and
+// runs the action.
+func (controller *moderationController) moderate(
+	ctx context.Context, action, userID, moderator, reason string, run func(services.ModerationRequest) error,
+) (*moderationpb.ModerationResponse, error) {
+	logger := controller.logger.WithFields(logrus.Fields{
+		"action":    action,
+		"user_id":   userID,
+		"moderator": moderator,
+	})
+
+	logger.Info("moderation request has been received")
+
+	if userID == "" {
+		return nil, ErrInvalidUserID
+	}
+
+	if moderator == "" {
+		return nil, ErrModeratorRequired
+	}
+
+	err := run(services.ModerationRequest{
+		UserID:    userID,
+		Moderator: moderator,
+		Reason:    reason,
+	})
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("moderation request is failed")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &moderationpb.ModerationResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
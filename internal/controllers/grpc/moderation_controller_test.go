@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	moderationpb "game/internal/proto/moderation/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type ModerationControllerTestSuite struct {
+	suite.Suite
+
+	controller *moderationController
+
+	mockLeaderboardModerationService *mocks.MockLeaderboardModerationService
+}
+
+func TestModerationControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ModerationControllerTestSuite))
+}
+
+func (suite *ModerationControllerTestSuite) SetupTest() {
+	suite.mockLeaderboardModerationService = mocks.NewMockLeaderboardModerationService(suite.T())
+
+	suite.controller = NewModerationController(ModerationControllerDependencies{
+		LeaderboardModerationService: suite.mockLeaderboardModerationService,
+		Logger:                       logrus.New(),
+	})
+}
+
+func (suite *ModerationControllerTestSuite) TestBanUser() {
+	suite.mockLeaderboardModerationService.
+		EXPECT().
+		BanUser(mock.Anything, services.ModerationRequest{
+			UserID:    "user-id",
+			Moderator: "moderator",
+			Reason:    "cheating",
+		}).
+		Return(nil)
+
+	result, err := suite.controller.BanUser(context.Background(), &moderationpb.BanUserRequest{
+		UserID:    "user-id",
+		Moderator: "moderator",
+		Reason:    "cheating",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.NotEmpty(result.Timestamp)
+}
+
+func (suite *ModerationControllerTestSuite) TestBanUser_NoModerator() {
+	result, err := suite.controller.BanUser(context.Background(), &moderationpb.BanUserRequest{
+		UserID: "user-id",
+	})
+	suite.ErrorIs(err, ErrModeratorRequired)
+	suite.Empty(result)
+}
+
+func (suite *ModerationControllerTestSuite) TestRemoveScore_UserNotFound() {
+	suite.mockLeaderboardModerationService.
+		EXPECT().
+		RemoveScore(mock.Anything, mock.Anything).
+		Return(domain.ErrResourceNotFound)
+
+	result, err := suite.controller.RemoveScore(context.Background(), &moderationpb.RemoveScoreRequest{
+		UserID:    "user-id",
+		Moderator: "moderator",
+	})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
+
+func (suite *ModerationControllerTestSuite) TestSetScore() {
+	suite.mockLeaderboardModerationService.
+		EXPECT().
+		SetScore(mock.Anything, mock.Anything, float64(42)).
+		Return(nil)
+
+	result, err := suite.controller.SetScore(context.Background(), &moderationpb.SetScoreRequest{
+		UserID:    "user-id",
+		Moderator: "moderator",
+		Score:     42,
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *ModerationControllerTestSuite) TestSetScore_InvalidScore() {
+	result, err := suite.controller.SetScore(context.Background(), &moderationpb.SetScoreRequest{
+		UserID:    "user-id",
+		Moderator: "moderator",
+		Score:     -1,
+	})
+	suite.ErrorIs(err, ErrInvalidScore)
+	suite.Empty(result)
+}
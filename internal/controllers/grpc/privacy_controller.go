@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	privacypb "game/internal/proto/privacy/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrRequestedByRequired    = status.New(codes.InvalidArgument, "requested by is required").Err()
+	ErrErasureRecordIDMissing = status.New(codes.InvalidArgument, "erasure record id is required").Err()
+	ErrErasureRecordNotFound  = status.New(codes.NotFound, "erasure record not found").Err()
+)
+
+type PrivacyControllerDependencies struct {
+	PrivacyService services.PrivacyService
+
+	Logger *logrus.Logger
+}
+
+type privacyController struct {
+	privacypb.UnimplementedPrivacyServiceServer
+
+	privacyService services.PrivacyService
+
+	logger *logrus.Logger
+}
+
+func NewPrivacyController(deps PrivacyControllerDependencies) *privacyController {
+	return &privacyController{
+		privacyService: deps.PrivacyService,
+		logger:         deps.Logger,
+	}
+}
+
+func (controller *privacyController) ExportUserData(ctx context.Context, request *privacypb.ExportUserDataRequest) (*privacypb.ExportUserDataResponse, error) {
+	logger := controller.logger.WithFields(logrus.Fields{
+		"user_id":      request.UserID,
+		"requested_by": request.RequestedBy,
+	})
+
+	logger.Info("export user data request has been received")
+
+	if request.UserID == "" {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.RequestedBy == "" {
+		return nil, ErrRequestedByRequired
+	}
+
+	archive, err := controller.privacyService.ExportUserData(ctx, request.UserID, request.RequestedBy)
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("failed to export user data")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &privacypb.ExportUserDataResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Archive:   archive,
+	}, nil
+}
+
+func (controller *privacyController) EraseUserData(ctx context.Context, request *privacypb.EraseUserDataRequest) (*privacypb.EraseUserDataResponse, error) {
+	logger := controller.logger.WithFields(logrus.Fields{
+		"user_id":      request.UserID,
+		"requested_by": request.RequestedBy,
+	})
+
+	logger.Info("erase user data request has been received")
+
+	if request.UserID == "" {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.RequestedBy == "" {
+		return nil, ErrRequestedByRequired
+	}
+
+	record, err := controller.privacyService.EraseUserData(ctx, request.UserID, request.RequestedBy)
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("failed to erase user data")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &privacypb.EraseUserDataResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Record:    toErasureRecordResponse(record),
+	}, nil
+}
+
+func (controller *privacyController) VerifyErasureRecord(ctx context.Context, request *privacypb.VerifyErasureRecordRequest) (*privacypb.VerifyErasureRecordResponse, error) {
+	logger := controller.logger.WithField("erasure_id", request.Id)
+
+	logger.Info("verify erasure record request has been received")
+
+	if request.Id == "" {
+		return nil, ErrErasureRecordIDMissing
+	}
+
+	verification, err := controller.privacyService.VerifyErasureRecord(ctx, request.Id)
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("failed to verify erasure record")
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrErasureRecordNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &privacypb.VerifyErasureRecordResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Record:    toErasureRecordResponse(verification.Record),
+		Valid:     verification.Valid,
+	}, nil
+}
+
+func toErasureRecordResponse(record domain.ErasureRecord) *privacypb.ErasureRecord {
+	response := &privacypb.ErasureRecord{
+		Id:          record.ID,
+		SubjectHash: record.SubjectHash,
+		AnonymousID: record.AnonymousID,
+		RequestedBy: record.RequestedBy,
+		Status:      record.Status,
+		Steps:       record.Steps,
+		RequestedAt: record.RequestedAt.Unix(),
+		Signature:   record.Signature,
+	}
+
+	if !record.CompletedAt.IsZero() {
+		response.CompletedAt = record.CompletedAt.Unix()
+	}
+
+	return response
+}
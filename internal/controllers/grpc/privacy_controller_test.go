@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	privacypb "game/internal/proto/privacy/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type PrivacyControllerTestSuite struct {
+	suite.Suite
+
+	controller *privacyController
+
+	mockPrivacyService *mocks.MockPrivacyService
+}
+
+func TestPrivacyControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(PrivacyControllerTestSuite))
+}
+
+func (suite *PrivacyControllerTestSuite) SetupTest() {
+	suite.mockPrivacyService = mocks.NewMockPrivacyService(suite.T())
+
+	suite.controller = NewPrivacyController(PrivacyControllerDependencies{
+		PrivacyService: suite.mockPrivacyService,
+		Logger:         logrus.New(),
+	})
+}
+
+func (suite *PrivacyControllerTestSuite) TestExportUserData() {
+	suite.mockPrivacyService.
+		EXPECT().
+		ExportUserData(mock.Anything, "user-id", "support").
+		Return([]byte(`{"user":{"id":"user-id"}}`), nil)
+
+	result, err := suite.controller.ExportUserData(context.Background(), &privacypb.ExportUserDataRequest{
+		UserID:      "user-id",
+		RequestedBy: "support",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.JSONEq(`{"user":{"id":"user-id"}}`, string(result.Archive))
+}
+
+func (suite *PrivacyControllerTestSuite) TestExportUserData_NoRequestedBy() {
+	result, err := suite.controller.ExportUserData(context.Background(), &privacypb.ExportUserDataRequest{
+		UserID: "user-id",
+	})
+	suite.ErrorIs(err, ErrRequestedByRequired)
+	suite.Empty(result)
+}
+
+func (suite *PrivacyControllerTestSuite) TestEraseUserData() {
+	requestedAt := time.Unix(1700000000, 0)
+
+	suite.mockPrivacyService.
+		EXPECT().
+		EraseUserData(mock.Anything, "user-id", "support").
+		Return(domain.ErasureRecord{
+			ID:          "erasure-id",
+			SubjectHash: "subject-hash",
+			AnonymousID: "deleted-user:0011223344556677",
+			RequestedBy: "support",
+			Status:      domain.ErasureStatusCompleted,
+			Steps:       []string{domain.ErasureStepUserDeleted},
+			RequestedAt: requestedAt,
+			CompletedAt: requestedAt.Add(time.Second),
+			Signature:   []byte("signature"),
+		}, nil)
+
+	result, err := suite.controller.EraseUserData(context.Background(), &privacypb.EraseUserDataRequest{
+		UserID:      "user-id",
+		RequestedBy: "support",
+	})
+	suite.NoError(err)
+
+	suite.Equal("erasure-id", result.Record.Id)
+	suite.Equal(domain.ErasureStatusCompleted, result.Record.Status)
+	suite.Equal(requestedAt.Unix()+1, result.Record.CompletedAt)
+	suite.Equal([]byte("signature"), result.Record.Signature)
+}
+
+func (suite *PrivacyControllerTestSuite) TestEraseUserData_UserNotFound() {
+	suite.mockPrivacyService.
+		EXPECT().
+		EraseUserData(mock.Anything, "user-id", "support").
+		Return(domain.ErasureRecord{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.EraseUserData(context.Background(), &privacypb.EraseUserDataRequest{
+		UserID:      "user-id",
+		RequestedBy: "support",
+	})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
+
+func (suite *PrivacyControllerTestSuite) TestVerifyErasureRecord() {
+	suite.mockPrivacyService.
+		EXPECT().
+		VerifyErasureRecord(mock.Anything, "erasure-id").
+		Return(services.ErasureVerification{
+			Record: domain.ErasureRecord{ID: "erasure-id", Status: domain.ErasureStatusCompleted},
+			Valid:  true,
+		}, nil)
+
+	result, err := suite.controller.VerifyErasureRecord(context.Background(), &privacypb.VerifyErasureRecordRequest{
+		Id: "erasure-id",
+	})
+	suite.NoError(err)
+
+	suite.True(result.Valid)
+	suite.Equal("erasure-id", result.Record.Id)
+}
+
+func (suite *PrivacyControllerTestSuite) TestVerifyErasureRecord_NotFound() {
+	suite.mockPrivacyService.
+		EXPECT().
+		VerifyErasureRecord(mock.Anything, "erasure-id").
+		Return(services.ErasureVerification{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.VerifyErasureRecord(context.Background(), &privacypb.VerifyErasureRecordRequest{
+		Id: "erasure-id",
+	})
+	suite.ErrorIs(err, ErrErasureRecordNotFound)
+	suite.Empty(result)
+}
@@ -0,0 +1,285 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+)
+
+const (
+	HeaderRateLimitRemaining  = "x-ratelimit-remaining"
+	HeaderRateLimitRetryAfter = "retry-after"
+)
+
+var (
+	ErrRateLimitExceeded = status.New(codes.ResourceExhausted, "rate limit exceeded").Err()
+)
+
+const contextKeyIPRateLimit ContextKey = "ip_rate_limit"
+
+// ipRateLimit is the bucket InterceptIP has taken a token from.
+type ipRateLimit struct {
+	key    string
+	limit  domain.RateLimit
+	result domain.RateLimitResult
+}
+
+type MethodRateLimit struct {
+	PerUser domain.RateLimit
+	PerIP   domain.RateLimit
+}
+
+type RateLimitInterceptorDependencies struct {
+	RateLimiter domain.RateLimiter
+
+	// MethodRateLimits holds the limits of each full method name, methods
+	// without an entry fall back to DefaultRateLimit.
+	MethodRateLimits map[string]MethodRateLimit
+	DefaultRateLimit MethodRateLimit
+
+	Logger *logrus.Logger
+}
+
+type RateLimitInterceptor struct {
+	rateLimiter domain.RateLimiter
+
+	methodRateLimits map[string]MethodRateLimit
+	defaultRateLimit MethodRateLimit
+
+	logger *logrus.Logger
+}
+
+func NewRateLimitInterceptor(deps RateLimitInterceptorDependencies) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		rateLimiter:      deps.RateLimiter,
+		methodRateLimits: deps.MethodRateLimits,
+		defaultRateLimit: deps.DefaultRateLimit,
+		logger:           deps.Logger,
+	}
+}
+
+// InterceptIP limits the requests by the ip of the peer. It runs before
+// the request is authenticated, so failed logins and invalid tokens are
+// limited as well.
+func (interceptor *RateLimitInterceptor) InterceptIP(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, header, err := interceptor.limitIP(ctx, info.FullMethod)
+	if err != nil {
+		interceptor.setHeader(header, func(header metadata.MD) error {
+			return grpc.SetHeader(ctx, header)
+		})
+
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (interceptor *RateLimitInterceptor) InterceptIPStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, header, err := interceptor.limitIP(ss.Context(), info.FullMethod)
+	if err != nil {
+		interceptor.setHeader(header, ss.SetHeader)
+
+		return err
+	}
+
+	return handler(srv, &serverStreamWithContext{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+// Intercept limits the requests by the authenticated user, it runs after
+// InterceptIP and the authentication and sends the quota of both limits
+// back to the client.
+func (interceptor *RateLimitInterceptor) Intercept(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	header, err := interceptor.limit(ctx, info.FullMethod)
+	interceptor.setHeader(header, func(header metadata.MD) error {
+		return grpc.SetHeader(ctx, header)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (interceptor *RateLimitInterceptor) InterceptStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	header, err := interceptor.limit(ss.Context(), info.FullMethod)
+	interceptor.setHeader(header, ss.SetHeader)
+
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (interceptor *RateLimitInterceptor) methodRateLimit(method string) MethodRateLimit {
+	methodRateLimit, ok := interceptor.methodRateLimits[method]
+	if !ok {
+		methodRateLimit = interceptor.defaultRateLimit
+	}
+
+	return methodRateLimit
+}
+
+// limitIP takes a token from the ip bucket of the method and keeps the
+// result in the context for limit.
+func (interceptor *RateLimitInterceptor) limitIP(ctx context.Context, method string) (context.Context, metadata.MD, error) {
+	limit := interceptor.methodRateLimit(method).PerIP
+
+	ip, ok := peerIP(ctx)
+	if !ok || !limit.IsEnabled() {
+		return ctx, nil, nil
+	}
+
+	key := method + ":ip:" + ip
+
+	result, err := interceptor.rateLimiter.Allow(ctx, key, limit)
+	if err != nil {
+		interceptor.logger.
+			WithError(err).
+			WithField("method", method).
+			Error("failed to check ip rate limit")
+
+		return ctx, nil, ErrInternal
+	}
+
+	if !result.Allowed {
+		header, _ := rateLimitHeader(result)
+
+		return ctx, header, ErrRateLimitExceeded
+	}
+
+	return context.WithValue(ctx, contextKeyIPRateLimit, ipRateLimit{
+		key:    key,
+		limit:  limit,
+		result: result,
+	}), nil, nil
+}
+
+// limit takes a token from the user bucket of the method and returns the
+// quota metadata of both buckets that should be sent back to the client.
+func (interceptor *RateLimitInterceptor) limit(ctx context.Context, method string) (metadata.MD, error) {
+	limit := interceptor.methodRateLimit(method).PerUser
+
+	var results []domain.RateLimitResult
+
+	ipLimit, hasIPLimit := ctx.Value(contextKeyIPRateLimit).(ipRateLimit)
+	if hasIPLimit {
+		results = append(results, ipLimit.result)
+	}
+
+	if userID, ok := ctx.Value(ContextKeyUserID).(string); ok && limit.IsEnabled() {
+		result, err := interceptor.rateLimiter.Allow(ctx, method+":user:"+userID, limit)
+		if err != nil {
+			interceptor.logger.
+				WithError(err).
+				WithField("method", method).
+				Error("failed to check user rate limit")
+
+			return nil, ErrInternal
+		}
+
+		// the request is not served, the token of the ip bucket is given
+		// back so that the other users behind the ip are not limited by it.
+		if !result.Allowed && hasIPLimit {
+			err = interceptor.rateLimiter.Refund(ctx, ipLimit.key, ipLimit.limit)
+			if err != nil {
+				interceptor.logger.
+					WithError(err).
+					WithField("method", method).
+					Warn("failed to refund ip rate limit")
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	header, allowed := rateLimitHeader(results...)
+
+	if !allowed {
+		return header, ErrRateLimitExceeded
+	}
+
+	return header, nil
+}
+
+func (interceptor *RateLimitInterceptor) setHeader(header metadata.MD, set func(metadata.MD) error) {
+	if header == nil {
+		return
+	}
+
+	if err := set(header); err != nil {
+		interceptor.logger.
+			WithError(err).
+			Warn("failed to set rate limit header")
+	}
+}
+
+// rateLimitHeader returns the lowest remaining quota of the results and the
+// longest retry after of the denied ones, along with whether every result
+// has allowed the request.
+func rateLimitHeader(results ...domain.RateLimitResult) (metadata.MD, bool) {
+	remaining := results[0].Remaining
+	allowed := true
+	var retryAfter int64
+
+	for _, result := range results {
+		if result.Remaining < remaining {
+			remaining = result.Remaining
+		}
+
+		if !result.Allowed {
+			allowed = false
+
+			if seconds := int64(result.RetryAfter.Seconds() + 0.999); seconds > retryAfter {
+				retryAfter = seconds
+			}
+		}
+	}
+
+	header := metadata.Pairs(HeaderRateLimitRemaining, strconv.FormatInt(remaining, 10))
+
+	if !allowed {
+		header.Set(HeaderRateLimitRetryAfter, strconv.FormatInt(retryAfter, 10))
+	}
+
+	return header, allowed
+}
+
+func peerIP(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String(), true
+	}
+
+	return host, true
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (stream *fakeServerTransportStream) Method() string {
+	return "some-method"
+}
+
+func (stream *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func (stream *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return stream.SetHeader(md)
+}
+
+func (stream *fakeServerTransportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+type RateLimitInterceptorTestSuite struct {
+	suite.Suite
+
+	interceptor *RateLimitInterceptor
+
+	mockRateLimiter *mocks.MockRateLimiter
+
+	userRateLimit domain.RateLimit
+	ipRateLimit   domain.RateLimit
+}
+
+func TestRateLimitInterceptorTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitInterceptorTestSuite))
+}
+
+func (suite *RateLimitInterceptorTestSuite) SetupTest() {
+	suite.mockRateLimiter = mocks.NewMockRateLimiter(suite.T())
+
+	suite.userRateLimit = domain.RateLimit{Capacity: 5, RefillInterval: time.Second}
+	suite.ipRateLimit = domain.RateLimit{Capacity: 10, RefillInterval: time.Second}
+
+	suite.interceptor = NewRateLimitInterceptor(RateLimitInterceptorDependencies{
+		RateLimiter: suite.mockRateLimiter,
+		MethodRateLimits: map[string]MethodRateLimit{
+			"some-method": {
+				PerUser: suite.userRateLimit,
+				PerIP:   suite.ipRateLimit,
+			},
+		},
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *RateLimitInterceptorTestSuite) newContext(stream *fakeServerTransportStream) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000},
+	})
+
+	return grpc.NewContextWithServerTransportStream(ctx, stream)
+}
+
+// intercept runs the request through both limits, authenticated as the
+// user in between the same as the chain of the server.
+func (suite *RateLimitInterceptorTestSuite) intercept(ctx context.Context, method string) (bool, error) {
+	handlerCalled := false
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: method,
+	}
+
+	_, err := suite.interceptor.InterceptIP(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		ctx = context.WithValue(ctx, ContextKeyUserID, "user-id")
+
+		return suite.interceptor.Intercept(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			handlerCalled = true
+			return nil, nil
+		})
+	})
+
+	return handlerCalled, err
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestIntercept() {
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:user:user-id", suite.userRateLimit).
+		Return(domain.RateLimitResult{Allowed: true, Remaining: 4}, nil)
+
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(domain.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+
+	stream := &fakeServerTransportStream{}
+
+	handlerCalled, err := suite.intercept(suite.newContext(stream), "some-method")
+	suite.NoError(err)
+	suite.True(handlerCalled)
+	suite.Equal([]string{"4"}, stream.header.Get(HeaderRateLimitRemaining))
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestIntercept_Exceeded() {
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:user:user-id", suite.userRateLimit).
+		Return(domain.RateLimitResult{Allowed: false, Remaining: 0, RetryAfter: 1500 * time.Millisecond}, nil)
+
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(domain.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+
+	suite.mockRateLimiter.
+		EXPECT().
+		Refund(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(nil)
+
+	stream := &fakeServerTransportStream{}
+
+	handlerCalled, err := suite.intercept(suite.newContext(stream), "some-method")
+	suite.ErrorIs(err, ErrRateLimitExceeded)
+	suite.False(handlerCalled)
+	suite.Equal([]string{"0"}, stream.header.Get(HeaderRateLimitRemaining))
+	suite.Equal([]string{"2"}, stream.header.Get(HeaderRateLimitRetryAfter))
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestIntercept_IPExceeded() {
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(domain.RateLimitResult{Allowed: false, Remaining: 0, RetryAfter: 500 * time.Millisecond}, nil)
+
+	stream := &fakeServerTransportStream{}
+
+	handlerCalled, err := suite.intercept(suite.newContext(stream), "some-method")
+	suite.ErrorIs(err, ErrRateLimitExceeded)
+	suite.False(handlerCalled)
+	suite.Equal([]string{"0"}, stream.header.Get(HeaderRateLimitRemaining))
+	suite.Equal([]string{"1"}, stream.header.Get(HeaderRateLimitRetryAfter))
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestInterceptIP_Unauthenticated() {
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(domain.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+
+	_, err := suite.interceptor.InterceptIP(suite.newContext(&fakeServerTransportStream{}), nil, &grpc.UnaryServerInfo{
+		FullMethod: "some-method",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, ErrUnauthenticated
+	})
+
+	suite.ErrorIs(err, ErrUnauthenticated)
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestIntercept_RateLimiterFailed() {
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:ip:10.0.0.1", suite.ipRateLimit).
+		Return(domain.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+
+	suite.mockRateLimiter.
+		EXPECT().
+		Allow(mock.Anything, "some-method:user:user-id", suite.userRateLimit).
+		Return(domain.RateLimitResult{}, errors.New("some error"))
+
+	handlerCalled, err := suite.intercept(suite.newContext(&fakeServerTransportStream{}), "some-method")
+	suite.ErrorIs(err, ErrInternal)
+	suite.False(handlerCalled)
+}
+
+func (suite *RateLimitInterceptorTestSuite) TestIntercept_MethodWithoutLimit() {
+	handlerCalled, err := suite.intercept(suite.newContext(&fakeServerTransportStream{}), "a-different-method")
+	suite.NoError(err)
+	suite.True(handlerCalled)
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	ratingpb "game/internal/proto/rating/proto"
+	"game/internal/services"
+)
+
+type RatingAdminControllerDependencies struct {
+	RatingService services.RatingService
+
+	Logger *logrus.Logger
+}
+
+type ratingAdminController struct {
+	ratingpb.UnimplementedRatingAdminServiceServer
+
+	ratingService services.RatingService
+
+	logger *logrus.Logger
+}
+
+func NewRatingAdminController(deps RatingAdminControllerDependencies) *ratingAdminController {
+	return &ratingAdminController{
+		ratingService: deps.RatingService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *ratingAdminController) ReportMatchResult(ctx context.Context, request *ratingpb.ReportMatchResultRequest) (*ratingpb.ReportMatchResultResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"match_id": request.MatchID,
+			"mode":     request.Mode,
+		}).
+		Info("report match result request has been received")
+
+	result := domain.MatchResult{
+		MatchID: request.MatchID,
+		Mode:    request.Mode,
+	}
+
+	for _, placement := range request.Placements {
+		result.Placements = append(result.Placements, domain.MatchPlacement{
+			UserID: placement.UserID,
+			Place:  placement.Place,
+		})
+	}
+
+	ratings, err := controller.ratingService.ReportMatchResult(ctx, result)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"match_id": request.MatchID,
+				"mode":     request.Mode,
+			}).
+			Error("failed to report match result")
+
+		switch {
+		case errors.Is(err, services.ErrInvalidRatingMode):
+			return nil, ErrInvalidRatingMode
+		case errors.Is(err, services.ErrInvalidMatchResult):
+			return nil, ErrInvalidMatchResult
+		case errors.Is(err, services.ErrMatchReported):
+			return nil, ErrMatchReported
+		case errors.Is(err, services.ErrRatingConflict):
+			return nil, ErrRatingConflict
+		case errors.Is(err, domain.ErrResourceNotFound):
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	controller.logger.
+		WithFields(logrus.Fields{
+			"match_id": request.MatchID,
+			"mode":     request.Mode,
+		}).
+		Info("match result has been reported")
+
+	var responses []*ratingpb.Rating
+
+	for _, rating := range ratings {
+		responses = append(responses, toRatingResponse(rating))
+	}
+
+	return &ratingpb.ReportMatchResultResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Ratings:   responses,
+	}, nil
+}
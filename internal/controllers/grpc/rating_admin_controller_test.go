@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	ratingpb "game/internal/proto/rating/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type RatingAdminControllerTestSuite struct {
+	suite.Suite
+
+	controller *ratingAdminController
+
+	mockRatingService *mocks.MockRatingService
+}
+
+func TestRatingAdminControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(RatingAdminControllerTestSuite))
+}
+
+func (suite *RatingAdminControllerTestSuite) SetupTest() {
+	suite.mockRatingService = mocks.NewMockRatingService(suite.T())
+
+	suite.controller = NewRatingAdminController(RatingAdminControllerDependencies{
+		RatingService: suite.mockRatingService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *RatingAdminControllerTestSuite) request() *ratingpb.ReportMatchResultRequest {
+	return &ratingpb.ReportMatchResultRequest{
+		MatchID: "match-1",
+		Mode:    "duel",
+		Placements: []*ratingpb.MatchPlacement{
+			{UserID: "user-id", Place: 1},
+			{UserID: "user-id-2", Place: 2},
+		},
+	}
+}
+
+func (suite *RatingAdminControllerTestSuite) TestReportMatchResult() {
+	suite.mockRatingService.
+		EXPECT().
+		ReportMatchResult(mock.Anything, domain.MatchResult{
+			MatchID: "match-1",
+			Mode:    "duel",
+			Placements: []domain.MatchPlacement{
+				{UserID: "user-id", Place: 1},
+				{UserID: "user-id-2", Place: 2},
+			},
+		}).
+		Return([]domain.Rating{
+			{UserID: "user-id", Mode: "duel", Rating: 1662.2, Deviation: 290.3, Volatility: 0.06, Matches: 1},
+			{UserID: "user-id-2", Mode: "duel", Rating: 1337.8, Deviation: 290.3, Volatility: 0.06, Matches: 1},
+		}, nil)
+
+	result, err := suite.controller.ReportMatchResult(context.Background(), suite.request())
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Len(result.Ratings, 2)
+	suite.Equal("user-id", result.Ratings[0].UserID)
+	suite.Equal(1662.2, result.Ratings[0].Rating)
+	suite.Equal(int64(1), result.Ratings[1].Matches)
+}
+
+func (suite *RatingAdminControllerTestSuite) TestReportMatchResult_InvalidMatchResult() {
+	suite.mockRatingService.
+		EXPECT().
+		ReportMatchResult(mock.Anything, mock.Anything).
+		Return(nil, services.ErrInvalidMatchResult)
+
+	result, err := suite.controller.ReportMatchResult(context.Background(), suite.request())
+	suite.ErrorIs(err, ErrInvalidMatchResult)
+	suite.Empty(result)
+}
+
+func (suite *RatingAdminControllerTestSuite) TestReportMatchResult_MatchReported() {
+	suite.mockRatingService.
+		EXPECT().
+		ReportMatchResult(mock.Anything, mock.Anything).
+		Return(nil, services.ErrMatchReported)
+
+	result, err := suite.controller.ReportMatchResult(context.Background(), suite.request())
+	suite.ErrorIs(err, ErrMatchReported)
+	suite.Empty(result)
+}
+
+func (suite *RatingAdminControllerTestSuite) TestReportMatchResult_RatingConflict() {
+	suite.mockRatingService.
+		EXPECT().
+		ReportMatchResult(mock.Anything, mock.Anything).
+		Return(nil, services.ErrRatingConflict)
+
+	result, err := suite.controller.ReportMatchResult(context.Background(), suite.request())
+	suite.ErrorIs(err, ErrRatingConflict)
+	suite.Empty(result)
+}
+
+func (suite *RatingAdminControllerTestSuite) TestReportMatchResult_UserNotFound() {
+	suite.mockRatingService.
+		EXPECT().
+		ReportMatchResult(mock.Anything, mock.Anything).
+		Return(nil, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.ReportMatchResult(context.Background(), suite.request())
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
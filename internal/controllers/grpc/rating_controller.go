@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	ratingpb "game/internal/proto/rating/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrInvalidRatingMode  = status.New(codes.InvalidArgument, "invalid rating mode").Err()
+	ErrInvalidMatchResult = status.New(codes.InvalidArgument, "invalid match result").Err()
+	ErrMatchReported      = status.New(codes.AlreadyExists, "match reported").Err()
+	ErrRatingConflict     = status.New(codes.Aborted, "rating conflict").Err()
+)
+
+type RatingControllerDependencies struct {
+	RatingService services.RatingService
+
+	Logger *logrus.Logger
+}
+
+type ratingController struct {
+	ratingpb.UnimplementedRatingServiceServer
+
+	ratingService services.RatingService
+
+	logger *logrus.Logger
+}
+
+func NewRatingController(deps RatingControllerDependencies) *ratingController {
+	return &ratingController{
+		ratingService: deps.RatingService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *ratingController) GetRatingLeaderboard(ctx context.Context, request *ratingpb.GetRatingLeaderboardRequest) (*ratingpb.GetRatingLeaderboardResponse, error) {
+	controller.logger.
+		WithField("mode", request.Mode).
+		Info("get rating leaderboard request has been received")
+
+	ratedUserScores, err := controller.ratingService.GetRatingLeaderboard(ctx, request.Mode)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("mode", request.Mode).
+			Error("failed to get rating leaderboard")
+
+		if errors.Is(err, services.ErrInvalidRatingMode) {
+			return nil, ErrInvalidRatingMode
+		}
+
+		return nil, ErrInternal
+	}
+
+	var results []*ratingpb.RatedUserScore
+
+	for _, ratedUserScore := range ratedUserScores {
+		userScore := ratedUserScore.UserScore
+
+		results = append(results, &ratingpb.RatedUserScore{
+			UserID:      userScore.UserID,
+			Username:    userScore.Username,
+			Missing:     userScore.Missing,
+			DisplayName: userScore.Profile.DisplayName,
+			CountryCode: userScore.Profile.CountryCode,
+			AvatarURL:   userScore.Profile.AvatarURL,
+			Rank:        userScore.Rank,
+			Percentile:  userScore.Percentile,
+			Rating:      toRatingResponse(ratedUserScore.Rating),
+		})
+	}
+
+	return &ratingpb.GetRatingLeaderboardResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result:    results,
+	}, nil
+}
+
+func (controller *ratingController) GetUserRating(ctx context.Context, request *ratingpb.GetUserRatingRequest) (*ratingpb.GetUserRatingResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"mode":    request.Mode,
+			"user_id": request.UserID,
+		}).
+		Info("get user rating request has been received")
+
+	userID := request.UserID
+
+	if userID == "" {
+		var ok bool
+
+		userID, ok = ctx.Value(ContextKeyUserID).(string)
+		if !ok {
+			return nil, ErrInvalidUserID
+		}
+	}
+
+	userRating, err := controller.ratingService.GetUserRating(ctx, request.Mode, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"mode":    request.Mode,
+				"user_id": userID,
+			}).
+			Error("failed to get user rating")
+
+		switch {
+		case errors.Is(err, services.ErrInvalidRatingMode):
+			return nil, ErrInvalidRatingMode
+		case errors.Is(err, domain.ErrResourceNotFound):
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &ratingpb.GetUserRatingResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Rating:    toRatingResponse(userRating.Rating),
+		Rank:      userRating.Rank,
+	}, nil
+}
+
+func toRatingResponse(rating domain.Rating) *ratingpb.Rating {
+	var updatedAt int64
+
+	if !rating.UpdatedAt.IsZero() {
+		updatedAt = rating.UpdatedAt.Unix()
+	}
+
+	return &ratingpb.Rating{
+		UserID:     rating.UserID,
+		Mode:       rating.Mode,
+		Rating:     rating.Rating,
+		Deviation:  rating.Deviation,
+		Volatility: rating.Volatility,
+		Matches:    rating.Matches,
+		UpdatedAt:  updatedAt,
+	}
+}
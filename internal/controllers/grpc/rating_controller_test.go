@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	ratingpb "game/internal/proto/rating/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type RatingControllerTestSuite struct {
+	suite.Suite
+
+	controller *ratingController
+
+	mockRatingService *mocks.MockRatingService
+}
+
+func TestRatingControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(RatingControllerTestSuite))
+}
+
+func (suite *RatingControllerTestSuite) SetupTest() {
+	suite.mockRatingService = mocks.NewMockRatingService(suite.T())
+
+	suite.controller = NewRatingController(RatingControllerDependencies{
+		RatingService: suite.mockRatingService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *RatingControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *RatingControllerTestSuite) TestGetRatingLeaderboard() {
+	updatedAt := time.Unix(1700000000, 0)
+
+	suite.mockRatingService.
+		EXPECT().
+		GetRatingLeaderboard(mock.Anything, "duel").
+		Return([]domain.RatedUserScore{
+			{
+				UserScore: domain.UserScore{UserID: "user-id", Username: "user", Score: 1600, Rank: 1, Percentile: 100},
+				Rating:    domain.Rating{UserID: "user-id", Mode: "duel", Rating: 1600, Deviation: 80, Volatility: 0.06, Matches: 12, UpdatedAt: updatedAt},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetRatingLeaderboard(context.Background(), &ratingpb.GetRatingLeaderboardRequest{
+		Mode: "duel",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Len(result.Result, 1)
+	suite.Equal("user", result.Result[0].Username)
+	suite.Equal(int64(1), result.Result[0].Rank)
+	suite.Equal(1600.0, result.Result[0].Rating.Rating)
+	suite.Equal(int64(12), result.Result[0].Rating.Matches)
+	suite.Equal(updatedAt.Unix(), result.Result[0].Rating.UpdatedAt)
+}
+
+func (suite *RatingControllerTestSuite) TestGetRatingLeaderboard_InvalidMode() {
+	suite.mockRatingService.
+		EXPECT().
+		GetRatingLeaderboard(mock.Anything, "chess").
+		Return(nil, services.ErrInvalidRatingMode)
+
+	result, err := suite.controller.GetRatingLeaderboard(context.Background(), &ratingpb.GetRatingLeaderboardRequest{
+		Mode: "chess",
+	})
+	suite.ErrorIs(err, ErrInvalidRatingMode)
+	suite.Empty(result)
+}
+
+func (suite *RatingControllerTestSuite) TestGetUserRating() {
+	suite.mockRatingService.
+		EXPECT().
+		GetUserRating(mock.Anything, "duel", "user-id").
+		Return(services.UserRating{
+			Rating: domain.Rating{UserID: "user-id", Mode: "duel", Rating: 1550, Deviation: 120, Volatility: 0.06, Matches: 3},
+			Rank:   7,
+		}, nil)
+
+	result, err := suite.controller.GetUserRating(suite.userContext(), &ratingpb.GetUserRatingRequest{
+		Mode: "duel",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(1550.0, result.Rating.Rating)
+	suite.Equal(int64(7), result.Rank)
+}
+
+func (suite *RatingControllerTestSuite) TestGetUserRating_NotRated() {
+	suite.mockRatingService.
+		EXPECT().
+		GetUserRating(mock.Anything, "duel", "user-id-2").
+		Return(services.UserRating{Rating: domain.NewRating("user-id-2", "duel")}, nil)
+
+	result, err := suite.controller.GetUserRating(suite.userContext(), &ratingpb.GetUserRatingRequest{
+		Mode:   "duel",
+		UserID: "user-id-2",
+	})
+	suite.NoError(err)
+
+	suite.Equal(float64(domain.DefaultRating), result.Rating.Rating)
+	suite.Zero(result.Rating.UpdatedAt)
+	suite.Zero(result.Rank)
+}
+
+func (suite *RatingControllerTestSuite) TestGetUserRating_NoUserID() {
+	result, err := suite.controller.GetUserRating(context.Background(), &ratingpb.GetUserRatingRequest{
+		Mode: "duel",
+	})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *RatingControllerTestSuite) TestGetUserRating_UserNotFound() {
+	suite.mockRatingService.
+		EXPECT().
+		GetUserRating(mock.Anything, "duel", "user-id-2").
+		Return(services.UserRating{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetUserRating(suite.userContext(), &ratingpb.GetUserRatingRequest{
+		Mode:   "duel",
+		UserID: "user-id-2",
+	})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"game/internal/domain"
+)
+
+const HeaderRequestID = "x-request-id"
+
+// requestIDPattern is what a request id sent by the client has to look
+// like, the id ends up in the audit log and in the logs.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestInfoInterceptor attaches the request id and the peer ip of every
+// request to its context, the request id is taken from the x-request-id
+// metadata when the client sends a valid one and generated otherwise.
+type RequestInfoInterceptor struct{}
+
+func NewRequestInfoInterceptor() *RequestInfoInterceptor {
+	return &RequestInfoInterceptor{}
+}
+
+func (interceptor *RequestInfoInterceptor) Intercept(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx = interceptor.withRequestInfo(ctx)
+
+	return handler(ctx, req)
+}
+
+func (interceptor *RequestInfoInterceptor) InterceptStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	return handler(srv, &serverStreamWithContext{
+		ServerStream: ss,
+		ctx:          interceptor.withRequestInfo(ss.Context()),
+	})
+}
+
+func (interceptor *RequestInfoInterceptor) withRequestInfo(ctx context.Context) context.Context {
+	var requestID string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md[HeaderRequestID]; len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+
+	if !requestIDPattern.MatchString(requestID) {
+		requestID = newRequestID()
+	}
+
+	peerIP, _ := peerIP(ctx)
+
+	return domain.ContextWithRequestInfo(ctx, domain.RequestInfo{
+		RequestID: requestID,
+		PeerIP:    peerIP,
+	})
+}
+
+func newRequestID() string {
+	id := make([]byte, 16)
+
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// serverStreamWithContext overrides the context of a server stream so that
+// values added by interceptors reach the stream handler.
+type serverStreamWithContext struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (stream *serverStreamWithContext) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"game/internal/domain"
+)
+
+type RequestInfoInterceptorTestSuite struct {
+	suite.Suite
+
+	interceptor *RequestInfoInterceptor
+}
+
+func TestRequestInfoInterceptorTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestInfoInterceptorTestSuite))
+}
+
+func (suite *RequestInfoInterceptorTestSuite) SetupTest() {
+	suite.interceptor = NewRequestInfoInterceptor()
+}
+
+func (suite *RequestInfoInterceptorTestSuite) intercept(ctx context.Context) domain.RequestInfo {
+	var requestInfo domain.RequestInfo
+
+	_, err := suite.interceptor.Intercept(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "some-method",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestInfo = domain.RequestInfoFromContext(ctx)
+		return nil, nil
+	})
+	suite.NoError(err)
+
+	return requestInfo
+}
+
+func (suite *RequestInfoInterceptorTestSuite) TestIntercept() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		HeaderRequestID: "request-id",
+	}))
+
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000},
+	})
+
+	suite.Equal(domain.RequestInfo{
+		RequestID: "request-id",
+		PeerIP:    "10.0.0.1",
+	}, suite.intercept(ctx))
+}
+
+func (suite *RequestInfoInterceptorTestSuite) TestIntercept_GeneratesRequestID() {
+	requestInfo := suite.intercept(context.Background())
+
+	suite.Len(requestInfo.RequestID, 32)
+	suite.Empty(requestInfo.PeerIP)
+}
+
+func (suite *RequestInfoInterceptorTestSuite) TestIntercept_ReplacesInvalidRequestID() {
+	for _, requestID := range []string{"request id", "request-id\n", strings.Repeat("a", 65)} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+			HeaderRequestID: requestID,
+		}))
+
+		requestInfo := suite.intercept(ctx)
+
+		suite.NotEqual(requestID, requestInfo.RequestID)
+		suite.Len(requestInfo.RequestID, 32)
+	}
+}
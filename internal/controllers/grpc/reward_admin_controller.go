@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rewardpb "game/internal/proto/reward/proto"
+	"game/internal/services"
+)
+
+var ErrInvalidSeasonID = status.New(codes.InvalidArgument, "invalid season id").Err()
+
+type RewardAdminControllerDependencies struct {
+	RewardService services.RewardService
+
+	Logger *logrus.Logger
+}
+
+type rewardAdminController struct {
+	rewardpb.UnimplementedRewardAdminServiceServer
+
+	rewardService services.RewardService
+
+	logger *logrus.Logger
+}
+
+func NewRewardAdminController(deps RewardAdminControllerDependencies) *rewardAdminController {
+	return &rewardAdminController{
+		rewardService: deps.RewardService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *rewardAdminController) EndSeason(ctx context.Context, request *rewardpb.EndSeasonRequest) (*rewardpb.EndSeasonResponse, error) {
+	controller.logger.
+		WithField("season_id", request.SeasonID).
+		Info("end season request has been received")
+
+	result, err := controller.rewardService.EndSeason(ctx, request.SeasonID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("season_id", request.SeasonID).
+			Error("failed to end season")
+
+		if errors.Is(err, services.ErrInvalidSeasonID) {
+			return nil, ErrInvalidSeasonID
+		}
+
+		return nil, ErrInternal
+	}
+
+	controller.logger.
+		WithFields(logrus.Fields{
+			"season_id": request.SeasonID,
+			"ranked":    result.Ranked,
+			"granted":   result.Granted,
+		}).
+		Info("season has been ended")
+
+	return &rewardpb.EndSeasonResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Ranked:    result.Ranked,
+		Granted:   result.Granted,
+	}, nil
+}
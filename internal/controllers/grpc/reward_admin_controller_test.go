@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	rewardpb "game/internal/proto/reward/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type RewardAdminControllerTestSuite struct {
+	suite.Suite
+
+	controller *rewardAdminController
+
+	mockRewardService *mocks.MockRewardService
+}
+
+func TestRewardAdminControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(RewardAdminControllerTestSuite))
+}
+
+func (suite *RewardAdminControllerTestSuite) SetupTest() {
+	suite.mockRewardService = mocks.NewMockRewardService(suite.T())
+
+	suite.controller = NewRewardAdminController(RewardAdminControllerDependencies{
+		RewardService: suite.mockRewardService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *RewardAdminControllerTestSuite) TestEndSeason() {
+	suite.mockRewardService.
+		EXPECT().
+		EndSeason(mock.Anything, "season-1").
+		Return(services.SeasonResult{Ranked: 120, Granted: 30}, nil)
+
+	result, err := suite.controller.EndSeason(context.Background(), &rewardpb.EndSeasonRequest{
+		SeasonID: "season-1",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(int64(120), result.Ranked)
+	suite.Equal(int64(30), result.Granted)
+}
+
+func (suite *RewardAdminControllerTestSuite) TestEndSeason_InvalidSeasonID() {
+	suite.mockRewardService.
+		EXPECT().
+		EndSeason(mock.Anything, "season 1").
+		Return(services.SeasonResult{}, services.ErrInvalidSeasonID)
+
+	result, err := suite.controller.EndSeason(context.Background(), &rewardpb.EndSeasonRequest{
+		SeasonID: "season 1",
+	})
+	suite.ErrorIs(err, ErrInvalidSeasonID)
+	suite.Empty(result)
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	rewardpb "game/internal/proto/reward/proto"
+	"game/internal/services"
+)
+
+type RewardControllerDependencies struct {
+	RewardService services.RewardService
+
+	Logger *logrus.Logger
+}
+
+type rewardController struct {
+	rewardpb.UnimplementedRewardServiceServer
+
+	rewardService services.RewardService
+
+	logger *logrus.Logger
+}
+
+func NewRewardController(deps RewardControllerDependencies) *rewardController {
+	return &rewardController{
+		rewardService: deps.RewardService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *rewardController) ListRewards(ctx context.Context, request *rewardpb.ListRewardsRequest) (*rewardpb.ListRewardsResponse, error) {
+	controller.logger.Info("list rewards request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	grants, err := controller.rewardService.ListRewards(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to list rewards")
+
+		return nil, ErrInternal
+	}
+
+	return &rewardpb.ListRewardsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Grants:    toRewardGrantResponses(grants),
+	}, nil
+}
+
+func (controller *rewardController) ClaimRewards(ctx context.Context, request *rewardpb.ClaimRewardsRequest) (*rewardpb.ClaimRewardsResponse, error) {
+	controller.logger.Info("claim rewards request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	grants, err := controller.rewardService.ClaimRewards(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to claim rewards")
+
+		return nil, ErrInternal
+	}
+
+	return &rewardpb.ClaimRewardsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Grants:    toRewardGrantResponses(grants),
+	}, nil
+}
+
+func toRewardGrantResponses(grants []domain.RewardGrant) []*rewardpb.RewardGrant {
+	var responses []*rewardpb.RewardGrant
+
+	for _, grant := range grants {
+		var claimedAt int64
+		if grant.ClaimedAt != nil {
+			claimedAt = grant.ClaimedAt.Unix()
+		}
+
+		responses = append(responses, &rewardpb.RewardGrant{
+			Id:         grant.ID,
+			SeasonID:   grant.SeasonID,
+			Bracket:    grant.Bracket,
+			Reward:     grant.Reward,
+			Rank:       grant.Rank,
+			Percentile: grant.Percentile,
+			Score:      grant.Score,
+			CreatedAt:  grant.CreatedAt.Unix(),
+			ClaimedAt:  claimedAt,
+		})
+	}
+
+	return responses
+}
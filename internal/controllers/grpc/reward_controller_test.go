@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	rewardpb "game/internal/proto/reward/proto"
+	"game/internal/services/mocks"
+)
+
+type RewardControllerTestSuite struct {
+	suite.Suite
+
+	controller *rewardController
+
+	mockRewardService *mocks.MockRewardService
+}
+
+func TestRewardControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(RewardControllerTestSuite))
+}
+
+func (suite *RewardControllerTestSuite) SetupTest() {
+	suite.mockRewardService = mocks.NewMockRewardService(suite.T())
+
+	suite.controller = NewRewardController(RewardControllerDependencies{
+		RewardService: suite.mockRewardService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *RewardControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *RewardControllerTestSuite) TestListRewards() {
+	createdAt := time.Unix(1700000000, 0)
+	claimedAt := time.Unix(1700000100, 0)
+
+	suite.mockRewardService.
+		EXPECT().
+		ListRewards(mock.Anything, "user-id").
+		Return([]domain.RewardGrant{
+			{
+				ID:         "season-2:user-id",
+				SeasonID:   "season-2",
+				Bracket:    "gold",
+				Reward:     "gold_chest",
+				Rank:       1,
+				Percentile: 100,
+				Score:      500,
+				CreatedAt:  createdAt,
+			},
+			{
+				ID:        "season-1:user-id",
+				SeasonID:  "season-1",
+				Bracket:   "silver",
+				Reward:    "silver_chest",
+				CreatedAt: createdAt,
+				ClaimedAt: &claimedAt,
+			},
+		}, nil)
+
+	result, err := suite.controller.ListRewards(suite.userContext(), &rewardpb.ListRewardsRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Len(result.Grants, 2)
+	suite.Equal("season-2:user-id", result.Grants[0].Id)
+	suite.Equal("gold_chest", result.Grants[0].Reward)
+	suite.Equal(int64(1), result.Grants[0].Rank)
+	suite.Equal(createdAt.Unix(), result.Grants[0].CreatedAt)
+	suite.Zero(result.Grants[0].ClaimedAt)
+	suite.Equal(claimedAt.Unix(), result.Grants[1].ClaimedAt)
+}
+
+func (suite *RewardControllerTestSuite) TestListRewards_NoUserID() {
+	result, err := suite.controller.ListRewards(context.Background(), &rewardpb.ListRewardsRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *RewardControllerTestSuite) TestClaimRewards() {
+	claimedAt := time.Unix(1700000100, 0)
+
+	suite.mockRewardService.
+		EXPECT().
+		ClaimRewards(mock.Anything, "user-id").
+		Return([]domain.RewardGrant{
+			{ID: "season-1:user-id", Reward: "gold_chest", ClaimedAt: &claimedAt},
+		}, nil)
+
+	result, err := suite.controller.ClaimRewards(suite.userContext(), &rewardpb.ClaimRewardsRequest{})
+	suite.NoError(err)
+
+	suite.Len(result.Grants, 1)
+	suite.Equal("gold_chest", result.Grants[0].Reward)
+	suite.Equal(claimedAt.Unix(), result.Grants[0].ClaimedAt)
+}
+
+func (suite *RewardControllerTestSuite) TestClaimRewards_Error() {
+	suite.mockRewardService.
+		EXPECT().
+		ClaimRewards(mock.Anything, "user-id").
+		Return(nil, errors.New("failed"))
+
+	result, err := suite.controller.ClaimRewards(suite.userContext(), &rewardpb.ClaimRewardsRequest{})
+	suite.ErrorIs(err, ErrInternal)
+	suite.Empty(result)
+}
@@ -0,0 +1,381 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	socialpb "game/internal/proto/social/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrFriendIDMissing       = status.New(codes.InvalidArgument, "user id of the other user is required").Err()
+	ErrSameUser              = status.New(codes.InvalidArgument, "the other user is the logged in user").Err()
+	ErrUserBlocked           = status.New(codes.PermissionDenied, "user blocked").Err()
+	ErrUserNotBlocked        = status.New(codes.NotFound, "user not blocked").Err()
+	ErrAlreadyFriends        = status.New(codes.AlreadyExists, "already friends").Err()
+	ErrFriendRequestExists   = status.New(codes.AlreadyExists, "friend request exists").Err()
+	ErrFriendRequestNotFound = status.New(codes.NotFound, "friend request not found").Err()
+	ErrFriendNotFound        = status.New(codes.NotFound, "friend not found").Err()
+	ErrTooManyFriends        = status.New(codes.ResourceExhausted, "too many friends").Err()
+)
+
+type SocialControllerDependencies struct {
+	SocialService services.SocialService
+
+	Logger *logrus.Logger
+}
+
+type socialController struct {
+	socialpb.UnimplementedSocialServiceServer
+
+	socialService services.SocialService
+
+	logger *logrus.Logger
+}
+
+func NewSocialController(deps SocialControllerDependencies) *socialController {
+	return &socialController{
+		socialService: deps.SocialService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *socialController) SendFriendRequest(ctx context.Context, request *socialpb.SendFriendRequestRequest) (*socialpb.SendFriendRequestResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("send friend request request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	friendship, err := controller.socialService.SendFriendRequest(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to send friend request")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.SendFriendRequestResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Accepted:  friendship.Status == domain.FriendshipStatusAccepted,
+	}, nil
+}
+
+func (controller *socialController) AcceptFriendRequest(ctx context.Context, request *socialpb.AcceptFriendRequestRequest) (*socialpb.AcceptFriendRequestResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("accept friend request request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	err := controller.socialService.AcceptFriendRequest(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to accept friend request")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.AcceptFriendRequestResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *socialController) DeclineFriendRequest(ctx context.Context, request *socialpb.DeclineFriendRequestRequest) (*socialpb.DeclineFriendRequestResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("decline friend request request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	err := controller.socialService.DeclineFriendRequest(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to decline friend request")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.DeclineFriendRequestResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *socialController) RemoveFriend(ctx context.Context, request *socialpb.RemoveFriendRequest) (*socialpb.RemoveFriendResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("remove friend request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	err := controller.socialService.RemoveFriend(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to remove friend")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.RemoveFriendResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *socialController) BlockUser(ctx context.Context, request *socialpb.BlockUserRequest) (*socialpb.BlockUserResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("block user request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	err := controller.socialService.BlockUser(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to block user")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.BlockUserResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *socialController) UnblockUser(ctx context.Context, request *socialpb.UnblockUserRequest) (*socialpb.UnblockUserResponse, error) {
+	controller.logger.
+		WithField("friend_id", request.UserID).
+		Info("unblock user request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.UserID == "" {
+		return nil, ErrFriendIDMissing
+	}
+
+	err := controller.socialService.UnblockUser(ctx, userID, request.UserID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"friend_id": request.UserID,
+			}).
+			Error("failed to unblock user")
+
+		return nil, controller.socialError(err)
+	}
+
+	return &socialpb.UnblockUserResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *socialController) ListFriends(ctx context.Context, request *socialpb.ListFriendsRequest) (*socialpb.ListFriendsResponse, error) {
+	controller.logger.Info("list friends request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	friends, err := controller.socialService.ListFriends(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to list friends")
+
+		return nil, ErrInternal
+	}
+
+	return &socialpb.ListFriendsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Friends:   toFriendResponses(friends),
+	}, nil
+}
+
+func (controller *socialController) ListFriendRequests(ctx context.Context, request *socialpb.ListFriendRequestsRequest) (*socialpb.ListFriendRequestsResponse, error) {
+	controller.logger.Info("list friend requests request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	requests, err := controller.socialService.ListFriendRequests(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to list friend requests")
+
+		return nil, ErrInternal
+	}
+
+	return &socialpb.ListFriendRequestsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Requests:  toFriendResponses(requests),
+	}, nil
+}
+
+func (controller *socialController) GetFriendsLeaderboard(ctx context.Context, request *socialpb.GetFriendsLeaderboardRequest) (*socialpb.GetFriendsLeaderboardResponse, error) {
+	controller.logger.Info("get friends leaderboard request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	leaderboard, err := controller.socialService.GetFriendsLeaderboard(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to get friends leaderboard")
+
+		return nil, ErrInternal
+	}
+
+	var results []*socialpb.FriendScore
+
+	for _, userScore := range leaderboard.UserScores {
+		results = append(results, &socialpb.FriendScore{
+			UserID:      userScore.UserID,
+			Username:    userScore.Username,
+			Score:       userScore.Score,
+			DisplayName: userScore.Profile.DisplayName,
+			CountryCode: userScore.Profile.CountryCode,
+			AvatarURL:   userScore.Profile.AvatarURL,
+			Rank:        userScore.Rank,
+			Percentile:  userScore.Percentile,
+		})
+	}
+
+	return &socialpb.GetFriendsLeaderboardResponse{
+		Status:      StatusSuccess,
+		Timestamp:   time.Now().Unix(),
+		Results:     results,
+		GeneratedAt: leaderboard.GeneratedAt.Unix(),
+	}, nil
+}
+
+func (controller *socialController) socialError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrSameUser):
+		return ErrSameUser
+	case errors.Is(err, services.ErrUserBlocked):
+		return ErrUserBlocked
+	case errors.Is(err, services.ErrUserNotBlocked):
+		return ErrUserNotBlocked
+	case errors.Is(err, services.ErrAlreadyFriends):
+		return ErrAlreadyFriends
+	case errors.Is(err, services.ErrFriendRequestExists), errors.Is(err, domain.ErrResourceExists):
+		return ErrFriendRequestExists
+	case errors.Is(err, services.ErrFriendRequestNotFound):
+		return ErrFriendRequestNotFound
+	case errors.Is(err, services.ErrFriendNotFound):
+		return ErrFriendNotFound
+	case errors.Is(err, services.ErrTooManyFriends):
+		return ErrTooManyFriends
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrUserNotFound
+	default:
+		return ErrInternal
+	}
+}
+
+func toFriendResponses(friends []services.Friend) []*socialpb.Friend {
+	var results []*socialpb.Friend
+
+	for _, friend := range friends {
+		results = append(results, &socialpb.Friend{
+			UserID:      friend.UserID,
+			Username:    friend.User.Name,
+			DisplayName: friend.User.Profile.DisplayName,
+			CountryCode: friend.User.Profile.CountryCode,
+			AvatarURL:   friend.User.Profile.AvatarURL,
+			Incoming:    friend.Incoming,
+			Since:       friend.Since.Unix(),
+		})
+	}
+
+	return results
+}
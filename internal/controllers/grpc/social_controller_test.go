@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	socialpb "game/internal/proto/social/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type SocialControllerTestSuite struct {
+	suite.Suite
+
+	controller *socialController
+
+	mockSocialService *mocks.MockSocialService
+}
+
+func TestSocialControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(SocialControllerTestSuite))
+}
+
+func (suite *SocialControllerTestSuite) SetupTest() {
+	suite.mockSocialService = mocks.NewMockSocialService(suite.T())
+
+	suite.controller = NewSocialController(SocialControllerDependencies{
+		SocialService: suite.mockSocialService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *SocialControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest() {
+	suite.mockSocialService.
+		EXPECT().
+		SendFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(domain.Friendship{Status: domain.FriendshipStatusPending}, nil)
+
+	result, err := suite.controller.SendFriendRequest(suite.userContext(), &socialpb.SendFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.False(result.Accepted)
+	suite.NotEmpty(result.Timestamp)
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest_Accepted() {
+	suite.mockSocialService.
+		EXPECT().
+		SendFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(domain.Friendship{Status: domain.FriendshipStatusAccepted}, nil)
+
+	result, err := suite.controller.SendFriendRequest(suite.userContext(), &socialpb.SendFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.NoError(err)
+	suite.True(result.Accepted)
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest_NoUserID() {
+	result, err := suite.controller.SendFriendRequest(context.Background(), &socialpb.SendFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest_FriendIDMissing() {
+	result, err := suite.controller.SendFriendRequest(suite.userContext(), &socialpb.SendFriendRequestRequest{})
+	suite.ErrorIs(err, ErrFriendIDMissing)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest_Blocked() {
+	suite.mockSocialService.
+		EXPECT().
+		SendFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(domain.Friendship{}, services.ErrUserBlocked)
+
+	result, err := suite.controller.SendFriendRequest(suite.userContext(), &socialpb.SendFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.ErrorIs(err, ErrUserBlocked)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestSendFriendRequest_UserNotFound() {
+	suite.mockSocialService.
+		EXPECT().
+		SendFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(domain.Friendship{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.SendFriendRequest(suite.userContext(), &socialpb.SendFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestAcceptFriendRequest() {
+	suite.mockSocialService.
+		EXPECT().
+		AcceptFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(nil)
+
+	result, err := suite.controller.AcceptFriendRequest(suite.userContext(), &socialpb.AcceptFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *SocialControllerTestSuite) TestAcceptFriendRequest_NotFound() {
+	suite.mockSocialService.
+		EXPECT().
+		AcceptFriendRequest(mock.Anything, "user-id", "friend-id").
+		Return(services.ErrFriendRequestNotFound)
+
+	result, err := suite.controller.AcceptFriendRequest(suite.userContext(), &socialpb.AcceptFriendRequestRequest{
+		UserID: "friend-id",
+	})
+	suite.ErrorIs(err, ErrFriendRequestNotFound)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestRemoveFriend_NotFriends() {
+	suite.mockSocialService.
+		EXPECT().
+		RemoveFriend(mock.Anything, "user-id", "friend-id").
+		Return(services.ErrFriendNotFound)
+
+	result, err := suite.controller.RemoveFriend(suite.userContext(), &socialpb.RemoveFriendRequest{
+		UserID: "friend-id",
+	})
+	suite.ErrorIs(err, ErrFriendNotFound)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestBlockUser() {
+	suite.mockSocialService.
+		EXPECT().
+		BlockUser(mock.Anything, "user-id", "friend-id").
+		Return(nil)
+
+	result, err := suite.controller.BlockUser(suite.userContext(), &socialpb.BlockUserRequest{
+		UserID: "friend-id",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *SocialControllerTestSuite) TestBlockUser_SameUser() {
+	suite.mockSocialService.
+		EXPECT().
+		BlockUser(mock.Anything, "user-id", "user-id").
+		Return(services.ErrSameUser)
+
+	result, err := suite.controller.BlockUser(suite.userContext(), &socialpb.BlockUserRequest{
+		UserID: "user-id",
+	})
+	suite.ErrorIs(err, ErrSameUser)
+	suite.Empty(result)
+}
+
+func (suite *SocialControllerTestSuite) TestListFriends() {
+	since := time.Unix(1700000000, 0)
+
+	suite.mockSocialService.
+		EXPECT().
+		ListFriends(mock.Anything, "user-id").
+		Return([]services.Friend{
+			{
+				UserID: "friend-id",
+				User:   domain.PublicUser{Name: "friend", Profile: domain.UserProfile{DisplayName: "Friend"}},
+				Since:  since,
+			},
+		}, nil)
+
+	result, err := suite.controller.ListFriends(suite.userContext(), &socialpb.ListFriendsRequest{})
+	suite.NoError(err)
+
+	suite.Equal([]*socialpb.Friend{
+		{
+			UserID:      "friend-id",
+			Username:    "friend",
+			DisplayName: "Friend",
+			Since:       since.Unix(),
+		},
+	}, result.Friends)
+}
+
+func (suite *SocialControllerTestSuite) TestGetFriendsLeaderboard() {
+	generatedAt := time.Now()
+
+	suite.mockSocialService.
+		EXPECT().
+		GetFriendsLeaderboard(mock.Anything, "user-id").
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "friend-id", Username: "friend", Score: 86},
+				{UserID: "user-id", Username: "username", Score: 82},
+			},
+			GeneratedAt: generatedAt,
+		}, nil)
+
+	result, err := suite.controller.GetFriendsLeaderboard(suite.userContext(), &socialpb.GetFriendsLeaderboardRequest{})
+	suite.NoError(err)
+
+	suite.Equal([]*socialpb.FriendScore{
+		{UserID: "friend-id", Username: "friend", Score: 86},
+		{UserID: "user-id", Username: "username", Score: 82},
+	}, result.Results)
+	suite.Equal(generatedAt.Unix(), result.GeneratedAt)
+}
+
+func (suite *SocialControllerTestSuite) TestGetFriendsLeaderboard_ServiceFailed() {
+	suite.mockSocialService.
+		EXPECT().
+		GetFriendsLeaderboard(mock.Anything, "user-id").
+		Return(domain.Leaderboard{}, domain.ErrInternal)
+
+	result, err := suite.controller.GetFriendsLeaderboard(suite.userContext(), &socialpb.GetFriendsLeaderboardRequest{})
+	suite.ErrorIs(err, ErrInternal)
+	suite.Empty(result)
+}
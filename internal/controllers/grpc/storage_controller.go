@@ -0,0 +1,235 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	storagepb "game/internal/proto/storage/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrInvalidStorageObject   = status.New(codes.InvalidArgument, "invalid storage object").Err()
+	ErrStorageObjectTooLarge  = status.New(codes.InvalidArgument, "storage object too large").Err()
+	ErrStorageObjectNotFound  = status.New(codes.NotFound, "storage object not found").Err()
+	ErrStorageQuotaExceeded   = status.New(codes.ResourceExhausted, "storage quota exceeded").Err()
+	ErrStorageVersionConflict = status.New(codes.Aborted, "storage version conflict").Err()
+)
+
+type StorageControllerDependencies struct {
+	StorageService services.StorageService
+
+	Logger *logrus.Logger
+}
+
+type storageController struct {
+	storagepb.UnimplementedStorageServiceServer
+
+	storageService services.StorageService
+
+	logger *logrus.Logger
+}
+
+func NewStorageController(deps StorageControllerDependencies) *storageController {
+	return &storageController{
+		storageService: deps.StorageService,
+		logger:         deps.Logger,
+	}
+}
+
+func (controller *storageController) Put(ctx context.Context, request *storagepb.PutRequest) (*storagepb.PutResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"namespace": request.Namespace,
+			"key":       request.Key,
+			"version":   request.Version,
+		}).
+		Info("put storage object request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	object, err := controller.storageService.Put(ctx, domain.StorageObject{
+		UserID:     userID,
+		Namespace:  request.Namespace,
+		Key:        request.Key,
+		Value:      request.Value,
+		Permission: request.Permission,
+	}, request.Version)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"namespace": request.Namespace,
+				"key":       request.Key,
+			}).
+			Error("failed to put storage object")
+
+		return nil, storageError(err)
+	}
+
+	return &storagepb.PutResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Object:    toStorageObjectResponse(object),
+	}, nil
+}
+
+func (controller *storageController) Get(ctx context.Context, request *storagepb.GetRequest) (*storagepb.GetResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"owner_id":  request.UserID,
+			"namespace": request.Namespace,
+			"key":       request.Key,
+		}).
+		Info("get storage object request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	ownerID := request.UserID
+	if ownerID == "" {
+		ownerID = userID
+	}
+
+	object, err := controller.storageService.Get(ctx, userID, ownerID, request.Namespace, request.Key)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"owner_id":  ownerID,
+				"namespace": request.Namespace,
+				"key":       request.Key,
+			}).
+			Error("failed to get storage object")
+
+		return nil, storageError(err)
+	}
+
+	return &storagepb.GetResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Object:    toStorageObjectResponse(object),
+	}, nil
+}
+
+func (controller *storageController) List(ctx context.Context, request *storagepb.ListRequest) (*storagepb.ListResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"owner_id":  request.UserID,
+			"namespace": request.Namespace,
+		}).
+		Info("list storage objects request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	ownerID := request.UserID
+	if ownerID == "" {
+		ownerID = userID
+	}
+
+	objects, err := controller.storageService.List(ctx, userID, ownerID, request.Namespace)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"owner_id":  ownerID,
+				"namespace": request.Namespace,
+			}).
+			Error("failed to list storage objects")
+
+		return nil, storageError(err)
+	}
+
+	var results []*storagepb.StorageObject
+
+	for _, object := range objects {
+		results = append(results, toStorageObjectResponse(object))
+	}
+
+	return &storagepb.ListResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Objects:   results,
+	}, nil
+}
+
+func (controller *storageController) Delete(ctx context.Context, request *storagepb.DeleteRequest) (*storagepb.DeleteResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"namespace": request.Namespace,
+			"key":       request.Key,
+			"version":   request.Version,
+		}).
+		Info("delete storage object request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	err := controller.storageService.Delete(ctx, userID, request.Namespace, request.Key, request.Version)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":   userID,
+				"namespace": request.Namespace,
+				"key":       request.Key,
+			}).
+			Error("failed to delete storage object")
+
+		return nil, storageError(err)
+	}
+
+	return &storagepb.DeleteResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidStorageObject):
+		return ErrInvalidStorageObject
+	case errors.Is(err, services.ErrStorageObjectTooLarge):
+		return ErrStorageObjectTooLarge
+	case errors.Is(err, services.ErrStorageQuotaExceeded):
+		return ErrStorageQuotaExceeded
+	case errors.Is(err, services.ErrStorageVersionConflict):
+		return ErrStorageVersionConflict
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrStorageObjectNotFound
+	default:
+		return ErrInternal
+	}
+}
+
+func toStorageObjectResponse(object domain.StorageObject) *storagepb.StorageObject {
+	return &storagepb.StorageObject{
+		UserID:     object.UserID,
+		Namespace:  object.Namespace,
+		Key:        object.Key,
+		Value:      object.Value,
+		Permission: object.Permission,
+		Version:    object.Version,
+		CreatedAt:  object.CreatedAt.Unix(),
+		UpdatedAt:  object.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	storagepb "game/internal/proto/storage/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type StorageControllerTestSuite struct {
+	suite.Suite
+
+	controller *storageController
+
+	mockStorageService *mocks.MockStorageService
+}
+
+func TestStorageControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(StorageControllerTestSuite))
+}
+
+func (suite *StorageControllerTestSuite) SetupTest() {
+	suite.mockStorageService = mocks.NewMockStorageService(suite.T())
+
+	suite.controller = NewStorageController(StorageControllerDependencies{
+		StorageService: suite.mockStorageService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *StorageControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *StorageControllerTestSuite) TestPut() {
+	suite.mockStorageService.
+		EXPECT().
+		Put(mock.Anything, domain.StorageObject{
+			UserID:     "user-id",
+			Namespace:  "settings",
+			Key:        "audio",
+			Value:      []byte(`{"volume":80}`),
+			Permission: domain.StoragePermissionPublicRead,
+		}, int64(0)).
+		Return(domain.StorageObject{
+			UserID:     "user-id",
+			Namespace:  "settings",
+			Key:        "audio",
+			Value:      []byte(`{"volume":80}`),
+			Permission: domain.StoragePermissionPublicRead,
+			Version:    1,
+			CreatedAt:  time.Unix(1700000000, 0),
+			UpdatedAt:  time.Unix(1700000000, 0),
+		}, nil)
+
+	result, err := suite.controller.Put(suite.userContext(), &storagepb.PutRequest{
+		Namespace:  "settings",
+		Key:        "audio",
+		Value:      []byte(`{"volume":80}`),
+		Permission: domain.StoragePermissionPublicRead,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(int64(1), result.Object.Version)
+	suite.Equal([]byte(`{"volume":80}`), result.Object.Value)
+	suite.Equal(int64(1700000000), result.Object.UpdatedAt)
+}
+
+func (suite *StorageControllerTestSuite) TestPut_VersionConflict() {
+	suite.mockStorageService.
+		EXPECT().
+		Put(mock.Anything, mock.Anything, int64(2)).
+		Return(domain.StorageObject{}, services.ErrStorageVersionConflict)
+
+	result, err := suite.controller.Put(suite.userContext(), &storagepb.PutRequest{
+		Namespace: "settings",
+		Key:       "audio",
+		Version:   2,
+	})
+	suite.ErrorIs(err, ErrStorageVersionConflict)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestPut_TooLarge() {
+	suite.mockStorageService.
+		EXPECT().
+		Put(mock.Anything, mock.Anything, int64(0)).
+		Return(domain.StorageObject{}, services.ErrStorageObjectTooLarge)
+
+	result, err := suite.controller.Put(suite.userContext(), &storagepb.PutRequest{
+		Namespace: "settings",
+		Key:       "audio",
+	})
+	suite.ErrorIs(err, ErrStorageObjectTooLarge)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestPut_QuotaExceeded() {
+	suite.mockStorageService.
+		EXPECT().
+		Put(mock.Anything, mock.Anything, int64(0)).
+		Return(domain.StorageObject{}, services.ErrStorageQuotaExceeded)
+
+	result, err := suite.controller.Put(suite.userContext(), &storagepb.PutRequest{
+		Namespace: "settings",
+		Key:       "audio",
+	})
+	suite.ErrorIs(err, ErrStorageQuotaExceeded)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestPut_NoUserID() {
+	result, err := suite.controller.Put(context.Background(), &storagepb.PutRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestGet_Own() {
+	suite.mockStorageService.
+		EXPECT().
+		Get(mock.Anything, "user-id", "user-id", "settings", "audio").
+		Return(domain.StorageObject{UserID: "user-id", Key: "audio", Version: 3}, nil)
+
+	result, err := suite.controller.Get(suite.userContext(), &storagepb.GetRequest{
+		Namespace: "settings",
+		Key:       "audio",
+	})
+	suite.NoError(err)
+
+	suite.Equal("audio", result.Object.Key)
+	suite.Equal(int64(3), result.Object.Version)
+}
+
+func (suite *StorageControllerTestSuite) TestGet_OtherUser() {
+	suite.mockStorageService.
+		EXPECT().
+		Get(mock.Anything, "user-id", "user-id-2", "progress", "level").
+		Return(domain.StorageObject{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.Get(suite.userContext(), &storagepb.GetRequest{
+		UserID:    "user-id-2",
+		Namespace: "progress",
+		Key:       "level",
+	})
+	suite.ErrorIs(err, ErrStorageObjectNotFound)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestList() {
+	suite.mockStorageService.
+		EXPECT().
+		List(mock.Anything, "user-id", "user-id-2", "progress").
+		Return([]domain.StorageObject{{UserID: "user-id-2", Key: "level"}}, nil)
+
+	result, err := suite.controller.List(suite.userContext(), &storagepb.ListRequest{
+		UserID:    "user-id-2",
+		Namespace: "progress",
+	})
+	suite.NoError(err)
+
+	suite.Len(result.Objects, 1)
+	suite.Equal("level", result.Objects[0].Key)
+}
+
+func (suite *StorageControllerTestSuite) TestList_Invalid() {
+	suite.mockStorageService.
+		EXPECT().
+		List(mock.Anything, "user-id", "user-id", "").
+		Return(nil, services.ErrInvalidStorageObject)
+
+	result, err := suite.controller.List(suite.userContext(), &storagepb.ListRequest{})
+	suite.ErrorIs(err, ErrInvalidStorageObject)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestDelete() {
+	suite.mockStorageService.
+		EXPECT().
+		Delete(mock.Anything, "user-id", "settings", "audio", int64(3)).
+		Return(nil)
+
+	result, err := suite.controller.Delete(suite.userContext(), &storagepb.DeleteRequest{
+		Namespace: "settings",
+		Key:       "audio",
+		Version:   3,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *StorageControllerTestSuite) TestDelete_NotFound() {
+	suite.mockStorageService.
+		EXPECT().
+		Delete(mock.Anything, "user-id", "settings", "audio", int64(3)).
+		Return(domain.ErrResourceNotFound)
+
+	result, err := suite.controller.Delete(suite.userContext(), &storagepb.DeleteRequest{
+		Namespace: "settings",
+		Key:       "audio",
+		Version:   3,
+	})
+	suite.ErrorIs(err, ErrStorageObjectNotFound)
+	suite.Empty(result)
+}
+
+func (suite *StorageControllerTestSuite) TestDelete_NoUserID() {
+	result, err := suite.controller.Delete(context.Background(), &storagepb.DeleteRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	tournamentpb "game/internal/proto/tournament/proto"
+	"game/internal/services"
+)
+
+type TournamentAdminControllerDependencies struct {
+	TournamentService services.TournamentService
+
+	Logger *logrus.Logger
+}
+
+type tournamentAdminController struct {
+	tournamentpb.UnimplementedTournamentAdminServiceServer
+
+	tournamentService services.TournamentService
+
+	logger *logrus.Logger
+}
+
+func NewTournamentAdminController(deps TournamentAdminControllerDependencies) *tournamentAdminController {
+	return &tournamentAdminController{
+		tournamentService: deps.TournamentService,
+		logger:            deps.Logger,
+	}
+}
+
+func (controller *tournamentAdminController) CreateTournament(ctx context.Context, request *tournamentpb.CreateTournamentRequest) (*tournamentpb.CreateTournamentResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"name":   request.Name,
+			"format": request.Format,
+		}).
+		Info("create tournament request has been received")
+
+	tournament, err := controller.tournamentService.CreateTournament(ctx, domain.Tournament{
+		Name:          request.Name,
+		Format:        request.Format,
+		MaxPlayers:    request.MaxPlayers,
+		Rounds:        request.Rounds,
+		RoundDuration: time.Duration(request.RoundDuration) * time.Second,
+		StartsAt:      time.Unix(request.StartsAt, 0),
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("name", request.Name).
+			Error("failed to create tournament")
+
+		return nil, tournamentError(err)
+	}
+
+	controller.logger.
+		WithField("tournament_id", tournament.ID).
+		Info("tournament has been created")
+
+	return &tournamentpb.CreateTournamentResponse{
+		Status:     StatusSuccess,
+		Timestamp:  time.Now().Unix(),
+		Tournament: toTournamentResponse(tournament),
+	}, nil
+}
+
+func (controller *tournamentAdminController) RecordMatchResult(ctx context.Context, request *tournamentpb.RecordMatchResultRequest) (*tournamentpb.RecordMatchResultResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"tournament_id": request.TournamentID,
+			"match_id":      request.MatchID,
+		}).
+		Info("record match result request has been received")
+
+	if request.TournamentID == "" {
+		return nil, ErrTournamentIDMissing
+	}
+
+	tournament, err := controller.tournamentService.RecordResult(ctx, request.TournamentID, request.MatchID, request.WinnerID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"tournament_id": request.TournamentID,
+				"match_id":      request.MatchID,
+			}).
+			Error("failed to record match result")
+
+		return nil, tournamentError(err)
+	}
+
+	return &tournamentpb.RecordMatchResultResponse{
+		Status:     StatusSuccess,
+		Timestamp:  time.Now().Unix(),
+		Tournament: toTournamentResponse(tournament),
+	}, nil
+}
+
+func (controller *tournamentAdminController) RecordScore(ctx context.Context, request *tournamentpb.RecordScoreRequest) (*tournamentpb.RecordScoreResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"tournament_id": request.TournamentID,
+			"user_id":       request.UserID,
+			"score":         request.Score,
+		}).
+		Info("record tournament score request has been received")
+
+	if request.TournamentID == "" {
+		return nil, ErrTournamentIDMissing
+	}
+
+	tournament, err := controller.tournamentService.RecordScore(ctx, request.TournamentID, request.UserID, request.Score)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"tournament_id": request.TournamentID,
+				"user_id":       request.UserID,
+			}).
+			Error("failed to record tournament score")
+
+		return nil, tournamentError(err)
+	}
+
+	return &tournamentpb.RecordScoreResponse{
+		Status:     StatusSuccess,
+		Timestamp:  time.Now().Unix(),
+		Tournament: toTournamentResponse(tournament),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	tournamentpb "game/internal/proto/tournament/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type TournamentAdminControllerTestSuite struct {
+	suite.Suite
+
+	controller *tournamentAdminController
+
+	mockTournamentService *mocks.MockTournamentService
+}
+
+func TestTournamentAdminControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TournamentAdminControllerTestSuite))
+}
+
+func (suite *TournamentAdminControllerTestSuite) SetupTest() {
+	suite.mockTournamentService = mocks.NewMockTournamentService(suite.T())
+
+	suite.controller = NewTournamentAdminController(TournamentAdminControllerDependencies{
+		TournamentService: suite.mockTournamentService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestCreateTournament() {
+	suite.mockTournamentService.
+		EXPECT().
+		CreateTournament(mock.Anything, domain.Tournament{
+			Name:          "Weekly Cup",
+			Format:        domain.TournamentSwiss,
+			MaxPlayers:    32,
+			Rounds:        5,
+			RoundDuration: 30 * time.Minute,
+			StartsAt:      time.Unix(1700000000, 0),
+		}).
+		Return(domain.Tournament{
+			ID:        "tournament-id",
+			Name:      "Weekly Cup",
+			Status:    domain.TournamentRegistering,
+			StartsAt:  time.Unix(1700000000, 0),
+			CreatedAt: time.Unix(1690000000, 0),
+		}, nil)
+
+	result, err := suite.controller.CreateTournament(context.Background(), &tournamentpb.CreateTournamentRequest{
+		Name:          "Weekly Cup",
+		Format:        domain.TournamentSwiss,
+		MaxPlayers:    32,
+		Rounds:        5,
+		RoundDuration: 1800,
+		StartsAt:      1700000000,
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("tournament-id", result.Tournament.Id)
+	suite.Equal(domain.TournamentRegistering, result.Tournament.Status)
+	suite.Equal(int64(1690000000), result.Tournament.CreatedAt)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestCreateTournament_Invalid() {
+	suite.mockTournamentService.
+		EXPECT().
+		CreateTournament(mock.Anything, mock.Anything).
+		Return(domain.Tournament{}, services.ErrInvalidTournament)
+
+	result, err := suite.controller.CreateTournament(context.Background(), &tournamentpb.CreateTournamentRequest{
+		Format: "round_robin",
+	})
+	suite.ErrorIs(err, ErrInvalidTournament)
+	suite.Empty(result)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordMatchResult() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordResult(mock.Anything, "tournament-id", "1-1", "user-id").
+		Return(domain.Tournament{ID: "tournament-id", CurrentRound: 2}, nil)
+
+	result, err := suite.controller.RecordMatchResult(context.Background(), &tournamentpb.RecordMatchResultRequest{
+		TournamentID: "tournament-id",
+		MatchID:      "1-1",
+		WinnerID:     "user-id",
+	})
+	suite.NoError(err)
+
+	suite.Equal(int64(2), result.Tournament.CurrentRound)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordMatchResult_Invalid() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordResult(mock.Anything, "tournament-id", "1-1", "").
+		Return(domain.Tournament{}, services.ErrInvalidTournamentResult)
+
+	result, err := suite.controller.RecordMatchResult(context.Background(), &tournamentpb.RecordMatchResultRequest{
+		TournamentID: "tournament-id",
+		MatchID:      "1-1",
+	})
+	suite.ErrorIs(err, ErrInvalidTournamentResult)
+	suite.Empty(result)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordMatchResult_Conflict() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordResult(mock.Anything, "tournament-id", "1-1", "user-id").
+		Return(domain.Tournament{}, services.ErrTournamentConflict)
+
+	result, err := suite.controller.RecordMatchResult(context.Background(), &tournamentpb.RecordMatchResultRequest{
+		TournamentID: "tournament-id",
+		MatchID:      "1-1",
+		WinnerID:     "user-id",
+	})
+	suite.ErrorIs(err, ErrTournamentConflict)
+	suite.Empty(result)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordMatchResult_IDMissing() {
+	result, err := suite.controller.RecordMatchResult(context.Background(), &tournamentpb.RecordMatchResultRequest{})
+	suite.ErrorIs(err, ErrTournamentIDMissing)
+	suite.Empty(result)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordScore() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordScore(mock.Anything, "tournament-id", "user-id", 1250.0).
+		Return(domain.Tournament{
+			ID:      "tournament-id",
+			Players: []domain.TournamentPlayer{{UserID: "user-id", Score: 1250, Scored: true}},
+		}, nil)
+
+	result, err := suite.controller.RecordScore(context.Background(), &tournamentpb.RecordScoreRequest{
+		TournamentID: "tournament-id",
+		UserID:       "user-id",
+		Score:        1250,
+	})
+	suite.NoError(err)
+
+	suite.Equal(1250.0, result.Tournament.Players[0].Score)
+	suite.True(result.Tournament.Players[0].Scored)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordScore_NotRunning() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordScore(mock.Anything, "tournament-id", "user-id", 1250.0).
+		Return(domain.Tournament{}, services.ErrTournamentNotRunning)
+
+	result, err := suite.controller.RecordScore(context.Background(), &tournamentpb.RecordScoreRequest{
+		TournamentID: "tournament-id",
+		UserID:       "user-id",
+		Score:        1250,
+	})
+	suite.ErrorIs(err, ErrTournamentNotRunning)
+	suite.Empty(result)
+}
+
+func (suite *TournamentAdminControllerTestSuite) TestRecordScore_NotRegistered() {
+	suite.mockTournamentService.
+		EXPECT().
+		RecordScore(mock.Anything, "tournament-id", "user-id", 1250.0).
+		Return(domain.Tournament{}, services.ErrNotRegistered)
+
+	result, err := suite.controller.RecordScore(context.Background(), &tournamentpb.RecordScoreRequest{
+		TournamentID: "tournament-id",
+		UserID:       "user-id",
+		Score:        1250,
+	})
+	suite.ErrorIs(err, ErrNotRegistered)
+	suite.Empty(result)
+}
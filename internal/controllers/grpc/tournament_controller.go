@@ -0,0 +1,222 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	tournamentpb "game/internal/proto/tournament/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrTournamentIDMissing     = status.New(codes.InvalidArgument, "tournament id is required").Err()
+	ErrInvalidTournament       = status.New(codes.InvalidArgument, "invalid tournament").Err()
+	ErrInvalidTournamentResult = status.New(codes.InvalidArgument, "invalid tournament result").Err()
+	ErrTournamentNotFound      = status.New(codes.NotFound, "tournament not found").Err()
+	ErrAlreadyRegistered       = status.New(codes.AlreadyExists, "already registered").Err()
+	ErrRegistrationClosed      = status.New(codes.FailedPrecondition, "registration closed").Err()
+	ErrNotRegistered           = status.New(codes.FailedPrecondition, "not registered").Err()
+	ErrTournamentNotRunning    = status.New(codes.FailedPrecondition, "tournament not running").Err()
+	ErrTournamentFull          = status.New(codes.ResourceExhausted, "tournament full").Err()
+	ErrTournamentConflict      = status.New(codes.Aborted, "tournament conflict").Err()
+)
+
+type TournamentControllerDependencies struct {
+	TournamentService services.TournamentService
+
+	Logger *logrus.Logger
+}
+
+type tournamentController struct {
+	tournamentpb.UnimplementedTournamentServiceServer
+
+	tournamentService services.TournamentService
+
+	logger *logrus.Logger
+}
+
+func NewTournamentController(deps TournamentControllerDependencies) *tournamentController {
+	return &tournamentController{
+		tournamentService: deps.TournamentService,
+		logger:            deps.Logger,
+	}
+}
+
+func (controller *tournamentController) GetTournament(ctx context.Context, request *tournamentpb.GetTournamentRequest) (*tournamentpb.GetTournamentResponse, error) {
+	controller.logger.
+		WithField("tournament_id", request.Id).
+		Info("get tournament request has been received")
+
+	if request.Id == "" {
+		return nil, ErrTournamentIDMissing
+	}
+
+	tournament, err := controller.tournamentService.GetTournament(ctx, request.Id)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("tournament_id", request.Id).
+			Error("failed to get tournament")
+
+		return nil, tournamentError(err)
+	}
+
+	return &tournamentpb.GetTournamentResponse{
+		Status:     StatusSuccess,
+		Timestamp:  time.Now().Unix(),
+		Tournament: toTournamentResponse(tournament),
+	}, nil
+}
+
+func (controller *tournamentController) ListTournaments(ctx context.Context, request *tournamentpb.ListTournamentsRequest) (*tournamentpb.ListTournamentsResponse, error) {
+	controller.logger.
+		WithField("tournament_status", request.TournamentStatus).
+		Info("list tournaments request has been received")
+
+	tournaments, err := controller.tournamentService.ListTournaments(ctx, request.TournamentStatus)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("tournament_status", request.TournamentStatus).
+			Error("failed to list tournaments")
+
+		return nil, tournamentError(err)
+	}
+
+	var results []*tournamentpb.Tournament
+
+	for _, tournament := range tournaments {
+		results = append(results, toTournamentResponse(tournament))
+	}
+
+	return &tournamentpb.ListTournamentsResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result:    results,
+	}, nil
+}
+
+func (controller *tournamentController) RegisterForTournament(ctx context.Context, request *tournamentpb.RegisterForTournamentRequest) (*tournamentpb.RegisterForTournamentResponse, error) {
+	controller.logger.
+		WithField("tournament_id", request.Id).
+		Info("register for tournament request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if request.Id == "" {
+		return nil, ErrTournamentIDMissing
+	}
+
+	tournament, err := controller.tournamentService.Register(ctx, request.Id, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"tournament_id": request.Id,
+				"user_id":       userID,
+			}).
+			Error("failed to register for tournament")
+
+		return nil, tournamentError(err)
+	}
+
+	controller.logger.
+		WithFields(logrus.Fields{
+			"tournament_id": request.Id,
+			"user_id":       userID,
+		}).
+		Info("player has registered for tournament")
+
+	return &tournamentpb.RegisterForTournamentResponse{
+		Status:     StatusSuccess,
+		Timestamp:  time.Now().Unix(),
+		Tournament: toTournamentResponse(tournament),
+	}, nil
+}
+
+func tournamentError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidTournament):
+		return ErrInvalidTournament
+	case errors.Is(err, services.ErrInvalidTournamentResult):
+		return ErrInvalidTournamentResult
+	case errors.Is(err, services.ErrAlreadyRegistered):
+		return ErrAlreadyRegistered
+	case errors.Is(err, services.ErrRegistrationClosed):
+		return ErrRegistrationClosed
+	case errors.Is(err, services.ErrNotRegistered):
+		return ErrNotRegistered
+	case errors.Is(err, services.ErrTournamentNotRunning):
+		return ErrTournamentNotRunning
+	case errors.Is(err, services.ErrTournamentFull):
+		return ErrTournamentFull
+	case errors.Is(err, services.ErrTournamentConflict):
+		return ErrTournamentConflict
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrTournamentNotFound
+	default:
+		return ErrInternal
+	}
+}
+
+func toTournamentResponse(tournament domain.Tournament) *tournamentpb.Tournament {
+	response := &tournamentpb.Tournament{
+		Id:            tournament.ID,
+		Name:          tournament.Name,
+		Format:        tournament.Format,
+		Status:        tournament.Status,
+		MaxPlayers:    tournament.MaxPlayers,
+		Rounds:        tournament.Rounds,
+		RoundDuration: int64(tournament.RoundDuration / time.Second),
+		StartsAt:      tournament.StartsAt.Unix(),
+		CreatedAt:     tournament.CreatedAt.Unix(),
+		CurrentRound:  tournament.CurrentRound,
+	}
+
+	if !tournament.RoundDeadline.IsZero() {
+		response.RoundDeadline = tournament.RoundDeadline.Unix()
+	}
+
+	for _, player := range tournament.Players {
+		response.Players = append(response.Players, &tournamentpb.TournamentPlayer{
+			UserID:       player.UserID,
+			Seed:         player.Seed,
+			RegisteredAt: player.RegisteredAt.Unix(),
+			Losses:       player.Losses,
+			Points:       player.Points,
+			Score:        player.Score,
+			Scored:       player.Scored,
+			EliminatedIn: player.EliminatedIn,
+		})
+	}
+
+	for _, match := range tournament.Matches {
+		response.Matches = append(response.Matches, &tournamentpb.TournamentMatch{
+			Id:        match.ID,
+			Round:     match.Round,
+			Bracket:   match.Bracket,
+			PlayerIDs: match.PlayerIDs,
+			WinnerID:  match.WinnerID,
+			Completed: match.Completed,
+		})
+	}
+
+	for _, standing := range tournament.Standings {
+		response.Standings = append(response.Standings, &tournamentpb.TournamentStanding{
+			UserID: standing.UserID,
+			Place:  standing.Place,
+			Points: standing.Points,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	tournamentpb "game/internal/proto/tournament/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type TournamentControllerTestSuite struct {
+	suite.Suite
+
+	controller *tournamentController
+
+	mockTournamentService *mocks.MockTournamentService
+}
+
+func TestTournamentControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TournamentControllerTestSuite))
+}
+
+func (suite *TournamentControllerTestSuite) SetupTest() {
+	suite.mockTournamentService = mocks.NewMockTournamentService(suite.T())
+
+	suite.controller = NewTournamentController(TournamentControllerDependencies{
+		TournamentService: suite.mockTournamentService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *TournamentControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *TournamentControllerTestSuite) TestGetTournament() {
+	suite.mockTournamentService.
+		EXPECT().
+		GetTournament(mock.Anything, "tournament-id").
+		Return(domain.Tournament{
+			ID:            "tournament-id",
+			Name:          "Weekly Cup",
+			Format:        domain.TournamentSingleElimination,
+			Status:        domain.TournamentFinished,
+			RoundDuration: time.Hour,
+			StartsAt:      time.Unix(1700000000, 0),
+			CurrentRound:  1,
+			RoundDeadline: time.Unix(1700003600, 0),
+			Players: []domain.TournamentPlayer{
+				{UserID: "user-id", Seed: 1},
+				{UserID: "user-id-2", Seed: 2, Losses: 1, EliminatedIn: 1},
+			},
+			Matches: []domain.TournamentMatch{
+				{ID: "1-1", Round: 1, Bracket: domain.TournamentBracketWinners, PlayerIDs: []string{"user-id", "user-id-2"}, WinnerID: "user-id", Completed: true},
+			},
+			Standings: []domain.TournamentStanding{
+				{UserID: "user-id", Place: 1},
+				{UserID: "user-id-2", Place: 2},
+			},
+		}, nil)
+
+	result, err := suite.controller.GetTournament(context.Background(), &tournamentpb.GetTournamentRequest{Id: "tournament-id"})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("Weekly Cup", result.Tournament.Name)
+	suite.Equal(int64(3600), result.Tournament.RoundDuration)
+	suite.Equal(int64(1700003600), result.Tournament.RoundDeadline)
+	suite.Len(result.Tournament.Players, 2)
+	suite.Equal(int64(1), result.Tournament.Players[1].EliminatedIn)
+	suite.Equal([]string{"user-id", "user-id-2"}, result.Tournament.Matches[0].PlayerIDs)
+	suite.Equal("user-id", result.Tournament.Matches[0].WinnerID)
+	suite.Equal(int64(2), result.Tournament.Standings[1].Place)
+}
+
+func (suite *TournamentControllerTestSuite) TestGetTournament_NotFound() {
+	suite.mockTournamentService.
+		EXPECT().
+		GetTournament(mock.Anything, "tournament-id").
+		Return(domain.Tournament{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetTournament(context.Background(), &tournamentpb.GetTournamentRequest{Id: "tournament-id"})
+	suite.ErrorIs(err, ErrTournamentNotFound)
+	suite.Empty(result)
+}
+
+func (suite *TournamentControllerTestSuite) TestGetTournament_IDMissing() {
+	result, err := suite.controller.GetTournament(context.Background(), &tournamentpb.GetTournamentRequest{})
+	suite.ErrorIs(err, ErrTournamentIDMissing)
+	suite.Empty(result)
+}
+
+func (suite *TournamentControllerTestSuite) TestListTournaments() {
+	suite.mockTournamentService.
+		EXPECT().
+		ListTournaments(mock.Anything, domain.TournamentRegistering).
+		Return([]domain.Tournament{{ID: "tournament-id-1"}, {ID: "tournament-id-2"}}, nil)
+
+	result, err := suite.controller.ListTournaments(context.Background(), &tournamentpb.ListTournamentsRequest{
+		TournamentStatus: domain.TournamentRegistering,
+	})
+	suite.NoError(err)
+
+	suite.Len(result.Result, 2)
+	suite.Equal("tournament-id-2", result.Result[1].Id)
+	suite.Zero(result.Result[1].RoundDeadline)
+}
+
+func (suite *TournamentControllerTestSuite) TestListTournaments_InvalidStatus() {
+	suite.mockTournamentService.
+		EXPECT().
+		ListTournaments(mock.Anything, "paused").
+		Return(nil, services.ErrInvalidTournament)
+
+	result, err := suite.controller.ListTournaments(context.Background(), &tournamentpb.ListTournamentsRequest{
+		TournamentStatus: "paused",
+	})
+	suite.ErrorIs(err, ErrInvalidTournament)
+	suite.Empty(result)
+}
+
+func (suite *TournamentControllerTestSuite) TestRegisterForTournament() {
+	suite.mockTournamentService.
+		EXPECT().
+		Register(mock.Anything, "tournament-id", "user-id").
+		Return(domain.Tournament{
+			ID:      "tournament-id",
+			Players: []domain.TournamentPlayer{{UserID: "user-id"}},
+		}, nil)
+
+	result, err := suite.controller.RegisterForTournament(suite.userContext(), &tournamentpb.RegisterForTournamentRequest{Id: "tournament-id"})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("user-id", result.Tournament.Players[0].UserID)
+}
+
+func (suite *TournamentControllerTestSuite) TestRegisterForTournament_Full() {
+	suite.mockTournamentService.
+		EXPECT().
+		Register(mock.Anything, "tournament-id", "user-id").
+		Return(domain.Tournament{}, services.ErrTournamentFull)
+
+	result, err := suite.controller.RegisterForTournament(suite.userContext(), &tournamentpb.RegisterForTournamentRequest{Id: "tournament-id"})
+	suite.ErrorIs(err, ErrTournamentFull)
+	suite.Empty(result)
+}
+
+func (suite *TournamentControllerTestSuite) TestRegisterForTournament_Closed() {
+	suite.mockTournamentService.
+		EXPECT().
+		Register(mock.Anything, "tournament-id", "user-id").
+		Return(domain.Tournament{}, services.ErrRegistrationClosed)
+
+	result, err := suite.controller.RegisterForTournament(suite.userContext(), &tournamentpb.RegisterForTournamentRequest{Id: "tournament-id"})
+	suite.ErrorIs(err, ErrRegistrationClosed)
+	suite.Empty(result)
+}
+
+func (suite *TournamentControllerTestSuite) TestRegisterForTournament_NoUserID() {
+	result, err := suite.controller.RegisterForTournament(context.Background(), &tournamentpb.RegisterForTournamentRequest{Id: "tournament-id"})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -64,6 +65,24 @@ func (interceptor *UnaryInterceptor) Intercept(
 	return handler(ctx, req)
 }
 
+func (interceptor *UnaryInterceptor) InterceptStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if !interceptor.isMethodAuthorized(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	userID, err := interceptor.authorize(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStreamWithContext{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), ContextKeyUserID, userID),
+	})
+}
+
 func (interceptor *UnaryInterceptor) isMethodAuthorized(method string) bool {
 	if _, ok := interceptor.authorizedMethodNames[method]; ok {
 		return true
@@ -96,6 +115,10 @@ func (interceptor *UnaryInterceptor) authorize(ctx context.Context) (string, err
 
 	userID, err := interceptor.tokenManager.ExtractUserID(ctx, token)
 	if err != nil {
+		if errors.Is(err, domain.ErrTokenRevoked) {
+			return "", ErrUnauthenticated
+		}
+
 		return "", err
 	}
 
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"game/internal/domain"
 	"game/internal/domain/mocks"
 )
 
@@ -206,3 +207,75 @@ func (suite *UnaryInterceptorTestSuite) TestUnaryInterceptor_InvalidToken() {
 
 	suite.ErrorIs(err, someError)
 }
+
+func (suite *UnaryInterceptorTestSuite) TestUnaryInterceptor_RevokedToken() {
+	suite.mockTokenManager.
+		EXPECT().
+		ExtractUserID(mock.Anything, "token").
+		Return("", domain.ErrTokenRevoked)
+
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		suite.Fail("handler should not be called")
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"Authorization": "Bearer token",
+	}))
+
+	_, err := suite.interceptor.Intercept(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "some-method",
+	}, unaryHandler)
+
+	suite.ErrorIs(err, ErrUnauthenticated)
+}
+
+// fakeServerStream is a server stream with nothing but a context.
+type fakeServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (stream *fakeServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (suite *UnaryInterceptorTestSuite) TestUnaryInterceptor_Stream() {
+	suite.mockTokenManager.
+		EXPECT().
+		ExtractUserID(mock.Anything, "token").
+		Return("user-id", nil)
+
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		suite.Equal("user-id", stream.Context().Value(ContextKeyUserID))
+
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"Authorization": "Bearer token",
+	}))
+
+	err := suite.interceptor.InterceptStream(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{
+		FullMethod: "some-method",
+	}, streamHandler)
+
+	suite.NoError(err)
+}
+
+func (suite *UnaryInterceptorTestSuite) TestUnaryInterceptor_StreamNoAuthorizationHeader() {
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		suite.Fail("the handler is not expected to be called")
+
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{}))
+
+	err := suite.interceptor.InterceptStream(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{
+		FullMethod: "some-method",
+	}, streamHandler)
+
+	suite.ErrorIs(err, ErrUnauthenticated)
+}
@@ -17,12 +17,13 @@ import (
 const StatusSuccess = "success"
 
 var (
-	ErrInvalidPassword  = status.New(codes.InvalidArgument, "invalid password").Err()
-	ErrInternal         = status.New(codes.Internal, "internal error").Err()
-	ErrUsernameExists   = status.New(codes.AlreadyExists, "username exists").Err()
-	ErrUsernameRequired = status.New(codes.InvalidArgument, "username is required").Err()
-	ErrPasswordRequired = status.New(codes.InvalidArgument, "password is required").Err()
-	ErrUserNotFound     = status.New(codes.NotFound, "user not found").Err()
+	ErrInvalidCredentials = status.New(codes.Unauthenticated, "invalid credentials").Err()
+	ErrInternal           = status.New(codes.Internal, "internal error").Err()
+	ErrUsernameExists     = status.New(codes.AlreadyExists, "username exists").Err()
+	ErrUsernameRequired   = status.New(codes.InvalidArgument, "username is required").Err()
+	ErrPasswordRequired   = status.New(codes.InvalidArgument, "password is required").Err()
+	ErrUserNotFound       = status.New(codes.NotFound, "user not found").Err()
+	ErrInvalidProfile     = status.New(codes.InvalidArgument, "invalid profile").Err()
 )
 
 type UserControllerDependencies struct {
@@ -70,12 +71,8 @@ func (controller *userController) Login(ctx context.Context, req *userpb.LoginRe
 			}).
 			Error("login request is failed, ", err)
 
-		if errors.Is(err, services.ErrInvalidPassword) {
-			return nil, ErrInvalidPassword
-		}
-
-		if errors.Is(err, domain.ErrResourceNotFound) {
-			return nil, ErrUserNotFound
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			return nil, ErrInvalidCredentials
 		}
 
 		return nil, ErrInternal
@@ -133,3 +130,168 @@ func (controller *userController) Register(ctx context.Context, req *userpb.Regi
 		},
 	}, nil
 }
+
+func (controller *userController) GetProfile(ctx context.Context, req *userpb.GetProfileRequest) (*userpb.GetProfileResponse, error) {
+	controller.logger.Info("get profile request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	profile, err := controller.userService.GetProfile(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithField("user_id", userID).
+			Error("get profile request is failed, ", err)
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &userpb.GetProfileResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Result: &userpb.Profile{
+			UserID:      profile.UserID,
+			Username:    profile.Username,
+			Score:       profile.Score,
+			DisplayName: profile.UserProfile.DisplayName,
+			CountryCode: profile.UserProfile.CountryCode,
+			AvatarURL:   profile.UserProfile.AvatarURL,
+			Metadata:    profile.UserProfile.Metadata,
+		},
+	}, nil
+}
+
+func (controller *userController) UpdateUsername(ctx context.Context, req *userpb.UpdateUsernameRequest) (*userpb.UpdateUsernameResponse, error) {
+	controller.
+		logger.
+		WithFields(logrus.Fields{
+			"username": req.Username,
+		}).
+		Info("update username request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if req.Username == "" {
+		return nil, ErrUsernameRequired
+	}
+
+	err := controller.userService.UpdateUsername(ctx, userID, req.Username)
+	if err != nil {
+		controller.logger.
+			WithField("user_id", userID).
+			Error("update username request is failed, ", err)
+
+		return nil, controller.profileError(err)
+	}
+
+	return &userpb.UpdateUsernameResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *userController) UpdateProfile(ctx context.Context, req *userpb.UpdateProfileRequest) (*userpb.UpdateProfileResponse, error) {
+	controller.logger.Info("update profile request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	err := controller.userService.UpdateProfile(ctx, userID, domain.UserProfile{
+		DisplayName: req.DisplayName,
+		CountryCode: req.CountryCode,
+		AvatarURL:   req.AvatarURL,
+		Metadata:    req.Metadata,
+	})
+	if err != nil {
+		controller.logger.
+			WithField("user_id", userID).
+			Error("update profile request is failed, ", err)
+
+		return nil, controller.profileError(err)
+	}
+
+	return &userpb.UpdateProfileResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *userController) ChangePassword(ctx context.Context, req *userpb.ChangePasswordRequest) (*userpb.ChangePasswordResponse, error) {
+	controller.logger.Info("change password request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return nil, ErrPasswordRequired
+	}
+
+	err := controller.userService.ChangePassword(ctx, userID, req.OldPassword, req.NewPassword)
+	if err != nil {
+		controller.logger.
+			WithField("user_id", userID).
+			Error("change password request is failed, ", err)
+
+		return nil, controller.profileError(err)
+	}
+
+	return &userpb.ChangePasswordResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *userController) DeleteAccount(ctx context.Context, req *userpb.DeleteAccountRequest) (*userpb.DeleteAccountResponse, error) {
+	controller.logger.Info("delete account request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	if req.Password == "" {
+		return nil, ErrPasswordRequired
+	}
+
+	err := controller.userService.DeleteAccount(ctx, userID, req.Password)
+	if err != nil {
+		controller.logger.
+			WithField("user_id", userID).
+			Error("delete account request is failed, ", err)
+
+		return nil, controller.profileError(err)
+	}
+
+	return &userpb.DeleteAccountResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (controller *userController) profileError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidCredentials):
+		return ErrInvalidCredentials
+	case errors.Is(err, services.ErrUsernameExists):
+		return ErrUsernameExists
+	case errors.Is(err, services.ErrInvalidProfile):
+		return ErrInvalidProfile
+	case errors.Is(err, domain.ErrResourceNotFound):
+		return ErrUserNotFound
+	default:
+		return ErrInternal
+	}
+}
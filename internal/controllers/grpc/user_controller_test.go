@@ -85,33 +85,18 @@ func (suite *UserControllerTestSuite) TestLogin_NoPassword() {
 	suite.Empty(result)
 }
 
-func (suite *UserControllerTestSuite) TestLogin_InvalidPassword() {
+func (suite *UserControllerTestSuite) TestLogin_InvalidCredentials() {
 	suite.mockUserService.
 		EXPECT().
 		Login(mock.Anything, "username", "password").
-		Return(services.LoginResult{}, services.ErrInvalidPassword)
+		Return(services.LoginResult{}, services.ErrInvalidCredentials)
 
 	result, err := suite.controller.Login(context.Background(), &userpb.LoginRequest{
 		Username: "username",
 		Password: "password",
 	})
 
-	suite.ErrorIs(err, ErrInvalidPassword)
-	suite.Empty(result)
-}
-
-func (suite *UserControllerTestSuite) TestLogin_UserNotFound() {
-	suite.mockUserService.
-		EXPECT().
-		Login(mock.Anything, "username", "password").
-		Return(services.LoginResult{}, domain.ErrResourceNotFound)
-
-	result, err := suite.controller.Login(context.Background(), &userpb.LoginRequest{
-		Username: "username",
-		Password: "password",
-	})
-
-	suite.ErrorIs(err, ErrUserNotFound)
+	suite.ErrorIs(err, ErrInvalidCredentials)
 	suite.Empty(result)
 }
 
@@ -209,3 +194,168 @@ func (suite *UserControllerTestSuite) TestRegister_InternalError() {
 	suite.ErrorIs(err, ErrInternal)
 	suite.Empty(result)
 }
+
+func (suite *UserControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *UserControllerTestSuite) TestGetProfile() {
+	score := float64(100)
+
+	suite.mockUserService.
+		EXPECT().
+		GetProfile(mock.Anything, "user-id").
+		Return(services.Profile{
+			UserID:   "user-id",
+			Username: "username",
+			Score:    &score,
+		}, nil)
+
+	result, err := suite.controller.GetProfile(suite.userContext(), &userpb.GetProfileRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("username", result.Result.Username)
+	suite.Equal(&score, result.Result.Score)
+}
+
+func (suite *UserControllerTestSuite) TestGetProfile_NoUserID() {
+	result, err := suite.controller.GetProfile(context.Background(), &userpb.GetProfileRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestGetProfile_UserNotFound() {
+	suite.mockUserService.
+		EXPECT().
+		GetProfile(mock.Anything, "user-id").
+		Return(services.Profile{}, domain.ErrResourceNotFound)
+
+	result, err := suite.controller.GetProfile(suite.userContext(), &userpb.GetProfileRequest{})
+	suite.ErrorIs(err, ErrUserNotFound)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestUpdateUsername() {
+	suite.mockUserService.
+		EXPECT().
+		UpdateUsername(mock.Anything, "user-id", "new-username").
+		Return(nil)
+
+	result, err := suite.controller.UpdateUsername(suite.userContext(), &userpb.UpdateUsernameRequest{
+		Username: "new-username",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *UserControllerTestSuite) TestUpdateUsername_UsernameExists() {
+	suite.mockUserService.
+		EXPECT().
+		UpdateUsername(mock.Anything, "user-id", "new-username").
+		Return(services.ErrUsernameExists)
+
+	result, err := suite.controller.UpdateUsername(suite.userContext(), &userpb.UpdateUsernameRequest{
+		Username: "new-username",
+	})
+	suite.ErrorIs(err, ErrUsernameExists)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestUpdateUsername_NoUsername() {
+	result, err := suite.controller.UpdateUsername(suite.userContext(), &userpb.UpdateUsernameRequest{})
+	suite.ErrorIs(err, ErrUsernameRequired)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestUpdateProfile() {
+	suite.mockUserService.
+		EXPECT().
+		UpdateProfile(mock.Anything, "user-id", domain.UserProfile{
+			DisplayName: "Player One",
+			CountryCode: "DE",
+		}).
+		Return(nil)
+
+	result, err := suite.controller.UpdateProfile(suite.userContext(), &userpb.UpdateProfileRequest{
+		DisplayName: "Player One",
+		CountryCode: "DE",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *UserControllerTestSuite) TestUpdateProfile_InvalidProfile() {
+	suite.mockUserService.
+		EXPECT().
+		UpdateProfile(mock.Anything, "user-id", mock.Anything).
+		Return(services.ErrInvalidProfile)
+
+	result, err := suite.controller.UpdateProfile(suite.userContext(), &userpb.UpdateProfileRequest{
+		CountryCode: "DEU",
+	})
+	suite.ErrorIs(err, ErrInvalidProfile)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestChangePassword() {
+	suite.mockUserService.
+		EXPECT().
+		ChangePassword(mock.Anything, "user-id", "old-password", "new-password").
+		Return(nil)
+
+	result, err := suite.controller.ChangePassword(suite.userContext(), &userpb.ChangePasswordRequest{
+		OldPassword: "old-password",
+		NewPassword: "new-password",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *UserControllerTestSuite) TestChangePassword_InvalidCredentials() {
+	suite.mockUserService.
+		EXPECT().
+		ChangePassword(mock.Anything, "user-id", "old-password", "new-password").
+		Return(services.ErrInvalidCredentials)
+
+	result, err := suite.controller.ChangePassword(suite.userContext(), &userpb.ChangePasswordRequest{
+		OldPassword: "old-password",
+		NewPassword: "new-password",
+	})
+	suite.ErrorIs(err, ErrInvalidCredentials)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestChangePassword_NoPassword() {
+	result, err := suite.controller.ChangePassword(suite.userContext(), &userpb.ChangePasswordRequest{
+		OldPassword: "old-password",
+	})
+	suite.ErrorIs(err, ErrPasswordRequired)
+	suite.Empty(result)
+}
+
+func (suite *UserControllerTestSuite) TestDeleteAccount() {
+	suite.mockUserService.
+		EXPECT().
+		DeleteAccount(mock.Anything, "user-id", "password").
+		Return(nil)
+
+	result, err := suite.controller.DeleteAccount(suite.userContext(), &userpb.DeleteAccountRequest{
+		Password: "password",
+	})
+	suite.NoError(err)
+	suite.Equal(StatusSuccess, result.Status)
+}
+
+func (suite *UserControllerTestSuite) TestDeleteAccount_ServiceFailed() {
+	suite.mockUserService.
+		EXPECT().
+		DeleteAccount(mock.Anything, "user-id", "password").
+		Return(domain.ErrInternal)
+
+	result, err := suite.controller.DeleteAccount(suite.userContext(), &userpb.DeleteAccountRequest{
+		Password: "password",
+	})
+	suite.ErrorIs(err, ErrInternal)
+	suite.Empty(result)
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+	walletpb "game/internal/proto/wallet/proto"
+	"game/internal/services"
+)
+
+type WalletAdminControllerDependencies struct {
+	WalletService services.WalletService
+
+	Logger *logrus.Logger
+}
+
+type walletAdminController struct {
+	walletpb.UnimplementedWalletAdminServiceServer
+
+	walletService services.WalletService
+
+	logger *logrus.Logger
+}
+
+func NewWalletAdminController(deps WalletAdminControllerDependencies) *walletAdminController {
+	return &walletAdminController{
+		walletService: deps.WalletService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *walletAdminController) Grant(ctx context.Context, request *walletpb.GrantRequest) (*walletpb.GrantResponse, error) {
+	controller.logger.
+		WithFields(logrus.Fields{
+			"user_id":         request.UserID,
+			"idempotency_key": request.IdempotencyKey,
+		}).
+		Info("grant request has been received")
+
+	entry, err := controller.walletService.Grant(ctx, domain.LedgerEntry{
+		UserID:         request.UserID,
+		IdempotencyKey: request.IdempotencyKey,
+		Reason:         request.Reason,
+		Currencies:     request.Currencies,
+		Items:          request.Items,
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":         request.UserID,
+				"idempotency_key": request.IdempotencyKey,
+			}).
+			Error("failed to grant")
+
+		return nil, walletError(err)
+	}
+
+	controller.logger.
+		WithFields(logrus.Fields{
+			"user_id":  request.UserID,
+			"entry_id": entry.ID,
+		}).
+		Info("grant has been applied")
+
+	return &walletpb.GrantResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Entry:     toLedgerEntryResponse(entry),
+	}, nil
+}
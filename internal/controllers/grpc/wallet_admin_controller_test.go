@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	walletpb "game/internal/proto/wallet/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type WalletAdminControllerTestSuite struct {
+	suite.Suite
+
+	controller *walletAdminController
+
+	mockWalletService *mocks.MockWalletService
+}
+
+func TestWalletAdminControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(WalletAdminControllerTestSuite))
+}
+
+func (suite *WalletAdminControllerTestSuite) SetupTest() {
+	suite.mockWalletService = mocks.NewMockWalletService(suite.T())
+
+	suite.controller = NewWalletAdminController(WalletAdminControllerDependencies{
+		WalletService: suite.mockWalletService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *WalletAdminControllerTestSuite) TestGrant() {
+	suite.mockWalletService.
+		EXPECT().
+		Grant(mock.Anything, domain.LedgerEntry{
+			UserID:         "user-id",
+			IdempotencyKey: "grant-1",
+			Reason:         "compensation",
+			Currencies:     map[string]int64{"coins": 100},
+			Items:          map[string]int64{"sword": 1},
+		}).
+		Return(domain.LedgerEntry{
+			ID:             "user-id:grant-1",
+			IdempotencyKey: "grant-1",
+			Type:           domain.LedgerEntryGrant,
+			Currencies:     map[string]int64{"coins": 100},
+			Items:          map[string]int64{"sword": 1},
+			Wallet: domain.Wallet{
+				Balances: map[string]int64{"coins": 100},
+				Items:    map[string]int64{"sword": 1},
+			},
+			CreatedAt: time.Unix(1700000000, 0),
+		}, nil)
+
+	result, err := suite.controller.Grant(context.Background(), &walletpb.GrantRequest{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Reason:         "compensation",
+		Currencies:     map[string]int64{"coins": 100},
+		Items:          map[string]int64{"sword": 1},
+	})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal("user-id:grant-1", result.Entry.Id)
+	suite.Equal(domain.LedgerEntryGrant, result.Entry.Type)
+	suite.Equal(int64(1), result.Entry.Wallet.Items["sword"])
+}
+
+func (suite *WalletAdminControllerTestSuite) TestGrant_Invalid() {
+	suite.mockWalletService.
+		EXPECT().
+		Grant(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, services.ErrInvalidWalletOperation)
+
+	result, err := suite.controller.Grant(context.Background(), &walletpb.GrantRequest{
+		UserID:     "user-id",
+		Currencies: map[string]int64{"coins": 100},
+	})
+	suite.ErrorIs(err, ErrInvalidWalletOperation)
+	suite.Empty(result)
+}
+
+func (suite *WalletAdminControllerTestSuite) TestGrant_Failed() {
+	suite.mockWalletService.
+		EXPECT().
+		Grant(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, domain.ErrInternal)
+
+	result, err := suite.controller.Grant(context.Background(), &walletpb.GrantRequest{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Currencies:     map[string]int64{"coins": 100},
+	})
+	suite.ErrorIs(err, ErrInternal)
+	suite.Empty(result)
+}
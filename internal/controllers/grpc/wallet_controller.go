@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"game/internal/domain"
+	walletpb "game/internal/proto/wallet/proto"
+	"game/internal/services"
+)
+
+var (
+	ErrInvalidWalletOperation = status.New(codes.InvalidArgument, "invalid wallet operation").Err()
+	ErrInsufficientFunds      = status.New(codes.FailedPrecondition, "insufficient funds").Err()
+	ErrIdempotencyKeyReused   = status.New(codes.AlreadyExists, "idempotency key reused").Err()
+)
+
+type WalletControllerDependencies struct {
+	WalletService services.WalletService
+
+	Logger *logrus.Logger
+}
+
+type walletController struct {
+	walletpb.UnimplementedWalletServiceServer
+
+	walletService services.WalletService
+
+	logger *logrus.Logger
+}
+
+func NewWalletController(deps WalletControllerDependencies) *walletController {
+	return &walletController{
+		walletService: deps.WalletService,
+		logger:        deps.Logger,
+	}
+}
+
+func (controller *walletController) GetBalance(ctx context.Context, request *walletpb.GetBalanceRequest) (*walletpb.GetBalanceResponse, error) {
+	controller.logger.Info("get balance request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	wallet, err := controller.walletService.GetBalance(ctx, userID)
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithField("user_id", userID).
+			Error("failed to get balance")
+
+		return nil, ErrInternal
+	}
+
+	return &walletpb.GetBalanceResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Wallet:    toWalletResponse(wallet),
+	}, nil
+}
+
+func (controller *walletController) Spend(ctx context.Context, request *walletpb.SpendRequest) (*walletpb.SpendResponse, error) {
+	controller.logger.
+		WithField("idempotency_key", request.IdempotencyKey).
+		Info("spend request has been received")
+
+	userID, ok := ctx.Value(ContextKeyUserID).(string)
+	if !ok {
+		return nil, ErrInvalidUserID
+	}
+
+	entry, err := controller.walletService.Spend(ctx, domain.LedgerEntry{
+		UserID:         userID,
+		IdempotencyKey: request.IdempotencyKey,
+		Reason:         request.Reason,
+		Currencies:     request.Currencies,
+		Items:          request.Items,
+	})
+	if err != nil {
+		controller.logger.
+			WithError(err).
+			WithFields(logrus.Fields{
+				"user_id":         userID,
+				"idempotency_key": request.IdempotencyKey,
+			}).
+			Error("failed to spend")
+
+		return nil, walletError(err)
+	}
+
+	return &walletpb.SpendResponse{
+		Status:    StatusSuccess,
+		Timestamp: time.Now().Unix(),
+		Entry:     toLedgerEntryResponse(entry),
+	}, nil
+}
+
+func walletError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidWalletOperation):
+		return ErrInvalidWalletOperation
+	case errors.Is(err, services.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	case errors.Is(err, services.ErrIdempotencyKeyReused):
+		return ErrIdempotencyKeyReused
+	default:
+		return ErrInternal
+	}
+}
+
+func toWalletResponse(wallet domain.Wallet) *walletpb.Wallet {
+	response := &walletpb.Wallet{
+		Balances: wallet.Balances,
+		Items:    wallet.Items,
+	}
+
+	if !wallet.UpdatedAt.IsZero() {
+		response.UpdatedAt = wallet.UpdatedAt.Unix()
+	}
+
+	return response
+}
+
+func toLedgerEntryResponse(entry domain.LedgerEntry) *walletpb.LedgerEntry {
+	return &walletpb.LedgerEntry{
+		Id:             entry.ID,
+		IdempotencyKey: entry.IdempotencyKey,
+		Type:           entry.Type,
+		Reason:         entry.Reason,
+		Currencies:     entry.Currencies,
+		Items:          entry.Items,
+		Wallet:         toWalletResponse(entry.Wallet),
+		CreatedAt:      entry.CreatedAt.Unix(),
+	}
+}
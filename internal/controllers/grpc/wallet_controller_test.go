@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	walletpb "game/internal/proto/wallet/proto"
+	"game/internal/services"
+	"game/internal/services/mocks"
+)
+
+type WalletControllerTestSuite struct {
+	suite.Suite
+
+	controller *walletController
+
+	mockWalletService *mocks.MockWalletService
+}
+
+func TestWalletControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(WalletControllerTestSuite))
+}
+
+func (suite *WalletControllerTestSuite) SetupTest() {
+	suite.mockWalletService = mocks.NewMockWalletService(suite.T())
+
+	suite.controller = NewWalletController(WalletControllerDependencies{
+		WalletService: suite.mockWalletService,
+
+		Logger: logrus.New(),
+	})
+}
+
+func (suite *WalletControllerTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), ContextKeyUserID, "user-id")
+}
+
+func (suite *WalletControllerTestSuite) TestGetBalance() {
+	suite.mockWalletService.
+		EXPECT().
+		GetBalance(mock.Anything, "user-id").
+		Return(domain.Wallet{
+			UserID:    "user-id",
+			Balances:  map[string]int64{"coins": 150},
+			Items:     map[string]int64{"gold_chest": 1},
+			UpdatedAt: time.Unix(1700000000, 0),
+		}, nil)
+
+	result, err := suite.controller.GetBalance(suite.userContext(), &walletpb.GetBalanceRequest{})
+	suite.NoError(err)
+
+	suite.Equal(StatusSuccess, result.Status)
+	suite.Equal(map[string]int64{"coins": 150}, result.Wallet.Balances)
+	suite.Equal(map[string]int64{"gold_chest": 1}, result.Wallet.Items)
+	suite.Equal(int64(1700000000), result.Wallet.UpdatedAt)
+}
+
+func (suite *WalletControllerTestSuite) TestGetBalance_Empty() {
+	suite.mockWalletService.
+		EXPECT().
+		GetBalance(mock.Anything, "user-id").
+		Return(domain.Wallet{UserID: "user-id"}, nil)
+
+	result, err := suite.controller.GetBalance(suite.userContext(), &walletpb.GetBalanceRequest{})
+	suite.NoError(err)
+
+	suite.Empty(result.Wallet.Balances)
+	suite.Zero(result.Wallet.UpdatedAt)
+}
+
+func (suite *WalletControllerTestSuite) TestGetBalance_NoUserID() {
+	result, err := suite.controller.GetBalance(context.Background(), &walletpb.GetBalanceRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
+
+func (suite *WalletControllerTestSuite) TestSpend() {
+	suite.mockWalletService.
+		EXPECT().
+		Spend(mock.Anything, domain.LedgerEntry{
+			UserID:         "user-id",
+			IdempotencyKey: "spend-1",
+			Reason:         "shop",
+			Currencies:     map[string]int64{"coins": 50},
+		}).
+		Return(domain.LedgerEntry{
+			ID:             "user-id:spend-1",
+			IdempotencyKey: "spend-1",
+			Type:           domain.LedgerEntrySpend,
+			Currencies:     map[string]int64{"coins": 50},
+			Wallet:         domain.Wallet{Balances: map[string]int64{"coins": 100}},
+			CreatedAt:      time.Unix(1700000000, 0),
+		}, nil)
+
+	result, err := suite.controller.Spend(suite.userContext(), &walletpb.SpendRequest{
+		IdempotencyKey: "spend-1",
+		Reason:         "shop",
+		Currencies:     map[string]int64{"coins": 50},
+	})
+	suite.NoError(err)
+
+	suite.Equal("user-id:spend-1", result.Entry.Id)
+	suite.Equal(domain.LedgerEntrySpend, result.Entry.Type)
+	suite.Equal(int64(100), result.Entry.Wallet.Balances["coins"])
+	suite.Equal(int64(1700000000), result.Entry.CreatedAt)
+}
+
+func (suite *WalletControllerTestSuite) TestSpend_InsufficientFunds() {
+	suite.mockWalletService.
+		EXPECT().
+		Spend(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, services.ErrInsufficientFunds)
+
+	result, err := suite.controller.Spend(suite.userContext(), &walletpb.SpendRequest{
+		IdempotencyKey: "spend-1",
+		Currencies:     map[string]int64{"coins": 1000},
+	})
+	suite.ErrorIs(err, ErrInsufficientFunds)
+	suite.Empty(result)
+}
+
+func (suite *WalletControllerTestSuite) TestSpend_IdempotencyKeyReused() {
+	suite.mockWalletService.
+		EXPECT().
+		Spend(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, services.ErrIdempotencyKeyReused)
+
+	result, err := suite.controller.Spend(suite.userContext(), &walletpb.SpendRequest{
+		IdempotencyKey: "spend-1",
+		Currencies:     map[string]int64{"coins": 10},
+	})
+	suite.ErrorIs(err, ErrIdempotencyKeyReused)
+	suite.Empty(result)
+}
+
+func (suite *WalletControllerTestSuite) TestSpend_NoUserID() {
+	result, err := suite.controller.Spend(context.Background(), &walletpb.SpendRequest{})
+	suite.ErrorIs(err, ErrInvalidUserID)
+	suite.Empty(result)
+}
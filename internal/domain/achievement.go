@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The kinds of the rules that unlock an achievement.
+const (
+	// AchievementScore unlocks when the user submits a score of Threshold or
+	// more.
+	AchievementScore = "score"
+	// AchievementRank unlocks when the user is ranked Threshold or better on
+	// the global leaderboard or on the leaderboard of its country.
+	AchievementRank = "rank"
+	// AchievementSubmissions unlocks when the user has submitted Threshold
+	// scores, quarantined scores are not counted.
+	AchievementSubmissions = "submissions"
+)
+
+// Achievement is the definition of an achievement, the users unlock it once
+// they reach the threshold of its kind.
+type Achievement struct {
+	ID        string
+	Name      string
+	Kind      string
+	Threshold float64
+}
+
+// AchievementProgress is what the rules of the achievements are evaluated
+// against. Rank and CountryRank are 0 when they are not known.
+type AchievementProgress struct {
+	Score       float64
+	Submissions int64
+	Rank        int64
+	CountryRank int64
+}
+
+// Unlocked reports whether the progress reaches the threshold of the
+// achievement.
+func (achievement Achievement) Unlocked(progress AchievementProgress) bool {
+	switch achievement.Kind {
+	case AchievementScore:
+		return progress.Score >= achievement.Threshold
+	case AchievementRank:
+		return isRankedWithin(progress.Rank, achievement.Threshold) ||
+			isRankedWithin(progress.CountryRank, achievement.Threshold)
+	case AchievementSubmissions:
+		return float64(progress.Submissions) >= achievement.Threshold
+	default:
+		return false
+	}
+}
+
+func isRankedWithin(rank int64, threshold float64) bool {
+	return rank > 0 && float64(rank) <= threshold
+}
+
+// UserAchievement is an achievement unlocked by a user, an achievement is
+// only unlocked once.
+type UserAchievement struct {
+	UserID        string
+	AchievementID string
+	UnlockedAt    time.Time
+}
+
+//go:generate mockery --name AchievementRepository --structname MockAchievementRepository --outpkg mocks --filename achievement_repository_mock.go --output ./mocks/. --with-expecter
+type AchievementRepository interface {
+	// IncrementSubmissions counts a submission of the user and returns the
+	// number of submissions it has made.
+	IncrementSubmissions(ctx context.Context, userID string) (int64, error)
+	// GetSubmissions returns 0 when the user has not submitted a score.
+	GetSubmissions(ctx context.Context, userID string) (int64, error)
+	// Unlock stores the achievements the users have not unlocked yet, it
+	// returns how many have been stored.
+	Unlock(ctx context.Context, achievements []UserAchievement) (int64, error)
+	// ListByUserID returns the achievements of the user, the first unlocked
+	// first.
+	ListByUserID(ctx context.Context, userID string) ([]UserAchievement, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
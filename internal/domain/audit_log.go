@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AuditEventLoginSucceeded   = "login_succeeded"
+	AuditEventLoginFailed      = "login_failed"
+	AuditEventUserRegistered   = "user_registered"
+	AuditEventUsernameUpdated  = "username_updated"
+	AuditEventPasswordChanged  = "password_changed"
+	AuditEventProfileUpdated   = "profile_updated"
+	AuditEventAccountDeleted   = "account_deleted"
+	AuditEventUserDataExported = "user_data_exported"
+	AuditEventUserDataErased   = "user_data_erased"
+	AuditEventScoreSubmitted   = "score_submitted"
+	AuditEventScoreQuarantined = "score_quarantined"
+
+	AuditEventModerationRemoveScore = "moderation_remove_score"
+	AuditEventModerationSetScore    = "moderation_set_score"
+	AuditEventModerationBanUser     = "moderation_ban_user"
+	AuditEventModerationUnbanUser   = "moderation_unban_user"
+)
+
+// AuditEvent is a durable record of a security or score related action.
+// Actor is who performed the action and UserID is the player it affects,
+// they differ for moderation actions and verified score submissions. Actor
+// is the user ID when the action is performed by a user, and it is empty
+// for failed logins, which keep the username that has been tried in
+// Details.
+type AuditEvent struct {
+	ID        string
+	Type      string
+	Actor     string
+	UserID    string
+	PeerIP    string
+	RequestID string
+	OldScore  *float64
+	NewScore  *float64
+	Details   map[string]string
+	CreatedAt time.Time
+}
+
+// AuditLogFilter narrows down a query of the audit log, zero values match
+// every event.
+type AuditLogFilter struct {
+	UserID string
+	Type   string
+	From   time.Time
+	To     time.Time
+	Limit  int64
+}
+
+//go:generate mockery --name AuditLog --structname MockAuditLog --outpkg mocks --filename audit_log_mock.go --output ./mocks/. --with-expecter
+type AuditLog interface {
+	Record(ctx context.Context, event AuditEvent) error
+	Query(ctx context.Context, filter AuditLogFilter) ([]AuditEvent, error)
+	// GetUserEvents returns every event that affects the user or is
+	// performed by it, oldest first.
+	GetUserEvents(ctx context.Context, userID string) ([]AuditEvent, error)
+	// AnonymizeUser replaces the user ID of a deleted user with anonymousID
+	// in its events, removes their peer IPs and the usernames in their
+	// details.
+	AnonymizeUser(ctx context.Context, userID, anonymousID string) error
+}
+
+// NewAuditEvent creates an event of the given type that happens now, with the
+// request details taken from the context.
+func NewAuditEvent(ctx context.Context, eventType, actor, userID string) AuditEvent {
+	info := RequestInfoFromContext(ctx)
+
+	return AuditEvent{
+		Type:      eventType,
+		Actor:     actor,
+		UserID:    userID,
+		PeerIP:    info.PeerIP,
+		RequestID: info.RequestID,
+		CreatedAt: time.Now(),
+	}
+}
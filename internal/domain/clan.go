@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The roles of the members of a clan, every clan has a single leader.
+const (
+	ClanRoleLeader  = "leader"
+	ClanRoleOfficer = "officer"
+	ClanRoleMember  = "member"
+)
+
+// The aggregates clans are ranked by on the clan leaderboards.
+const (
+	ClanAggregateSum     = "sum"
+	ClanAggregateAverage = "average"
+)
+
+type Clan struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+type ClanMember struct {
+	ClanID   string
+	UserID   string
+	Role     string
+	JoinedAt time.Time
+}
+
+// ClanScore is the aggregate of the top scores of the members of a clan.
+// ScoredMembers is the number of members on the leaderboard, the average
+// is taken over them.
+type ClanScore struct {
+	ClanID        string
+	Name          string
+	Score         float64
+	ScoredMembers int64
+}
+
+type ClanLeaderboard struct {
+	Aggregate   string
+	ClanScores  []ClanScore
+	GeneratedAt time.Time
+}
+
+//go:generate mockery --name ClanRepository --structname MockClanRepository --outpkg mocks --filename clan_repository_mock.go --output ./mocks/. --with-expecter
+type ClanRepository interface {
+	Create(ctx context.Context, clan Clan) (Clan, error)
+	GetByID(ctx context.Context, id string) (Clan, error)
+	// GetByName ignores the case of the name.
+	GetByName(ctx context.Context, name string) (Clan, error)
+	GetByIDs(ctx context.Context, ids []string) ([]Clan, error)
+	Delete(ctx context.Context, id string) error
+	// AddMember returns ErrResourceExists when the user is already a member
+	// of a clan.
+	AddMember(ctx context.Context, member ClanMember) error
+	GetMember(ctx context.Context, userID string) (ClanMember, error)
+	// ListMembers returns the members of the clan, the ones that have
+	// joined first come first.
+	ListMembers(ctx context.Context, clanID string) ([]ClanMember, error)
+	UpdateMemberRole(ctx context.Context, userID, role string) error
+	RemoveMember(ctx context.Context, userID string) error
+}
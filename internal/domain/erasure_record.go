@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ErasureStatusPending   = "pending"
+	ErasureStatusCompleted = "completed"
+	ErasureStatusFailed    = "failed"
+)
+
+// The steps of an erasure in the order they are performed, the user is
+// removed last so that a failed erasure can be retried.
+const (
+	ErasureStepScoreRemoved             = "score_removed"
+	ErasureStepSubmissionDeleted        = "submission_deleted"
+	ErasureStepFriendshipsDeleted       = "friendships_deleted"
+	ErasureStepClanLeft                 = "clan_left"
+	ErasureStepRewardGrantsDeleted      = "reward_grants_deleted"
+	ErasureStepAchievementsDeleted      = "achievements_deleted"
+	ErasureStepRatingsRemoved           = "ratings_removed"
+	ErasureStepEventScoresRemoved       = "event_scores_removed"
+	ErasureStepWalletDeleted            = "wallet_deleted"
+	ErasureStepStorageDeleted           = "storage_deleted"
+	ErasureStepQuarantinePseudonymized  = "quarantine_pseudonymized"
+	ErasureStepTournamentsPseudonymized = "tournaments_pseudonymized"
+	ErasureStepAuditLogPseudonymized    = "audit_log_pseudonymized"
+	ErasureStepTokensRevoked            = "tokens_revoked"
+	ErasureStepUserCacheCleared         = "user_cache_cleared"
+	ErasureStepUserDeleted              = "user_deleted"
+)
+
+// ErasureRecord is the proof that the data of a user has been erased. It
+// does not contain the user ID, SubjectHash is the hex encoded HMAC-SHA256
+// of it keyed with a secret so the record can be matched to a request without identifying the
+// user. AnonymousID is the ID that replaced the user ID in the history
+// that has been kept.
+type ErasureRecord struct {
+	ID          string
+	SubjectHash string
+	AnonymousID string
+	RequestedBy string
+	Status      string
+	Steps       []string
+	Error       string
+	RequestedAt time.Time
+	CompletedAt time.Time
+	Signature   []byte
+}
+
+// Payload returns the canonical form of the signed fields, one field per
+// line in the order: record id, subject hash, anonymous id, requested by,
+// comma separated steps, and the unix timestamps in seconds of the request
+// and the completion.
+func (record ErasureRecord) Payload() []byte {
+	return []byte(strings.Join([]string{
+		record.ID,
+		record.SubjectHash,
+		record.AnonymousID,
+		record.RequestedBy,
+		strings.Join(record.Steps, ","),
+		strconv.FormatInt(record.RequestedAt.Unix(), 10),
+		strconv.FormatInt(record.CompletedAt.Unix(), 10),
+	}, "\n"))
+}
+
+//go:generate mockery --name ErasureRecordRepository --structname MockErasureRecordRepository --outpkg mocks --filename erasure_record_repository_mock.go --output ./mocks/. --with-expecter
+type ErasureRecordRepository interface {
+	Create(ctx context.Context, record ErasureRecord) (ErasureRecord, error)
+	Update(ctx context.Context, record ErasureRecord) error
+	GetByID(ctx context.Context, id string) (ErasureRecord, error)
+}
@@ -4,5 +4,6 @@ import "errors"
 
 var (
 	ErrResourceNotFound = errors.New("resource not found")
+	ErrResourceExists   = errors.New("resource already exists")
 	ErrInternal         = errors.New("internal error")
 )
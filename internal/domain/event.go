@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Event is a time-limited competition of a mode, only the scores submitted
+// for the event between StartsAt and EndsAt count on its board. A user can
+// submit up to MaxAttempts scores, its best score is ranked.
+type Event struct {
+	ID          string
+	Name        string
+	Mode        string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	MaxAttempts int64
+	CreatedAt   time.Time
+	// FrozenAt is set once the event has ended and its board does not
+	// accept scores anymore.
+	FrozenAt time.Time
+}
+
+// EventScore is the best score of a user on the board of an event and the
+// number of scores it has submitted to it.
+type EventScore struct {
+	EventID  string
+	UserID   string
+	Score    float64
+	Attempts int64
+}
+
+// EventSubmission is the outcome of a score submitted to the board of an
+// event. Recorded is false when the user has no attempt left or the board
+// is frozen, Attempts is the number of attempts the user has used.
+type EventSubmission struct {
+	Recorded bool
+	Frozen   bool
+	Attempts int64
+}
+
+//go:generate mockery --name EventRepository --structname MockEventRepository --outpkg mocks --filename event_repository_mock.go --output ./mocks/. --with-expecter
+type EventRepository interface {
+	Create(ctx context.Context, event Event) (Event, error)
+	GetByID(ctx context.Context, id string) (Event, error)
+	// ListCurrent returns the events that have not ended at the given time,
+	// the ones that start first come first.
+	ListCurrent(ctx context.Context, now time.Time) ([]Event, error)
+	// ListEnded returns the events that have ended at the given time and
+	// whose board has not been frozen yet.
+	ListEnded(ctx context.Context, now time.Time) ([]Event, error)
+	SetFrozen(ctx context.Context, id string, frozenAt time.Time) error
+}
+
+//go:generate mockery --name EventBoardRepository --structname MockEventBoardRepository --outpkg mocks --filename event_board_repository_mock.go --output ./mocks/. --with-expecter
+type EventBoardRepository interface {
+	// SubmitEventScore uses an attempt of the user and keeps the score when
+	// it is the best score of the user, unless the user has used
+	// maxAttempts attempts or the board is frozen.
+	SubmitEventScore(ctx context.Context, eventID, userID string, score float64, submittedAt time.Time, maxAttempts int64) (EventSubmission, error)
+	GetEventLeaderboard(ctx context.Context, eventID string, count int64) (Leaderboard, error)
+	// FreezeEventBoard makes the board reject every score submitted to it
+	// from then on.
+	FreezeEventBoard(ctx context.Context, eventID string) error
+	// GetUserEventScores returns the scores of the user on the boards of
+	// every event it has submitted a score to.
+	GetUserEventScores(ctx context.Context, userID string) ([]EventScore, error)
+	RemoveUserEventScores(ctx context.Context, userID string) error
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	FriendshipStatusPending  = "pending"
+	FriendshipStatusAccepted = "accepted"
+	FriendshipStatusBlocked  = "blocked"
+)
+
+// Friendship is a relation between two users. A pending or accepted
+// friendship is stored once per pair of users and RequesterID is the user
+// that has sent the request. Blocks are one way, RequesterID is the user
+// that has blocked AddresseeID and a pair may have a block in both
+// directions.
+type Friendship struct {
+	ID          string
+	RequesterID string
+	AddresseeID string
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// OtherUserID returns the user on the other side of the friendship.
+func (friendship Friendship) OtherUserID(userID string) string {
+	if friendship.RequesterID == userID {
+		return friendship.AddresseeID
+	}
+
+	return friendship.RequesterID
+}
+
+//go:generate mockery --name FriendshipRepository --structname MockFriendshipRepository --outpkg mocks --filename friendship_repository_mock.go --output ./mocks/. --with-expecter
+type FriendshipRepository interface {
+	Create(ctx context.Context, friendship Friendship) (Friendship, error)
+	UpdateStatus(ctx context.Context, id, status string) error
+	Delete(ctx context.Context, id string) error
+	// ListBetween returns the relations of the two users in both directions.
+	ListBetween(ctx context.Context, userID, otherUserID string) ([]Friendship, error)
+	// ListByUserID returns the relations of the user in the status in both
+	// directions, oldest first.
+	ListByUserID(ctx context.Context, userID, status string) ([]Friendship, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
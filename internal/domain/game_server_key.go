@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	GameServerKeyAlgorithmHMACSHA256 = "hmac-sha256"
+	GameServerKeyAlgorithmEd25519    = "ed25519"
+)
+
+// GameServerKey is the key a dedicated game server signs its score
+// submissions with. Key is the shared secret for HMAC and the public key
+// for Ed25519.
+type GameServerKey struct {
+	ServerID  string
+	Algorithm string
+	Key       []byte
+	CreatedAt time.Time
+}
+
+type SignedScore struct {
+	ServerID  string
+	MatchID   string
+	UserID    string
+	Score     float64
+	Timestamp time.Time
+	Nonce     string
+	Signature []byte
+}
+
+// Payload returns the canonical form of the signed fields, one field per
+// line in the order: server id, match id, user id, score, unix timestamp
+// in seconds and nonce. Game servers have to sign exactly these bytes.
+func (score SignedScore) Payload() []byte {
+	return []byte(strings.Join([]string{
+		score.ServerID,
+		score.MatchID,
+		score.UserID,
+		strconv.FormatFloat(score.Score, 'g', -1, 64),
+		strconv.FormatInt(score.Timestamp.Unix(), 10),
+		score.Nonce,
+	}, "\n"))
+}
+
+//go:generate mockery --name GameServerKeyRepository --structname MockGameServerKeyRepository --outpkg mocks --filename game_server_key_repository_mock.go --output ./mocks/. --with-expecter
+type GameServerKeyRepository interface {
+	Create(ctx context.Context, key GameServerKey) (GameServerKey, error)
+	GetByServerID(ctx context.Context, serverID string) (GameServerKey, error)
+	List(ctx context.Context) ([]GameServerKey, error)
+	Delete(ctx context.Context, serverID string) error
+}
+
+//go:generate mockery --name NonceRepository --structname MockNonceRepository --outpkg mocks --filename nonce_repository_mock.go --output ./mocks/. --with-expecter
+type NonceRepository interface {
+	// Claim marks the nonce as used within the scope for the given duration.
+	// It returns false when the nonce has already been claimed.
+	Claim(ctx context.Context, scope, nonce string, ttl time.Duration) (bool, error)
+}
@@ -1,20 +1,132 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
+// UnknownUsername is shown in place of the username of ranked users that do
+// not exist anymore.
+const UnknownUsername = "unknown player"
+
+// The rules that rank users with the same score, by the time they have
+// reached it. Users that have reached it at the same time are ranked by
+// their user IDs in reverse.
+const (
+	TieBreakEarliest = "earliest"
+	TieBreakLatest   = "latest"
+)
+
+// The modes the ranks of the users on a leaderboard are numbered by, shown
+// for four users where the second and the third have the same score.
+const (
+	// RankModeStandard ranks the users 1, 2, 2, 4.
+	RankModeStandard = "standard"
+	// RankModeDense ranks the users 1, 2, 2, 3.
+	RankModeDense = "dense"
+	// RankModeOrdinal ranks the users 1, 2, 3, 4, the ties are broken by
+	// the time the users have reached their score.
+	RankModeOrdinal = "ordinal"
+)
+
+// IsRankMode reports whether mode is one of the rank modes.
+func IsRankMode(mode string) bool {
+	return mode == RankModeStandard || mode == RankModeDense || mode == RankModeOrdinal
+}
+
+// Leaderboard Total is the number of users ranked on the leaderboard, it is
+// larger than the number of UserScores when only the top of it has been
+// read, and 0 when it is not known.
 type Leaderboard struct {
-	UserScores []UserScore
+	UserScores  []UserScore
+	Total       int64
+	GeneratedAt time.Time
 }
 
 type UserScore struct {
 	UserID   string
 	Username string
 	Score    float64
+	Profile  UserProfile
+	// Missing is set when the user could not be found, Username is
+	// UnknownUsername then.
+	Missing bool
+
+	// Rank starts at 1 and is numbered by the rank mode of the leaderboard.
+	// Percentile is the percentage of the users on the leaderboard that the
+	// user is ranked above or tied with. Both are only set on the
+	// leaderboards returned by the services.
+	Rank       int64
+	Percentile float64
+}
+
+// RankCounts are what the ranks of a score are numbered from by the rank
+// modes. Above is the number of users with a higher score, DistinctAbove
+// the number of distinct scores higher than it and Total the number of
+// users on the leaderboard.
+type RankCounts struct {
+	Above         int64
+	DistinctAbove int64
+	Total         int64
+}
+
+// UserRank is the position of a user on the global leaderboard and on the
+// leaderboard of its country, ranks start at 1. CountryRank is 0 when the
+// user is not on the leaderboard of a country. Neighbours are the users
+// ranked around the user on the global leaderboard, including the user, the
+// first of them is ranked NeighboursStartRank. The repositories return the
+// ordinal ranks along with the counts of the score of the user and of the
+// score of the first neighbour, the services number them by the rank modes.
+type UserRank struct {
+	UserID      string
+	Score       float64
+	Rank        int64
+	CountryCode string
+	CountryRank int64
+
+	Neighbours          []UserScore
+	NeighboursStartRank int64
+
+	Counts           RankCounts
+	CountryCounts    RankCounts
+	NeighboursCounts RankCounts
 }
 
 //go:generate mockery --name UserScoreRepository --structname MockUserScoreRepository --outpkg mocks --filename user_score_repository_mock.go --output ./mocks/. --with-expecter
 type UserScoreRepository interface {
 	GetUserTopScore(ctx context.Context, userID string) (UserScore, error)
-	UpdateUserTopScore(ctx context.Context, userID string, score float64) error
+	// UpdateUserTopScore writes the score to the global leaderboard and to
+	// the leaderboard of the country, countryCode is empty when the user has
+	// no country. achievedAt breaks the ties with the users that have the
+	// same score.
+	UpdateUserTopScore(ctx context.Context, userID, countryCode string, score float64, achievedAt time.Time) error
+	SetUserCountry(ctx context.Context, userID, countryCode string) error
+	// GetUserCountries returns the countries whose leaderboard the users
+	// are on, the users that are on none are left out.
+	GetUserCountries(ctx context.Context, userIDs []string) (map[string]string, error)
+	RemoveUserScore(ctx context.Context, userID string) error
 	GetLeaderboard(ctx context.Context) (Leaderboard, error)
+	// GetTopLeaderboard reads only the top count users of the global
+	// leaderboard, Total is the number of users on all of it.
+	GetTopLeaderboard(ctx context.Context, count int64) (Leaderboard, error)
+	GetCountryLeaderboard(ctx context.Context, countryCode string) (Leaderboard, error)
+	// GetUsersLeaderboard ranks the users among themselves on the global
+	// leaderboard, users without a score are left out.
+	GetUsersLeaderboard(ctx context.Context, userIDs []string) (Leaderboard, error)
+	// GetUserRank returns the ranks of the user along with the users ranked
+	// within count places of it.
+	GetUserRank(ctx context.Context, userID string, count int64) (UserRank, error)
+	GetRankedUserIDs(ctx context.Context) ([]string, error)
+	// IndexRanks rebuilds the ranks of the users on every leaderboard, the
+	// ratings and the events boards included, from their scores and the
+	// times they have reached them at.
+	IndexRanks(ctx context.Context) error
+	// SetUserClan moves the score of the user to the aggregates of the clan,
+	// clanID is empty when the user has left its clan.
+	SetUserClan(ctx context.Context, userID, clanID string) error
+	// RemoveClan removes the aggregates of a clan that has no members left.
+	RemoveClan(ctx context.Context, clanID string) error
+	// GetClanLeaderboard ranks the clans by the aggregate, the names of the
+	// clans are left empty.
+	GetClanLeaderboard(ctx context.Context, aggregate string) ([]ClanScore, error)
 }
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// LeaderboardInvalidator notifies every replica of the service that the
+// cached leaderboard snapshots are out of date.
+//
+//go:generate mockery --name LeaderboardInvalidator --structname MockLeaderboardInvalidator --outpkg mocks --filename leaderboard_invalidator_mock.go --output ./mocks/. --with-expecter
+type LeaderboardInvalidator interface {
+	Invalidate(ctx context.Context) error
+	// Subscribe returns a channel that receives a value on every
+	// invalidation, it is closed when the context is done.
+	Subscribe(ctx context.Context) <-chan struct{}
+}
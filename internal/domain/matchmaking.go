@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MatchQueue identifies the players that can be matched with each other,
+// MatchSize is the number of players a match of the queue is made of, every
+// player queues alone.
+type MatchQueue struct {
+	Mode      string
+	Region    string
+	MatchSize int64
+}
+
+// QueueEntry is a player waiting for a match, Rating is the rating of the
+// player in the mode of the queue when it has joined the queue.
+type QueueEntry struct {
+	UserID   string
+	Queue    MatchQueue
+	Rating   float64
+	JoinedAt time.Time
+}
+
+// MatchTicket is the result of matchmaking, it is signed so that the game
+// server hosting the match can verify it has been issued by matchmaking.
+type MatchTicket struct {
+	MatchID   string
+	Mode      string
+	Region    string
+	UserIDs   []string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Signature []byte
+}
+
+// Payload returns the canonical form of the signed fields, one field per
+// line in the order: match id, mode, region, the comma separated user ids,
+// and the unix creation and expiration times in seconds.
+func (ticket MatchTicket) Payload() []byte {
+	return []byte(strings.Join([]string{
+		ticket.MatchID,
+		ticket.Mode,
+		ticket.Region,
+		strings.Join(ticket.UserIDs, ","),
+		strconv.FormatInt(ticket.CreatedAt.Unix(), 10),
+		strconv.FormatInt(ticket.ExpiresAt.Unix(), 10),
+	}, "\n"))
+}
+
+//go:generate mockery --name MatchmakingRepository --structname MockMatchmakingRepository --outpkg mocks --filename matchmaking_repository_mock.go --output ./mocks/. --with-expecter
+type MatchmakingRepository interface {
+	// Enqueue adds the player to its queue for the given duration and drops
+	// the ticket of its previous match. It returns ErrResourceExists when the
+	// player is already queued.
+	Enqueue(ctx context.Context, entry QueueEntry, ttl time.Duration) error
+	// Dequeue returns ErrResourceNotFound when the player is not queued.
+	Dequeue(ctx context.Context, userID string) error
+	GetEntry(ctx context.Context, userID string) (QueueEntry, error)
+	// ListEntries returns the players waiting in the queue, the ones that
+	// have waited the longest first.
+	ListEntries(ctx context.Context, queue MatchQueue) ([]QueueEntry, error)
+	// CreateMatch removes the players of the ticket from the queue and
+	// stores the ticket for every one of them until it expires. It returns
+	// false when one of them is not in the queue anymore.
+	CreateMatch(ctx context.Context, queue MatchQueue, ticket MatchTicket) (bool, error)
+	GetMatch(ctx context.Context, userID string) (MatchTicket, error)
+}
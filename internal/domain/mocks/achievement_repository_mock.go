@@ -0,0 +1,295 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAchievementRepository is an autogenerated mock type for the AchievementRepository type
+type MockAchievementRepository struct {
+	mock.Mock
+}
+
+type MockAchievementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAchievementRepository) EXPECT() *MockAchievementRepository_Expecter {
+	return &MockAchievementRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockAchievementRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAchievementRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockAchievementRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAchievementRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockAchievementRepository_DeleteByUserID_Call {
+	return &MockAchievementRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockAchievementRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAchievementRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAchievementRepository_DeleteByUserID_Call) Return(_a0 error) *MockAchievementRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAchievementRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockAchievementRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubmissions provides a mock function with given fields: ctx, userID
+func (_m *MockAchievementRepository) GetSubmissions(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementRepository_GetSubmissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubmissions'
+type MockAchievementRepository_GetSubmissions_Call struct {
+	*mock.Call
+}
+
+// GetSubmissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAchievementRepository_Expecter) GetSubmissions(ctx interface{}, userID interface{}) *MockAchievementRepository_GetSubmissions_Call {
+	return &MockAchievementRepository_GetSubmissions_Call{Call: _e.mock.On("GetSubmissions", ctx, userID)}
+}
+
+func (_c *MockAchievementRepository_GetSubmissions_Call) Run(run func(ctx context.Context, userID string)) *MockAchievementRepository_GetSubmissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAchievementRepository_GetSubmissions_Call) Return(_a0 int64, _a1 error) *MockAchievementRepository_GetSubmissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementRepository_GetSubmissions_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockAchievementRepository_GetSubmissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementSubmissions provides a mock function with given fields: ctx, userID
+func (_m *MockAchievementRepository) IncrementSubmissions(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementRepository_IncrementSubmissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementSubmissions'
+type MockAchievementRepository_IncrementSubmissions_Call struct {
+	*mock.Call
+}
+
+// IncrementSubmissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAchievementRepository_Expecter) IncrementSubmissions(ctx interface{}, userID interface{}) *MockAchievementRepository_IncrementSubmissions_Call {
+	return &MockAchievementRepository_IncrementSubmissions_Call{Call: _e.mock.On("IncrementSubmissions", ctx, userID)}
+}
+
+func (_c *MockAchievementRepository_IncrementSubmissions_Call) Run(run func(ctx context.Context, userID string)) *MockAchievementRepository_IncrementSubmissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAchievementRepository_IncrementSubmissions_Call) Return(_a0 int64, _a1 error) *MockAchievementRepository_IncrementSubmissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementRepository_IncrementSubmissions_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockAchievementRepository_IncrementSubmissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockAchievementRepository) ListByUserID(ctx context.Context, userID string) ([]domain.UserAchievement, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.UserAchievement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.UserAchievement, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.UserAchievement); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserAchievement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockAchievementRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAchievementRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *MockAchievementRepository_ListByUserID_Call {
+	return &MockAchievementRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *MockAchievementRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAchievementRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAchievementRepository_ListByUserID_Call) Return(_a0 []domain.UserAchievement, _a1 error) *MockAchievementRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]domain.UserAchievement, error)) *MockAchievementRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function with given fields: ctx, achievements
+func (_m *MockAchievementRepository) Unlock(ctx context.Context, achievements []domain.UserAchievement) (int64, error) {
+	ret := _m.Called(ctx, achievements)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.UserAchievement) (int64, error)); ok {
+		return rf(ctx, achievements)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.UserAchievement) int64); ok {
+		r0 = rf(ctx, achievements)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.UserAchievement) error); ok {
+		r1 = rf(ctx, achievements)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementRepository_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockAchievementRepository_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - achievements []domain.UserAchievement
+func (_e *MockAchievementRepository_Expecter) Unlock(ctx interface{}, achievements interface{}) *MockAchievementRepository_Unlock_Call {
+	return &MockAchievementRepository_Unlock_Call{Call: _e.mock.On("Unlock", ctx, achievements)}
+}
+
+func (_c *MockAchievementRepository_Unlock_Call) Run(run func(ctx context.Context, achievements []domain.UserAchievement)) *MockAchievementRepository_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.UserAchievement))
+	})
+	return _c
+}
+
+func (_c *MockAchievementRepository_Unlock_Call) Return(_a0 int64, _a1 error) *MockAchievementRepository_Unlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementRepository_Unlock_Call) RunAndReturn(run func(context.Context, []domain.UserAchievement) (int64, error)) *MockAchievementRepository_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockAchievementRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockAchievementRepository creates a new instance of MockAchievementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockAchievementRepository(t mockConstructorTestingTNewMockAchievementRepository) *MockAchievementRepository {
+	mock := &MockAchievementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
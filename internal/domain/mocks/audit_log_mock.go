@@ -0,0 +1,235 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuditLog is an autogenerated mock type for the AuditLog type
+type MockAuditLog struct {
+	mock.Mock
+}
+
+type MockAuditLog_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditLog) EXPECT() *MockAuditLog_Expecter {
+	return &MockAuditLog_Expecter{mock: &_m.Mock}
+}
+
+// AnonymizeUser provides a mock function with given fields: ctx, userID, anonymousID
+func (_m *MockAuditLog) AnonymizeUser(ctx context.Context, userID string, anonymousID string) error {
+	ret := _m.Called(ctx, userID, anonymousID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, anonymousID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuditLog_AnonymizeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeUser'
+type MockAuditLog_AnonymizeUser_Call struct {
+	*mock.Call
+}
+
+// AnonymizeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - anonymousID string
+func (_e *MockAuditLog_Expecter) AnonymizeUser(ctx interface{}, userID interface{}, anonymousID interface{}) *MockAuditLog_AnonymizeUser_Call {
+	return &MockAuditLog_AnonymizeUser_Call{Call: _e.mock.On("AnonymizeUser", ctx, userID, anonymousID)}
+}
+
+func (_c *MockAuditLog_AnonymizeUser_Call) Run(run func(ctx context.Context, userID string, anonymousID string)) *MockAuditLog_AnonymizeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuditLog_AnonymizeUser_Call) Return(_a0 error) *MockAuditLog_AnonymizeUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuditLog_AnonymizeUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAuditLog_AnonymizeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserEvents provides a mock function with given fields: ctx, userID
+func (_m *MockAuditLog) GetUserEvents(ctx context.Context, userID string) ([]domain.AuditEvent, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.AuditEvent, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.AuditEvent); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuditLog_GetUserEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserEvents'
+type MockAuditLog_GetUserEvents_Call struct {
+	*mock.Call
+}
+
+// GetUserEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuditLog_Expecter) GetUserEvents(ctx interface{}, userID interface{}) *MockAuditLog_GetUserEvents_Call {
+	return &MockAuditLog_GetUserEvents_Call{Call: _e.mock.On("GetUserEvents", ctx, userID)}
+}
+
+func (_c *MockAuditLog_GetUserEvents_Call) Run(run func(ctx context.Context, userID string)) *MockAuditLog_GetUserEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuditLog_GetUserEvents_Call) Return(_a0 []domain.AuditEvent, _a1 error) *MockAuditLog_GetUserEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuditLog_GetUserEvents_Call) RunAndReturn(run func(context.Context, string) ([]domain.AuditEvent, error)) *MockAuditLog_GetUserEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, filter
+func (_m *MockAuditLog) Query(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditEvent, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []domain.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditLogFilter) ([]domain.AuditEvent, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditLogFilter) []domain.AuditEvent); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuditLogFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuditLog_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockAuditLog_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AuditLogFilter
+func (_e *MockAuditLog_Expecter) Query(ctx interface{}, filter interface{}) *MockAuditLog_Query_Call {
+	return &MockAuditLog_Query_Call{Call: _e.mock.On("Query", ctx, filter)}
+}
+
+func (_c *MockAuditLog_Query_Call) Run(run func(ctx context.Context, filter domain.AuditLogFilter)) *MockAuditLog_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditLogFilter))
+	})
+	return _c
+}
+
+func (_c *MockAuditLog_Query_Call) Return(_a0 []domain.AuditEvent, _a1 error) *MockAuditLog_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuditLog_Query_Call) RunAndReturn(run func(context.Context, domain.AuditLogFilter) ([]domain.AuditEvent, error)) *MockAuditLog_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, event
+func (_m *MockAuditLog) Record(ctx context.Context, event domain.AuditEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuditLog_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAuditLog_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.AuditEvent
+func (_e *MockAuditLog_Expecter) Record(ctx interface{}, event interface{}) *MockAuditLog_Record_Call {
+	return &MockAuditLog_Record_Call{Call: _e.mock.On("Record", ctx, event)}
+}
+
+func (_c *MockAuditLog_Record_Call) Run(run func(ctx context.Context, event domain.AuditEvent)) *MockAuditLog_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockAuditLog_Record_Call) Return(_a0 error) *MockAuditLog_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuditLog_Record_Call) RunAndReturn(run func(context.Context, domain.AuditEvent) error) *MockAuditLog_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockAuditLog interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockAuditLog creates a new instance of MockAuditLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockAuditLog(t mockConstructorTestingTNewMockAuditLog) *MockAuditLog {
+	mock := &MockAuditLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,533 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockClanRepository is an autogenerated mock type for the ClanRepository type
+type MockClanRepository struct {
+	mock.Mock
+}
+
+type MockClanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClanRepository) EXPECT() *MockClanRepository_Expecter {
+	return &MockClanRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddMember provides a mock function with given fields: ctx, member
+func (_m *MockClanRepository) AddMember(ctx context.Context, member domain.ClanMember) error {
+	ret := _m.Called(ctx, member)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClanMember) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanRepository_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockClanRepository_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member domain.ClanMember
+func (_e *MockClanRepository_Expecter) AddMember(ctx interface{}, member interface{}) *MockClanRepository_AddMember_Call {
+	return &MockClanRepository_AddMember_Call{Call: _e.mock.On("AddMember", ctx, member)}
+}
+
+func (_c *MockClanRepository_AddMember_Call) Run(run func(ctx context.Context, member domain.ClanMember)) *MockClanRepository_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ClanMember))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_AddMember_Call) Return(_a0 error) *MockClanRepository_AddMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanRepository_AddMember_Call) RunAndReturn(run func(context.Context, domain.ClanMember) error) *MockClanRepository_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, clan
+func (_m *MockClanRepository) Create(ctx context.Context, clan domain.Clan) (domain.Clan, error) {
+	ret := _m.Called(ctx, clan)
+
+	var r0 domain.Clan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Clan) (domain.Clan, error)); ok {
+		return rf(ctx, clan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Clan) domain.Clan); ok {
+		r0 = rf(ctx, clan)
+	} else {
+		r0 = ret.Get(0).(domain.Clan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Clan) error); ok {
+		r1 = rf(ctx, clan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockClanRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clan domain.Clan
+func (_e *MockClanRepository_Expecter) Create(ctx interface{}, clan interface{}) *MockClanRepository_Create_Call {
+	return &MockClanRepository_Create_Call{Call: _e.mock.On("Create", ctx, clan)}
+}
+
+func (_c *MockClanRepository_Create_Call) Run(run func(ctx context.Context, clan domain.Clan)) *MockClanRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Clan))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_Create_Call) Return(_a0 domain.Clan, _a1 error) *MockClanRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Clan) (domain.Clan, error)) *MockClanRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockClanRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockClanRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockClanRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockClanRepository_Delete_Call {
+	return &MockClanRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockClanRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockClanRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_Delete_Call) Return(_a0 error) *MockClanRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockClanRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockClanRepository) GetByID(ctx context.Context, id string) (domain.Clan, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Clan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Clan, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Clan); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Clan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockClanRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockClanRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockClanRepository_GetByID_Call {
+	return &MockClanRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockClanRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockClanRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_GetByID_Call) Return(_a0 domain.Clan, _a1 error) *MockClanRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (domain.Clan, error)) *MockClanRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockClanRepository) GetByIDs(ctx context.Context, ids []string) ([]domain.Clan, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []domain.Clan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Clan, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.Clan); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Clan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockClanRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockClanRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}) *MockClanRepository_GetByIDs_Call {
+	return &MockClanRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *MockClanRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []string)) *MockClanRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_GetByIDs_Call) Return(_a0 []domain.Clan, _a1 error) *MockClanRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]domain.Clan, error)) *MockClanRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *MockClanRepository) GetByName(ctx context.Context, name string) (domain.Clan, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 domain.Clan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Clan, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Clan); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(domain.Clan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type MockClanRepository_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockClanRepository_Expecter) GetByName(ctx interface{}, name interface{}) *MockClanRepository_GetByName_Call {
+	return &MockClanRepository_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *MockClanRepository_GetByName_Call) Run(run func(ctx context.Context, name string)) *MockClanRepository_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_GetByName_Call) Return(_a0 domain.Clan, _a1 error) *MockClanRepository_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_GetByName_Call) RunAndReturn(run func(context.Context, string) (domain.Clan, error)) *MockClanRepository_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMember provides a mock function with given fields: ctx, userID
+func (_m *MockClanRepository) GetMember(ctx context.Context, userID string) (domain.ClanMember, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.ClanMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.ClanMember, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.ClanMember); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.ClanMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_GetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMember'
+type MockClanRepository_GetMember_Call struct {
+	*mock.Call
+}
+
+// GetMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockClanRepository_Expecter) GetMember(ctx interface{}, userID interface{}) *MockClanRepository_GetMember_Call {
+	return &MockClanRepository_GetMember_Call{Call: _e.mock.On("GetMember", ctx, userID)}
+}
+
+func (_c *MockClanRepository_GetMember_Call) Run(run func(ctx context.Context, userID string)) *MockClanRepository_GetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_GetMember_Call) Return(_a0 domain.ClanMember, _a1 error) *MockClanRepository_GetMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_GetMember_Call) RunAndReturn(run func(context.Context, string) (domain.ClanMember, error)) *MockClanRepository_GetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, clanID
+func (_m *MockClanRepository) ListMembers(ctx context.Context, clanID string) ([]domain.ClanMember, error) {
+	ret := _m.Called(ctx, clanID)
+
+	var r0 []domain.ClanMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.ClanMember, error)); ok {
+		return rf(ctx, clanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.ClanMember); ok {
+		r0 = rf(ctx, clanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ClanMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, clanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanRepository_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MockClanRepository_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clanID string
+func (_e *MockClanRepository_Expecter) ListMembers(ctx interface{}, clanID interface{}) *MockClanRepository_ListMembers_Call {
+	return &MockClanRepository_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, clanID)}
+}
+
+func (_c *MockClanRepository_ListMembers_Call) Run(run func(ctx context.Context, clanID string)) *MockClanRepository_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_ListMembers_Call) Return(_a0 []domain.ClanMember, _a1 error) *MockClanRepository_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanRepository_ListMembers_Call) RunAndReturn(run func(context.Context, string) ([]domain.ClanMember, error)) *MockClanRepository_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, userID
+func (_m *MockClanRepository) RemoveMember(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanRepository_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockClanRepository_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockClanRepository_Expecter) RemoveMember(ctx interface{}, userID interface{}) *MockClanRepository_RemoveMember_Call {
+	return &MockClanRepository_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, userID)}
+}
+
+func (_c *MockClanRepository_RemoveMember_Call) Run(run func(ctx context.Context, userID string)) *MockClanRepository_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_RemoveMember_Call) Return(_a0 error) *MockClanRepository_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanRepository_RemoveMember_Call) RunAndReturn(run func(context.Context, string) error) *MockClanRepository_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberRole provides a mock function with given fields: ctx, userID, role
+func (_m *MockClanRepository) UpdateMemberRole(ctx context.Context, userID string, role string) error {
+	ret := _m.Called(ctx, userID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanRepository_UpdateMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberRole'
+type MockClanRepository_UpdateMemberRole_Call struct {
+	*mock.Call
+}
+
+// UpdateMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - role string
+func (_e *MockClanRepository_Expecter) UpdateMemberRole(ctx interface{}, userID interface{}, role interface{}) *MockClanRepository_UpdateMemberRole_Call {
+	return &MockClanRepository_UpdateMemberRole_Call{Call: _e.mock.On("UpdateMemberRole", ctx, userID, role)}
+}
+
+func (_c *MockClanRepository_UpdateMemberRole_Call) Run(run func(ctx context.Context, userID string, role string)) *MockClanRepository_UpdateMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanRepository_UpdateMemberRole_Call) Return(_a0 error) *MockClanRepository_UpdateMemberRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanRepository_UpdateMemberRole_Call) RunAndReturn(run func(context.Context, string, string) error) *MockClanRepository_UpdateMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockClanRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockClanRepository creates a new instance of MockClanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockClanRepository(t mockConstructorTestingTNewMockClanRepository) *MockClanRepository {
+	mock := &MockClanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
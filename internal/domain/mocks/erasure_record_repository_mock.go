@@ -0,0 +1,187 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockErasureRecordRepository is an autogenerated mock type for the ErasureRecordRepository type
+type MockErasureRecordRepository struct {
+	mock.Mock
+}
+
+type MockErasureRecordRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockErasureRecordRepository) EXPECT() *MockErasureRecordRepository_Expecter {
+	return &MockErasureRecordRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, record
+func (_m *MockErasureRecordRepository) Create(ctx context.Context, record domain.ErasureRecord) (domain.ErasureRecord, error) {
+	ret := _m.Called(ctx, record)
+
+	var r0 domain.ErasureRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ErasureRecord) (domain.ErasureRecord, error)); ok {
+		return rf(ctx, record)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ErasureRecord) domain.ErasureRecord); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Get(0).(domain.ErasureRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ErasureRecord) error); ok {
+		r1 = rf(ctx, record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockErasureRecordRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockErasureRecordRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record domain.ErasureRecord
+func (_e *MockErasureRecordRepository_Expecter) Create(ctx interface{}, record interface{}) *MockErasureRecordRepository_Create_Call {
+	return &MockErasureRecordRepository_Create_Call{Call: _e.mock.On("Create", ctx, record)}
+}
+
+func (_c *MockErasureRecordRepository_Create_Call) Run(run func(ctx context.Context, record domain.ErasureRecord)) *MockErasureRecordRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ErasureRecord))
+	})
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_Create_Call) Return(_a0 domain.ErasureRecord, _a1 error) *MockErasureRecordRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_Create_Call) RunAndReturn(run func(context.Context, domain.ErasureRecord) (domain.ErasureRecord, error)) *MockErasureRecordRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockErasureRecordRepository) GetByID(ctx context.Context, id string) (domain.ErasureRecord, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.ErasureRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.ErasureRecord, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.ErasureRecord); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.ErasureRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockErasureRecordRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockErasureRecordRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockErasureRecordRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockErasureRecordRepository_GetByID_Call {
+	return &MockErasureRecordRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockErasureRecordRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockErasureRecordRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_GetByID_Call) Return(_a0 domain.ErasureRecord, _a1 error) *MockErasureRecordRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (domain.ErasureRecord, error)) *MockErasureRecordRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, record
+func (_m *MockErasureRecordRepository) Update(ctx context.Context, record domain.ErasureRecord) error {
+	ret := _m.Called(ctx, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ErasureRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockErasureRecordRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockErasureRecordRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record domain.ErasureRecord
+func (_e *MockErasureRecordRepository_Expecter) Update(ctx interface{}, record interface{}) *MockErasureRecordRepository_Update_Call {
+	return &MockErasureRecordRepository_Update_Call{Call: _e.mock.On("Update", ctx, record)}
+}
+
+func (_c *MockErasureRecordRepository_Update_Call) Run(run func(ctx context.Context, record domain.ErasureRecord)) *MockErasureRecordRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ErasureRecord))
+	})
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_Update_Call) Return(_a0 error) *MockErasureRecordRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockErasureRecordRepository_Update_Call) RunAndReturn(run func(context.Context, domain.ErasureRecord) error) *MockErasureRecordRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockErasureRecordRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockErasureRecordRepository creates a new instance of MockErasureRecordRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockErasureRecordRepository(t mockConstructorTestingTNewMockErasureRecordRepository) *MockErasureRecordRepository {
+	mock := &MockErasureRecordRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
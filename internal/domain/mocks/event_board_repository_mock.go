@@ -0,0 +1,292 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockEventBoardRepository is an autogenerated mock type for the EventBoardRepository type
+type MockEventBoardRepository struct {
+	mock.Mock
+}
+
+type MockEventBoardRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventBoardRepository) EXPECT() *MockEventBoardRepository_Expecter {
+	return &MockEventBoardRepository_Expecter{mock: &_m.Mock}
+}
+
+// FreezeEventBoard provides a mock function with given fields: ctx, eventID
+func (_m *MockEventBoardRepository) FreezeEventBoard(ctx context.Context, eventID string) error {
+	ret := _m.Called(ctx, eventID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEventBoardRepository_FreezeEventBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeEventBoard'
+type MockEventBoardRepository_FreezeEventBoard_Call struct {
+	*mock.Call
+}
+
+// FreezeEventBoard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEventBoardRepository_Expecter) FreezeEventBoard(ctx interface{}, eventID interface{}) *MockEventBoardRepository_FreezeEventBoard_Call {
+	return &MockEventBoardRepository_FreezeEventBoard_Call{Call: _e.mock.On("FreezeEventBoard", ctx, eventID)}
+}
+
+func (_c *MockEventBoardRepository_FreezeEventBoard_Call) Run(run func(ctx context.Context, eventID string)) *MockEventBoardRepository_FreezeEventBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventBoardRepository_FreezeEventBoard_Call) Return(_a0 error) *MockEventBoardRepository_FreezeEventBoard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEventBoardRepository_FreezeEventBoard_Call) RunAndReturn(run func(context.Context, string) error) *MockEventBoardRepository_FreezeEventBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventLeaderboard provides a mock function with given fields: ctx, eventID, count
+func (_m *MockEventBoardRepository) GetEventLeaderboard(ctx context.Context, eventID string, count int64) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, eventID, count)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (domain.Leaderboard, error)); ok {
+		return rf(ctx, eventID, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) domain.Leaderboard); ok {
+		r0 = rf(ctx, eventID, count)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, eventID, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventBoardRepository_GetEventLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventLeaderboard'
+type MockEventBoardRepository_GetEventLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetEventLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - count int64
+func (_e *MockEventBoardRepository_Expecter) GetEventLeaderboard(ctx interface{}, eventID interface{}, count interface{}) *MockEventBoardRepository_GetEventLeaderboard_Call {
+	return &MockEventBoardRepository_GetEventLeaderboard_Call{Call: _e.mock.On("GetEventLeaderboard", ctx, eventID, count)}
+}
+
+func (_c *MockEventBoardRepository_GetEventLeaderboard_Call) Run(run func(ctx context.Context, eventID string, count int64)) *MockEventBoardRepository_GetEventLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockEventBoardRepository_GetEventLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockEventBoardRepository_GetEventLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventBoardRepository_GetEventLeaderboard_Call) RunAndReturn(run func(context.Context, string, int64) (domain.Leaderboard, error)) *MockEventBoardRepository_GetEventLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserEventScores provides a mock function with given fields: ctx, userID
+func (_m *MockEventBoardRepository) GetUserEventScores(ctx context.Context, userID string) ([]domain.EventScore, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.EventScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.EventScore, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.EventScore); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EventScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventBoardRepository_GetUserEventScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserEventScores'
+type MockEventBoardRepository_GetUserEventScores_Call struct {
+	*mock.Call
+}
+
+// GetUserEventScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockEventBoardRepository_Expecter) GetUserEventScores(ctx interface{}, userID interface{}) *MockEventBoardRepository_GetUserEventScores_Call {
+	return &MockEventBoardRepository_GetUserEventScores_Call{Call: _e.mock.On("GetUserEventScores", ctx, userID)}
+}
+
+func (_c *MockEventBoardRepository_GetUserEventScores_Call) Run(run func(ctx context.Context, userID string)) *MockEventBoardRepository_GetUserEventScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventBoardRepository_GetUserEventScores_Call) Return(_a0 []domain.EventScore, _a1 error) *MockEventBoardRepository_GetUserEventScores_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventBoardRepository_GetUserEventScores_Call) RunAndReturn(run func(context.Context, string) ([]domain.EventScore, error)) *MockEventBoardRepository_GetUserEventScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserEventScores provides a mock function with given fields: ctx, userID
+func (_m *MockEventBoardRepository) RemoveUserEventScores(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEventBoardRepository_RemoveUserEventScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserEventScores'
+type MockEventBoardRepository_RemoveUserEventScores_Call struct {
+	*mock.Call
+}
+
+// RemoveUserEventScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockEventBoardRepository_Expecter) RemoveUserEventScores(ctx interface{}, userID interface{}) *MockEventBoardRepository_RemoveUserEventScores_Call {
+	return &MockEventBoardRepository_RemoveUserEventScores_Call{Call: _e.mock.On("RemoveUserEventScores", ctx, userID)}
+}
+
+func (_c *MockEventBoardRepository_RemoveUserEventScores_Call) Run(run func(ctx context.Context, userID string)) *MockEventBoardRepository_RemoveUserEventScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventBoardRepository_RemoveUserEventScores_Call) Return(_a0 error) *MockEventBoardRepository_RemoveUserEventScores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEventBoardRepository_RemoveUserEventScores_Call) RunAndReturn(run func(context.Context, string) error) *MockEventBoardRepository_RemoveUserEventScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitEventScore provides a mock function with given fields: ctx, eventID, userID, score, submittedAt, maxAttempts
+func (_m *MockEventBoardRepository) SubmitEventScore(ctx context.Context, eventID string, userID string, score float64, submittedAt time.Time, maxAttempts int64) (domain.EventSubmission, error) {
+	ret := _m.Called(ctx, eventID, userID, score, submittedAt, maxAttempts)
+
+	var r0 domain.EventSubmission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, time.Time, int64) (domain.EventSubmission, error)); ok {
+		return rf(ctx, eventID, userID, score, submittedAt, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, time.Time, int64) domain.EventSubmission); ok {
+		r0 = rf(ctx, eventID, userID, score, submittedAt, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(domain.EventSubmission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64, time.Time, int64) error); ok {
+		r1 = rf(ctx, eventID, userID, score, submittedAt, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventBoardRepository_SubmitEventScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitEventScore'
+type MockEventBoardRepository_SubmitEventScore_Call struct {
+	*mock.Call
+}
+
+// SubmitEventScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - userID string
+//   - score float64
+//   - submittedAt time.Time
+//   - maxAttempts int64
+func (_e *MockEventBoardRepository_Expecter) SubmitEventScore(ctx interface{}, eventID interface{}, userID interface{}, score interface{}, submittedAt interface{}, maxAttempts interface{}) *MockEventBoardRepository_SubmitEventScore_Call {
+	return &MockEventBoardRepository_SubmitEventScore_Call{Call: _e.mock.On("SubmitEventScore", ctx, eventID, userID, score, submittedAt, maxAttempts)}
+}
+
+func (_c *MockEventBoardRepository_SubmitEventScore_Call) Run(run func(ctx context.Context, eventID string, userID string, score float64, submittedAt time.Time, maxAttempts int64)) *MockEventBoardRepository_SubmitEventScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(float64), args[4].(time.Time), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *MockEventBoardRepository_SubmitEventScore_Call) Return(_a0 domain.EventSubmission, _a1 error) *MockEventBoardRepository_SubmitEventScore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventBoardRepository_SubmitEventScore_Call) RunAndReturn(run func(context.Context, string, string, float64, time.Time, int64) (domain.EventSubmission, error)) *MockEventBoardRepository_SubmitEventScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockEventBoardRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockEventBoardRepository creates a new instance of MockEventBoardRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockEventBoardRepository(t mockConstructorTestingTNewMockEventBoardRepository) *MockEventBoardRepository {
+	mock := &MockEventBoardRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
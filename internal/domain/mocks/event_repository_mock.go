@@ -0,0 +1,300 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockEventRepository is an autogenerated mock type for the EventRepository type
+type MockEventRepository struct {
+	mock.Mock
+}
+
+type MockEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventRepository) EXPECT() *MockEventRepository_Expecter {
+	return &MockEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *MockEventRepository) Create(ctx context.Context, event domain.Event) (domain.Event, error) {
+	ret := _m.Called(ctx, event)
+
+	var r0 domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event) (domain.Event, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event) domain.Event); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Event) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockEventRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.Event
+func (_e *MockEventRepository_Expecter) Create(ctx interface{}, event interface{}) *MockEventRepository_Create_Call {
+	return &MockEventRepository_Create_Call{Call: _e.mock.On("Create", ctx, event)}
+}
+
+func (_c *MockEventRepository_Create_Call) Run(run func(ctx context.Context, event domain.Event)) *MockEventRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Event))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_Create_Call) Return(_a0 domain.Event, _a1 error) *MockEventRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Event) (domain.Event, error)) *MockEventRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockEventRepository) GetByID(ctx context.Context, id string) (domain.Event, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Event, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Event); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockEventRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockEventRepository_GetByID_Call {
+	return &MockEventRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockEventRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockEventRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_GetByID_Call) Return(_a0 domain.Event, _a1 error) *MockEventRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (domain.Event, error)) *MockEventRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCurrent provides a mock function with given fields: ctx, now
+func (_m *MockEventRepository) ListCurrent(ctx context.Context, now time.Time) ([]domain.Event, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Event, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Event); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_ListCurrent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCurrent'
+type MockEventRepository_ListCurrent_Call struct {
+	*mock.Call
+}
+
+// ListCurrent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockEventRepository_Expecter) ListCurrent(ctx interface{}, now interface{}) *MockEventRepository_ListCurrent_Call {
+	return &MockEventRepository_ListCurrent_Call{Call: _e.mock.On("ListCurrent", ctx, now)}
+}
+
+func (_c *MockEventRepository_ListCurrent_Call) Run(run func(ctx context.Context, now time.Time)) *MockEventRepository_ListCurrent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_ListCurrent_Call) Return(_a0 []domain.Event, _a1 error) *MockEventRepository_ListCurrent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_ListCurrent_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.Event, error)) *MockEventRepository_ListCurrent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnded provides a mock function with given fields: ctx, now
+func (_m *MockEventRepository) ListEnded(ctx context.Context, now time.Time) ([]domain.Event, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Event, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Event); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_ListEnded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnded'
+type MockEventRepository_ListEnded_Call struct {
+	*mock.Call
+}
+
+// ListEnded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockEventRepository_Expecter) ListEnded(ctx interface{}, now interface{}) *MockEventRepository_ListEnded_Call {
+	return &MockEventRepository_ListEnded_Call{Call: _e.mock.On("ListEnded", ctx, now)}
+}
+
+func (_c *MockEventRepository_ListEnded_Call) Run(run func(ctx context.Context, now time.Time)) *MockEventRepository_ListEnded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_ListEnded_Call) Return(_a0 []domain.Event, _a1 error) *MockEventRepository_ListEnded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_ListEnded_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.Event, error)) *MockEventRepository_ListEnded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFrozen provides a mock function with given fields: ctx, id, frozenAt
+func (_m *MockEventRepository) SetFrozen(ctx context.Context, id string, frozenAt time.Time) error {
+	ret := _m.Called(ctx, id, frozenAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, frozenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEventRepository_SetFrozen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFrozen'
+type MockEventRepository_SetFrozen_Call struct {
+	*mock.Call
+}
+
+// SetFrozen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - frozenAt time.Time
+func (_e *MockEventRepository_Expecter) SetFrozen(ctx interface{}, id interface{}, frozenAt interface{}) *MockEventRepository_SetFrozen_Call {
+	return &MockEventRepository_SetFrozen_Call{Call: _e.mock.On("SetFrozen", ctx, id, frozenAt)}
+}
+
+func (_c *MockEventRepository_SetFrozen_Call) Run(run func(ctx context.Context, id string, frozenAt time.Time)) *MockEventRepository_SetFrozen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_SetFrozen_Call) Return(_a0 error) *MockEventRepository_SetFrozen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEventRepository_SetFrozen_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockEventRepository_SetFrozen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockEventRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockEventRepository creates a new instance of MockEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockEventRepository(t mockConstructorTestingTNewMockEventRepository) *MockEventRepository {
+	mock := &MockEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
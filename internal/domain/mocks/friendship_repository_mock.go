@@ -0,0 +1,333 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFriendshipRepository is an autogenerated mock type for the FriendshipRepository type
+type MockFriendshipRepository struct {
+	mock.Mock
+}
+
+type MockFriendshipRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFriendshipRepository) EXPECT() *MockFriendshipRepository_Expecter {
+	return &MockFriendshipRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, friendship
+func (_m *MockFriendshipRepository) Create(ctx context.Context, friendship domain.Friendship) (domain.Friendship, error) {
+	ret := _m.Called(ctx, friendship)
+
+	var r0 domain.Friendship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Friendship) (domain.Friendship, error)); ok {
+		return rf(ctx, friendship)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Friendship) domain.Friendship); ok {
+		r0 = rf(ctx, friendship)
+	} else {
+		r0 = ret.Get(0).(domain.Friendship)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Friendship) error); ok {
+		r1 = rf(ctx, friendship)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFriendshipRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockFriendshipRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - friendship domain.Friendship
+func (_e *MockFriendshipRepository_Expecter) Create(ctx interface{}, friendship interface{}) *MockFriendshipRepository_Create_Call {
+	return &MockFriendshipRepository_Create_Call{Call: _e.mock.On("Create", ctx, friendship)}
+}
+
+func (_c *MockFriendshipRepository_Create_Call) Run(run func(ctx context.Context, friendship domain.Friendship)) *MockFriendshipRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Friendship))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Create_Call) Return(_a0 domain.Friendship, _a1 error) *MockFriendshipRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Friendship) (domain.Friendship, error)) *MockFriendshipRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFriendshipRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFriendshipRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockFriendshipRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockFriendshipRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockFriendshipRepository_Delete_Call {
+	return &MockFriendshipRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) Return(_a0 error) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockFriendshipRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFriendshipRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockFriendshipRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockFriendshipRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockFriendshipRepository_DeleteByUserID_Call {
+	return &MockFriendshipRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockFriendshipRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockFriendshipRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_DeleteByUserID_Call) Return(_a0 error) *MockFriendshipRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockFriendshipRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBetween provides a mock function with given fields: ctx, userID, otherUserID
+func (_m *MockFriendshipRepository) ListBetween(ctx context.Context, userID string, otherUserID string) ([]domain.Friendship, error) {
+	ret := _m.Called(ctx, userID, otherUserID)
+
+	var r0 []domain.Friendship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.Friendship, error)); ok {
+		return rf(ctx, userID, otherUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.Friendship); ok {
+		r0 = rf(ctx, userID, otherUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Friendship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, otherUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFriendshipRepository_ListBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBetween'
+type MockFriendshipRepository_ListBetween_Call struct {
+	*mock.Call
+}
+
+// ListBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - otherUserID string
+func (_e *MockFriendshipRepository_Expecter) ListBetween(ctx interface{}, userID interface{}, otherUserID interface{}) *MockFriendshipRepository_ListBetween_Call {
+	return &MockFriendshipRepository_ListBetween_Call{Call: _e.mock.On("ListBetween", ctx, userID, otherUserID)}
+}
+
+func (_c *MockFriendshipRepository_ListBetween_Call) Run(run func(ctx context.Context, userID string, otherUserID string)) *MockFriendshipRepository_ListBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_ListBetween_Call) Return(_a0 []domain.Friendship, _a1 error) *MockFriendshipRepository_ListBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_ListBetween_Call) RunAndReturn(run func(context.Context, string, string) ([]domain.Friendship, error)) *MockFriendshipRepository_ListBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID, status
+func (_m *MockFriendshipRepository) ListByUserID(ctx context.Context, userID string, status string) ([]domain.Friendship, error) {
+	ret := _m.Called(ctx, userID, status)
+
+	var r0 []domain.Friendship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.Friendship, error)); ok {
+		return rf(ctx, userID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.Friendship); ok {
+		r0 = rf(ctx, userID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Friendship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFriendshipRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockFriendshipRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - status string
+func (_e *MockFriendshipRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}, status interface{}) *MockFriendshipRepository_ListByUserID_Call {
+	return &MockFriendshipRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID, status)}
+}
+
+func (_c *MockFriendshipRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string, status string)) *MockFriendshipRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_ListByUserID_Call) Return(_a0 []domain.Friendship, _a1 error) *MockFriendshipRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string, string) ([]domain.Friendship, error)) *MockFriendshipRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, status
+func (_m *MockFriendshipRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+	ret := _m.Called(ctx, id, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFriendshipRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockFriendshipRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status string
+func (_e *MockFriendshipRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}) *MockFriendshipRepository_UpdateStatus_Call {
+	return &MockFriendshipRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status)}
+}
+
+func (_c *MockFriendshipRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id string, status string)) *MockFriendshipRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_UpdateStatus_Call) Return(_a0 error) *MockFriendshipRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockFriendshipRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockFriendshipRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockFriendshipRepository creates a new instance of MockFriendshipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockFriendshipRepository(t mockConstructorTestingTNewMockFriendshipRepository) *MockFriendshipRepository {
+	mock := &MockFriendshipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,241 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGameServerKeyRepository is an autogenerated mock type for the GameServerKeyRepository type
+type MockGameServerKeyRepository struct {
+	mock.Mock
+}
+
+type MockGameServerKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGameServerKeyRepository) EXPECT() *MockGameServerKeyRepository_Expecter {
+	return &MockGameServerKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, key
+func (_m *MockGameServerKeyRepository) Create(ctx context.Context, key domain.GameServerKey) (domain.GameServerKey, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 domain.GameServerKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GameServerKey) (domain.GameServerKey, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GameServerKey) domain.GameServerKey); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(domain.GameServerKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GameServerKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGameServerKeyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockGameServerKeyRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key domain.GameServerKey
+func (_e *MockGameServerKeyRepository_Expecter) Create(ctx interface{}, key interface{}) *MockGameServerKeyRepository_Create_Call {
+	return &MockGameServerKeyRepository_Create_Call{Call: _e.mock.On("Create", ctx, key)}
+}
+
+func (_c *MockGameServerKeyRepository_Create_Call) Run(run func(ctx context.Context, key domain.GameServerKey)) *MockGameServerKeyRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GameServerKey))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_Create_Call) Return(_a0 domain.GameServerKey, _a1 error) *MockGameServerKeyRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_Create_Call) RunAndReturn(run func(context.Context, domain.GameServerKey) (domain.GameServerKey, error)) *MockGameServerKeyRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, serverID
+func (_m *MockGameServerKeyRepository) Delete(ctx context.Context, serverID string) error {
+	ret := _m.Called(ctx, serverID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGameServerKeyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGameServerKeyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID string
+func (_e *MockGameServerKeyRepository_Expecter) Delete(ctx interface{}, serverID interface{}) *MockGameServerKeyRepository_Delete_Call {
+	return &MockGameServerKeyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, serverID)}
+}
+
+func (_c *MockGameServerKeyRepository_Delete_Call) Run(run func(ctx context.Context, serverID string)) *MockGameServerKeyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_Delete_Call) Return(_a0 error) *MockGameServerKeyRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockGameServerKeyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByServerID provides a mock function with given fields: ctx, serverID
+func (_m *MockGameServerKeyRepository) GetByServerID(ctx context.Context, serverID string) (domain.GameServerKey, error) {
+	ret := _m.Called(ctx, serverID)
+
+	var r0 domain.GameServerKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.GameServerKey, error)); ok {
+		return rf(ctx, serverID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.GameServerKey); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Get(0).(domain.GameServerKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGameServerKeyRepository_GetByServerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByServerID'
+type MockGameServerKeyRepository_GetByServerID_Call struct {
+	*mock.Call
+}
+
+// GetByServerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID string
+func (_e *MockGameServerKeyRepository_Expecter) GetByServerID(ctx interface{}, serverID interface{}) *MockGameServerKeyRepository_GetByServerID_Call {
+	return &MockGameServerKeyRepository_GetByServerID_Call{Call: _e.mock.On("GetByServerID", ctx, serverID)}
+}
+
+func (_c *MockGameServerKeyRepository_GetByServerID_Call) Run(run func(ctx context.Context, serverID string)) *MockGameServerKeyRepository_GetByServerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_GetByServerID_Call) Return(_a0 domain.GameServerKey, _a1 error) *MockGameServerKeyRepository_GetByServerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_GetByServerID_Call) RunAndReturn(run func(context.Context, string) (domain.GameServerKey, error)) *MockGameServerKeyRepository_GetByServerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockGameServerKeyRepository) List(ctx context.Context) ([]domain.GameServerKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.GameServerKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.GameServerKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.GameServerKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GameServerKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGameServerKeyRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockGameServerKeyRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGameServerKeyRepository_Expecter) List(ctx interface{}) *MockGameServerKeyRepository_List_Call {
+	return &MockGameServerKeyRepository_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockGameServerKeyRepository_List_Call) Run(run func(ctx context.Context)) *MockGameServerKeyRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_List_Call) Return(_a0 []domain.GameServerKey, _a1 error) *MockGameServerKeyRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGameServerKeyRepository_List_Call) RunAndReturn(run func(context.Context) ([]domain.GameServerKey, error)) *MockGameServerKeyRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockGameServerKeyRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockGameServerKeyRepository creates a new instance of MockGameServerKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockGameServerKeyRepository(t mockConstructorTestingTNewMockGameServerKeyRepository) *MockGameServerKeyRepository {
+	mock := &MockGameServerKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLeaderboardInvalidator is an autogenerated mock type for the LeaderboardInvalidator type
+type MockLeaderboardInvalidator struct {
+	mock.Mock
+}
+
+type MockLeaderboardInvalidator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaderboardInvalidator) EXPECT() *MockLeaderboardInvalidator_Expecter {
+	return &MockLeaderboardInvalidator_Expecter{mock: &_m.Mock}
+}
+
+// Invalidate provides a mock function with given fields: ctx
+func (_m *MockLeaderboardInvalidator) Invalidate(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardInvalidator_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockLeaderboardInvalidator_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLeaderboardInvalidator_Expecter) Invalidate(ctx interface{}) *MockLeaderboardInvalidator_Invalidate_Call {
+	return &MockLeaderboardInvalidator_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx)}
+}
+
+func (_c *MockLeaderboardInvalidator_Invalidate_Call) Run(run func(ctx context.Context)) *MockLeaderboardInvalidator_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardInvalidator_Invalidate_Call) Return(_a0 error) *MockLeaderboardInvalidator_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardInvalidator_Invalidate_Call) RunAndReturn(run func(context.Context) error) *MockLeaderboardInvalidator_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx
+func (_m *MockLeaderboardInvalidator) Subscribe(ctx context.Context) <-chan struct{} {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan struct{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// MockLeaderboardInvalidator_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockLeaderboardInvalidator_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLeaderboardInvalidator_Expecter) Subscribe(ctx interface{}) *MockLeaderboardInvalidator_Subscribe_Call {
+	return &MockLeaderboardInvalidator_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx)}
+}
+
+func (_c *MockLeaderboardInvalidator_Subscribe_Call) Run(run func(ctx context.Context)) *MockLeaderboardInvalidator_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardInvalidator_Subscribe_Call) Return(_a0 <-chan struct{}) *MockLeaderboardInvalidator_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardInvalidator_Subscribe_Call) RunAndReturn(run func(context.Context) <-chan struct{}) *MockLeaderboardInvalidator_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockLeaderboardInvalidator interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockLeaderboardInvalidator creates a new instance of MockLeaderboardInvalidator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockLeaderboardInvalidator(t mockConstructorTestingTNewMockLeaderboardInvalidator) *MockLeaderboardInvalidator {
+	mock := &MockLeaderboardInvalidator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
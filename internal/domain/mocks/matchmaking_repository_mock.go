@@ -0,0 +1,342 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMatchmakingRepository is an autogenerated mock type for the MatchmakingRepository type
+type MockMatchmakingRepository struct {
+	mock.Mock
+}
+
+type MockMatchmakingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMatchmakingRepository) EXPECT() *MockMatchmakingRepository_Expecter {
+	return &MockMatchmakingRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMatch provides a mock function with given fields: ctx, queue, ticket
+func (_m *MockMatchmakingRepository) CreateMatch(ctx context.Context, queue domain.MatchQueue, ticket domain.MatchTicket) (bool, error) {
+	ret := _m.Called(ctx, queue, ticket)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchQueue, domain.MatchTicket) (bool, error)); ok {
+		return rf(ctx, queue, ticket)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchQueue, domain.MatchTicket) bool); ok {
+		r0 = rf(ctx, queue, ticket)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MatchQueue, domain.MatchTicket) error); ok {
+		r1 = rf(ctx, queue, ticket)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchmakingRepository_CreateMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMatch'
+type MockMatchmakingRepository_CreateMatch_Call struct {
+	*mock.Call
+}
+
+// CreateMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queue domain.MatchQueue
+//   - ticket domain.MatchTicket
+func (_e *MockMatchmakingRepository_Expecter) CreateMatch(ctx interface{}, queue interface{}, ticket interface{}) *MockMatchmakingRepository_CreateMatch_Call {
+	return &MockMatchmakingRepository_CreateMatch_Call{Call: _e.mock.On("CreateMatch", ctx, queue, ticket)}
+}
+
+func (_c *MockMatchmakingRepository_CreateMatch_Call) Run(run func(ctx context.Context, queue domain.MatchQueue, ticket domain.MatchTicket)) *MockMatchmakingRepository_CreateMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MatchQueue), args[2].(domain.MatchTicket))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_CreateMatch_Call) Return(_a0 bool, _a1 error) *MockMatchmakingRepository_CreateMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_CreateMatch_Call) RunAndReturn(run func(context.Context, domain.MatchQueue, domain.MatchTicket) (bool, error)) *MockMatchmakingRepository_CreateMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dequeue provides a mock function with given fields: ctx, userID
+func (_m *MockMatchmakingRepository) Dequeue(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchmakingRepository_Dequeue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dequeue'
+type MockMatchmakingRepository_Dequeue_Call struct {
+	*mock.Call
+}
+
+// Dequeue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchmakingRepository_Expecter) Dequeue(ctx interface{}, userID interface{}) *MockMatchmakingRepository_Dequeue_Call {
+	return &MockMatchmakingRepository_Dequeue_Call{Call: _e.mock.On("Dequeue", ctx, userID)}
+}
+
+func (_c *MockMatchmakingRepository_Dequeue_Call) Run(run func(ctx context.Context, userID string)) *MockMatchmakingRepository_Dequeue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_Dequeue_Call) Return(_a0 error) *MockMatchmakingRepository_Dequeue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_Dequeue_Call) RunAndReturn(run func(context.Context, string) error) *MockMatchmakingRepository_Dequeue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function with given fields: ctx, entry, ttl
+func (_m *MockMatchmakingRepository) Enqueue(ctx context.Context, entry domain.QueueEntry, ttl time.Duration) error {
+	ret := _m.Called(ctx, entry, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.QueueEntry, time.Duration) error); ok {
+		r0 = rf(ctx, entry, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchmakingRepository_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockMatchmakingRepository_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry domain.QueueEntry
+//   - ttl time.Duration
+func (_e *MockMatchmakingRepository_Expecter) Enqueue(ctx interface{}, entry interface{}, ttl interface{}) *MockMatchmakingRepository_Enqueue_Call {
+	return &MockMatchmakingRepository_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, entry, ttl)}
+}
+
+func (_c *MockMatchmakingRepository_Enqueue_Call) Run(run func(ctx context.Context, entry domain.QueueEntry, ttl time.Duration)) *MockMatchmakingRepository_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.QueueEntry), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_Enqueue_Call) Return(_a0 error) *MockMatchmakingRepository_Enqueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_Enqueue_Call) RunAndReturn(run func(context.Context, domain.QueueEntry, time.Duration) error) *MockMatchmakingRepository_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEntry provides a mock function with given fields: ctx, userID
+func (_m *MockMatchmakingRepository) GetEntry(ctx context.Context, userID string) (domain.QueueEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.QueueEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.QueueEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.QueueEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.QueueEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchmakingRepository_GetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntry'
+type MockMatchmakingRepository_GetEntry_Call struct {
+	*mock.Call
+}
+
+// GetEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchmakingRepository_Expecter) GetEntry(ctx interface{}, userID interface{}) *MockMatchmakingRepository_GetEntry_Call {
+	return &MockMatchmakingRepository_GetEntry_Call{Call: _e.mock.On("GetEntry", ctx, userID)}
+}
+
+func (_c *MockMatchmakingRepository_GetEntry_Call) Run(run func(ctx context.Context, userID string)) *MockMatchmakingRepository_GetEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_GetEntry_Call) Return(_a0 domain.QueueEntry, _a1 error) *MockMatchmakingRepository_GetEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_GetEntry_Call) RunAndReturn(run func(context.Context, string) (domain.QueueEntry, error)) *MockMatchmakingRepository_GetEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMatch provides a mock function with given fields: ctx, userID
+func (_m *MockMatchmakingRepository) GetMatch(ctx context.Context, userID string) (domain.MatchTicket, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.MatchTicket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.MatchTicket, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.MatchTicket); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.MatchTicket)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchmakingRepository_GetMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatch'
+type MockMatchmakingRepository_GetMatch_Call struct {
+	*mock.Call
+}
+
+// GetMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchmakingRepository_Expecter) GetMatch(ctx interface{}, userID interface{}) *MockMatchmakingRepository_GetMatch_Call {
+	return &MockMatchmakingRepository_GetMatch_Call{Call: _e.mock.On("GetMatch", ctx, userID)}
+}
+
+func (_c *MockMatchmakingRepository_GetMatch_Call) Run(run func(ctx context.Context, userID string)) *MockMatchmakingRepository_GetMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_GetMatch_Call) Return(_a0 domain.MatchTicket, _a1 error) *MockMatchmakingRepository_GetMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_GetMatch_Call) RunAndReturn(run func(context.Context, string) (domain.MatchTicket, error)) *MockMatchmakingRepository_GetMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: ctx, queue
+func (_m *MockMatchmakingRepository) ListEntries(ctx context.Context, queue domain.MatchQueue) ([]domain.QueueEntry, error) {
+	ret := _m.Called(ctx, queue)
+
+	var r0 []domain.QueueEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchQueue) ([]domain.QueueEntry, error)); ok {
+		return rf(ctx, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchQueue) []domain.QueueEntry); ok {
+		r0 = rf(ctx, queue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.QueueEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MatchQueue) error); ok {
+		r1 = rf(ctx, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchmakingRepository_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type MockMatchmakingRepository_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queue domain.MatchQueue
+func (_e *MockMatchmakingRepository_Expecter) ListEntries(ctx interface{}, queue interface{}) *MockMatchmakingRepository_ListEntries_Call {
+	return &MockMatchmakingRepository_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, queue)}
+}
+
+func (_c *MockMatchmakingRepository_ListEntries_Call) Run(run func(ctx context.Context, queue domain.MatchQueue)) *MockMatchmakingRepository_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MatchQueue))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_ListEntries_Call) Return(_a0 []domain.QueueEntry, _a1 error) *MockMatchmakingRepository_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchmakingRepository_ListEntries_Call) RunAndReturn(run func(context.Context, domain.MatchQueue) ([]domain.QueueEntry, error)) *MockMatchmakingRepository_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockMatchmakingRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockMatchmakingRepository creates a new instance of MockMatchmakingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockMatchmakingRepository(t mockConstructorTestingTNewMockMatchmakingRepository) *MockMatchmakingRepository {
+	mock := &MockMatchmakingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockNonceRepository is an autogenerated mock type for the NonceRepository type
+type MockNonceRepository struct {
+	mock.Mock
+}
+
+type MockNonceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNonceRepository) EXPECT() *MockNonceRepository_Expecter {
+	return &MockNonceRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, scope, nonce, ttl
+func (_m *MockNonceRepository) Claim(ctx context.Context, scope string, nonce string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, scope, nonce, ttl)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, scope, nonce, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = rf(ctx, scope, nonce, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, scope, nonce, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNonceRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockNonceRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope string
+//   - nonce string
+//   - ttl time.Duration
+func (_e *MockNonceRepository_Expecter) Claim(ctx interface{}, scope interface{}, nonce interface{}, ttl interface{}) *MockNonceRepository_Claim_Call {
+	return &MockNonceRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, scope, nonce, ttl)}
+}
+
+func (_c *MockNonceRepository_Claim_Call) Run(run func(ctx context.Context, scope string, nonce string, ttl time.Duration)) *MockNonceRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockNonceRepository_Claim_Call) Return(_a0 bool, _a1 error) *MockNonceRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNonceRepository_Claim_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (bool, error)) *MockNonceRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockNonceRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockNonceRepository creates a new instance of MockNonceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockNonceRepository(t mockConstructorTestingTNewMockNonceRepository) *MockNonceRepository {
+	mock := &MockNonceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,190 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockQuarantinedScoreRepository is an autogenerated mock type for the QuarantinedScoreRepository type
+type MockQuarantinedScoreRepository struct {
+	mock.Mock
+}
+
+type MockQuarantinedScoreRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuarantinedScoreRepository) EXPECT() *MockQuarantinedScoreRepository_Expecter {
+	return &MockQuarantinedScoreRepository_Expecter{mock: &_m.Mock}
+}
+
+// AnonymizeUser provides a mock function with given fields: ctx, userID, anonymousID
+func (_m *MockQuarantinedScoreRepository) AnonymizeUser(ctx context.Context, userID string, anonymousID string) error {
+	ret := _m.Called(ctx, userID, anonymousID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, anonymousID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuarantinedScoreRepository_AnonymizeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeUser'
+type MockQuarantinedScoreRepository_AnonymizeUser_Call struct {
+	*mock.Call
+}
+
+// AnonymizeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - anonymousID string
+func (_e *MockQuarantinedScoreRepository_Expecter) AnonymizeUser(ctx interface{}, userID interface{}, anonymousID interface{}) *MockQuarantinedScoreRepository_AnonymizeUser_Call {
+	return &MockQuarantinedScoreRepository_AnonymizeUser_Call{Call: _e.mock.On("AnonymizeUser", ctx, userID, anonymousID)}
+}
+
+func (_c *MockQuarantinedScoreRepository_AnonymizeUser_Call) Run(run func(ctx context.Context, userID string, anonymousID string)) *MockQuarantinedScoreRepository_AnonymizeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_AnonymizeUser_Call) Return(_a0 error) *MockQuarantinedScoreRepository_AnonymizeUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_AnonymizeUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockQuarantinedScoreRepository_AnonymizeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, score
+func (_m *MockQuarantinedScoreRepository) Create(ctx context.Context, score domain.QuarantinedScore) (domain.QuarantinedScore, error) {
+	ret := _m.Called(ctx, score)
+
+	var r0 domain.QuarantinedScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.QuarantinedScore) (domain.QuarantinedScore, error)); ok {
+		return rf(ctx, score)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.QuarantinedScore) domain.QuarantinedScore); ok {
+		r0 = rf(ctx, score)
+	} else {
+		r0 = ret.Get(0).(domain.QuarantinedScore)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.QuarantinedScore) error); ok {
+		r1 = rf(ctx, score)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuarantinedScoreRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockQuarantinedScoreRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - score domain.QuarantinedScore
+func (_e *MockQuarantinedScoreRepository_Expecter) Create(ctx interface{}, score interface{}) *MockQuarantinedScoreRepository_Create_Call {
+	return &MockQuarantinedScoreRepository_Create_Call{Call: _e.mock.On("Create", ctx, score)}
+}
+
+func (_c *MockQuarantinedScoreRepository_Create_Call) Run(run func(ctx context.Context, score domain.QuarantinedScore)) *MockQuarantinedScoreRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.QuarantinedScore))
+	})
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_Create_Call) Return(_a0 domain.QuarantinedScore, _a1 error) *MockQuarantinedScoreRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_Create_Call) RunAndReturn(run func(context.Context, domain.QuarantinedScore) (domain.QuarantinedScore, error)) *MockQuarantinedScoreRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockQuarantinedScoreRepository) ListByUserID(ctx context.Context, userID string) ([]domain.QuarantinedScore, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.QuarantinedScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.QuarantinedScore, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.QuarantinedScore); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.QuarantinedScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuarantinedScoreRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockQuarantinedScoreRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockQuarantinedScoreRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *MockQuarantinedScoreRepository_ListByUserID_Call {
+	return &MockQuarantinedScoreRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *MockQuarantinedScoreRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockQuarantinedScoreRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_ListByUserID_Call) Return(_a0 []domain.QuarantinedScore, _a1 error) *MockQuarantinedScoreRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuarantinedScoreRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]domain.QuarantinedScore, error)) *MockQuarantinedScoreRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockQuarantinedScoreRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockQuarantinedScoreRepository creates a new instance of MockQuarantinedScoreRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockQuarantinedScoreRepository(t mockConstructorTestingTNewMockQuarantinedScoreRepository) *MockQuarantinedScoreRepository {
+	mock := &MockQuarantinedScoreRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
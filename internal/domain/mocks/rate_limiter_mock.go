@@ -0,0 +1,136 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRateLimiter is an autogenerated mock type for the RateLimiter type
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+type MockRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimiter) EXPECT() *MockRateLimiter_Expecter {
+	return &MockRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Allow provides a mock function with given fields: ctx, key, limit
+func (_m *MockRateLimiter) Allow(ctx context.Context, key string, limit domain.RateLimit) (domain.RateLimitResult, error) {
+	ret := _m.Called(ctx, key, limit)
+
+	var r0 domain.RateLimitResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.RateLimit) (domain.RateLimitResult, error)); ok {
+		return rf(ctx, key, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.RateLimit) domain.RateLimitResult); ok {
+		r0 = rf(ctx, key, limit)
+	} else {
+		r0 = ret.Get(0).(domain.RateLimitResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.RateLimit) error); ok {
+		r1 = rf(ctx, key, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRateLimiter_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type MockRateLimiter_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - limit domain.RateLimit
+func (_e *MockRateLimiter_Expecter) Allow(ctx interface{}, key interface{}, limit interface{}) *MockRateLimiter_Allow_Call {
+	return &MockRateLimiter_Allow_Call{Call: _e.mock.On("Allow", ctx, key, limit)}
+}
+
+func (_c *MockRateLimiter_Allow_Call) Run(run func(ctx context.Context, key string, limit domain.RateLimit)) *MockRateLimiter_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.RateLimit))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) Return(_a0 domain.RateLimitResult, _a1 error) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) RunAndReturn(run func(context.Context, string, domain.RateLimit) (domain.RateLimitResult, error)) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refund provides a mock function with given fields: ctx, key, limit
+func (_m *MockRateLimiter) Refund(ctx context.Context, key string, limit domain.RateLimit) error {
+	ret := _m.Called(ctx, key, limit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.RateLimit) error); ok {
+		r0 = rf(ctx, key, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRateLimiter_Refund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refund'
+type MockRateLimiter_Refund_Call struct {
+	*mock.Call
+}
+
+// Refund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - limit domain.RateLimit
+func (_e *MockRateLimiter_Expecter) Refund(ctx interface{}, key interface{}, limit interface{}) *MockRateLimiter_Refund_Call {
+	return &MockRateLimiter_Refund_Call{Call: _e.mock.On("Refund", ctx, key, limit)}
+}
+
+func (_c *MockRateLimiter_Refund_Call) Run(run func(ctx context.Context, key string, limit domain.RateLimit)) *MockRateLimiter_Refund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.RateLimit))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Refund_Call) Return(_a0 error) *MockRateLimiter_Refund_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRateLimiter_Refund_Call) RunAndReturn(run func(context.Context, string, domain.RateLimit) error) *MockRateLimiter_Refund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRateLimiter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRateLimiter creates a new instance of MockRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRateLimiter(t mockConstructorTestingTNewMockRateLimiter) *MockRateLimiter {
+	mock := &MockRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,355 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRatingRepository is an autogenerated mock type for the RatingRepository type
+type MockRatingRepository struct {
+	mock.Mock
+}
+
+type MockRatingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRatingRepository) EXPECT() *MockRatingRepository_Expecter {
+	return &MockRatingRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetRatingLeaderboard provides a mock function with given fields: ctx, mode, count
+func (_m *MockRatingRepository) GetRatingLeaderboard(ctx context.Context, mode string, count int64) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, mode, count)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (domain.Leaderboard, error)); ok {
+		return rf(ctx, mode, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) domain.Leaderboard); ok {
+		r0 = rf(ctx, mode, count)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, mode, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingRepository_GetRatingLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatingLeaderboard'
+type MockRatingRepository_GetRatingLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetRatingLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mode string
+//   - count int64
+func (_e *MockRatingRepository_Expecter) GetRatingLeaderboard(ctx interface{}, mode interface{}, count interface{}) *MockRatingRepository_GetRatingLeaderboard_Call {
+	return &MockRatingRepository_GetRatingLeaderboard_Call{Call: _e.mock.On("GetRatingLeaderboard", ctx, mode, count)}
+}
+
+func (_c *MockRatingRepository_GetRatingLeaderboard_Call) Run(run func(ctx context.Context, mode string, count int64)) *MockRatingRepository_GetRatingLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatingLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockRatingRepository_GetRatingLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatingLeaderboard_Call) RunAndReturn(run func(context.Context, string, int64) (domain.Leaderboard, error)) *MockRatingRepository_GetRatingLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatingRank provides a mock function with given fields: ctx, mode, userID
+func (_m *MockRatingRepository) GetRatingRank(ctx context.Context, mode string, userID string) (int64, error) {
+	ret := _m.Called(ctx, mode, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, mode, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, mode, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, mode, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingRepository_GetRatingRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatingRank'
+type MockRatingRepository_GetRatingRank_Call struct {
+	*mock.Call
+}
+
+// GetRatingRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mode string
+//   - userID string
+func (_e *MockRatingRepository_Expecter) GetRatingRank(ctx interface{}, mode interface{}, userID interface{}) *MockRatingRepository_GetRatingRank_Call {
+	return &MockRatingRepository_GetRatingRank_Call{Call: _e.mock.On("GetRatingRank", ctx, mode, userID)}
+}
+
+func (_c *MockRatingRepository_GetRatingRank_Call) Run(run func(ctx context.Context, mode string, userID string)) *MockRatingRepository_GetRatingRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatingRank_Call) Return(_a0 int64, _a1 error) *MockRatingRepository_GetRatingRank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatingRank_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *MockRatingRepository_GetRatingRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatings provides a mock function with given fields: ctx, mode, userIDs
+func (_m *MockRatingRepository) GetRatings(ctx context.Context, mode string, userIDs []string) (map[string]domain.Rating, error) {
+	ret := _m.Called(ctx, mode, userIDs)
+
+	var r0 map[string]domain.Rating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]domain.Rating, error)); ok {
+		return rf(ctx, mode, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) map[string]domain.Rating); ok {
+		r0 = rf(ctx, mode, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]domain.Rating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, mode, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingRepository_GetRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatings'
+type MockRatingRepository_GetRatings_Call struct {
+	*mock.Call
+}
+
+// GetRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mode string
+//   - userIDs []string
+func (_e *MockRatingRepository_Expecter) GetRatings(ctx interface{}, mode interface{}, userIDs interface{}) *MockRatingRepository_GetRatings_Call {
+	return &MockRatingRepository_GetRatings_Call{Call: _e.mock.On("GetRatings", ctx, mode, userIDs)}
+}
+
+func (_c *MockRatingRepository_GetRatings_Call) Run(run func(ctx context.Context, mode string, userIDs []string)) *MockRatingRepository_GetRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatings_Call) Return(_a0 map[string]domain.Rating, _a1 error) *MockRatingRepository_GetRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingRepository_GetRatings_Call) RunAndReturn(run func(context.Context, string, []string) (map[string]domain.Rating, error)) *MockRatingRepository_GetRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRatings provides a mock function with given fields: ctx, userID
+func (_m *MockRatingRepository) GetUserRatings(ctx context.Context, userID string) ([]domain.Rating, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.Rating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Rating, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Rating); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Rating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingRepository_GetUserRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRatings'
+type MockRatingRepository_GetUserRatings_Call struct {
+	*mock.Call
+}
+
+// GetUserRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRatingRepository_Expecter) GetUserRatings(ctx interface{}, userID interface{}) *MockRatingRepository_GetUserRatings_Call {
+	return &MockRatingRepository_GetUserRatings_Call{Call: _e.mock.On("GetUserRatings", ctx, userID)}
+}
+
+func (_c *MockRatingRepository_GetUserRatings_Call) Run(run func(ctx context.Context, userID string)) *MockRatingRepository_GetUserRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_GetUserRatings_Call) Return(_a0 []domain.Rating, _a1 error) *MockRatingRepository_GetUserRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingRepository_GetUserRatings_Call) RunAndReturn(run func(context.Context, string) ([]domain.Rating, error)) *MockRatingRepository_GetUserRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserRatings provides a mock function with given fields: ctx, userID
+func (_m *MockRatingRepository) RemoveUserRatings(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRatingRepository_RemoveUserRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserRatings'
+type MockRatingRepository_RemoveUserRatings_Call struct {
+	*mock.Call
+}
+
+// RemoveUserRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRatingRepository_Expecter) RemoveUserRatings(ctx interface{}, userID interface{}) *MockRatingRepository_RemoveUserRatings_Call {
+	return &MockRatingRepository_RemoveUserRatings_Call{Call: _e.mock.On("RemoveUserRatings", ctx, userID)}
+}
+
+func (_c *MockRatingRepository_RemoveUserRatings_Call) Run(run func(ctx context.Context, userID string)) *MockRatingRepository_RemoveUserRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_RemoveUserRatings_Call) Return(_a0 error) *MockRatingRepository_RemoveUserRatings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRatingRepository_RemoveUserRatings_Call) RunAndReturn(run func(context.Context, string) error) *MockRatingRepository_RemoveUserRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRatings provides a mock function with given fields: ctx, matchID, mode, ratings
+func (_m *MockRatingRepository) SaveRatings(ctx context.Context, matchID string, mode string, ratings []domain.Rating) (bool, error) {
+	ret := _m.Called(ctx, matchID, mode, ratings)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []domain.Rating) (bool, error)); ok {
+		return rf(ctx, matchID, mode, ratings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []domain.Rating) bool); ok {
+		r0 = rf(ctx, matchID, mode, ratings)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []domain.Rating) error); ok {
+		r1 = rf(ctx, matchID, mode, ratings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingRepository_SaveRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRatings'
+type MockRatingRepository_SaveRatings_Call struct {
+	*mock.Call
+}
+
+// SaveRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+//   - mode string
+//   - ratings []domain.Rating
+func (_e *MockRatingRepository_Expecter) SaveRatings(ctx interface{}, matchID interface{}, mode interface{}, ratings interface{}) *MockRatingRepository_SaveRatings_Call {
+	return &MockRatingRepository_SaveRatings_Call{Call: _e.mock.On("SaveRatings", ctx, matchID, mode, ratings)}
+}
+
+func (_c *MockRatingRepository_SaveRatings_Call) Run(run func(ctx context.Context, matchID string, mode string, ratings []domain.Rating)) *MockRatingRepository_SaveRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]domain.Rating))
+	})
+	return _c
+}
+
+func (_c *MockRatingRepository_SaveRatings_Call) Return(_a0 bool, _a1 error) *MockRatingRepository_SaveRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingRepository_SaveRatings_Call) RunAndReturn(run func(context.Context, string, string, []domain.Rating) (bool, error)) *MockRatingRepository_SaveRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRatingRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRatingRepository creates a new instance of MockRatingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRatingRepository(t mockConstructorTestingTNewMockRatingRepository) *MockRatingRepository {
+	mock := &MockRatingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
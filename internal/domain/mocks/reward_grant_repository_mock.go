@@ -0,0 +1,245 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRewardGrantRepository is an autogenerated mock type for the RewardGrantRepository type
+type MockRewardGrantRepository struct {
+	mock.Mock
+}
+
+type MockRewardGrantRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRewardGrantRepository) EXPECT() *MockRewardGrantRepository_Expecter {
+	return &MockRewardGrantRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, id, claimedAt
+func (_m *MockRewardGrantRepository) Claim(ctx context.Context, id string, claimedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, claimedAt)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return rf(ctx, id, claimedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = rf(ctx, id, claimedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, id, claimedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardGrantRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockRewardGrantRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - claimedAt time.Time
+func (_e *MockRewardGrantRepository_Expecter) Claim(ctx interface{}, id interface{}, claimedAt interface{}) *MockRewardGrantRepository_Claim_Call {
+	return &MockRewardGrantRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, id, claimedAt)}
+}
+
+func (_c *MockRewardGrantRepository_Claim_Call) Run(run func(ctx context.Context, id string, claimedAt time.Time)) *MockRewardGrantRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_Claim_Call) Return(_a0 bool, _a1 error) *MockRewardGrantRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_Claim_Call) RunAndReturn(run func(context.Context, string, time.Time) (bool, error)) *MockRewardGrantRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMany provides a mock function with given fields: ctx, grants
+func (_m *MockRewardGrantRepository) CreateMany(ctx context.Context, grants []domain.RewardGrant) (int64, error) {
+	ret := _m.Called(ctx, grants)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.RewardGrant) (int64, error)); ok {
+		return rf(ctx, grants)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.RewardGrant) int64); ok {
+		r0 = rf(ctx, grants)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.RewardGrant) error); ok {
+		r1 = rf(ctx, grants)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardGrantRepository_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type MockRewardGrantRepository_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - grants []domain.RewardGrant
+func (_e *MockRewardGrantRepository_Expecter) CreateMany(ctx interface{}, grants interface{}) *MockRewardGrantRepository_CreateMany_Call {
+	return &MockRewardGrantRepository_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, grants)}
+}
+
+func (_c *MockRewardGrantRepository_CreateMany_Call) Run(run func(ctx context.Context, grants []domain.RewardGrant)) *MockRewardGrantRepository_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.RewardGrant))
+	})
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_CreateMany_Call) Return(_a0 int64, _a1 error) *MockRewardGrantRepository_CreateMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_CreateMany_Call) RunAndReturn(run func(context.Context, []domain.RewardGrant) (int64, error)) *MockRewardGrantRepository_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockRewardGrantRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRewardGrantRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockRewardGrantRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRewardGrantRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockRewardGrantRepository_DeleteByUserID_Call {
+	return &MockRewardGrantRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockRewardGrantRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockRewardGrantRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_DeleteByUserID_Call) Return(_a0 error) *MockRewardGrantRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockRewardGrantRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockRewardGrantRepository) ListByUserID(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.RewardGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.RewardGrant, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.RewardGrant); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RewardGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardGrantRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockRewardGrantRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRewardGrantRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *MockRewardGrantRepository_ListByUserID_Call {
+	return &MockRewardGrantRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *MockRewardGrantRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockRewardGrantRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_ListByUserID_Call) Return(_a0 []domain.RewardGrant, _a1 error) *MockRewardGrantRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardGrantRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]domain.RewardGrant, error)) *MockRewardGrantRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRewardGrantRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRewardGrantRepository creates a new instance of MockRewardGrantRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRewardGrantRepository(t mockConstructorTestingTNewMockRewardGrantRepository) *MockRewardGrantRepository {
+	mock := &MockRewardGrantRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,330 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockScoreSubmissionRepository is an autogenerated mock type for the ScoreSubmissionRepository type
+type MockScoreSubmissionRepository struct {
+	mock.Mock
+}
+
+type MockScoreSubmissionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockScoreSubmissionRepository) EXPECT() *MockScoreSubmissionRepository_Expecter {
+	return &MockScoreSubmissionRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddToStatistics provides a mock function with given fields: ctx, score
+func (_m *MockScoreSubmissionRepository) AddToStatistics(ctx context.Context, score float64) error {
+	ret := _m.Called(ctx, score)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64) error); ok {
+		r0 = rf(ctx, score)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScoreSubmissionRepository_AddToStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToStatistics'
+type MockScoreSubmissionRepository_AddToStatistics_Call struct {
+	*mock.Call
+}
+
+// AddToStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - score float64
+func (_e *MockScoreSubmissionRepository_Expecter) AddToStatistics(ctx interface{}, score interface{}) *MockScoreSubmissionRepository_AddToStatistics_Call {
+	return &MockScoreSubmissionRepository_AddToStatistics_Call{Call: _e.mock.On("AddToStatistics", ctx, score)}
+}
+
+func (_c *MockScoreSubmissionRepository_AddToStatistics_Call) Run(run func(ctx context.Context, score float64)) *MockScoreSubmissionRepository_AddToStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_AddToStatistics_Call) Return(_a0 error) *MockScoreSubmissionRepository_AddToStatistics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_AddToStatistics_Call) RunAndReturn(run func(context.Context, float64) error) *MockScoreSubmissionRepository_AddToStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLastSubmission provides a mock function with given fields: ctx, userID
+func (_m *MockScoreSubmissionRepository) DeleteLastSubmission(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScoreSubmissionRepository_DeleteLastSubmission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastSubmission'
+type MockScoreSubmissionRepository_DeleteLastSubmission_Call struct {
+	*mock.Call
+}
+
+// DeleteLastSubmission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockScoreSubmissionRepository_Expecter) DeleteLastSubmission(ctx interface{}, userID interface{}) *MockScoreSubmissionRepository_DeleteLastSubmission_Call {
+	return &MockScoreSubmissionRepository_DeleteLastSubmission_Call{Call: _e.mock.On("DeleteLastSubmission", ctx, userID)}
+}
+
+func (_c *MockScoreSubmissionRepository_DeleteLastSubmission_Call) Run(run func(ctx context.Context, userID string)) *MockScoreSubmissionRepository_DeleteLastSubmission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_DeleteLastSubmission_Call) Return(_a0 error) *MockScoreSubmissionRepository_DeleteLastSubmission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_DeleteLastSubmission_Call) RunAndReturn(run func(context.Context, string) error) *MockScoreSubmissionRepository_DeleteLastSubmission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastSubmission provides a mock function with given fields: ctx, userID
+func (_m *MockScoreSubmissionRepository) GetLastSubmission(ctx context.Context, userID string) (domain.ScoreSubmission, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.ScoreSubmission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.ScoreSubmission, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.ScoreSubmission); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.ScoreSubmission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockScoreSubmissionRepository_GetLastSubmission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastSubmission'
+type MockScoreSubmissionRepository_GetLastSubmission_Call struct {
+	*mock.Call
+}
+
+// GetLastSubmission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockScoreSubmissionRepository_Expecter) GetLastSubmission(ctx interface{}, userID interface{}) *MockScoreSubmissionRepository_GetLastSubmission_Call {
+	return &MockScoreSubmissionRepository_GetLastSubmission_Call{Call: _e.mock.On("GetLastSubmission", ctx, userID)}
+}
+
+func (_c *MockScoreSubmissionRepository_GetLastSubmission_Call) Run(run func(ctx context.Context, userID string)) *MockScoreSubmissionRepository_GetLastSubmission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_GetLastSubmission_Call) Return(_a0 domain.ScoreSubmission, _a1 error) *MockScoreSubmissionRepository_GetLastSubmission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_GetLastSubmission_Call) RunAndReturn(run func(context.Context, string) (domain.ScoreSubmission, error)) *MockScoreSubmissionRepository_GetLastSubmission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatistics provides a mock function with given fields: ctx
+func (_m *MockScoreSubmissionRepository) GetStatistics(ctx context.Context) (domain.ScoreStatistics, error) {
+	ret := _m.Called(ctx)
+
+	var r0 domain.ScoreStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (domain.ScoreStatistics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) domain.ScoreStatistics); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.ScoreStatistics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockScoreSubmissionRepository_GetStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatistics'
+type MockScoreSubmissionRepository_GetStatistics_Call struct {
+	*mock.Call
+}
+
+// GetStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockScoreSubmissionRepository_Expecter) GetStatistics(ctx interface{}) *MockScoreSubmissionRepository_GetStatistics_Call {
+	return &MockScoreSubmissionRepository_GetStatistics_Call{Call: _e.mock.On("GetStatistics", ctx)}
+}
+
+func (_c *MockScoreSubmissionRepository_GetStatistics_Call) Run(run func(ctx context.Context)) *MockScoreSubmissionRepository_GetStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_GetStatistics_Call) Return(_a0 domain.ScoreStatistics, _a1 error) *MockScoreSubmissionRepository_GetStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_GetStatistics_Call) RunAndReturn(run func(context.Context) (domain.ScoreStatistics, error)) *MockScoreSubmissionRepository_GetStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveSubmission provides a mock function with given fields: ctx, submission, minInterval
+func (_m *MockScoreSubmissionRepository) ReserveSubmission(ctx context.Context, submission domain.ScoreSubmission, minInterval time.Duration) (*domain.ScoreSubmission, error) {
+	ret := _m.Called(ctx, submission, minInterval)
+
+	var r0 *domain.ScoreSubmission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScoreSubmission, time.Duration) (*domain.ScoreSubmission, error)); ok {
+		return rf(ctx, submission, minInterval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScoreSubmission, time.Duration) *domain.ScoreSubmission); ok {
+		r0 = rf(ctx, submission, minInterval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ScoreSubmission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ScoreSubmission, time.Duration) error); ok {
+		r1 = rf(ctx, submission, minInterval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockScoreSubmissionRepository_ReserveSubmission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveSubmission'
+type MockScoreSubmissionRepository_ReserveSubmission_Call struct {
+	*mock.Call
+}
+
+// ReserveSubmission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - submission domain.ScoreSubmission
+//   - minInterval time.Duration
+func (_e *MockScoreSubmissionRepository_Expecter) ReserveSubmission(ctx interface{}, submission interface{}, minInterval interface{}) *MockScoreSubmissionRepository_ReserveSubmission_Call {
+	return &MockScoreSubmissionRepository_ReserveSubmission_Call{Call: _e.mock.On("ReserveSubmission", ctx, submission, minInterval)}
+}
+
+func (_c *MockScoreSubmissionRepository_ReserveSubmission_Call) Run(run func(ctx context.Context, submission domain.ScoreSubmission, minInterval time.Duration)) *MockScoreSubmissionRepository_ReserveSubmission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScoreSubmission), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_ReserveSubmission_Call) Return(_a0 *domain.ScoreSubmission, _a1 error) *MockScoreSubmissionRepository_ReserveSubmission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_ReserveSubmission_Call) RunAndReturn(run func(context.Context, domain.ScoreSubmission, time.Duration) (*domain.ScoreSubmission, error)) *MockScoreSubmissionRepository_ReserveSubmission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveLastSubmission provides a mock function with given fields: ctx, submission
+func (_m *MockScoreSubmissionRepository) SaveLastSubmission(ctx context.Context, submission domain.ScoreSubmission) error {
+	ret := _m.Called(ctx, submission)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScoreSubmission) error); ok {
+		r0 = rf(ctx, submission)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScoreSubmissionRepository_SaveLastSubmission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLastSubmission'
+type MockScoreSubmissionRepository_SaveLastSubmission_Call struct {
+	*mock.Call
+}
+
+// SaveLastSubmission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - submission domain.ScoreSubmission
+func (_e *MockScoreSubmissionRepository_Expecter) SaveLastSubmission(ctx interface{}, submission interface{}) *MockScoreSubmissionRepository_SaveLastSubmission_Call {
+	return &MockScoreSubmissionRepository_SaveLastSubmission_Call{Call: _e.mock.On("SaveLastSubmission", ctx, submission)}
+}
+
+func (_c *MockScoreSubmissionRepository_SaveLastSubmission_Call) Run(run func(ctx context.Context, submission domain.ScoreSubmission)) *MockScoreSubmissionRepository_SaveLastSubmission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScoreSubmission))
+	})
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_SaveLastSubmission_Call) Return(_a0 error) *MockScoreSubmissionRepository_SaveLastSubmission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScoreSubmissionRepository_SaveLastSubmission_Call) RunAndReturn(run func(context.Context, domain.ScoreSubmission) error) *MockScoreSubmissionRepository_SaveLastSubmission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockScoreSubmissionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockScoreSubmissionRepository creates a new instance of MockScoreSubmissionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockScoreSubmissionRepository(t mockConstructorTestingTNewMockScoreSubmissionRepository) *MockScoreSubmissionRepository {
+	mock := &MockScoreSubmissionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,417 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStorageRepository is an autogenerated mock type for the StorageRepository type
+type MockStorageRepository struct {
+	mock.Mock
+}
+
+type MockStorageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStorageRepository) EXPECT() *MockStorageRepository_Expecter {
+	return &MockStorageRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStorageRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageRepository_CountByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserID'
+type MockStorageRepository_CountByUserID_Call struct {
+	*mock.Call
+}
+
+// CountByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockStorageRepository_Expecter) CountByUserID(ctx interface{}, userID interface{}) *MockStorageRepository_CountByUserID_Call {
+	return &MockStorageRepository_CountByUserID_Call{Call: _e.mock.On("CountByUserID", ctx, userID)}
+}
+
+func (_c *MockStorageRepository_CountByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockStorageRepository_CountByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_CountByUserID_Call) Return(_a0 int64, _a1 error) *MockStorageRepository_CountByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageRepository_CountByUserID_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockStorageRepository_CountByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, namespace, key, version
+func (_m *MockStorageRepository) Delete(ctx context.Context, userID string, namespace string, key string, version int64) (bool, error) {
+	ret := _m.Called(ctx, userID, namespace, key, version)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64) (bool, error)); ok {
+		return rf(ctx, userID, namespace, key, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64) bool); ok {
+		r0 = rf(ctx, userID, namespace, key, version)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int64) error); ok {
+		r1 = rf(ctx, userID, namespace, key, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStorageRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - namespace string
+//   - key string
+//   - version int64
+func (_e *MockStorageRepository_Expecter) Delete(ctx interface{}, userID interface{}, namespace interface{}, key interface{}, version interface{}) *MockStorageRepository_Delete_Call {
+	return &MockStorageRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, namespace, key, version)}
+}
+
+func (_c *MockStorageRepository_Delete_Call) Run(run func(ctx context.Context, userID string, namespace string, key string, version int64)) *MockStorageRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_Delete_Call) Return(_a0 bool, _a1 error) *MockStorageRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageRepository_Delete_Call) RunAndReturn(run func(context.Context, string, string, string, int64) (bool, error)) *MockStorageRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStorageRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockStorageRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockStorageRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockStorageRepository_DeleteByUserID_Call {
+	return &MockStorageRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockStorageRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockStorageRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_DeleteByUserID_Call) Return(_a0 error) *MockStorageRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockStorageRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, userID, namespace, key
+func (_m *MockStorageRepository) Get(ctx context.Context, userID string, namespace string, key string) (domain.StorageObject, error) {
+	ret := _m.Called(ctx, userID, namespace, key)
+
+	var r0 domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.StorageObject, error)); ok {
+		return rf(ctx, userID, namespace, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) domain.StorageObject); ok {
+		r0 = rf(ctx, userID, namespace, key)
+	} else {
+		r0 = ret.Get(0).(domain.StorageObject)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userID, namespace, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockStorageRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - namespace string
+//   - key string
+func (_e *MockStorageRepository_Expecter) Get(ctx interface{}, userID interface{}, namespace interface{}, key interface{}) *MockStorageRepository_Get_Call {
+	return &MockStorageRepository_Get_Call{Call: _e.mock.On("Get", ctx, userID, namespace, key)}
+}
+
+func (_c *MockStorageRepository_Get_Call) Run(run func(ctx context.Context, userID string, namespace string, key string)) *MockStorageRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_Get_Call) Return(_a0 domain.StorageObject, _a1 error) *MockStorageRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageRepository_Get_Call) RunAndReturn(run func(context.Context, string, string, string) (domain.StorageObject, error)) *MockStorageRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, namespace
+func (_m *MockStorageRepository) List(ctx context.Context, userID string, namespace string) ([]domain.StorageObject, error) {
+	ret := _m.Called(ctx, userID, namespace)
+
+	var r0 []domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.StorageObject, error)); ok {
+		return rf(ctx, userID, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.StorageObject); ok {
+		r0 = rf(ctx, userID, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StorageObject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStorageRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - namespace string
+func (_e *MockStorageRepository_Expecter) List(ctx interface{}, userID interface{}, namespace interface{}) *MockStorageRepository_List_Call {
+	return &MockStorageRepository_List_Call{Call: _e.mock.On("List", ctx, userID, namespace)}
+}
+
+func (_c *MockStorageRepository_List_Call) Run(run func(ctx context.Context, userID string, namespace string)) *MockStorageRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_List_Call) Return(_a0 []domain.StorageObject, _a1 error) *MockStorageRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageRepository_List_Call) RunAndReturn(run func(context.Context, string, string) ([]domain.StorageObject, error)) *MockStorageRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStorageRepository) ListByUserID(ctx context.Context, userID string) ([]domain.StorageObject, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.StorageObject, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.StorageObject); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StorageObject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockStorageRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockStorageRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *MockStorageRepository_ListByUserID_Call {
+	return &MockStorageRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *MockStorageRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockStorageRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_ListByUserID_Call) Return(_a0 []domain.StorageObject, _a1 error) *MockStorageRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]domain.StorageObject, error)) *MockStorageRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, object, version
+func (_m *MockStorageRepository) Put(ctx context.Context, object domain.StorageObject, version int64) (domain.StorageObject, bool, error) {
+	ret := _m.Called(ctx, object, version)
+
+	var r0 domain.StorageObject
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StorageObject, int64) (domain.StorageObject, bool, error)); ok {
+		return rf(ctx, object, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StorageObject, int64) domain.StorageObject); ok {
+		r0 = rf(ctx, object, version)
+	} else {
+		r0 = ret.Get(0).(domain.StorageObject)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StorageObject, int64) bool); ok {
+		r1 = rf(ctx, object, version)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.StorageObject, int64) error); ok {
+		r2 = rf(ctx, object, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockStorageRepository_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockStorageRepository_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - object domain.StorageObject
+//   - version int64
+func (_e *MockStorageRepository_Expecter) Put(ctx interface{}, object interface{}, version interface{}) *MockStorageRepository_Put_Call {
+	return &MockStorageRepository_Put_Call{Call: _e.mock.On("Put", ctx, object, version)}
+}
+
+func (_c *MockStorageRepository_Put_Call) Run(run func(ctx context.Context, object domain.StorageObject, version int64)) *MockStorageRepository_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StorageObject), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStorageRepository_Put_Call) Return(_a0 domain.StorageObject, _a1 bool, _a2 error) *MockStorageRepository_Put_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockStorageRepository_Put_Call) RunAndReturn(run func(context.Context, domain.StorageObject, int64) (domain.StorageObject, bool, error)) *MockStorageRepository_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockStorageRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockStorageRepository creates a new instance of MockStorageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockStorageRepository(t mockConstructorTestingTNewMockStorageRepository) *MockStorageRepository {
+	mock := &MockStorageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -127,6 +127,49 @@ func (_c *MockTokenManager_ExtractUserID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RevokeUserTokens provides a mock function with given fields: ctx, userID
+func (_m *MockTokenManager) RevokeUserTokens(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenManager_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type MockTokenManager_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockTokenManager_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}) *MockTokenManager_RevokeUserTokens_Call {
+	return &MockTokenManager_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID)}
+}
+
+func (_c *MockTokenManager_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID string)) *MockTokenManager_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenManager_RevokeUserTokens_Call) Return(_a0 error) *MockTokenManager_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenManager_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, string) error) *MockTokenManager_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockTokenManager interface {
 	mock.TestingT
 	Cleanup(func())
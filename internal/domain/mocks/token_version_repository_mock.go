@@ -0,0 +1,143 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTokenVersionRepository is an autogenerated mock type for the TokenVersionRepository type
+type MockTokenVersionRepository struct {
+	mock.Mock
+}
+
+type MockTokenVersionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenVersionRepository) EXPECT() *MockTokenVersionRepository_Expecter {
+	return &MockTokenVersionRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetTokenVersion provides a mock function with given fields: ctx, userID
+func (_m *MockTokenVersionRepository) GetTokenVersion(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenVersionRepository_GetTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenVersion'
+type MockTokenVersionRepository_GetTokenVersion_Call struct {
+	*mock.Call
+}
+
+// GetTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockTokenVersionRepository_Expecter) GetTokenVersion(ctx interface{}, userID interface{}) *MockTokenVersionRepository_GetTokenVersion_Call {
+	return &MockTokenVersionRepository_GetTokenVersion_Call{Call: _e.mock.On("GetTokenVersion", ctx, userID)}
+}
+
+func (_c *MockTokenVersionRepository_GetTokenVersion_Call) Run(run func(ctx context.Context, userID string)) *MockTokenVersionRepository_GetTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenVersionRepository_GetTokenVersion_Call) Return(_a0 int64, _a1 error) *MockTokenVersionRepository_GetTokenVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenVersionRepository_GetTokenVersion_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockTokenVersionRepository_GetTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementTokenVersion provides a mock function with given fields: ctx, userID
+func (_m *MockTokenVersionRepository) IncrementTokenVersion(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenVersionRepository_IncrementTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementTokenVersion'
+type MockTokenVersionRepository_IncrementTokenVersion_Call struct {
+	*mock.Call
+}
+
+// IncrementTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockTokenVersionRepository_Expecter) IncrementTokenVersion(ctx interface{}, userID interface{}) *MockTokenVersionRepository_IncrementTokenVersion_Call {
+	return &MockTokenVersionRepository_IncrementTokenVersion_Call{Call: _e.mock.On("IncrementTokenVersion", ctx, userID)}
+}
+
+func (_c *MockTokenVersionRepository_IncrementTokenVersion_Call) Run(run func(ctx context.Context, userID string)) *MockTokenVersionRepository_IncrementTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenVersionRepository_IncrementTokenVersion_Call) Return(_a0 int64, _a1 error) *MockTokenVersionRepository_IncrementTokenVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenVersionRepository_IncrementTokenVersion_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockTokenVersionRepository_IncrementTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockTokenVersionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockTokenVersionRepository creates a new instance of MockTokenVersionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockTokenVersionRepository(t mockConstructorTestingTNewMockTokenVersionRepository) *MockTokenVersionRepository {
+	mock := &MockTokenVersionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,408 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTournamentRepository is an autogenerated mock type for the TournamentRepository type
+type MockTournamentRepository struct {
+	mock.Mock
+}
+
+type MockTournamentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTournamentRepository) EXPECT() *MockTournamentRepository_Expecter {
+	return &MockTournamentRepository_Expecter{mock: &_m.Mock}
+}
+
+// AnonymizeUser provides a mock function with given fields: ctx, userID, anonymousID
+func (_m *MockTournamentRepository) AnonymizeUser(ctx context.Context, userID string, anonymousID string) error {
+	ret := _m.Called(ctx, userID, anonymousID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, anonymousID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTournamentRepository_AnonymizeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeUser'
+type MockTournamentRepository_AnonymizeUser_Call struct {
+	*mock.Call
+}
+
+// AnonymizeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - anonymousID string
+func (_e *MockTournamentRepository_Expecter) AnonymizeUser(ctx interface{}, userID interface{}, anonymousID interface{}) *MockTournamentRepository_AnonymizeUser_Call {
+	return &MockTournamentRepository_AnonymizeUser_Call{Call: _e.mock.On("AnonymizeUser", ctx, userID, anonymousID)}
+}
+
+func (_c *MockTournamentRepository_AnonymizeUser_Call) Run(run func(ctx context.Context, userID string, anonymousID string)) *MockTournamentRepository_AnonymizeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_AnonymizeUser_Call) Return(_a0 error) *MockTournamentRepository_AnonymizeUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTournamentRepository_AnonymizeUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockTournamentRepository_AnonymizeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, tournament
+func (_m *MockTournamentRepository) Create(ctx context.Context, tournament domain.Tournament) (domain.Tournament, error) {
+	ret := _m.Called(ctx, tournament)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) (domain.Tournament, error)); ok {
+		return rf(ctx, tournament)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) domain.Tournament); ok {
+		r0 = rf(ctx, tournament)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Tournament) error); ok {
+		r1 = rf(ctx, tournament)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTournamentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournament domain.Tournament
+func (_e *MockTournamentRepository_Expecter) Create(ctx interface{}, tournament interface{}) *MockTournamentRepository_Create_Call {
+	return &MockTournamentRepository_Create_Call{Call: _e.mock.On("Create", ctx, tournament)}
+}
+
+func (_c *MockTournamentRepository_Create_Call) Run(run func(ctx context.Context, tournament domain.Tournament)) *MockTournamentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Tournament))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_Create_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Tournament) (domain.Tournament, error)) *MockTournamentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockTournamentRepository) GetByID(ctx context.Context, id string) (domain.Tournament, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Tournament, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Tournament); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockTournamentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTournamentRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockTournamentRepository_GetByID_Call {
+	return &MockTournamentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockTournamentRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockTournamentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_GetByID_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (domain.Tournament, error)) *MockTournamentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, status
+func (_m *MockTournamentRepository) List(ctx context.Context, status string) ([]domain.Tournament, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Tournament, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Tournament); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tournament)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockTournamentRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+func (_e *MockTournamentRepository_Expecter) List(ctx interface{}, status interface{}) *MockTournamentRepository_List_Call {
+	return &MockTournamentRepository_List_Call{Call: _e.mock.On("List", ctx, status)}
+}
+
+func (_c *MockTournamentRepository_List_Call) Run(run func(ctx context.Context, status string)) *MockTournamentRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_List_Call) Return(_a0 []domain.Tournament, _a1 error) *MockTournamentRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_List_Call) RunAndReturn(run func(context.Context, string) ([]domain.Tournament, error)) *MockTournamentRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockTournamentRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Tournament, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Tournament, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Tournament); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tournament)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockTournamentRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockTournamentRepository_Expecter) ListByUserID(ctx interface{}, userID interface{}) *MockTournamentRepository_ListByUserID_Call {
+	return &MockTournamentRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userID)}
+}
+
+func (_c *MockTournamentRepository_ListByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockTournamentRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_ListByUserID_Call) Return(_a0 []domain.Tournament, _a1 error) *MockTournamentRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]domain.Tournament, error)) *MockTournamentRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDue provides a mock function with given fields: ctx, now
+func (_m *MockTournamentRepository) ListDue(ctx context.Context, now time.Time) ([]domain.Tournament, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Tournament, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Tournament); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tournament)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type MockTournamentRepository_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockTournamentRepository_Expecter) ListDue(ctx interface{}, now interface{}) *MockTournamentRepository_ListDue_Call {
+	return &MockTournamentRepository_ListDue_Call{Call: _e.mock.On("ListDue", ctx, now)}
+}
+
+func (_c *MockTournamentRepository_ListDue_Call) Run(run func(ctx context.Context, now time.Time)) *MockTournamentRepository_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_ListDue_Call) Return(_a0 []domain.Tournament, _a1 error) *MockTournamentRepository_ListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_ListDue_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.Tournament, error)) *MockTournamentRepository_ListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, tournament
+func (_m *MockTournamentRepository) Update(ctx context.Context, tournament domain.Tournament) (bool, error) {
+	ret := _m.Called(ctx, tournament)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) (bool, error)); ok {
+		return rf(ctx, tournament)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) bool); ok {
+		r0 = rf(ctx, tournament)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Tournament) error); ok {
+		r1 = rf(ctx, tournament)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTournamentRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournament domain.Tournament
+func (_e *MockTournamentRepository_Expecter) Update(ctx interface{}, tournament interface{}) *MockTournamentRepository_Update_Call {
+	return &MockTournamentRepository_Update_Call{Call: _e.mock.On("Update", ctx, tournament)}
+}
+
+func (_c *MockTournamentRepository_Update_Call) Run(run func(ctx context.Context, tournament domain.Tournament)) *MockTournamentRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Tournament))
+	})
+	return _c
+}
+
+func (_c *MockTournamentRepository_Update_Call) Return(_a0 bool, _a1 error) *MockTournamentRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentRepository_Update_Call) RunAndReturn(run func(context.Context, domain.Tournament) (bool, error)) *MockTournamentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockTournamentRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockTournamentRepository creates a new instance of MockTournamentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockTournamentRepository(t mockConstructorTestingTNewMockTournamentRepository) *MockTournamentRepository {
+	mock := &MockTournamentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,179 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUserCache is an autogenerated mock type for the UserCache type
+type MockUserCache struct {
+	mock.Mock
+}
+
+type MockUserCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserCache) EXPECT() *MockUserCache_Expecter {
+	return &MockUserCache_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID
+func (_m *MockUserCache) Delete(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserCache_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserCache_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserCache_Expecter) Delete(ctx interface{}, userID interface{}) *MockUserCache_Delete_Call {
+	return &MockUserCache_Delete_Call{Call: _e.mock.On("Delete", ctx, userID)}
+}
+
+func (_c *MockUserCache_Delete_Call) Run(run func(ctx context.Context, userID string)) *MockUserCache_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserCache_Delete_Call) Return(_a0 error) *MockUserCache_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserCache_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockUserCache_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, userIDs
+func (_m *MockUserCache) Get(ctx context.Context, userIDs []string) (map[string]domain.PublicUser, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	var r0 map[string]domain.PublicUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]domain.PublicUser, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]domain.PublicUser); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]domain.PublicUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockUserCache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *MockUserCache_Expecter) Get(ctx interface{}, userIDs interface{}) *MockUserCache_Get_Call {
+	return &MockUserCache_Get_Call{Call: _e.mock.On("Get", ctx, userIDs)}
+}
+
+func (_c *MockUserCache_Get_Call) Run(run func(ctx context.Context, userIDs []string)) *MockUserCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserCache_Get_Call) Return(_a0 map[string]domain.PublicUser, _a1 error) *MockUserCache_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserCache_Get_Call) RunAndReturn(run func(context.Context, []string) (map[string]domain.PublicUser, error)) *MockUserCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, users
+func (_m *MockUserCache) Set(ctx context.Context, users map[string]domain.PublicUser) error {
+	ret := _m.Called(ctx, users)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]domain.PublicUser) error); ok {
+		r0 = rf(ctx, users)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockUserCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - users map[string]domain.PublicUser
+func (_e *MockUserCache_Expecter) Set(ctx interface{}, users interface{}) *MockUserCache_Set_Call {
+	return &MockUserCache_Set_Call{Call: _e.mock.On("Set", ctx, users)}
+}
+
+func (_c *MockUserCache_Set_Call) Run(run func(ctx context.Context, users map[string]domain.PublicUser)) *MockUserCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]domain.PublicUser))
+	})
+	return _c
+}
+
+func (_c *MockUserCache_Set_Call) Return(_a0 error) *MockUserCache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserCache_Set_Call) RunAndReturn(run func(context.Context, map[string]domain.PublicUser) error) *MockUserCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockUserCache interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockUserCache creates a new instance of MockUserCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockUserCache(t mockConstructorTestingTNewMockUserCache) *MockUserCache {
+	mock := &MockUserCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -182,6 +182,102 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepository_Delete_Call {
+	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) Return(_a0 error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) GetByID(ctx context.Context, id string) (domain.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserRepository_GetByID_Call {
+	return &MockUserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByID_Call) Return(_a0 domain.User, _a1 error) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (domain.User, error)) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByName provides a mock function with given fields: ctx, username
 func (_m *MockUserRepository) GetByName(ctx context.Context, username string) (domain.User, error) {
 	ret := _m.Called(ctx, username)
@@ -290,6 +386,182 @@ func (_c *MockUserRepository_GetUsersByIDs_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// SetBanned provides a mock function with given fields: ctx, id, banned
+func (_m *MockUserRepository) SetBanned(ctx context.Context, id string, banned bool) error {
+	ret := _m.Called(ctx, id, banned)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, id, banned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_SetBanned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBanned'
+type MockUserRepository_SetBanned_Call struct {
+	*mock.Call
+}
+
+// SetBanned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - banned bool
+func (_e *MockUserRepository_Expecter) SetBanned(ctx interface{}, id interface{}, banned interface{}) *MockUserRepository_SetBanned_Call {
+	return &MockUserRepository_SetBanned_Call{Call: _e.mock.On("SetBanned", ctx, id, banned)}
+}
+
+func (_c *MockUserRepository_SetBanned_Call) Run(run func(ctx context.Context, id string, banned bool)) *MockUserRepository_SetBanned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetBanned_Call) Return(_a0 error) *MockUserRepository_SetBanned_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_SetBanned_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockUserRepository_SetBanned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasswordHash provides a mock function with given fields: ctx, id, passwordHash
+func (_m *MockUserRepository) UpdatePasswordHash(ctx context.Context, id string, passwordHash string) error {
+	ret := _m.Called(ctx, id, passwordHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdatePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordHash'
+type MockUserRepository_UpdatePasswordHash_Call struct {
+	*mock.Call
+}
+
+// UpdatePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - passwordHash string
+func (_e *MockUserRepository_Expecter) UpdatePasswordHash(ctx interface{}, id interface{}, passwordHash interface{}) *MockUserRepository_UpdatePasswordHash_Call {
+	return &MockUserRepository_UpdatePasswordHash_Call{Call: _e.mock.On("UpdatePasswordHash", ctx, id, passwordHash)}
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Run(run func(ctx context.Context, id string, passwordHash string)) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Return(_a0 error) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, id, profile
+func (_m *MockUserRepository) UpdateProfile(ctx context.Context, id string, profile domain.UserProfile) error {
+	ret := _m.Called(ctx, id, profile)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserProfile) error); ok {
+		r0 = rf(ctx, id, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserRepository_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - profile domain.UserProfile
+func (_e *MockUserRepository_Expecter) UpdateProfile(ctx interface{}, id interface{}, profile interface{}) *MockUserRepository_UpdateProfile_Call {
+	return &MockUserRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, profile)}
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Run(run func(ctx context.Context, id string, profile domain.UserProfile)) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.UserProfile))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Return(_a0 error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) RunAndReturn(run func(context.Context, string, domain.UserProfile) error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUsername provides a mock function with given fields: ctx, id, username
+func (_m *MockUserRepository) UpdateUsername(ctx context.Context, id string, username string) error {
+	ret := _m.Called(ctx, id, username)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsername'
+type MockUserRepository_UpdateUsername_Call struct {
+	*mock.Call
+}
+
+// UpdateUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - username string
+func (_e *MockUserRepository_Expecter) UpdateUsername(ctx interface{}, id interface{}, username interface{}) *MockUserRepository_UpdateUsername_Call {
+	return &MockUserRepository_UpdateUsername_Call{Call: _e.mock.On("UpdateUsername", ctx, id, username)}
+}
+
+func (_c *MockUserRepository_UpdateUsername_Call) Run(run func(ctx context.Context, id string, username string)) *MockUserRepository_UpdateUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateUsername_Call) Return(_a0 error) *MockUserRepository_UpdateUsername_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateUsername_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserRepository_UpdateUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockUserRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -7,6 +7,8 @@ import (
 	domain "game/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockUserScoreRepository is an autogenerated mock type for the UserScoreRepository type
@@ -22,6 +24,114 @@ func (_m *MockUserScoreRepository) EXPECT() *MockUserScoreRepository_Expecter {
 	return &MockUserScoreRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetClanLeaderboard provides a mock function with given fields: ctx, aggregate
+func (_m *MockUserScoreRepository) GetClanLeaderboard(ctx context.Context, aggregate string) ([]domain.ClanScore, error) {
+	ret := _m.Called(ctx, aggregate)
+
+	var r0 []domain.ClanScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.ClanScore, error)); ok {
+		return rf(ctx, aggregate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.ClanScore); ok {
+		r0 = rf(ctx, aggregate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ClanScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, aggregate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetClanLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClanLeaderboard'
+type MockUserScoreRepository_GetClanLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetClanLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregate string
+func (_e *MockUserScoreRepository_Expecter) GetClanLeaderboard(ctx interface{}, aggregate interface{}) *MockUserScoreRepository_GetClanLeaderboard_Call {
+	return &MockUserScoreRepository_GetClanLeaderboard_Call{Call: _e.mock.On("GetClanLeaderboard", ctx, aggregate)}
+}
+
+func (_c *MockUserScoreRepository_GetClanLeaderboard_Call) Run(run func(ctx context.Context, aggregate string)) *MockUserScoreRepository_GetClanLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetClanLeaderboard_Call) Return(_a0 []domain.ClanScore, _a1 error) *MockUserScoreRepository_GetClanLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetClanLeaderboard_Call) RunAndReturn(run func(context.Context, string) ([]domain.ClanScore, error)) *MockUserScoreRepository_GetClanLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCountryLeaderboard provides a mock function with given fields: ctx, countryCode
+func (_m *MockUserScoreRepository) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, countryCode)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Leaderboard, error)); ok {
+		return rf(ctx, countryCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Leaderboard); ok {
+		r0 = rf(ctx, countryCode)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, countryCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetCountryLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountryLeaderboard'
+type MockUserScoreRepository_GetCountryLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetCountryLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - countryCode string
+func (_e *MockUserScoreRepository_Expecter) GetCountryLeaderboard(ctx interface{}, countryCode interface{}) *MockUserScoreRepository_GetCountryLeaderboard_Call {
+	return &MockUserScoreRepository_GetCountryLeaderboard_Call{Call: _e.mock.On("GetCountryLeaderboard", ctx, countryCode)}
+}
+
+func (_c *MockUserScoreRepository_GetCountryLeaderboard_Call) Run(run func(ctx context.Context, countryCode string)) *MockUserScoreRepository_GetCountryLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetCountryLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockUserScoreRepository_GetCountryLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetCountryLeaderboard_Call) RunAndReturn(run func(context.Context, string) (domain.Leaderboard, error)) *MockUserScoreRepository_GetCountryLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLeaderboard provides a mock function with given fields: ctx
 func (_m *MockUserScoreRepository) GetLeaderboard(ctx context.Context) (domain.Leaderboard, error) {
 	ret := _m.Called(ctx)
@@ -74,6 +184,222 @@ func (_c *MockUserScoreRepository_GetLeaderboard_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetRankedUserIDs provides a mock function with given fields: ctx
+func (_m *MockUserScoreRepository) GetRankedUserIDs(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetRankedUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRankedUserIDs'
+type MockUserScoreRepository_GetRankedUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetRankedUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserScoreRepository_Expecter) GetRankedUserIDs(ctx interface{}) *MockUserScoreRepository_GetRankedUserIDs_Call {
+	return &MockUserScoreRepository_GetRankedUserIDs_Call{Call: _e.mock.On("GetRankedUserIDs", ctx)}
+}
+
+func (_c *MockUserScoreRepository_GetRankedUserIDs_Call) Run(run func(ctx context.Context)) *MockUserScoreRepository_GetRankedUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetRankedUserIDs_Call) Return(_a0 []string, _a1 error) *MockUserScoreRepository_GetRankedUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetRankedUserIDs_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockUserScoreRepository_GetRankedUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopLeaderboard provides a mock function with given fields: ctx, count
+func (_m *MockUserScoreRepository) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, count)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Leaderboard, error)); ok {
+		return rf(ctx, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Leaderboard); ok {
+		r0 = rf(ctx, count)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetTopLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopLeaderboard'
+type MockUserScoreRepository_GetTopLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetTopLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - count int64
+func (_e *MockUserScoreRepository_Expecter) GetTopLeaderboard(ctx interface{}, count interface{}) *MockUserScoreRepository_GetTopLeaderboard_Call {
+	return &MockUserScoreRepository_GetTopLeaderboard_Call{Call: _e.mock.On("GetTopLeaderboard", ctx, count)}
+}
+
+func (_c *MockUserScoreRepository_GetTopLeaderboard_Call) Run(run func(ctx context.Context, count int64)) *MockUserScoreRepository_GetTopLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetTopLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockUserScoreRepository_GetTopLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetTopLeaderboard_Call) RunAndReturn(run func(context.Context, int64) (domain.Leaderboard, error)) *MockUserScoreRepository_GetTopLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCountries provides a mock function with given fields: ctx, userIDs
+func (_m *MockUserScoreRepository) GetUserCountries(ctx context.Context, userIDs []string) (map[string]string, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetUserCountries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCountries'
+type MockUserScoreRepository_GetUserCountries_Call struct {
+	*mock.Call
+}
+
+// GetUserCountries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *MockUserScoreRepository_Expecter) GetUserCountries(ctx interface{}, userIDs interface{}) *MockUserScoreRepository_GetUserCountries_Call {
+	return &MockUserScoreRepository_GetUserCountries_Call{Call: _e.mock.On("GetUserCountries", ctx, userIDs)}
+}
+
+func (_c *MockUserScoreRepository_GetUserCountries_Call) Run(run func(ctx context.Context, userIDs []string)) *MockUserScoreRepository_GetUserCountries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUserCountries_Call) Return(_a0 map[string]string, _a1 error) *MockUserScoreRepository_GetUserCountries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUserCountries_Call) RunAndReturn(run func(context.Context, []string) (map[string]string, error)) *MockUserScoreRepository_GetUserCountries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRank provides a mock function with given fields: ctx, userID, count
+func (_m *MockUserScoreRepository) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	ret := _m.Called(ctx, userID, count)
+
+	var r0 domain.UserRank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (domain.UserRank, error)); ok {
+		return rf(ctx, userID, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) domain.UserRank); ok {
+		r0 = rf(ctx, userID, count)
+	} else {
+		r0 = ret.Get(0).(domain.UserRank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, userID, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetUserRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRank'
+type MockUserScoreRepository_GetUserRank_Call struct {
+	*mock.Call
+}
+
+// GetUserRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - count int64
+func (_e *MockUserScoreRepository_Expecter) GetUserRank(ctx interface{}, userID interface{}, count interface{}) *MockUserScoreRepository_GetUserRank_Call {
+	return &MockUserScoreRepository_GetUserRank_Call{Call: _e.mock.On("GetUserRank", ctx, userID, count)}
+}
+
+func (_c *MockUserScoreRepository_GetUserRank_Call) Run(run func(ctx context.Context, userID string, count int64)) *MockUserScoreRepository_GetUserRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUserRank_Call) Return(_a0 domain.UserRank, _a1 error) *MockUserScoreRepository_GetUserRank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUserRank_Call) RunAndReturn(run func(context.Context, string, int64) (domain.UserRank, error)) *MockUserScoreRepository_GetUserRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserTopScore provides a mock function with given fields: ctx, userID
 func (_m *MockUserScoreRepository) GetUserTopScore(ctx context.Context, userID string) (domain.UserScore, error) {
 	ret := _m.Called(ctx, userID)
@@ -127,13 +453,282 @@ func (_c *MockUserScoreRepository_GetUserTopScore_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// UpdateUserTopScore provides a mock function with given fields: ctx, userID, score
-func (_m *MockUserScoreRepository) UpdateUserTopScore(ctx context.Context, userID string, score float64) error {
-	ret := _m.Called(ctx, userID, score)
+// GetUsersLeaderboard provides a mock function with given fields: ctx, userIDs
+func (_m *MockUserScoreRepository) GetUsersLeaderboard(ctx context.Context, userIDs []string) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (domain.Leaderboard, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) domain.Leaderboard); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserScoreRepository_GetUsersLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersLeaderboard'
+type MockUserScoreRepository_GetUsersLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetUsersLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *MockUserScoreRepository_Expecter) GetUsersLeaderboard(ctx interface{}, userIDs interface{}) *MockUserScoreRepository_GetUsersLeaderboard_Call {
+	return &MockUserScoreRepository_GetUsersLeaderboard_Call{Call: _e.mock.On("GetUsersLeaderboard", ctx, userIDs)}
+}
+
+func (_c *MockUserScoreRepository_GetUsersLeaderboard_Call) Run(run func(ctx context.Context, userIDs []string)) *MockUserScoreRepository_GetUsersLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUsersLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockUserScoreRepository_GetUsersLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_GetUsersLeaderboard_Call) RunAndReturn(run func(context.Context, []string) (domain.Leaderboard, error)) *MockUserScoreRepository_GetUsersLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IndexRanks provides a mock function with given fields: ctx
+func (_m *MockUserScoreRepository) IndexRanks(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserScoreRepository_IndexRanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexRanks'
+type MockUserScoreRepository_IndexRanks_Call struct {
+	*mock.Call
+}
+
+// IndexRanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserScoreRepository_Expecter) IndexRanks(ctx interface{}) *MockUserScoreRepository_IndexRanks_Call {
+	return &MockUserScoreRepository_IndexRanks_Call{Call: _e.mock.On("IndexRanks", ctx)}
+}
+
+func (_c *MockUserScoreRepository_IndexRanks_Call) Run(run func(ctx context.Context)) *MockUserScoreRepository_IndexRanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_IndexRanks_Call) Return(_a0 error) *MockUserScoreRepository_IndexRanks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_IndexRanks_Call) RunAndReturn(run func(context.Context) error) *MockUserScoreRepository_IndexRanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveClan provides a mock function with given fields: ctx, clanID
+func (_m *MockUserScoreRepository) RemoveClan(ctx context.Context, clanID string) error {
+	ret := _m.Called(ctx, clanID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, clanID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserScoreRepository_RemoveClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveClan'
+type MockUserScoreRepository_RemoveClan_Call struct {
+	*mock.Call
+}
+
+// RemoveClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clanID string
+func (_e *MockUserScoreRepository_Expecter) RemoveClan(ctx interface{}, clanID interface{}) *MockUserScoreRepository_RemoveClan_Call {
+	return &MockUserScoreRepository_RemoveClan_Call{Call: _e.mock.On("RemoveClan", ctx, clanID)}
+}
+
+func (_c *MockUserScoreRepository_RemoveClan_Call) Run(run func(ctx context.Context, clanID string)) *MockUserScoreRepository_RemoveClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_RemoveClan_Call) Return(_a0 error) *MockUserScoreRepository_RemoveClan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_RemoveClan_Call) RunAndReturn(run func(context.Context, string) error) *MockUserScoreRepository_RemoveClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserScore provides a mock function with given fields: ctx, userID
+func (_m *MockUserScoreRepository) RemoveUserScore(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserScoreRepository_RemoveUserScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserScore'
+type MockUserScoreRepository_RemoveUserScore_Call struct {
+	*mock.Call
+}
+
+// RemoveUserScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserScoreRepository_Expecter) RemoveUserScore(ctx interface{}, userID interface{}) *MockUserScoreRepository_RemoveUserScore_Call {
+	return &MockUserScoreRepository_RemoveUserScore_Call{Call: _e.mock.On("RemoveUserScore", ctx, userID)}
+}
+
+func (_c *MockUserScoreRepository_RemoveUserScore_Call) Run(run func(ctx context.Context, userID string)) *MockUserScoreRepository_RemoveUserScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_RemoveUserScore_Call) Return(_a0 error) *MockUserScoreRepository_RemoveUserScore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_RemoveUserScore_Call) RunAndReturn(run func(context.Context, string) error) *MockUserScoreRepository_RemoveUserScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserClan provides a mock function with given fields: ctx, userID, clanID
+func (_m *MockUserScoreRepository) SetUserClan(ctx context.Context, userID string, clanID string) error {
+	ret := _m.Called(ctx, userID, clanID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clanID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserScoreRepository_SetUserClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserClan'
+type MockUserScoreRepository_SetUserClan_Call struct {
+	*mock.Call
+}
+
+// SetUserClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clanID string
+func (_e *MockUserScoreRepository_Expecter) SetUserClan(ctx interface{}, userID interface{}, clanID interface{}) *MockUserScoreRepository_SetUserClan_Call {
+	return &MockUserScoreRepository_SetUserClan_Call{Call: _e.mock.On("SetUserClan", ctx, userID, clanID)}
+}
+
+func (_c *MockUserScoreRepository_SetUserClan_Call) Run(run func(ctx context.Context, userID string, clanID string)) *MockUserScoreRepository_SetUserClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_SetUserClan_Call) Return(_a0 error) *MockUserScoreRepository_SetUserClan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_SetUserClan_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserScoreRepository_SetUserClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserCountry provides a mock function with given fields: ctx, userID, countryCode
+func (_m *MockUserScoreRepository) SetUserCountry(ctx context.Context, userID string, countryCode string) error {
+	ret := _m.Called(ctx, userID, countryCode)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, countryCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserScoreRepository_SetUserCountry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserCountry'
+type MockUserScoreRepository_SetUserCountry_Call struct {
+	*mock.Call
+}
+
+// SetUserCountry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - countryCode string
+func (_e *MockUserScoreRepository_Expecter) SetUserCountry(ctx interface{}, userID interface{}, countryCode interface{}) *MockUserScoreRepository_SetUserCountry_Call {
+	return &MockUserScoreRepository_SetUserCountry_Call{Call: _e.mock.On("SetUserCountry", ctx, userID, countryCode)}
+}
+
+func (_c *MockUserScoreRepository_SetUserCountry_Call) Run(run func(ctx context.Context, userID string, countryCode string)) *MockUserScoreRepository_SetUserCountry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserScoreRepository_SetUserCountry_Call) Return(_a0 error) *MockUserScoreRepository_SetUserCountry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserScoreRepository_SetUserCountry_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserScoreRepository_SetUserCountry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserTopScore provides a mock function with given fields: ctx, userID, countryCode, score, achievedAt
+func (_m *MockUserScoreRepository) UpdateUserTopScore(ctx context.Context, userID string, countryCode string, score float64, achievedAt time.Time) error {
+	ret := _m.Called(ctx, userID, countryCode, score, achievedAt)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
-		r0 = rf(ctx, userID, score)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, time.Time) error); ok {
+		r0 = rf(ctx, userID, countryCode, score, achievedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -149,14 +744,16 @@ type MockUserScoreRepository_UpdateUserTopScore_Call struct {
 // UpdateUserTopScore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
+//   - countryCode string
 //   - score float64
-func (_e *MockUserScoreRepository_Expecter) UpdateUserTopScore(ctx interface{}, userID interface{}, score interface{}) *MockUserScoreRepository_UpdateUserTopScore_Call {
-	return &MockUserScoreRepository_UpdateUserTopScore_Call{Call: _e.mock.On("UpdateUserTopScore", ctx, userID, score)}
+//   - achievedAt time.Time
+func (_e *MockUserScoreRepository_Expecter) UpdateUserTopScore(ctx interface{}, userID interface{}, countryCode interface{}, score interface{}, achievedAt interface{}) *MockUserScoreRepository_UpdateUserTopScore_Call {
+	return &MockUserScoreRepository_UpdateUserTopScore_Call{Call: _e.mock.On("UpdateUserTopScore", ctx, userID, countryCode, score, achievedAt)}
 }
 
-func (_c *MockUserScoreRepository_UpdateUserTopScore_Call) Run(run func(ctx context.Context, userID string, score float64)) *MockUserScoreRepository_UpdateUserTopScore_Call {
+func (_c *MockUserScoreRepository_UpdateUserTopScore_Call) Run(run func(ctx context.Context, userID string, countryCode string, score float64, achievedAt time.Time)) *MockUserScoreRepository_UpdateUserTopScore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(float64), args[4].(time.Time))
 	})
 	return _c
 }
@@ -166,7 +763,7 @@ func (_c *MockUserScoreRepository_UpdateUserTopScore_Call) Return(_a0 error) *Mo
 	return _c
 }
 
-func (_c *MockUserScoreRepository_UpdateUserTopScore_Call) RunAndReturn(run func(context.Context, string, float64) error) *MockUserScoreRepository_UpdateUserTopScore_Call {
+func (_c *MockUserScoreRepository_UpdateUserTopScore_Call) RunAndReturn(run func(context.Context, string, string, float64, time.Time) error) *MockUserScoreRepository_UpdateUserTopScore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,249 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWalletRepository is an autogenerated mock type for the WalletRepository type
+type MockWalletRepository struct {
+	mock.Mock
+}
+
+type MockWalletRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWalletRepository) EXPECT() *MockWalletRepository_Expecter {
+	return &MockWalletRepository_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, entry
+func (_m *MockWalletRepository) Apply(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, bool, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 domain.LedgerEntry
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, bool, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) domain.LedgerEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(domain.LedgerEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LedgerEntry) bool); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.LedgerEntry) error); ok {
+		r2 = rf(ctx, entry)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWalletRepository_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockWalletRepository_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry domain.LedgerEntry
+func (_e *MockWalletRepository_Expecter) Apply(ctx interface{}, entry interface{}) *MockWalletRepository_Apply_Call {
+	return &MockWalletRepository_Apply_Call{Call: _e.mock.On("Apply", ctx, entry)}
+}
+
+func (_c *MockWalletRepository_Apply_Call) Run(run func(ctx context.Context, entry domain.LedgerEntry)) *MockWalletRepository_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LedgerEntry))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_Apply_Call) Return(_a0 domain.LedgerEntry, _a1 bool, _a2 error) *MockWalletRepository_Apply_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWalletRepository_Apply_Call) RunAndReturn(run func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, bool, error)) *MockWalletRepository_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockWalletRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockWalletRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockWalletRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockWalletRepository_DeleteByUserID_Call {
+	return &MockWalletRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockWalletRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockWalletRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_DeleteByUserID_Call) Return(_a0 error) *MockWalletRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockWalletRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWallet provides a mock function with given fields: ctx, userID
+func (_m *MockWalletRepository) GetWallet(ctx context.Context, userID string) (domain.Wallet, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Wallet, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Wallet); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Wallet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_GetWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWallet'
+type MockWalletRepository_GetWallet_Call struct {
+	*mock.Call
+}
+
+// GetWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockWalletRepository_Expecter) GetWallet(ctx interface{}, userID interface{}) *MockWalletRepository_GetWallet_Call {
+	return &MockWalletRepository_GetWallet_Call{Call: _e.mock.On("GetWallet", ctx, userID)}
+}
+
+func (_c *MockWalletRepository_GetWallet_Call) Run(run func(ctx context.Context, userID string)) *MockWalletRepository_GetWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWallet_Call) Return(_a0 domain.Wallet, _a1 error) *MockWalletRepository_GetWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWallet_Call) RunAndReturn(run func(context.Context, string) (domain.Wallet, error)) *MockWalletRepository_GetWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: ctx, userID
+func (_m *MockWalletRepository) ListEntries(ctx context.Context, userID string) ([]domain.LedgerEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.LedgerEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.LedgerEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type MockWalletRepository_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockWalletRepository_Expecter) ListEntries(ctx interface{}, userID interface{}) *MockWalletRepository_ListEntries_Call {
+	return &MockWalletRepository_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, userID)}
+}
+
+func (_c *MockWalletRepository_ListEntries_Call) Run(run func(ctx context.Context, userID string)) *MockWalletRepository_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_ListEntries_Call) Return(_a0 []domain.LedgerEntry, _a1 error) *MockWalletRepository_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_ListEntries_Call) RunAndReturn(run func(context.Context, string) ([]domain.LedgerEntry, error)) *MockWalletRepository_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockWalletRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockWalletRepository creates a new instance of MockWalletRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockWalletRepository(t mockConstructorTestingTNewMockWalletRepository) *MockWalletRepository {
+	mock := &MockWalletRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimit struct {
+	Capacity       int64
+	RefillInterval time.Duration
+}
+
+func (limit RateLimit) IsEnabled() bool {
+	return limit.Capacity > 0 && limit.RefillInterval > 0
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int64
+	RetryAfter time.Duration
+}
+
+//go:generate mockery --name RateLimiter --structname MockRateLimiter --outpkg mocks --filename rate_limiter_mock.go --output ./mocks/. --with-expecter
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit RateLimit) (RateLimitResult, error)
+	// Refund gives back a token taken by Allow for a request that has not
+	// been served, the bucket is never filled over its capacity.
+	Refund(ctx context.Context, key string, limit RateLimit) error
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The Glicko-2 rating of a player that has not played a match of a mode yet.
+const (
+	DefaultRating           = 1500
+	DefaultRatingDeviation  = 350
+	DefaultRatingVolatility = 0.06
+)
+
+// Rating is the Glicko-2 rating of a player in a mode, Matches is the number
+// of matches of the mode the rating has been updated with.
+type Rating struct {
+	UserID     string
+	Mode       string
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+	Matches    int64
+	UpdatedAt  time.Time
+}
+
+// NewRating returns the rating of a player that has not played the mode yet.
+func NewRating(userID, mode string) Rating {
+	return Rating{
+		UserID:     userID,
+		Mode:       mode,
+		Rating:     DefaultRating,
+		Deviation:  DefaultRatingDeviation,
+		Volatility: DefaultRatingVolatility,
+	}
+}
+
+// MatchResult is the outcome of a match between two or more players. The
+// players are placed starting at 1, players with the same place have tied.
+type MatchResult struct {
+	MatchID    string
+	Mode       string
+	Placements []MatchPlacement
+}
+
+type MatchPlacement struct {
+	UserID string
+	Place  int64
+}
+
+// RatedUserScore is an entry of a rating leaderboard, the score of the
+// entry is the rating.
+type RatedUserScore struct {
+	UserScore UserScore
+	Rating    Rating
+}
+
+//go:generate mockery --name RatingRepository --structname MockRatingRepository --outpkg mocks --filename rating_repository_mock.go --output ./mocks/. --with-expecter
+type RatingRepository interface {
+	// GetRatings returns the ratings of the users in the mode, the users that
+	// have not played it are left out.
+	GetRatings(ctx context.Context, mode string, userIDs []string) (map[string]Rating, error)
+	// SaveRatings stores the ratings the players have after the match only
+	// when none of them has been updated since it has been read, that is when
+	// the stored Matches of each is one less than its Matches. It returns
+	// false when one of them has been, and ErrResourceExists when the match
+	// has been saved before.
+	SaveRatings(ctx context.Context, matchID, mode string, ratings []Rating) (bool, error)
+	// GetRatingLeaderboard returns the top count players of the mode sorted
+	// by their ratings.
+	GetRatingLeaderboard(ctx context.Context, mode string, count int64) (Leaderboard, error)
+	// GetRatingRank returns the rank of the user in the mode, starting at 1.
+	GetRatingRank(ctx context.Context, mode, userID string) (int64, error)
+	// GetUserRatings returns the ratings of the user in every mode.
+	GetUserRatings(ctx context.Context, userID string) ([]Rating, error)
+	RemoveUserRatings(ctx context.Context, userID string) error
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+type requestInfoContextKey struct{}
+
+// RequestInfo holds details of the incoming request that are recorded in
+// the audit log.
+type RequestInfo struct {
+	RequestID string
+	PeerIP    string
+}
+
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey{}, info)
+}
+
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoContextKey{}).(RequestInfo)
+
+	return info
+}
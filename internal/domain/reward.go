@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The kinds of the rules that place the users in a reward bracket.
+const (
+	// RewardRuleRank places the users ranked Threshold or better.
+	RewardRuleRank = "rank"
+	// RewardRulePercentile places the users whose percentile is Threshold
+	// or more.
+	RewardRulePercentile = "percentile"
+)
+
+// RewardRule places the users of the final leaderboard of a season in a
+// bracket, the users get the reward of the first rule they match.
+type RewardRule struct {
+	Bracket   string
+	Kind      string
+	Threshold float64
+	Reward    string
+}
+
+// Matches reports whether the user on the leaderboard is in the bracket.
+func (rule RewardRule) Matches(userScore UserScore) bool {
+	switch rule.Kind {
+	case RewardRuleRank:
+		return float64(userScore.Rank) <= rule.Threshold
+	case RewardRulePercentile:
+		return userScore.Percentile >= rule.Threshold
+	default:
+		return false
+	}
+}
+
+// RewardGrant is the reward of a user for a season, a user has at most one
+// grant per season. Rank, Percentile and Score are the ones of the user on
+// the final leaderboard. ClaimedAt is nil until the grant is claimed.
+type RewardGrant struct {
+	ID         string
+	SeasonID   string
+	UserID     string
+	Bracket    string
+	Reward     string
+	Rank       int64
+	Percentile float64
+	Score      float64
+	CreatedAt  time.Time
+	ClaimedAt  *time.Time
+}
+
+//go:generate mockery --name RewardGrantRepository --structname MockRewardGrantRepository --outpkg mocks --filename reward_grant_repository_mock.go --output ./mocks/. --with-expecter
+type RewardGrantRepository interface {
+	// CreateMany stores the grants the users do not have for the season
+	// yet, it returns how many have been created.
+	CreateMany(ctx context.Context, grants []RewardGrant) (int64, error)
+	// ListByUserID returns the grants of the user, the newest first.
+	ListByUserID(ctx context.Context, userID string) ([]RewardGrant, error)
+	// Claim marks the grant as claimed, it returns false when the grant has
+	// been claimed before.
+	Claim(ctx context.Context, id string, claimedAt time.Time) (bool, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrSubmissionTooFrequent = errors.New("submission too frequent")
+
+type ScoreSubmission struct {
+	UserID      string
+	Score       float64
+	SubmittedAt time.Time
+}
+
+type ScoreStatistics struct {
+	Count  int64
+	Mean   float64
+	StdDev float64
+}
+
+// ScoreValidationRules describes the checks a submitted score has to pass
+// before it is published to a leaderboard. Zero values disable a rule.
+type ScoreValidationRules struct {
+	MinScore float64
+	MaxScore float64
+
+	// MinSubmissionInterval is the minimum time a user has to wait between
+	// two submissions.
+	MinSubmissionInterval time.Duration
+
+	// MaxScoreGain is the highest increase over the previous submission
+	// that has passed the validation that is allowed per ScoreGainInterval,
+	// quarantined submissions never become the baseline. The first submission of a user
+	// may be MaxScoreGain above MinScore.
+	MaxScoreGain      float64
+	ScoreGainInterval time.Duration
+
+	// A score is an outlier when it is more than OutlierZScore standard
+	// deviations above the mean of the published scores. The check only
+	// runs once OutlierMinSamples scores have been published.
+	OutlierZScore     float64
+	OutlierMinSamples int64
+}
+
+type QuarantinedScore struct {
+	ID          string
+	UserID      string
+	Score       float64
+	Reason      string
+	SubmittedAt time.Time
+}
+
+//go:generate mockery --name ScoreSubmissionRepository --structname MockScoreSubmissionRepository --outpkg mocks --filename score_submission_repository_mock.go --output ./mocks/. --with-expecter
+type ScoreSubmissionRepository interface {
+	GetLastSubmission(ctx context.Context, userID string) (ScoreSubmission, error)
+	// ReserveSubmission records the time of the submission as the last
+	// attempt of the user and returns the last submission that has passed
+	// the validation, nil when there is none. When the submission comes less
+	// than minInterval after the last attempt it is not recorded and
+	// ErrSubmissionTooFrequent is returned, the check and the write are
+	// atomic.
+	ReserveSubmission(ctx context.Context, submission ScoreSubmission, minInterval time.Duration) (*ScoreSubmission, error)
+	// SaveLastSubmission saves the submission as the last one that has
+	// passed the validation, the next ones are checked against it.
+	SaveLastSubmission(ctx context.Context, submission ScoreSubmission) error
+	GetStatistics(ctx context.Context) (ScoreStatistics, error)
+	AddToStatistics(ctx context.Context, score float64) error
+	DeleteLastSubmission(ctx context.Context, userID string) error
+}
+
+//go:generate mockery --name QuarantinedScoreRepository --structname MockQuarantinedScoreRepository --outpkg mocks --filename quarantined_score_repository_mock.go --output ./mocks/. --with-expecter
+type QuarantinedScoreRepository interface {
+	Create(ctx context.Context, score QuarantinedScore) (QuarantinedScore, error)
+	ListByUserID(ctx context.Context, userID string) ([]QuarantinedScore, error)
+	AnonymizeUser(ctx context.Context, userID, anonymousID string) error
+}
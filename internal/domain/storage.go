@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The permissions of a storage object, only the owner can ever write it.
+const (
+	// StoragePermissionOwnerOnly lets only the owner read the object.
+	StoragePermissionOwnerOnly = "owner_only"
+	// StoragePermissionPublicRead lets every user read the object.
+	StoragePermissionPublicRead = "public_read"
+)
+
+// StorageObject is a value a user has saved under a key of one of its
+// namespaces. Version starts at 1 and is incremented by every write, a
+// write only succeeds against the version the writer has read.
+type StorageObject struct {
+	UserID     string
+	Namespace  string
+	Key        string
+	Value      []byte
+	Permission string
+	Version    int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Readable reports whether the user can read the object.
+func (object StorageObject) Readable(userID string) bool {
+	return object.UserID == userID || object.Permission == StoragePermissionPublicRead
+}
+
+//go:generate mockery --name StorageRepository --structname MockStorageRepository --outpkg mocks --filename storage_repository_mock.go --output ./mocks/. --with-expecter
+type StorageRepository interface {
+	// Put creates the object when version is 0 and otherwise replaces it
+	// while it is still at version, it returns false when the object
+	// exists already or is at another version.
+	Put(ctx context.Context, object StorageObject, version int64) (StorageObject, bool, error)
+	Get(ctx context.Context, userID, namespace, key string) (StorageObject, error)
+	// List returns the objects in the namespace of the user ordered by key.
+	List(ctx context.Context, userID, namespace string) ([]StorageObject, error)
+	// Delete removes the object while it is at version, it returns false
+	// when it is at another version.
+	Delete(ctx context.Context, userID, namespace, key string, version int64) (bool, error)
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+	// ListByUserID returns the objects of the user in every namespace.
+	ListByUserID(ctx context.Context, userID string) ([]StorageObject, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
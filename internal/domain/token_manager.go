@@ -1,9 +1,16 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+var ErrTokenRevoked = errors.New("token revoked")
 
 //go:generate mockery --name TokenManager --structname MockTokenManager --outpkg mocks --filename token_manager_mock.go --output ./mocks/. --with-expecter
 type TokenManager interface {
 	Create(ctx context.Context, userID string) (string, error)
 	ExtractUserID(ctx context.Context, token string) (string, error)
+	// RevokeUserTokens invalidates every token created for the user so far.
+	RevokeUserTokens(ctx context.Context, userID string) error
 }
@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+// TokenVersionRepository keeps a counter per user that is embedded in the
+// tokens of the user, increasing it revokes the tokens created before.
+//
+//go:generate mockery --name TokenVersionRepository --structname MockTokenVersionRepository --outpkg mocks --filename token_version_repository_mock.go --output ./mocks/. --with-expecter
+type TokenVersionRepository interface {
+	GetTokenVersion(ctx context.Context, userID string) (int64, error)
+	IncrementTokenVersion(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The formats of the tournaments. The elimination formats eliminate a
+// player after one or two lost matches, Swiss pairs the players with the
+// same points for a fixed number of rounds and score-attack ranks the best
+// scores submitted in a single round.
+const (
+	TournamentSingleElimination = "single_elimination"
+	TournamentDoubleElimination = "double_elimination"
+	TournamentSwiss             = "swiss"
+	TournamentScoreAttack       = "score_attack"
+)
+
+// The statuses of a tournament, the players register until it starts and
+// the standings are final once it has finished. A tournament that has not
+// enough players when it should start is cancelled.
+const (
+	TournamentRegistering = "registering"
+	TournamentRunning     = "running"
+	TournamentFinished    = "finished"
+	TournamentCancelled   = "cancelled"
+)
+
+// The brackets of the matches of a tournament.
+const (
+	TournamentBracketWinners = "winners"
+	TournamentBracketLosers  = "losers"
+	TournamentBracketFinal   = "final"
+	TournamentBracketSwiss   = "swiss"
+)
+
+type Tournament struct {
+	ID     string
+	Name   string
+	Format string
+	Status string
+	// MaxPlayers is the number of players that can register, Rounds is the
+	// number of rounds of a Swiss tournament.
+	MaxPlayers    int64
+	Rounds        int64
+	RoundDuration time.Duration
+	StartsAt      time.Time
+	CreatedAt     time.Time
+
+	// CurrentRound starts at 1 once the tournament has started, the matches
+	// of the round have to be played before RoundDeadline.
+	CurrentRound  int64
+	RoundDeadline time.Time
+
+	Players   []TournamentPlayer
+	Matches   []TournamentMatch
+	Standings []TournamentStanding
+
+	// Version is incremented by every update, an update only applies to the
+	// version it has been made from.
+	Version int64
+}
+
+// TournamentPlayer is a registered player. Seed starts at 1 for the best
+// ranked player on the leaderboard, Losses and Points are the results of
+// its matches and Score is its best score in a score-attack tournament.
+// EliminatedIn is the round the player has been eliminated in.
+type TournamentPlayer struct {
+	UserID       string
+	Seed         int64
+	RegisteredAt time.Time
+	Losses       int64
+	Points       float64
+	Score        float64
+	Scored       bool
+	EliminatedIn int64
+}
+
+// TournamentMatch is a match of a round, a match with a single player is a
+// bye the player wins. WinnerID is empty when the match is a draw.
+type TournamentMatch struct {
+	ID        string
+	Round     int64
+	Bracket   string
+	PlayerIDs []string
+	WinnerID  string
+	Completed bool
+}
+
+// TournamentStanding is the final place of a player, players with the same
+// place have tied. Points is the number of points of a Swiss tournament
+// and the score of a score-attack tournament.
+type TournamentStanding struct {
+	UserID string
+	Place  int64
+	Points float64
+}
+
+// Player returns the index of the player in the tournament, -1 when the
+// user has not registered.
+func (tournament Tournament) Player(userID string) int {
+	for i, player := range tournament.Players {
+		if player.UserID == userID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+//go:generate mockery --name TournamentRepository --structname MockTournamentRepository --outpkg mocks --filename tournament_repository_mock.go --output ./mocks/. --with-expecter
+type TournamentRepository interface {
+	Create(ctx context.Context, tournament Tournament) (Tournament, error)
+	GetByID(ctx context.Context, id string) (Tournament, error)
+	// List returns the tournaments with the status, the ones that start
+	// first come first.
+	List(ctx context.Context, status string) ([]Tournament, error)
+	// ListDue returns the tournaments that should start or whose round
+	// deadline has passed at the given time.
+	ListDue(ctx context.Context, now time.Time) ([]Tournament, error)
+	// ListByUserID returns the tournaments the user has registered for.
+	ListByUserID(ctx context.Context, userID string) ([]Tournament, error)
+	// Update stores the tournament and increments its version, it returns
+	// false when the stored version is not the version of the tournament.
+	Update(ctx context.Context, tournament Tournament) (bool, error)
+	// AnonymizeUser replaces the user ID in the players, the matches and
+	// the standings of every tournament.
+	AnonymizeUser(ctx context.Context, userID, anonymousID string) error
+}
@@ -6,13 +6,40 @@ type User struct {
 	ID           string
 	Name         string
 	PasswordHash string
+	Banned       bool
+	Profile      UserProfile
+}
+
+// UserProfile holds the optional display data of a user. DisplayName is
+// shown instead of the login name when it is set, CountryCode is an ISO
+// 3166-1 alpha-2 code.
+type UserProfile struct {
+	DisplayName string
+	CountryCode string
+	AvatarURL   string
+	Metadata    map[string]string
+}
+
+// Public returns the part of the user that is shown to other players.
+func (user User) Public() PublicUser {
+	return PublicUser{
+		Name:    user.Name,
+		Profile: user.Profile,
+	}
 }
 
 //go:generate mockery --name UserRepository --structname MockUserRepository --outpkg mocks --filename user_repository_mock.go --output ./mocks/. --with-expecter
 type UserRepository interface {
 	Create(ctx context.Context, username, password string) (User, error)
+	GetByID(ctx context.Context, id string) (User, error)
 	GetByName(ctx context.Context, username string) (User, error)
 	CheckExistsByID(ctx context.Context, id string) (bool, error)
 	CheckExistsByName(ctx context.Context, username string) (bool, error)
+	// GetUsersByIDs returns the users that exist, invalid ids are not found.
 	GetUsersByIDs(ctx context.Context, ids []string) ([]User, error)
+	SetBanned(ctx context.Context, id string, banned bool) error
+	UpdateUsername(ctx context.Context, id, username string) error
+	UpdatePasswordHash(ctx context.Context, id, passwordHash string) error
+	UpdateProfile(ctx context.Context, id string, profile UserProfile) error
+	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+// PublicUser is the part of a user that is shown to other players.
+type PublicUser struct {
+	Name    string
+	Profile UserProfile
+}
+
+// UserCache maps user IDs to their public data so that reading the
+// leaderboard does not need to query the users collection every time.
+//
+//go:generate mockery --name UserCache --structname MockUserCache --outpkg mocks --filename user_cache_mock.go --output ./mocks/. --with-expecter
+type UserCache interface {
+	// Get returns the cached users of the given IDs, users that are not in
+	// the cache are left out of the result.
+	Get(ctx context.Context, userIDs []string) (map[string]PublicUser, error)
+	Set(ctx context.Context, users map[string]PublicUser) error
+	Delete(ctx context.Context, userID string) error
+}
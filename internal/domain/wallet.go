@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// The types of the entries of the ledger of a wallet.
+const (
+	LedgerEntryGrant = "grant"
+	LedgerEntrySpend = "spend"
+)
+
+// Wallet holds the soft-currency balances and the inventory of a user,
+// Balances maps the currencies and Items the item IDs to quantities that
+// never go below zero.
+type Wallet struct {
+	UserID    string
+	Balances  map[string]int64
+	Items     map[string]int64
+	UpdatedAt time.Time
+}
+
+// LedgerEntry is an operation on the wallet of a user. Currencies and Items
+// hold the positive quantities that have been granted or spent depending on
+// the Type, Wallet is the wallet once the entry has been applied. A user can
+// only use an IdempotencyKey once.
+type LedgerEntry struct {
+	ID             string
+	UserID         string
+	IdempotencyKey string
+	Type           string
+	Reason         string
+	Currencies     map[string]int64
+	Items          map[string]int64
+	Wallet         Wallet
+	CreatedAt      time.Time
+}
+
+//go:generate mockery --name WalletRepository --structname MockWalletRepository --outpkg mocks --filename wallet_repository_mock.go --output ./mocks/. --with-expecter
+type WalletRepository interface {
+	// Apply appends the entry to the ledger and applies it to the wallet of
+	// the user at once. It returns false when the wallet does not hold
+	// enough of a currency or an item for a spend, and ErrResourceExists
+	// with the entry that has been applied before when the user has
+	// already used the idempotency key.
+	Apply(ctx context.Context, entry LedgerEntry) (LedgerEntry, bool, error)
+	// GetWallet returns an empty wallet when nothing has been granted to
+	// the user yet.
+	GetWallet(ctx context.Context, userID string) (Wallet, error)
+	// ListEntries returns the ledger of the user, the newest entry first.
+	ListEntries(ctx context.Context, userID string) ([]LedgerEntry, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const leaderboardInvalidationChannel = "leaderboard:invalidated"
+
+type RedisLeaderboardInvalidatorDependencies struct {
+	Client *redis.Client
+}
+
+// RedisLeaderboardInvalidator broadcasts the invalidations over redis pub/sub.
+type RedisLeaderboardInvalidator struct {
+	client *redis.Client
+}
+
+func NewRedisLeaderboardInvalidator(deps RedisLeaderboardInvalidatorDependencies) *RedisLeaderboardInvalidator {
+	return &RedisLeaderboardInvalidator{
+		client: deps.Client,
+	}
+}
+
+func (invalidator *RedisLeaderboardInvalidator) Invalidate(ctx context.Context) error {
+	_, err := invalidator.client.Publish(ctx, leaderboardInvalidationChannel, "").Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (invalidator *RedisLeaderboardInvalidator) Subscribe(ctx context.Context) <-chan struct{} {
+	pubsub := invalidator.client.Subscribe(ctx, leaderboardInvalidationChannel)
+
+	invalidations := make(chan struct{}, 1)
+
+	go func() {
+		defer close(invalidations)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				// invalidations that arrive while one is pending are merged
+				// into it.
+				select {
+				case invalidations <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return invalidations
+}
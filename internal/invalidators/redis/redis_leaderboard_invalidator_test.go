@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedisLeaderboardInvalidatorTestSuite struct {
+	suite.Suite
+
+	invalidator *RedisLeaderboardInvalidator
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisLeaderboardInvalidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisLeaderboardInvalidatorTestSuite))
+}
+
+func (suite *RedisLeaderboardInvalidatorTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.invalidator = NewRedisLeaderboardInvalidator(RedisLeaderboardInvalidatorDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisLeaderboardInvalidatorTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisLeaderboardInvalidatorTestSuite) TestInvalidate() {
+	suite.redisMock.
+		ExpectPublish("leaderboard:invalidated", "").
+		SetVal(2)
+
+	err := suite.invalidator.Invalidate(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *RedisLeaderboardInvalidatorTestSuite) TestInvalidate_PublishFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectPublish("leaderboard:invalidated", "").
+		SetErr(someError)
+
+	err := suite.invalidator.Invalidate(context.Background())
+	suite.ErrorIs(err, someError)
+}
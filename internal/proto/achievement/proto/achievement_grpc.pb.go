@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/achievement.proto
+
+package achievement
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AchievementServiceClient is the client API for AchievementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AchievementServiceClient interface {
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error)
+}
+
+type achievementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAchievementServiceClient(cc grpc.ClientConnInterface) AchievementServiceClient {
+	return &achievementServiceClient{cc}
+}
+
+func (c *achievementServiceClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/achievement.AchievementService/ListAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *achievementServiceClient) GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error) {
+	out := new(GetUserAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/achievement.AchievementService/GetUserAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AchievementServiceServer is the server API for AchievementService service.
+// All implementations must embed UnimplementedAchievementServiceServer
+// for forward compatibility
+type AchievementServiceServer interface {
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	GetUserAchievements(context.Context, *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error)
+	mustEmbedUnimplementedAchievementServiceServer()
+}
+
+// UnimplementedAchievementServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAchievementServiceServer struct {
+}
+
+func (UnimplementedAchievementServiceServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedAchievementServiceServer) GetUserAchievements(context.Context, *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAchievements not implemented")
+}
+func (UnimplementedAchievementServiceServer) mustEmbedUnimplementedAchievementServiceServer() {}
+
+// UnsafeAchievementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AchievementServiceServer will
+// result in compilation errors.
+type UnsafeAchievementServiceServer interface {
+	mustEmbedUnimplementedAchievementServiceServer()
+}
+
+func RegisterAchievementServiceServer(s grpc.ServiceRegistrar, srv AchievementServiceServer) {
+	s.RegisterService(&AchievementService_ServiceDesc, srv)
+}
+
+func _AchievementService_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServiceServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.AchievementService/ListAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServiceServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AchievementService_GetUserAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServiceServer).GetUserAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.AchievementService/GetUserAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServiceServer).GetUserAchievements(ctx, req.(*GetUserAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AchievementService_ServiceDesc is the grpc.ServiceDesc for AchievementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AchievementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "achievement.AchievementService",
+	HandlerType: (*AchievementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAchievements",
+			Handler:    _AchievementService_ListAchievements_Handler,
+		},
+		{
+			MethodName: "GetUserAchievements",
+			Handler:    _AchievementService_GetUserAchievements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/achievement.proto",
+}
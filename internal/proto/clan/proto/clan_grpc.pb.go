@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/clan.proto
+
+package clan
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClanServiceClient is the client API for ClanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClanServiceClient interface {
+	CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*CreateClanResponse, error)
+	GetClan(ctx context.Context, in *GetClanRequest, opts ...grpc.CallOption) (*GetClanResponse, error)
+	JoinClan(ctx context.Context, in *JoinClanRequest, opts ...grpc.CallOption) (*JoinClanResponse, error)
+	LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*LeaveClanResponse, error)
+	KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error)
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error)
+	GetClanLeaderboard(ctx context.Context, in *GetClanLeaderboardRequest, opts ...grpc.CallOption) (*GetClanLeaderboardResponse, error)
+}
+
+type clanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClanServiceClient(cc grpc.ClientConnInterface) ClanServiceClient {
+	return &clanServiceClient{cc}
+}
+
+func (c *clanServiceClient) CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*CreateClanResponse, error) {
+	out := new(CreateClanResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/CreateClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) GetClan(ctx context.Context, in *GetClanRequest, opts ...grpc.CallOption) (*GetClanResponse, error) {
+	out := new(GetClanResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/GetClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) JoinClan(ctx context.Context, in *JoinClanRequest, opts ...grpc.CallOption) (*JoinClanResponse, error) {
+	out := new(JoinClanResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/JoinClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*LeaveClanResponse, error) {
+	out := new(LeaveClanResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/LeaveClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error) {
+	out := new(KickMemberResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/KickMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error) {
+	out := new(SetMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/SetMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clanServiceClient) GetClanLeaderboard(ctx context.Context, in *GetClanLeaderboardRequest, opts ...grpc.CallOption) (*GetClanLeaderboardResponse, error) {
+	out := new(GetClanLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/clan.ClanService/GetClanLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClanServiceServer is the server API for ClanService service.
+// All implementations must embed UnimplementedClanServiceServer
+// for forward compatibility
+type ClanServiceServer interface {
+	CreateClan(context.Context, *CreateClanRequest) (*CreateClanResponse, error)
+	GetClan(context.Context, *GetClanRequest) (*GetClanResponse, error)
+	JoinClan(context.Context, *JoinClanRequest) (*JoinClanResponse, error)
+	LeaveClan(context.Context, *LeaveClanRequest) (*LeaveClanResponse, error)
+	KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error)
+	SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error)
+	GetClanLeaderboard(context.Context, *GetClanLeaderboardRequest) (*GetClanLeaderboardResponse, error)
+	mustEmbedUnimplementedClanServiceServer()
+}
+
+// UnimplementedClanServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClanServiceServer struct {
+}
+
+func (UnimplementedClanServiceServer) CreateClan(context.Context, *CreateClanRequest) (*CreateClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClan not implemented")
+}
+func (UnimplementedClanServiceServer) GetClan(context.Context, *GetClanRequest) (*GetClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClan not implemented")
+}
+func (UnimplementedClanServiceServer) JoinClan(context.Context, *JoinClanRequest) (*JoinClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinClan not implemented")
+}
+func (UnimplementedClanServiceServer) LeaveClan(context.Context, *LeaveClanRequest) (*LeaveClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveClan not implemented")
+}
+func (UnimplementedClanServiceServer) KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickMember not implemented")
+}
+func (UnimplementedClanServiceServer) SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberRole not implemented")
+}
+func (UnimplementedClanServiceServer) GetClanLeaderboard(context.Context, *GetClanLeaderboardRequest) (*GetClanLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClanLeaderboard not implemented")
+}
+func (UnimplementedClanServiceServer) mustEmbedUnimplementedClanServiceServer() {}
+
+// UnsafeClanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClanServiceServer will
+// result in compilation errors.
+type UnsafeClanServiceServer interface {
+	mustEmbedUnimplementedClanServiceServer()
+}
+
+func RegisterClanServiceServer(s grpc.ServiceRegistrar, srv ClanServiceServer) {
+	s.RegisterService(&ClanService_ServiceDesc, srv)
+}
+
+func _ClanService_CreateClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).CreateClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/CreateClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).CreateClan(ctx, req.(*CreateClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_GetClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).GetClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/GetClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).GetClan(ctx, req.(*GetClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_JoinClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).JoinClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/JoinClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).JoinClan(ctx, req.(*JoinClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_LeaveClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).LeaveClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/LeaveClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).LeaveClan(ctx, req.(*LeaveClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/KickMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).KickMember(ctx, req.(*KickMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).SetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/SetMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).SetMemberRole(ctx, req.(*SetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClanService_GetClanLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClanLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClanServiceServer).GetClanLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clan.ClanService/GetClanLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClanServiceServer).GetClanLeaderboard(ctx, req.(*GetClanLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClanService_ServiceDesc is the grpc.ServiceDesc for ClanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clan.ClanService",
+	HandlerType: (*ClanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClan",
+			Handler:    _ClanService_CreateClan_Handler,
+		},
+		{
+			MethodName: "GetClan",
+			Handler:    _ClanService_GetClan_Handler,
+		},
+		{
+			MethodName: "JoinClan",
+			Handler:    _ClanService_JoinClan_Handler,
+		},
+		{
+			MethodName: "LeaveClan",
+			Handler:    _ClanService_LeaveClan_Handler,
+		},
+		{
+			MethodName: "KickMember",
+			Handler:    _ClanService_KickMember_Handler,
+		},
+		{
+			MethodName: "SetMemberRole",
+			Handler:    _ClanService_SetMemberRole_Handler,
+		},
+		{
+			MethodName: "GetClanLeaderboard",
+			Handler:    _ClanService_GetClanLeaderboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/clan.proto",
+}
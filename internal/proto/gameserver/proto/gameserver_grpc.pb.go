@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/gameserver.proto
+
+package gameserver
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GameServerAdminServiceClient is the client API for GameServerAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameServerAdminServiceClient interface {
+	CreateGameServerKey(ctx context.Context, in *CreateGameServerKeyRequest, opts ...grpc.CallOption) (*CreateGameServerKeyResponse, error)
+	ListGameServerKeys(ctx context.Context, in *ListGameServerKeysRequest, opts ...grpc.CallOption) (*ListGameServerKeysResponse, error)
+	RevokeGameServerKey(ctx context.Context, in *RevokeGameServerKeyRequest, opts ...grpc.CallOption) (*RevokeGameServerKeyResponse, error)
+}
+
+type gameServerAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameServerAdminServiceClient(cc grpc.ClientConnInterface) GameServerAdminServiceClient {
+	return &gameServerAdminServiceClient{cc}
+}
+
+func (c *gameServerAdminServiceClient) CreateGameServerKey(ctx context.Context, in *CreateGameServerKeyRequest, opts ...grpc.CallOption) (*CreateGameServerKeyResponse, error) {
+	out := new(CreateGameServerKeyResponse)
+	err := c.cc.Invoke(ctx, "/gameserver.GameServerAdminService/CreateGameServerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerAdminServiceClient) ListGameServerKeys(ctx context.Context, in *ListGameServerKeysRequest, opts ...grpc.CallOption) (*ListGameServerKeysResponse, error) {
+	out := new(ListGameServerKeysResponse)
+	err := c.cc.Invoke(ctx, "/gameserver.GameServerAdminService/ListGameServerKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerAdminServiceClient) RevokeGameServerKey(ctx context.Context, in *RevokeGameServerKeyRequest, opts ...grpc.CallOption) (*RevokeGameServerKeyResponse, error) {
+	out := new(RevokeGameServerKeyResponse)
+	err := c.cc.Invoke(ctx, "/gameserver.GameServerAdminService/RevokeGameServerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServerAdminServiceServer is the server API for GameServerAdminService service.
+// All implementations must embed UnimplementedGameServerAdminServiceServer
+// for forward compatibility
+type GameServerAdminServiceServer interface {
+	CreateGameServerKey(context.Context, *CreateGameServerKeyRequest) (*CreateGameServerKeyResponse, error)
+	ListGameServerKeys(context.Context, *ListGameServerKeysRequest) (*ListGameServerKeysResponse, error)
+	RevokeGameServerKey(context.Context, *RevokeGameServerKeyRequest) (*RevokeGameServerKeyResponse, error)
+	mustEmbedUnimplementedGameServerAdminServiceServer()
+}
+
+// UnimplementedGameServerAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGameServerAdminServiceServer struct {
+}
+
+func (UnimplementedGameServerAdminServiceServer) CreateGameServerKey(context.Context, *CreateGameServerKeyRequest) (*CreateGameServerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGameServerKey not implemented")
+}
+func (UnimplementedGameServerAdminServiceServer) ListGameServerKeys(context.Context, *ListGameServerKeysRequest) (*ListGameServerKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameServerKeys not implemented")
+}
+func (UnimplementedGameServerAdminServiceServer) RevokeGameServerKey(context.Context, *RevokeGameServerKeyRequest) (*RevokeGameServerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGameServerKey not implemented")
+}
+func (UnimplementedGameServerAdminServiceServer) mustEmbedUnimplementedGameServerAdminServiceServer() {
+}
+
+// UnsafeGameServerAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameServerAdminServiceServer will
+// result in compilation errors.
+type UnsafeGameServerAdminServiceServer interface {
+	mustEmbedUnimplementedGameServerAdminServiceServer()
+}
+
+func RegisterGameServerAdminServiceServer(s grpc.ServiceRegistrar, srv GameServerAdminServiceServer) {
+	s.RegisterService(&GameServerAdminService_ServiceDesc, srv)
+}
+
+func _GameServerAdminService_CreateGameServerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameServerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerAdminServiceServer).CreateGameServerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gameserver.GameServerAdminService/CreateGameServerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerAdminServiceServer).CreateGameServerKey(ctx, req.(*CreateGameServerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServerAdminService_ListGameServerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameServerKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerAdminServiceServer).ListGameServerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gameserver.GameServerAdminService/ListGameServerKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerAdminServiceServer).ListGameServerKeys(ctx, req.(*ListGameServerKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServerAdminService_RevokeGameServerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGameServerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerAdminServiceServer).RevokeGameServerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gameserver.GameServerAdminService/RevokeGameServerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerAdminServiceServer).RevokeGameServerKey(ctx, req.(*RevokeGameServerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameServerAdminService_ServiceDesc is the grpc.ServiceDesc for GameServerAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameServerAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gameserver.GameServerAdminService",
+	HandlerType: (*GameServerAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGameServerKey",
+			Handler:    _GameServerAdminService_CreateGameServerKey_Handler,
+		},
+		{
+			MethodName: "ListGameServerKeys",
+			Handler:    _GameServerAdminService_ListGameServerKeys_Handler,
+		},
+		{
+			MethodName: "RevokeGameServerKey",
+			Handler:    _GameServerAdminService_RevokeGameServerKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gameserver.proto",
+}
@@ -23,7 +23,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LeaderboardServiceClient interface {
 	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetUserRank(ctx context.Context, in *GetUserRankRequest, opts ...grpc.CallOption) (*GetUserRankResponse, error)
 	SubmitUserScore(ctx context.Context, in *SubmitUserScoreRequest, opts ...grpc.CallOption) (*SubmitUserScoreResponse, error)
+	SubmitVerifiedScore(ctx context.Context, in *SubmitVerifiedScoreRequest, opts ...grpc.CallOption) (*SubmitVerifiedScoreResponse, error)
 }
 
 type leaderboardServiceClient struct {
@@ -43,6 +45,15 @@ func (c *leaderboardServiceClient) GetLeaderboard(ctx context.Context, in *GetLe
 	return out, nil
 }
 
+func (c *leaderboardServiceClient) GetUserRank(ctx context.Context, in *GetUserRankRequest, opts ...grpc.CallOption) (*GetUserRankResponse, error) {
+	out := new(GetUserRankResponse)
+	err := c.cc.Invoke(ctx, "/leaderboard.LeaderboardService/GetUserRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaderboardServiceClient) SubmitUserScore(ctx context.Context, in *SubmitUserScoreRequest, opts ...grpc.CallOption) (*SubmitUserScoreResponse, error) {
 	out := new(SubmitUserScoreResponse)
 	err := c.cc.Invoke(ctx, "/leaderboard.LeaderboardService/SubmitUserScore", in, out, opts...)
@@ -52,12 +63,23 @@ func (c *leaderboardServiceClient) SubmitUserScore(ctx context.Context, in *Subm
 	return out, nil
 }
 
+func (c *leaderboardServiceClient) SubmitVerifiedScore(ctx context.Context, in *SubmitVerifiedScoreRequest, opts ...grpc.CallOption) (*SubmitVerifiedScoreResponse, error) {
+	out := new(SubmitVerifiedScoreResponse)
+	err := c.cc.Invoke(ctx, "/leaderboard.LeaderboardService/SubmitVerifiedScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaderboardServiceServer is the server API for LeaderboardService service.
 // All implementations must embed UnimplementedLeaderboardServiceServer
 // for forward compatibility
 type LeaderboardServiceServer interface {
 	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetUserRank(context.Context, *GetUserRankRequest) (*GetUserRankResponse, error)
 	SubmitUserScore(context.Context, *SubmitUserScoreRequest) (*SubmitUserScoreResponse, error)
+	SubmitVerifiedScore(context.Context, *SubmitVerifiedScoreRequest) (*SubmitVerifiedScoreResponse, error)
 	mustEmbedUnimplementedLeaderboardServiceServer()
 }
 
@@ -68,9 +90,15 @@ type UnimplementedLeaderboardServiceServer struct {
 func (UnimplementedLeaderboardServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
 }
+func (UnimplementedLeaderboardServiceServer) GetUserRank(context.Context, *GetUserRankRequest) (*GetUserRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRank not implemented")
+}
 func (UnimplementedLeaderboardServiceServer) SubmitUserScore(context.Context, *SubmitUserScoreRequest) (*SubmitUserScoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitUserScore not implemented")
 }
+func (UnimplementedLeaderboardServiceServer) SubmitVerifiedScore(context.Context, *SubmitVerifiedScoreRequest) (*SubmitVerifiedScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVerifiedScore not implemented")
+}
 func (UnimplementedLeaderboardServiceServer) mustEmbedUnimplementedLeaderboardServiceServer() {}
 
 // UnsafeLeaderboardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -102,6 +130,24 @@ func _LeaderboardService_GetLeaderboard_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaderboardService_GetUserRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetUserRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaderboard.LeaderboardService/GetUserRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetUserRank(ctx, req.(*GetUserRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeaderboardService_SubmitUserScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitUserScoreRequest)
 	if err := dec(in); err != nil {
@@ -120,6 +166,24 @@ func _LeaderboardService_SubmitUserScore_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaderboardService_SubmitVerifiedScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitVerifiedScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).SubmitVerifiedScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaderboard.LeaderboardService/SubmitVerifiedScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).SubmitVerifiedScore(ctx, req.(*SubmitVerifiedScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaderboardService_ServiceDesc is the grpc.ServiceDesc for LeaderboardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,10 +195,18 @@ var LeaderboardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeaderboard",
 			Handler:    _LeaderboardService_GetLeaderboard_Handler,
 		},
+		{
+			MethodName: "GetUserRank",
+			Handler:    _LeaderboardService_GetUserRank_Handler,
+		},
 		{
 			MethodName: "SubmitUserScore",
 			Handler:    _LeaderboardService_SubmitUserScore_Handler,
 		},
+		{
+			MethodName: "SubmitVerifiedScore",
+			Handler:    _LeaderboardService_SubmitVerifiedScore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/leaderboard.proto",
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/matchmaking.proto
+
+package matchmaking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MatchmakingServiceClient is the client API for MatchmakingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MatchmakingServiceClient interface {
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error)
+	LeaveQueue(ctx context.Context, in *LeaveQueueRequest, opts ...grpc.CallOption) (*LeaveQueueResponse, error)
+	WaitForMatch(ctx context.Context, in *WaitForMatchRequest, opts ...grpc.CallOption) (MatchmakingService_WaitForMatchClient, error)
+	GetTicketPublicKey(ctx context.Context, in *GetTicketPublicKeyRequest, opts ...grpc.CallOption) (*GetTicketPublicKeyResponse, error)
+}
+
+type matchmakingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchmakingServiceClient(cc grpc.ClientConnInterface) MatchmakingServiceClient {
+	return &matchmakingServiceClient{cc}
+}
+
+func (c *matchmakingServiceClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error) {
+	out := new(JoinQueueResponse)
+	err := c.cc.Invoke(ctx, "/matchmaking.MatchmakingService/JoinQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchmakingServiceClient) LeaveQueue(ctx context.Context, in *LeaveQueueRequest, opts ...grpc.CallOption) (*LeaveQueueResponse, error) {
+	out := new(LeaveQueueResponse)
+	err := c.cc.Invoke(ctx, "/matchmaking.MatchmakingService/LeaveQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchmakingServiceClient) WaitForMatch(ctx context.Context, in *WaitForMatchRequest, opts ...grpc.CallOption) (MatchmakingService_WaitForMatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MatchmakingService_ServiceDesc.Streams[0], "/matchmaking.MatchmakingService/WaitForMatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matchmakingServiceWaitForMatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MatchmakingService_WaitForMatchClient interface {
+	Recv() (*WaitForMatchResponse, error)
+	grpc.ClientStream
+}
+
+type matchmakingServiceWaitForMatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *matchmakingServiceWaitForMatchClient) Recv() (*WaitForMatchResponse, error) {
+	m := new(WaitForMatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *matchmakingServiceClient) GetTicketPublicKey(ctx context.Context, in *GetTicketPublicKeyRequest, opts ...grpc.CallOption) (*GetTicketPublicKeyResponse, error) {
+	out := new(GetTicketPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/matchmaking.MatchmakingService/GetTicketPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatchmakingServiceServer is the server API for MatchmakingService service.
+// All implementations must embed UnimplementedMatchmakingServiceServer
+// for forward compatibility
+type MatchmakingServiceServer interface {
+	JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error)
+	LeaveQueue(context.Context, *LeaveQueueRequest) (*LeaveQueueResponse, error)
+	WaitForMatch(*WaitForMatchRequest, MatchmakingService_WaitForMatchServer) error
+	GetTicketPublicKey(context.Context, *GetTicketPublicKeyRequest) (*GetTicketPublicKeyResponse, error)
+	mustEmbedUnimplementedMatchmakingServiceServer()
+}
+
+// UnimplementedMatchmakingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMatchmakingServiceServer struct {
+}
+
+func (UnimplementedMatchmakingServiceServer) JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedMatchmakingServiceServer) LeaveQueue(context.Context, *LeaveQueueRequest) (*LeaveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedMatchmakingServiceServer) WaitForMatch(*WaitForMatchRequest, MatchmakingService_WaitForMatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForMatch not implemented")
+}
+func (UnimplementedMatchmakingServiceServer) GetTicketPublicKey(context.Context, *GetTicketPublicKeyRequest) (*GetTicketPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketPublicKey not implemented")
+}
+func (UnimplementedMatchmakingServiceServer) mustEmbedUnimplementedMatchmakingServiceServer() {}
+
+// UnsafeMatchmakingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchmakingServiceServer will
+// result in compilation errors.
+type UnsafeMatchmakingServiceServer interface {
+	mustEmbedUnimplementedMatchmakingServiceServer()
+}
+
+func RegisterMatchmakingServiceServer(s grpc.ServiceRegistrar, srv MatchmakingServiceServer) {
+	s.RegisterService(&MatchmakingService_ServiceDesc, srv)
+}
+
+func _MatchmakingService_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServiceServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matchmaking.MatchmakingService/JoinQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServiceServer).JoinQueue(ctx, req.(*JoinQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchmakingService_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServiceServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matchmaking.MatchmakingService/LeaveQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServiceServer).LeaveQueue(ctx, req.(*LeaveQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchmakingService_WaitForMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WaitForMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MatchmakingServiceServer).WaitForMatch(m, &matchmakingServiceWaitForMatchServer{stream})
+}
+
+type MatchmakingService_WaitForMatchServer interface {
+	Send(*WaitForMatchResponse) error
+	grpc.ServerStream
+}
+
+type matchmakingServiceWaitForMatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *matchmakingServiceWaitForMatchServer) Send(m *WaitForMatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MatchmakingService_GetTicketPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServiceServer).GetTicketPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matchmaking.MatchmakingService/GetTicketPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServiceServer).GetTicketPublicKey(ctx, req.(*GetTicketPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MatchmakingService_ServiceDesc is the grpc.ServiceDesc for MatchmakingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchmakingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "matchmaking.MatchmakingService",
+	HandlerType: (*MatchmakingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinQueue",
+			Handler:    _MatchmakingService_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _MatchmakingService_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "GetTicketPublicKey",
+			Handler:    _MatchmakingService_GetTicketPublicKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForMatch",
+			Handler:       _MatchmakingService_WaitForMatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/matchmaking.proto",
+}
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/moderation.proto
+
+package moderation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LeaderboardModerationServiceClient is the client API for LeaderboardModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaderboardModerationServiceClient interface {
+	RemoveScore(ctx context.Context, in *RemoveScoreRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	SetScore(ctx context.Context, in *SetScoreRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+}
+
+type leaderboardModerationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaderboardModerationServiceClient(cc grpc.ClientConnInterface) LeaderboardModerationServiceClient {
+	return &leaderboardModerationServiceClient{cc}
+}
+
+func (c *leaderboardModerationServiceClient) RemoveScore(ctx context.Context, in *RemoveScoreRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, "/moderation.LeaderboardModerationService/RemoveScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardModerationServiceClient) SetScore(ctx context.Context, in *SetScoreRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, "/moderation.LeaderboardModerationService/SetScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardModerationServiceClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, "/moderation.LeaderboardModerationService/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardModerationServiceClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, "/moderation.LeaderboardModerationService/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaderboardModerationServiceServer is the server API for LeaderboardModerationService service.
+// All implementations must embed UnimplementedLeaderboardModerationServiceServer
+// for forward compatibility
+type LeaderboardModerationServiceServer interface {
+	RemoveScore(context.Context, *RemoveScoreRequest) (*ModerationResponse, error)
+	SetScore(context.Context, *SetScoreRequest) (*ModerationResponse, error)
+	BanUser(context.Context, *BanUserRequest) (*ModerationResponse, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*ModerationResponse, error)
+	mustEmbedUnimplementedLeaderboardModerationServiceServer()
+}
+
+// UnimplementedLeaderboardModerationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLeaderboardModerationServiceServer struct {
+}
+
+func (UnimplementedLeaderboardModerationServiceServer) RemoveScore(context.Context, *RemoveScoreRequest) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveScore not implemented")
+}
+func (UnimplementedLeaderboardModerationServiceServer) SetScore(context.Context, *SetScoreRequest) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScore not implemented")
+}
+func (UnimplementedLeaderboardModerationServiceServer) BanUser(context.Context, *BanUserRequest) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedLeaderboardModerationServiceServer) UnbanUser(context.Context, *UnbanUserRequest) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedLeaderboardModerationServiceServer) mustEmbedUnimplementedLeaderboardModerationServiceServer() {
+}
+
+// UnsafeLeaderboardModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaderboardModerationServiceServer will
+// result in compilation errors.
+type UnsafeLeaderboardModerationServiceServer interface {
+	mustEmbedUnimplementedLeaderboardModerationServiceServer()
+}
+
+func RegisterLeaderboardModerationServiceServer(s grpc.ServiceRegistrar, srv LeaderboardModerationServiceServer) {
+	s.RegisterService(&LeaderboardModerationService_ServiceDesc, srv)
+}
+
+func _LeaderboardModerationService_RemoveScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardModerationServiceServer).RemoveScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moderation.LeaderboardModerationService/RemoveScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardModerationServiceServer).RemoveScore(ctx, req.(*RemoveScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardModerationService_SetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardModerationServiceServer).SetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moderation.LeaderboardModerationService/SetScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardModerationServiceServer).SetScore(ctx, req.(*SetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardModerationService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardModerationServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moderation.LeaderboardModerationService/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardModerationServiceServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardModerationService_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardModerationServiceServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moderation.LeaderboardModerationService/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardModerationServiceServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeaderboardModerationService_ServiceDesc is the grpc.ServiceDesc for LeaderboardModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeaderboardModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moderation.LeaderboardModerationService",
+	HandlerType: (*LeaderboardModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveScore",
+			Handler:    _LeaderboardModerationService_RemoveScore_Handler,
+		},
+		{
+			MethodName: "SetScore",
+			Handler:    _LeaderboardModerationService_SetScore_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _LeaderboardModerationService_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _LeaderboardModerationService_UnbanUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/moderation.proto",
+}
@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/rating.proto
+
+package rating
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RatingServiceClient is the client API for RatingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingServiceClient interface {
+	GetRatingLeaderboard(ctx context.Context, in *GetRatingLeaderboardRequest, opts ...grpc.CallOption) (*GetRatingLeaderboardResponse, error)
+	GetUserRating(ctx context.Context, in *GetUserRatingRequest, opts ...grpc.CallOption) (*GetUserRatingResponse, error)
+}
+
+type ratingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingServiceClient(cc grpc.ClientConnInterface) RatingServiceClient {
+	return &ratingServiceClient{cc}
+}
+
+func (c *ratingServiceClient) GetRatingLeaderboard(ctx context.Context, in *GetRatingLeaderboardRequest, opts ...grpc.CallOption) (*GetRatingLeaderboardResponse, error) {
+	out := new(GetRatingLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/rating.RatingService/GetRatingLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingServiceClient) GetUserRating(ctx context.Context, in *GetUserRatingRequest, opts ...grpc.CallOption) (*GetUserRatingResponse, error) {
+	out := new(GetUserRatingResponse)
+	err := c.cc.Invoke(ctx, "/rating.RatingService/GetUserRating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingServiceServer is the server API for RatingService service.
+// All implementations must embed UnimplementedRatingServiceServer
+// for forward compatibility
+type RatingServiceServer interface {
+	GetRatingLeaderboard(context.Context, *GetRatingLeaderboardRequest) (*GetRatingLeaderboardResponse, error)
+	GetUserRating(context.Context, *GetUserRatingRequest) (*GetUserRatingResponse, error)
+	mustEmbedUnimplementedRatingServiceServer()
+}
+
+// UnimplementedRatingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRatingServiceServer struct {
+}
+
+func (UnimplementedRatingServiceServer) GetRatingLeaderboard(context.Context, *GetRatingLeaderboardRequest) (*GetRatingLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingLeaderboard not implemented")
+}
+func (UnimplementedRatingServiceServer) GetUserRating(context.Context, *GetUserRatingRequest) (*GetUserRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRating not implemented")
+}
+func (UnimplementedRatingServiceServer) mustEmbedUnimplementedRatingServiceServer() {}
+
+// UnsafeRatingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingServiceServer will
+// result in compilation errors.
+type UnsafeRatingServiceServer interface {
+	mustEmbedUnimplementedRatingServiceServer()
+}
+
+func RegisterRatingServiceServer(s grpc.ServiceRegistrar, srv RatingServiceServer) {
+	s.RegisterService(&RatingService_ServiceDesc, srv)
+}
+
+func _RatingService_GetRatingLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingServiceServer).GetRatingLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rating.RatingService/GetRatingLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingServiceServer).GetRatingLeaderboard(ctx, req.(*GetRatingLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatingService_GetUserRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingServiceServer).GetUserRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rating.RatingService/GetUserRating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingServiceServer).GetUserRating(ctx, req.(*GetUserRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingService_ServiceDesc is the grpc.ServiceDesc for RatingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rating.RatingService",
+	HandlerType: (*RatingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRatingLeaderboard",
+			Handler:    _RatingService_GetRatingLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetUserRating",
+			Handler:    _RatingService_GetUserRating_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rating.proto",
+}
+
+// RatingAdminServiceClient is the client API for RatingAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingAdminServiceClient interface {
+	ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*ReportMatchResultResponse, error)
+}
+
+type ratingAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingAdminServiceClient(cc grpc.ClientConnInterface) RatingAdminServiceClient {
+	return &ratingAdminServiceClient{cc}
+}
+
+func (c *ratingAdminServiceClient) ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*ReportMatchResultResponse, error) {
+	out := new(ReportMatchResultResponse)
+	err := c.cc.Invoke(ctx, "/rating.RatingAdminService/ReportMatchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingAdminServiceServer is the server API for RatingAdminService service.
+// All implementations must embed UnimplementedRatingAdminServiceServer
+// for forward compatibility
+type RatingAdminServiceServer interface {
+	ReportMatchResult(context.Context, *ReportMatchResultRequest) (*ReportMatchResultResponse, error)
+	mustEmbedUnimplementedRatingAdminServiceServer()
+}
+
+// UnimplementedRatingAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRatingAdminServiceServer struct {
+}
+
+func (UnimplementedRatingAdminServiceServer) ReportMatchResult(context.Context, *ReportMatchResultRequest) (*ReportMatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMatchResult not implemented")
+}
+func (UnimplementedRatingAdminServiceServer) mustEmbedUnimplementedRatingAdminServiceServer() {}
+
+// UnsafeRatingAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingAdminServiceServer will
+// result in compilation errors.
+type UnsafeRatingAdminServiceServer interface {
+	mustEmbedUnimplementedRatingAdminServiceServer()
+}
+
+func RegisterRatingAdminServiceServer(s grpc.ServiceRegistrar, srv RatingAdminServiceServer) {
+	s.RegisterService(&RatingAdminService_ServiceDesc, srv)
+}
+
+func _RatingAdminService_ReportMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingAdminServiceServer).ReportMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rating.RatingAdminService/ReportMatchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingAdminServiceServer).ReportMatchResult(ctx, req.(*ReportMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatingAdminService_ServiceDesc is the grpc.ServiceDesc for RatingAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatingAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rating.RatingAdminService",
+	HandlerType: (*RatingAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportMatchResult",
+			Handler:    _RatingAdminService_ReportMatchResult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rating.proto",
+}
@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/reward.proto
+
+package reward
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RewardServiceClient is the client API for RewardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RewardServiceClient interface {
+	ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error)
+	ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error)
+}
+
+type rewardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRewardServiceClient(cc grpc.ClientConnInterface) RewardServiceClient {
+	return &rewardServiceClient{cc}
+}
+
+func (c *rewardServiceClient) ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error) {
+	out := new(ListRewardsResponse)
+	err := c.cc.Invoke(ctx, "/reward.RewardService/ListRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error) {
+	out := new(ClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/reward.RewardService/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardServiceServer is the server API for RewardService service.
+// All implementations must embed UnimplementedRewardServiceServer
+// for forward compatibility
+type RewardServiceServer interface {
+	ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error)
+	ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error)
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+// UnimplementedRewardServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRewardServiceServer struct {
+}
+
+func (UnimplementedRewardServiceServer) ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRewards not implemented")
+}
+func (UnimplementedRewardServiceServer) ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedRewardServiceServer) mustEmbedUnimplementedRewardServiceServer() {}
+
+// UnsafeRewardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RewardServiceServer will
+// result in compilation errors.
+type UnsafeRewardServiceServer interface {
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+func RegisterRewardServiceServer(s grpc.ServiceRegistrar, srv RewardServiceServer) {
+	s.RegisterService(&RewardService_ServiceDesc, srv)
+}
+
+func _RewardService_ListRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).ListRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.RewardService/ListRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).ListRewards(ctx, req.(*ListRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.RewardService/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).ClaimRewards(ctx, req.(*ClaimRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RewardService_ServiceDesc is the grpc.ServiceDesc for RewardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RewardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reward.RewardService",
+	HandlerType: (*RewardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRewards",
+			Handler:    _RewardService_ListRewards_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _RewardService_ClaimRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/reward.proto",
+}
+
+// RewardAdminServiceClient is the client API for RewardAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RewardAdminServiceClient interface {
+	EndSeason(ctx context.Context, in *EndSeasonRequest, opts ...grpc.CallOption) (*EndSeasonResponse, error)
+}
+
+type rewardAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRewardAdminServiceClient(cc grpc.ClientConnInterface) RewardAdminServiceClient {
+	return &rewardAdminServiceClient{cc}
+}
+
+func (c *rewardAdminServiceClient) EndSeason(ctx context.Context, in *EndSeasonRequest, opts ...grpc.CallOption) (*EndSeasonResponse, error) {
+	out := new(EndSeasonResponse)
+	err := c.cc.Invoke(ctx, "/reward.RewardAdminService/EndSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardAdminServiceServer is the server API for RewardAdminService service.
+// All implementations must embed UnimplementedRewardAdminServiceServer
+// for forward compatibility
+type RewardAdminServiceServer interface {
+	EndSeason(context.Context, *EndSeasonRequest) (*EndSeasonResponse, error)
+	mustEmbedUnimplementedRewardAdminServiceServer()
+}
+
+// UnimplementedRewardAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRewardAdminServiceServer struct {
+}
+
+func (UnimplementedRewardAdminServiceServer) EndSeason(context.Context, *EndSeasonRequest) (*EndSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSeason not implemented")
+}
+func (UnimplementedRewardAdminServiceServer) mustEmbedUnimplementedRewardAdminServiceServer() {}
+
+// UnsafeRewardAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RewardAdminServiceServer will
+// result in compilation errors.
+type UnsafeRewardAdminServiceServer interface {
+	mustEmbedUnimplementedRewardAdminServiceServer()
+}
+
+func RegisterRewardAdminServiceServer(s grpc.ServiceRegistrar, srv RewardAdminServiceServer) {
+	s.RegisterService(&RewardAdminService_ServiceDesc, srv)
+}
+
+func _RewardAdminService_EndSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardAdminServiceServer).EndSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.RewardAdminService/EndSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardAdminServiceServer).EndSeason(ctx, req.(*EndSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RewardAdminService_ServiceDesc is the grpc.ServiceDesc for RewardAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RewardAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reward.RewardAdminService",
+	HandlerType: (*RewardAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EndSeason",
+			Handler:    _RewardAdminService_EndSeason_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/reward.proto",
+}
@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/tournament.proto
+
+package tournament
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TournamentServiceClient is the client API for TournamentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TournamentServiceClient interface {
+	GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*GetTournamentResponse, error)
+	ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*ListTournamentsResponse, error)
+	RegisterForTournament(ctx context.Context, in *RegisterForTournamentRequest, opts ...grpc.CallOption) (*RegisterForTournamentResponse, error)
+}
+
+type tournamentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTournamentServiceClient(cc grpc.ClientConnInterface) TournamentServiceClient {
+	return &tournamentServiceClient{cc}
+}
+
+func (c *tournamentServiceClient) GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*GetTournamentResponse, error) {
+	out := new(GetTournamentResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentService/GetTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*ListTournamentsResponse, error) {
+	out := new(ListTournamentsResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentService/ListTournaments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) RegisterForTournament(ctx context.Context, in *RegisterForTournamentRequest, opts ...grpc.CallOption) (*RegisterForTournamentResponse, error) {
+	out := new(RegisterForTournamentResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentService/RegisterForTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TournamentServiceServer is the server API for TournamentService service.
+// All implementations must embed UnimplementedTournamentServiceServer
+// for forward compatibility
+type TournamentServiceServer interface {
+	GetTournament(context.Context, *GetTournamentRequest) (*GetTournamentResponse, error)
+	ListTournaments(context.Context, *ListTournamentsRequest) (*ListTournamentsResponse, error)
+	RegisterForTournament(context.Context, *RegisterForTournamentRequest) (*RegisterForTournamentResponse, error)
+	mustEmbedUnimplementedTournamentServiceServer()
+}
+
+// UnimplementedTournamentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTournamentServiceServer struct {
+}
+
+func (UnimplementedTournamentServiceServer) GetTournament(context.Context, *GetTournamentRequest) (*GetTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) ListTournaments(context.Context, *ListTournamentsRequest) (*ListTournamentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTournaments not implemented")
+}
+func (UnimplementedTournamentServiceServer) RegisterForTournament(context.Context, *RegisterForTournamentRequest) (*RegisterForTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) mustEmbedUnimplementedTournamentServiceServer() {}
+
+// UnsafeTournamentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TournamentServiceServer will
+// result in compilation errors.
+type UnsafeTournamentServiceServer interface {
+	mustEmbedUnimplementedTournamentServiceServer()
+}
+
+func RegisterTournamentServiceServer(s grpc.ServiceRegistrar, srv TournamentServiceServer) {
+	s.RegisterService(&TournamentService_ServiceDesc, srv)
+}
+
+func _TournamentService_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentService/GetTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournament(ctx, req.(*GetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ListTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTournamentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ListTournaments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentService/ListTournaments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ListTournaments(ctx, req.(*ListTournamentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_RegisterForTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).RegisterForTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentService/RegisterForTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).RegisterForTournament(ctx, req.(*RegisterForTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tournament.TournamentService",
+	HandlerType: (*TournamentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTournament",
+			Handler:    _TournamentService_GetTournament_Handler,
+		},
+		{
+			MethodName: "ListTournaments",
+			Handler:    _TournamentService_ListTournaments_Handler,
+		},
+		{
+			MethodName: "RegisterForTournament",
+			Handler:    _TournamentService_RegisterForTournament_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/tournament.proto",
+}
+
+// TournamentAdminServiceClient is the client API for TournamentAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TournamentAdminServiceClient interface {
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error)
+	RecordMatchResult(ctx context.Context, in *RecordMatchResultRequest, opts ...grpc.CallOption) (*RecordMatchResultResponse, error)
+	RecordScore(ctx context.Context, in *RecordScoreRequest, opts ...grpc.CallOption) (*RecordScoreResponse, error)
+}
+
+type tournamentAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTournamentAdminServiceClient(cc grpc.ClientConnInterface) TournamentAdminServiceClient {
+	return &tournamentAdminServiceClient{cc}
+}
+
+func (c *tournamentAdminServiceClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error) {
+	out := new(CreateTournamentResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentAdminService/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentAdminServiceClient) RecordMatchResult(ctx context.Context, in *RecordMatchResultRequest, opts ...grpc.CallOption) (*RecordMatchResultResponse, error) {
+	out := new(RecordMatchResultResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentAdminService/RecordMatchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentAdminServiceClient) RecordScore(ctx context.Context, in *RecordScoreRequest, opts ...grpc.CallOption) (*RecordScoreResponse, error) {
+	out := new(RecordScoreResponse)
+	err := c.cc.Invoke(ctx, "/tournament.TournamentAdminService/RecordScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TournamentAdminServiceServer is the server API for TournamentAdminService service.
+// All implementations must embed UnimplementedTournamentAdminServiceServer
+// for forward compatibility
+type TournamentAdminServiceServer interface {
+	CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error)
+	RecordMatchResult(context.Context, *RecordMatchResultRequest) (*RecordMatchResultResponse, error)
+	RecordScore(context.Context, *RecordScoreRequest) (*RecordScoreResponse, error)
+	mustEmbedUnimplementedTournamentAdminServiceServer()
+}
+
+// UnimplementedTournamentAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTournamentAdminServiceServer struct {
+}
+
+func (UnimplementedTournamentAdminServiceServer) CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedTournamentAdminServiceServer) RecordMatchResult(context.Context, *RecordMatchResultRequest) (*RecordMatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMatchResult not implemented")
+}
+func (UnimplementedTournamentAdminServiceServer) RecordScore(context.Context, *RecordScoreRequest) (*RecordScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordScore not implemented")
+}
+func (UnimplementedTournamentAdminServiceServer) mustEmbedUnimplementedTournamentAdminServiceServer() {
+}
+
+// UnsafeTournamentAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TournamentAdminServiceServer will
+// result in compilation errors.
+type UnsafeTournamentAdminServiceServer interface {
+	mustEmbedUnimplementedTournamentAdminServiceServer()
+}
+
+func RegisterTournamentAdminServiceServer(s grpc.ServiceRegistrar, srv TournamentAdminServiceServer) {
+	s.RegisterService(&TournamentAdminService_ServiceDesc, srv)
+}
+
+func _TournamentAdminService_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentAdminServiceServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentAdminService/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentAdminServiceServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentAdminService_RecordMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentAdminServiceServer).RecordMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentAdminService/RecordMatchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentAdminServiceServer).RecordMatchResult(ctx, req.(*RecordMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentAdminService_RecordScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentAdminServiceServer).RecordScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tournament.TournamentAdminService/RecordScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentAdminServiceServer).RecordScore(ctx, req.(*RecordScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentAdminService_ServiceDesc is the grpc.ServiceDesc for TournamentAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tournament.TournamentAdminService",
+	HandlerType: (*TournamentAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTournament",
+			Handler:    _TournamentAdminService_CreateTournament_Handler,
+		},
+		{
+			MethodName: "RecordMatchResult",
+			Handler:    _TournamentAdminService_RecordMatchResult_Handler,
+		},
+		{
+			MethodName: "RecordScore",
+			Handler:    _TournamentAdminService_RecordScore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/tournament.proto",
+}
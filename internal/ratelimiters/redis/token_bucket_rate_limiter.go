@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+const keyPrefix = "ratelimit:"
+
+// tokenBucketScript refills the bucket stored at KEYS[1] based on the time
+// elapsed since its last update and takes a single token from it. The redis
+// server clock is used so that every replica of the service agrees on time.
+//
+// ARGV[1] is the capacity of the bucket, ARGV[2] is the number of
+// milliseconds it takes to refill one token.
+//
+// It returns {allowed, remaining tokens, retry after in milliseconds}.
+var tokenBucketScript = redis.NewScript(`
+redis.replicate_commands()
+
+local capacity = tonumber(ARGV[1])
+local refill_interval = tonumber(ARGV[2])
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1])
+local updated_at = tonumber(bucket[2])
+
+if tokens == nil or updated_at == nil then
+	tokens = capacity
+	updated_at = now
+end
+
+local refilled = math.floor((now - updated_at) / refill_interval)
+if refilled > 0 then
+	tokens = math.min(capacity, tokens + refilled)
+	updated_at = updated_at + refilled * refill_interval
+end
+
+if tokens >= capacity then
+	updated_at = now
+end
+
+local allowed = 0
+local retry_after = 0
+
+if tokens > 0 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = refill_interval - (now - updated_at)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tokens, "updated_at", updated_at)
+redis.call("PEXPIRE", KEYS[1], capacity * refill_interval)
+
+return {allowed, tokens, retry_after}
+`)
+
+// refundScript gives a token back to the bucket stored at KEYS[1] when it
+// still exists. ARGV[1] is the capacity of the bucket.
+var refundScript = redis.NewScript(`
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+
+if tokens == nil then
+	return 0
+end
+
+redis.call("HSET", KEYS[1], "tokens", math.min(tonumber(ARGV[1]), tokens + 1))
+
+return 1
+`)
+
+type RedisRateLimiterDependencies struct {
+	Client *redis.Client
+}
+
+type RedisRateLimiter struct {
+	client *redis.Client
+}
+
+func NewRedisRateLimiter(deps RedisRateLimiterDependencies) *RedisRateLimiter {
+	return &RedisRateLimiter{
+		client: deps.Client,
+	}
+}
+
+func (limiter *RedisRateLimiter) Allow(ctx context.Context, key string, limit domain.RateLimit) (domain.RateLimitResult, error) {
+	result, err := tokenBucketScript.Run(
+		ctx,
+		limiter.client,
+		[]string{keyPrefix + key},
+		limit.Capacity,
+		limit.RefillInterval.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return domain.RateLimitResult{}, err
+	}
+
+	if len(result) != 3 {
+		return domain.RateLimitResult{}, fmt.Errorf("%w, unexpected rate limit script result: %v", domain.ErrInternal, result)
+	}
+
+	return domain.RateLimitResult{
+		Allowed:    result[0] == 1,
+		Remaining:  result[1],
+		RetryAfter: time.Duration(result[2]) * time.Millisecond,
+	}, nil
+}
+
+func (limiter *RedisRateLimiter) Refund(ctx context.Context, key string, limit domain.RateLimit) error {
+	err := refundScript.Run(ctx, limiter.client, []string{keyPrefix + key}, limit.Capacity).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+)
+
+type RedisRateLimiterTestSuite struct {
+	suite.Suite
+
+	rateLimiter *RedisRateLimiter
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisRateLimiterTestSuite))
+}
+
+func (suite *RedisRateLimiterTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.rateLimiter = NewRedisRateLimiter(RedisRateLimiterDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisRateLimiterTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisRateLimiterTestSuite) TestAllow() {
+	suite.redisMock.
+		ExpectEvalSha(tokenBucketScript.Hash(), []string{"ratelimit:some-key"}, int64(10), int64(1000)).
+		SetVal([]interface{}{int64(1), int64(9), int64(0)})
+
+	result, err := suite.rateLimiter.Allow(context.Background(), "some-key", domain.RateLimit{
+		Capacity:       10,
+		RefillInterval: time.Second,
+	})
+	suite.NoError(err)
+
+	suite.Equal(domain.RateLimitResult{
+		Allowed:   true,
+		Remaining: 9,
+	}, result)
+}
+
+func (suite *RedisRateLimiterTestSuite) TestAllow_Exhausted() {
+	suite.redisMock.
+		ExpectEvalSha(tokenBucketScript.Hash(), []string{"ratelimit:some-key"}, int64(10), int64(1000)).
+		SetVal([]interface{}{int64(0), int64(0), int64(250)})
+
+	result, err := suite.rateLimiter.Allow(context.Background(), "some-key", domain.RateLimit{
+		Capacity:       10,
+		RefillInterval: time.Second,
+	})
+	suite.NoError(err)
+
+	suite.Equal(domain.RateLimitResult{
+		Allowed:    false,
+		Remaining:  0,
+		RetryAfter: 250 * time.Millisecond,
+	}, result)
+}
+
+func (suite *RedisRateLimiterTestSuite) TestAllow_ScriptFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectEvalSha(tokenBucketScript.Hash(), []string{"ratelimit:some-key"}, int64(10), int64(1000)).
+		SetErr(someError)
+
+	_, err := suite.rateLimiter.Allow(context.Background(), "some-key", domain.RateLimit{
+		Capacity:       10,
+		RefillInterval: time.Second,
+	})
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisRateLimiterTestSuite) TestAllow_UnexpectedResult() {
+	suite.redisMock.
+		ExpectEvalSha(tokenBucketScript.Hash(), []string{"ratelimit:some-key"}, int64(10), int64(1000)).
+		SetVal([]interface{}{int64(1)})
+
+	_, err := suite.rateLimiter.Allow(context.Background(), "some-key", domain.RateLimit{
+		Capacity:       10,
+		RefillInterval: time.Second,
+	})
+	suite.ErrorIs(err, domain.ErrInternal)
+}
+
+func (suite *RedisRateLimiterTestSuite) TestRefund() {
+	suite.redisMock.
+		ExpectEvalSha(refundScript.Hash(), []string{"ratelimit:some-key"}, int64(10)).
+		SetVal(int64(1))
+
+	err := suite.rateLimiter.Refund(context.Background(), "some-key", domain.RateLimit{
+		Capacity:       10,
+		RefillInterval: time.Second,
+	})
+	suite.NoError(err)
+}
@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+)
+
+// userAchievementRecord is stored with the user and the achievement ID as
+// its ID, so an achievement can only be unlocked once.
+type userAchievementRecord struct {
+	ID            string    `bson:"_id"`
+	UserID        string    `bson:"userID"`
+	AchievementID string    `bson:"achievementID"`
+	UnlockedAt    time.Time `bson:"unlockedAt"`
+}
+
+// achievementProgressRecord is stored with the user ID as its ID.
+type achievementProgressRecord struct {
+	UserID      string `bson:"_id"`
+	Submissions int64  `bson:"submissions"`
+}
@@ -0,0 +1,147 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+type MongoAchievementRepositoryDependencies struct {
+	UserAchievementsCollection    *mongo.Collection
+	AchievementProgressCollection *mongo.Collection
+}
+
+type MongoAchievementRepository struct {
+	userAchievementsCollection    *mongo.Collection
+	achievementProgressCollection *mongo.Collection
+}
+
+func NewMongoAchievementRepository(deps MongoAchievementRepositoryDependencies) *MongoAchievementRepository {
+	return &MongoAchievementRepository{
+		userAchievementsCollection:    deps.UserAchievementsCollection,
+		achievementProgressCollection: deps.AchievementProgressCollection,
+	}
+}
+
+func (repo *MongoAchievementRepository) IncrementSubmissions(ctx context.Context, userID string) (int64, error) {
+	var record achievementProgressRecord
+
+	err := repo.achievementProgressCollection.FindOneAndUpdate(ctx, bson.M{
+		"_id": userID,
+	}, bson.M{
+		"$inc": bson.M{
+			"submissions": 1,
+		},
+	}, options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After),
+	).Decode(&record)
+	if err != nil {
+		return 0, err
+	}
+
+	return record.Submissions, nil
+}
+
+func (repo *MongoAchievementRepository) GetSubmissions(ctx context.Context, userID string) (int64, error) {
+	var record achievementProgressRecord
+
+	err := repo.achievementProgressCollection.FindOne(ctx, bson.M{
+		"_id": userID,
+	}).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return record.Submissions, nil
+}
+
+// Unlock upserts the achievements with $setOnInsert, so an achievement that
+// is unlocked again keeps the time it has been unlocked at first.
+func (repo *MongoAchievementRepository) Unlock(ctx context.Context, achievements []domain.UserAchievement) (int64, error) {
+	if len(achievements) == 0 {
+		return 0, nil
+	}
+
+	var models []mongo.WriteModel
+
+	for _, achievement := range achievements {
+		id := userAchievementID(achievement.UserID, achievement.AchievementID)
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": id}).
+			SetUpdate(bson.M{"$setOnInsert": userAchievementRecord{
+				ID:            id,
+				UserID:        achievement.UserID,
+				AchievementID: achievement.AchievementID,
+				UnlockedAt:    achievement.UnlockedAt,
+			}}).
+			SetUpsert(true))
+	}
+
+	result, err := repo.userAchievementsCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.UpsertedCount, nil
+}
+
+func (repo *MongoAchievementRepository) ListByUserID(ctx context.Context, userID string) ([]domain.UserAchievement, error) {
+	cursor, err := repo.userAchievementsCollection.Find(ctx, bson.M{
+		"userID": userID,
+	}, options.Find().SetSort(bson.D{{Key: "unlockedAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var achievements []domain.UserAchievement
+
+	for cursor.Next(ctx) {
+		var record userAchievementRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		achievements = append(achievements, domain.UserAchievement{
+			UserID:        record.UserID,
+			AchievementID: record.AchievementID,
+			UnlockedAt:    record.UnlockedAt,
+		})
+	}
+
+	return achievements, nil
+}
+
+func (repo *MongoAchievementRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := repo.userAchievementsCollection.DeleteMany(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.achievementProgressCollection.DeleteOne(ctx, bson.M{
+		"_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func userAchievementID(userID, achievementID string) string {
+	return userID + ":" + achievementID
+}
@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type auditEventRecord struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Type      string             `bson:"type"`
+	Actor     string             `bson:"actor"`
+	UserID    string             `bson:"userID"`
+	PeerIP    string             `bson:"peerIP"`
+	RequestID string             `bson:"requestID"`
+	OldScore  *float64           `bson:"oldScore,omitempty"`
+	NewScore  *float64           `bson:"newScore,omitempty"`
+	Details   map[string]string  `bson:"details,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
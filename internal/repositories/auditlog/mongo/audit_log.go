@@ -0,0 +1,188 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+const defaultQueryLimit = 100
+
+type MongoAuditLogDependencies struct {
+	AuditEventsCollection *mongo.Collection
+}
+
+type MongoAuditLog struct {
+	auditEventsCollection *mongo.Collection
+}
+
+func NewMongoAuditLog(deps MongoAuditLogDependencies) *MongoAuditLog {
+	return &MongoAuditLog{
+		auditEventsCollection: deps.AuditEventsCollection,
+	}
+}
+
+func (auditLog *MongoAuditLog) Record(ctx context.Context, event domain.AuditEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	_, err := auditLog.auditEventsCollection.InsertOne(ctx, auditEventRecord{
+		Type:      event.Type,
+		Actor:     event.Actor,
+		UserID:    event.UserID,
+		PeerIP:    event.PeerIP,
+		RequestID: event.RequestID,
+		OldScore:  event.OldScore,
+		NewScore:  event.NewScore,
+		Details:   event.Details,
+		CreatedAt: event.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (auditLog *MongoAuditLog) Query(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditEvent, error) {
+	query := bson.M{}
+
+	if filter.UserID != "" {
+		query["userID"] = filter.UserID
+	}
+
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+
+	createdAt := bson.M{}
+
+	if !filter.From.IsZero() {
+		createdAt["$gte"] = filter.From
+	}
+
+	if !filter.To.IsZero() {
+		createdAt["$lt"] = filter.To
+	}
+
+	if len(createdAt) > 0 {
+		query["createdAt"] = createdAt
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+
+	cursor, err := auditLog.auditEventsCollection.Find(ctx, query, options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetLimit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeEvents(ctx, cursor)
+}
+
+func (auditLog *MongoAuditLog) GetUserEvents(ctx context.Context, userID string) ([]domain.AuditEvent, error) {
+	cursor, err := auditLog.auditEventsCollection.Find(ctx, bson.M{
+		"$or": []bson.M{
+			{"userID": userID},
+			{"actor": userID},
+		},
+	}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeEvents(ctx, cursor)
+}
+
+func (auditLog *MongoAuditLog) AnonymizeUser(ctx context.Context, userID, anonymousID string) error {
+	// the logins and the registration of a user used to be recorded with
+	// its username as the actor, they are only ever performed by the user
+	// itself so their actor is anonymized by their type.
+	_, err := auditLog.auditEventsCollection.UpdateMany(ctx, bson.M{
+		"userID": userID,
+		"type": bson.M{
+			"$in": []string{
+				domain.AuditEventLoginSucceeded,
+				domain.AuditEventLoginFailed,
+				domain.AuditEventUserRegistered,
+			},
+		},
+	}, bson.M{
+		"$set": bson.M{
+			"actor": anonymousID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = auditLog.auditEventsCollection.UpdateMany(ctx, bson.M{
+		"userID": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"userID": anonymousID,
+			"peerIP": "",
+		},
+		"$unset": bson.M{
+			"details.username":    "",
+			"details.oldUsername": "",
+			"details.newUsername": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = auditLog.auditEventsCollection.UpdateMany(ctx, bson.M{
+		"actor": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"actor":  anonymousID,
+			"peerIP": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func decodeEvents(ctx context.Context, cursor *mongo.Cursor) ([]domain.AuditEvent, error) {
+	var events []domain.AuditEvent
+
+	for cursor.Next(ctx) {
+		var record auditEventRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, domain.AuditEvent{
+			ID:        record.ID.Hex(),
+			Type:      record.Type,
+			Actor:     record.Actor,
+			UserID:    record.UserID,
+			PeerIP:    record.PeerIP,
+			RequestID: record.RequestID,
+			OldScore:  record.OldScore,
+			NewScore:  record.NewScore,
+			Details:   record.Details,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
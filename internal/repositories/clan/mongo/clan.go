@@ -0,0 +1,25 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// clanRecord keeps the lower cased name in NameKey, names are unique
+// regardless of their case through the unique index of NameKey.
+type clanRecord struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Name      string             `bson:"name"`
+	NameKey   string             `bson:"nameKey"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// clanMemberRecord is keyed by the user ID, so a user can only be a member
+// of a single clan.
+type clanMemberRecord struct {
+	UserID   string    `bson:"_id"`
+	ClanID   string    `bson:"clanID"`
+	Role     string    `bson:"role"`
+	JoinedAt time.Time `bson:"joinedAt"`
+}
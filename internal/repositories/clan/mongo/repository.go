@@ -0,0 +1,282 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidID = fmt.Errorf("%w, invalid record ID", domain.ErrInternal)
+)
+
+type MongoClanRepositoryDependencies struct {
+	ClansCollection       *mongo.Collection
+	ClanMembersCollection *mongo.Collection
+}
+
+type MongoClanRepository struct {
+	clansCollection       *mongo.Collection
+	clanMembersCollection *mongo.Collection
+}
+
+func NewMongoClanRepository(deps MongoClanRepositoryDependencies) *MongoClanRepository {
+	return &MongoClanRepository{
+		clansCollection:       deps.ClansCollection,
+		clanMembersCollection: deps.ClanMembersCollection,
+	}
+}
+
+// CreateIndexes creates the unique index of the lower cased names, which
+// makes Create return ErrResourceExists when the name is taken.
+func (repo *MongoClanRepository) CreateIndexes(ctx context.Context) error {
+	_, err := repo.clansCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "nameKey", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoClanRepository) Create(ctx context.Context, clan domain.Clan) (domain.Clan, error) {
+	result, err := repo.clansCollection.InsertOne(ctx, clanRecord{
+		Name:      clan.Name,
+		NameKey:   strings.ToLower(clan.Name),
+		CreatedAt: clan.CreatedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.Clan{}, domain.ErrResourceExists
+		}
+
+		return domain.Clan{}, err
+	}
+
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return domain.Clan{}, ErrInvalidID
+	}
+
+	clan.ID = id.Hex()
+
+	return clan, nil
+}
+
+func (repo *MongoClanRepository) GetByID(ctx context.Context, id string) (domain.Clan, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.Clan{}, domain.ErrResourceNotFound
+	}
+
+	return repo.findOne(ctx, bson.M{
+		"_id": objectID,
+	})
+}
+
+func (repo *MongoClanRepository) GetByName(ctx context.Context, name string) (domain.Clan, error) {
+	return repo.findOne(ctx, bson.M{
+		"nameKey": strings.ToLower(name),
+	})
+}
+
+func (repo *MongoClanRepository) GetByIDs(ctx context.Context, ids []string) ([]domain.Clan, error) {
+	objectIDs := make([]primitive.ObjectID, len(ids))
+
+	for i, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+
+		objectIDs[i] = objectID
+	}
+
+	cursor, err := repo.clansCollection.Find(ctx, bson.M{
+		"_id": bson.M{
+			"$in": objectIDs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var clans []domain.Clan
+
+	for cursor.Next(ctx) {
+		var record clanRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		clans = append(clans, toClan(record))
+	}
+
+	return clans, nil
+}
+
+func (repo *MongoClanRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := repo.clansCollection.DeleteOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoClanRepository) AddMember(ctx context.Context, member domain.ClanMember) error {
+	_, err := repo.clanMembersCollection.InsertOne(ctx, clanMemberRecord{
+		UserID:   member.UserID,
+		ClanID:   member.ClanID,
+		Role:     member.Role,
+		JoinedAt: member.JoinedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrResourceExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoClanRepository) GetMember(ctx context.Context, userID string) (domain.ClanMember, error) {
+	result := repo.clanMembersCollection.FindOne(ctx, bson.M{
+		"_id": userID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.ClanMember{}, domain.ErrResourceNotFound
+		}
+
+		return domain.ClanMember{}, result.Err()
+	}
+
+	var record clanMemberRecord
+
+	err := result.Decode(&record)
+	if err != nil {
+		return domain.ClanMember{}, err
+	}
+
+	return toClanMember(record), nil
+}
+
+func (repo *MongoClanRepository) ListMembers(ctx context.Context, clanID string) ([]domain.ClanMember, error) {
+	cursor, err := repo.clanMembersCollection.Find(ctx, bson.M{
+		"clanID": clanID,
+	}, options.Find().SetSort(bson.D{{Key: "joinedAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var members []domain.ClanMember
+
+	for cursor.Next(ctx) {
+		var record clanMemberRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, toClanMember(record))
+	}
+
+	return members, nil
+}
+
+func (repo *MongoClanRepository) UpdateMemberRole(ctx context.Context, userID, role string) error {
+	result, err := repo.clanMembersCollection.UpdateOne(ctx, bson.M{
+		"_id": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"role": role,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoClanRepository) RemoveMember(ctx context.Context, userID string) error {
+	result, err := repo.clanMembersCollection.DeleteOne(ctx, bson.M{
+		"_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoClanRepository) findOne(ctx context.Context, filter bson.M) (domain.Clan, error) {
+	result := repo.clansCollection.FindOne(ctx, filter)
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.Clan{}, domain.ErrResourceNotFound
+		}
+
+		return domain.Clan{}, result.Err()
+	}
+
+	var record clanRecord
+
+	err := result.Decode(&record)
+	if err != nil {
+		return domain.Clan{}, err
+	}
+
+	return toClan(record), nil
+}
+
+func toClan(record clanRecord) domain.Clan {
+	return domain.Clan{
+		ID:        record.ID.Hex(),
+		Name:      record.Name,
+		CreatedAt: record.CreatedAt,
+	}
+}
+
+func toClanMember(record clanMemberRecord) domain.ClanMember {
+	return domain.ClanMember{
+		ClanID:   record.ClanID,
+		UserID:   record.UserID,
+		Role:     record.Role,
+		JoinedAt: record.JoinedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type erasureRecordRecord struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	SubjectHash string             `bson:"subjectHash"`
+	AnonymousID string             `bson:"anonymousID"`
+	RequestedBy string             `bson:"requestedBy"`
+	Status      string             `bson:"status"`
+	Steps       []string           `bson:"steps"`
+	Error       string             `bson:"error,omitempty"`
+	RequestedAt time.Time          `bson:"requestedAt"`
+	CompletedAt time.Time          `bson:"completedAt"`
+	Signature   []byte             `bson:"signature,omitempty"`
+}
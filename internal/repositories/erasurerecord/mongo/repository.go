@@ -0,0 +1,119 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidID = fmt.Errorf("%w, invalid record ID", domain.ErrInternal)
+)
+
+type MongoErasureRecordRepositoryDependencies struct {
+	ErasureRecordsCollection *mongo.Collection
+}
+
+type MongoErasureRecordRepository struct {
+	erasureRecordsCollection *mongo.Collection
+}
+
+func NewMongoErasureRecordRepository(deps MongoErasureRecordRepositoryDependencies) *MongoErasureRecordRepository {
+	return &MongoErasureRecordRepository{
+		erasureRecordsCollection: deps.ErasureRecordsCollection,
+	}
+}
+
+func (repo *MongoErasureRecordRepository) Create(ctx context.Context, record domain.ErasureRecord) (domain.ErasureRecord, error) {
+	result, err := repo.erasureRecordsCollection.InsertOne(ctx, toErasureRecordRecord(record))
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return domain.ErasureRecord{}, ErrInvalidID
+	}
+
+	record.ID = id.Hex()
+
+	return record, nil
+}
+
+func (repo *MongoErasureRecordRepository) Update(ctx context.Context, record domain.ErasureRecord) error {
+	objectID, err := primitive.ObjectIDFromHex(record.ID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := repo.erasureRecordsCollection.ReplaceOne(ctx, bson.M{
+		"_id": objectID,
+	}, toErasureRecordRecord(record))
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoErasureRecordRepository) GetByID(ctx context.Context, id string) (domain.ErasureRecord, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErasureRecord{}, ErrInvalidID
+	}
+
+	result := repo.erasureRecordsCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.ErasureRecord{}, domain.ErrResourceNotFound
+		}
+
+		return domain.ErasureRecord{}, result.Err()
+	}
+
+	var record erasureRecordRecord
+
+	err = result.Decode(&record)
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	return domain.ErasureRecord{
+		ID:          record.ID.Hex(),
+		SubjectHash: record.SubjectHash,
+		AnonymousID: record.AnonymousID,
+		RequestedBy: record.RequestedBy,
+		Status:      record.Status,
+		Steps:       record.Steps,
+		Error:       record.Error,
+		RequestedAt: record.RequestedAt,
+		CompletedAt: record.CompletedAt,
+		Signature:   record.Signature,
+	}, nil
+}
+
+// toErasureRecordRecord leaves the ID empty, it is the filter of updates.
+func toErasureRecordRecord(record domain.ErasureRecord) erasureRecordRecord {
+	return erasureRecordRecord{
+		SubjectHash: record.SubjectHash,
+		AnonymousID: record.AnonymousID,
+		RequestedBy: record.RequestedBy,
+		Status:      record.Status,
+		Steps:       record.Steps,
+		Error:       record.Error,
+		RequestedAt: record.RequestedAt,
+		CompletedAt: record.CompletedAt,
+		Signature:   record.Signature,
+	}
+}
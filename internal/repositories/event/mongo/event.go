@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// eventRecord omits frozenAt until the board of the event is frozen, so the
+// events left to freeze are the ended ones without it.
+type eventRecord struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Mode        string             `bson:"mode"`
+	StartsAt    time.Time          `bson:"startsAt"`
+	EndsAt      time.Time          `bson:"endsAt"`
+	MaxAttempts int64              `bson:"maxAttempts"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	FrozenAt    time.Time          `bson:"frozenAt,omitempty"`
+}
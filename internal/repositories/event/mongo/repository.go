@@ -0,0 +1,159 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidID = fmt.Errorf("%w, invalid record ID", domain.ErrInternal)
+)
+
+type MongoEventRepositoryDependencies struct {
+	EventsCollection *mongo.Collection
+}
+
+type MongoEventRepository struct {
+	eventsCollection *mongo.Collection
+}
+
+func NewMongoEventRepository(deps MongoEventRepositoryDependencies) *MongoEventRepository {
+	return &MongoEventRepository{
+		eventsCollection: deps.EventsCollection,
+	}
+}
+
+func (repo *MongoEventRepository) Create(ctx context.Context, event domain.Event) (domain.Event, error) {
+	result, err := repo.eventsCollection.InsertOne(ctx, toEventRecord(event))
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return domain.Event{}, ErrInvalidID
+	}
+
+	event.ID = id.Hex()
+
+	return event, nil
+}
+
+func (repo *MongoEventRepository) GetByID(ctx context.Context, id string) (domain.Event, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.Event{}, domain.ErrResourceNotFound
+	}
+
+	result := repo.eventsCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.Event{}, domain.ErrResourceNotFound
+		}
+
+		return domain.Event{}, result.Err()
+	}
+
+	var record eventRecord
+
+	err = result.Decode(&record)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return toEvent(record), nil
+}
+
+func (repo *MongoEventRepository) ListCurrent(ctx context.Context, now time.Time) ([]domain.Event, error) {
+	return repo.find(ctx, bson.M{
+		"endsAt": bson.M{"$gt": now},
+	})
+}
+
+func (repo *MongoEventRepository) ListEnded(ctx context.Context, now time.Time) ([]domain.Event, error) {
+	return repo.find(ctx, bson.M{
+		"endsAt":   bson.M{"$lte": now},
+		"frozenAt": bson.M{"$exists": false},
+	})
+}
+
+func (repo *MongoEventRepository) SetFrozen(ctx context.Context, id string, frozenAt time.Time) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrResourceNotFound
+	}
+
+	result, err := repo.eventsCollection.UpdateOne(ctx, bson.M{
+		"_id": objectID,
+	}, bson.M{
+		"$set": bson.M{
+			"frozenAt": frozenAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoEventRepository) find(ctx context.Context, filter bson.M) ([]domain.Event, error) {
+	cursor, err := repo.eventsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "startsAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.Event
+
+	for cursor.Next(ctx) {
+		var record eventRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, toEvent(record))
+	}
+
+	return events, nil
+}
+
+func toEventRecord(event domain.Event) eventRecord {
+	return eventRecord{
+		Name:        event.Name,
+		Mode:        event.Mode,
+		StartsAt:    event.StartsAt,
+		EndsAt:      event.EndsAt,
+		MaxAttempts: event.MaxAttempts,
+		CreatedAt:   event.CreatedAt,
+		FrozenAt:    event.FrozenAt,
+	}
+}
+
+func toEvent(record eventRecord) domain.Event {
+	return domain.Event{
+		ID:          record.ID.Hex(),
+		Name:        record.Name,
+		Mode:        record.Mode,
+		StartsAt:    record.StartsAt,
+		EndsAt:      record.EndsAt,
+		MaxAttempts: record.MaxAttempts,
+		CreatedAt:   record.CreatedAt,
+		FrozenAt:    record.FrozenAt,
+	}
+}
@@ -0,0 +1,33 @@
+package mongo
+
+import (
+	"time"
+
+	"game/internal/domain"
+)
+
+type friendshipRecord struct {
+	ID          string    `bson:"_id"`
+	RequesterID string    `bson:"requesterID"`
+	AddresseeID string    `bson:"addresseeID"`
+	Status      string    `bson:"status"`
+	CreatedAt   time.Time `bson:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// friendshipRecordID returns the ID of the record of the friendship. A
+// pending or accepted friendship is keyed by the ordered pair of users and a
+// block by its direction, so a second record for the same relation can not
+// be inserted.
+func friendshipRecordID(friendship domain.Friendship) string {
+	if friendship.Status == domain.FriendshipStatusBlocked {
+		return "block:" + friendship.RequesterID + ":" + friendship.AddresseeID
+	}
+
+	userID, otherUserID := friendship.RequesterID, friendship.AddresseeID
+	if otherUserID < userID {
+		userID, otherUserID = otherUserID, userID
+	}
+
+	return "friend:" + userID + ":" + otherUserID
+}
@@ -0,0 +1,147 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+type MongoFriendshipRepositoryDependencies struct {
+	FriendshipsCollection *mongo.Collection
+}
+
+type MongoFriendshipRepository struct {
+	friendshipsCollection *mongo.Collection
+}
+
+func NewMongoFriendshipRepository(deps MongoFriendshipRepositoryDependencies) *MongoFriendshipRepository {
+	return &MongoFriendshipRepository{
+		friendshipsCollection: deps.FriendshipsCollection,
+	}
+}
+
+func (repo *MongoFriendshipRepository) Create(ctx context.Context, friendship domain.Friendship) (domain.Friendship, error) {
+	id := friendshipRecordID(friendship)
+
+	_, err := repo.friendshipsCollection.InsertOne(ctx, friendshipRecord{
+		ID:          id,
+		RequesterID: friendship.RequesterID,
+		AddresseeID: friendship.AddresseeID,
+		Status:      friendship.Status,
+		CreatedAt:   friendship.CreatedAt,
+		UpdatedAt:   friendship.UpdatedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.Friendship{}, domain.ErrResourceExists
+		}
+
+		return domain.Friendship{}, err
+	}
+
+	friendship.ID = id
+
+	return friendship, nil
+}
+
+func (repo *MongoFriendshipRepository) UpdateStatus(ctx context.Context, id, status string) error {
+	result, err := repo.friendshipsCollection.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$set": bson.M{
+			"status":    status,
+			"updatedAt": time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoFriendshipRepository) Delete(ctx context.Context, id string) error {
+	result, err := repo.friendshipsCollection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoFriendshipRepository) ListBetween(ctx context.Context, userID, otherUserID string) ([]domain.Friendship, error) {
+	return repo.find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"requesterID": userID, "addresseeID": otherUserID},
+			bson.M{"requesterID": otherUserID, "addresseeID": userID},
+		},
+	})
+}
+
+func (repo *MongoFriendshipRepository) ListByUserID(ctx context.Context, userID, status string) ([]domain.Friendship, error) {
+	return repo.find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"requesterID": userID},
+			bson.M{"addresseeID": userID},
+		},
+		"status": status,
+	})
+}
+
+func (repo *MongoFriendshipRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := repo.friendshipsCollection.DeleteMany(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"requesterID": userID},
+			bson.M{"addresseeID": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoFriendshipRepository) find(ctx context.Context, filter bson.M) ([]domain.Friendship, error) {
+	cursor, err := repo.friendshipsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var friendships []domain.Friendship
+
+	for cursor.Next(ctx) {
+		var record friendshipRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		friendships = append(friendships, domain.Friendship{
+			ID:          record.ID,
+			RequesterID: record.RequesterID,
+			AddresseeID: record.AddresseeID,
+			Status:      record.Status,
+			CreatedAt:   record.CreatedAt,
+			UpdatedAt:   record.UpdatedAt,
+		})
+	}
+
+	return friendships, nil
+}
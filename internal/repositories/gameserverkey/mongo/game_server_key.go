@@ -0,0 +1,10 @@
+package mongo
+
+import "time"
+
+type gameServerKeyRecord struct {
+	ServerID  string    `bson:"_id"`
+	Algorithm string    `bson:"algorithm"`
+	Key       []byte    `bson:"key"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
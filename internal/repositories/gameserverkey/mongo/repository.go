@@ -0,0 +1,110 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"game/internal/domain"
+)
+
+type MongoGameServerKeyRepositoryDependencies struct {
+	GameServerKeysCollection *mongo.Collection
+}
+
+type MongoGameServerKeyRepository struct {
+	gameServerKeysCollection *mongo.Collection
+}
+
+func NewMongoGameServerKeyRepository(deps MongoGameServerKeyRepositoryDependencies) *MongoGameServerKeyRepository {
+	return &MongoGameServerKeyRepository{
+		gameServerKeysCollection: deps.GameServerKeysCollection,
+	}
+}
+
+func (repo *MongoGameServerKeyRepository) Create(ctx context.Context, key domain.GameServerKey) (domain.GameServerKey, error) {
+	_, err := repo.gameServerKeysCollection.InsertOne(ctx, gameServerKeyRecord{
+		ServerID:  key.ServerID,
+		Algorithm: key.Algorithm,
+		Key:       key.Key,
+		CreatedAt: key.CreatedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.GameServerKey{}, domain.ErrResourceExists
+		}
+
+		return domain.GameServerKey{}, err
+	}
+
+	return key, nil
+}
+
+func (repo *MongoGameServerKeyRepository) GetByServerID(ctx context.Context, serverID string) (domain.GameServerKey, error) {
+	result := repo.gameServerKeysCollection.FindOne(ctx, bson.M{
+		"_id": serverID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.GameServerKey{}, domain.ErrResourceNotFound
+		}
+
+		return domain.GameServerKey{}, result.Err()
+	}
+
+	var record gameServerKeyRecord
+
+	err := result.Decode(&record)
+	if err != nil {
+		return domain.GameServerKey{}, err
+	}
+
+	return toGameServerKey(record), nil
+}
+
+func (repo *MongoGameServerKeyRepository) List(ctx context.Context) ([]domain.GameServerKey, error) {
+	cursor, err := repo.gameServerKeysCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []domain.GameServerKey
+
+	for cursor.Next(ctx) {
+		var record gameServerKeyRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, toGameServerKey(record))
+	}
+
+	return keys, nil
+}
+
+func (repo *MongoGameServerKeyRepository) Delete(ctx context.Context, serverID string) error {
+	result, err := repo.gameServerKeysCollection.DeleteOne(ctx, bson.M{
+		"_id": serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func toGameServerKey(record gameServerKeyRecord) domain.GameServerKey {
+	return domain.GameServerKey{
+		ServerID:  record.ServerID,
+		Algorithm: record.Algorithm,
+		Key:       record.Key,
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -0,0 +1,326 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+// Every queue is a sorted set of the waiting players scored by the time
+// they have joined at, the entries of the players are kept in keys of their
+// own that expire when the players have waited too long. The ticket of a
+// match is kept for every player of the match until it expires.
+const (
+	queueKeyPrefix = "matchmaking:queue:"
+	entryKeyPrefix = "matchmaking:entry:"
+	matchKeyPrefix = "matchmaking:match:"
+)
+
+func queueKey(queue domain.MatchQueue) string {
+	return queueKeyPrefix + queue.Mode + ":" + queue.Region + ":" + strconv.FormatInt(queue.MatchSize, 10)
+}
+
+func entryKey(userID string) string {
+	return entryKeyPrefix + userID
+}
+
+func matchKey(userID string) string {
+	return matchKeyPrefix + userID
+}
+
+type entryRecord struct {
+	Mode      string  `json:"mode"`
+	Region    string  `json:"region"`
+	MatchSize int64   `json:"matchSize"`
+	Rating    float64 `json:"rating"`
+	// JoinedAt is a unix time in milliseconds.
+	JoinedAt int64 `json:"joinedAt"`
+}
+
+type ticketRecord struct {
+	MatchID   string   `json:"matchID"`
+	Mode      string   `json:"mode"`
+	Region    string   `json:"region"`
+	UserIDs   []string `json:"userIDs"`
+	CreatedAt int64    `json:"createdAt"`
+	ExpiresAt int64    `json:"expiresAt"`
+	Signature []byte   `json:"signature"`
+}
+
+// enqueueScript adds the player to the queue unless it already has an
+// entry, it returns 0 then. The arguments are the entry record, its TTL in
+// milliseconds, the user ID and the time the player has joined at.
+const enqueueScriptSource = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+
+redis.call("DEL", KEYS[3])
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+redis.call("ZADD", KEYS[2], ARGV[4], ARGV[3])
+
+return 1
+`
+
+var enqueueScript = redis.NewScript(enqueueScriptSource)
+
+// dequeueScript removes the player from the queue its entry is in, it
+// returns 0 when the player is not queued. The queue is read from the entry
+// in the script so that it is never the one of a stale entry. The arguments
+// are the queue key prefix and the user ID.
+const dequeueScriptSource = `
+local value = redis.call("GET", KEYS[1])
+if not value then
+	return 0
+end
+
+local record = cjson.decode(value)
+
+redis.call("DEL", KEYS[1])
+redis.call("ZREM", ARGV[1] .. record.mode .. ":" .. record.region .. ":" .. string.format("%d", record.matchSize), ARGV[2])
+
+return 1
+`
+
+var dequeueScript = redis.NewScript(dequeueScriptSource)
+
+// createMatchScript stores the ticket for the players only when every one
+// of them is still in the queue, it returns 0 otherwise. The keys are the
+// queue followed by the entry and the match key of every player, the
+// arguments are the ticket record, its TTL in milliseconds and the user IDs.
+const createMatchScriptSource = `
+for i = 3, #ARGV do
+	local base = (i - 3) * 2 + 2
+
+	if not redis.call("ZSCORE", KEYS[1], ARGV[i]) or redis.call("EXISTS", KEYS[base]) == 0 then
+		return 0
+	end
+end
+
+for i = 3, #ARGV do
+	local base = (i - 3) * 2 + 2
+
+	redis.call("ZREM", KEYS[1], ARGV[i])
+	redis.call("DEL", KEYS[base])
+	redis.call("SET", KEYS[base + 1], ARGV[1], "PX", ARGV[2])
+end
+
+return 1
+`
+
+var createMatchScript = redis.NewScript(createMatchScriptSource)
+
+type RedisMatchmakingRepositoryDependencies struct {
+	Client *redis.Client
+}
+
+type RedisMatchmakingRepository struct {
+	client *redis.Client
+}
+
+func NewRedisMatchmakingRepository(deps RedisMatchmakingRepositoryDependencies) *RedisMatchmakingRepository {
+	return &RedisMatchmakingRepository{
+		client: deps.Client,
+	}
+}
+
+func (repo *RedisMatchmakingRepository) Enqueue(ctx context.Context, entry domain.QueueEntry, ttl time.Duration) error {
+	record, err := json.Marshal(entryRecord{
+		Mode:      entry.Queue.Mode,
+		Region:    entry.Queue.Region,
+		MatchSize: entry.Queue.MatchSize,
+		Rating:    entry.Rating,
+		JoinedAt:  entry.JoinedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	enqueued, err := enqueueScript.Run(ctx, repo.client, []string{
+		entryKey(entry.UserID),
+		queueKey(entry.Queue),
+		matchKey(entry.UserID),
+	}, string(record), ttl.Milliseconds(), entry.UserID, entry.JoinedAt.UnixMilli()).Int64()
+	if err != nil {
+		return err
+	}
+
+	if enqueued == 0 {
+		return domain.ErrResourceExists
+	}
+
+	return nil
+}
+
+func (repo *RedisMatchmakingRepository) Dequeue(ctx context.Context, userID string) error {
+	dequeued, err := dequeueScript.Run(ctx, repo.client, []string{
+		entryKey(userID),
+	}, queueKeyPrefix, userID).Int64()
+	if err != nil {
+		return err
+	}
+
+	if dequeued == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *RedisMatchmakingRepository) GetEntry(ctx context.Context, userID string) (domain.QueueEntry, error) {
+	value, err := repo.client.Get(ctx, entryKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return domain.QueueEntry{}, domain.ErrResourceNotFound
+		}
+
+		return domain.QueueEntry{}, err
+	}
+
+	return toQueueEntry(userID, value)
+}
+
+// ListEntries also removes the players whose entries have expired, or who
+// have joined another queue since, from the queue.
+func (repo *RedisMatchmakingRepository) ListEntries(ctx context.Context, queue domain.MatchQueue) ([]domain.QueueEntry, error) {
+	userIDs, err := repo.client.ZRange(ctx, queueKey(queue), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(userIDs))
+
+	for _, userID := range userIDs {
+		keys = append(keys, entryKey(userID))
+	}
+
+	values, err := repo.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entries []domain.QueueEntry
+		stale   []interface{}
+	)
+
+	for i, value := range values {
+		value, ok := value.(string)
+		if !ok {
+			stale = append(stale, userIDs[i])
+
+			continue
+		}
+
+		entry, err := toQueueEntry(userIDs[i], value)
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.Queue != queue {
+			stale = append(stale, userIDs[i])
+
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(stale) > 0 {
+		_, err = repo.client.ZRem(ctx, queueKey(queue), stale...).Result()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (repo *RedisMatchmakingRepository) CreateMatch(ctx context.Context, queue domain.MatchQueue, ticket domain.MatchTicket) (bool, error) {
+	record, err := json.Marshal(ticketRecord{
+		MatchID:   ticket.MatchID,
+		Mode:      ticket.Mode,
+		Region:    ticket.Region,
+		UserIDs:   ticket.UserIDs,
+		CreatedAt: ticket.CreatedAt.UnixMilli(),
+		ExpiresAt: ticket.ExpiresAt.UnixMilli(),
+		Signature: ticket.Signature,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	keys := []string{queueKey(queue)}
+	args := []interface{}{string(record), ticket.ExpiresAt.Sub(ticket.CreatedAt).Milliseconds()}
+
+	for _, userID := range ticket.UserIDs {
+		keys = append(keys, entryKey(userID), matchKey(userID))
+		args = append(args, userID)
+	}
+
+	created, err := createMatchScript.Run(ctx, repo.client, keys, args...).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return created == 1, nil
+}
+
+func (repo *RedisMatchmakingRepository) GetMatch(ctx context.Context, userID string) (domain.MatchTicket, error) {
+	value, err := repo.client.Get(ctx, matchKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return domain.MatchTicket{}, domain.ErrResourceNotFound
+		}
+
+		return domain.MatchTicket{}, err
+	}
+
+	var record ticketRecord
+
+	err = json.Unmarshal([]byte(value), &record)
+	if err != nil {
+		return domain.MatchTicket{}, fmt.Errorf("%w, invalid match ticket: %s", domain.ErrInternal, value)
+	}
+
+	return domain.MatchTicket{
+		MatchID:   record.MatchID,
+		Mode:      record.Mode,
+		Region:    record.Region,
+		UserIDs:   record.UserIDs,
+		CreatedAt: time.UnixMilli(record.CreatedAt),
+		ExpiresAt: time.UnixMilli(record.ExpiresAt),
+		Signature: record.Signature,
+	}, nil
+}
+
+func toQueueEntry(userID, value string) (domain.QueueEntry, error) {
+	var record entryRecord
+
+	err := json.Unmarshal([]byte(value), &record)
+	if err != nil {
+		return domain.QueueEntry{}, fmt.Errorf("%w, invalid queue entry: %s", domain.ErrInternal, value)
+	}
+
+	return domain.QueueEntry{
+		UserID: userID,
+		Queue: domain.MatchQueue{
+			Mode:      record.Mode,
+			Region:    record.Region,
+			MatchSize: record.MatchSize,
+		},
+		Rating:   record.Rating,
+		JoinedAt: time.UnixMilli(record.JoinedAt),
+	}, nil
+}
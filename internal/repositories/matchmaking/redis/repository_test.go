@@ -0,0 +1,209 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+)
+
+var (
+	joinedAt = time.UnixMilli(1700000000000)
+	duel     = domain.MatchQueue{Mode: "duel", Region: "eu", MatchSize: 2}
+)
+
+const (
+	entryValue  = `{"mode":"duel","region":"eu","matchSize":2,"rating":1500,"joinedAt":1700000000000}`
+	ticketValue = `{"matchID":"match-1","mode":"duel","region":"eu","userIDs":["user-id","user-id-2"],"createdAt":1700000000000,"expiresAt":1700000120000,"signature":"c2lnbmF0dXJl"}`
+)
+
+type RedisMatchmakingRepositoryTestSuite struct {
+	suite.Suite
+
+	repository *RedisMatchmakingRepository
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisMatchmakingRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisMatchmakingRepositoryTestSuite))
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.repository = NewRedisMatchmakingRepository(RedisMatchmakingRepositoryDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) expectEnqueue(result int64) {
+	suite.redisMock.
+		ExpectEvalSha(enqueueScript.Hash(), []string{
+			"matchmaking:entry:user-id",
+			"matchmaking:queue:duel:eu:2",
+			"matchmaking:match:user-id",
+		}, entryValue, int64(300000), "user-id", int64(1700000000000)).
+		SetVal(result)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) enqueue() error {
+	return suite.repository.Enqueue(context.Background(), domain.QueueEntry{
+		UserID:   "user-id",
+		Queue:    duel,
+		Rating:   1500,
+		JoinedAt: joinedAt,
+	}, 5*time.Minute)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestEnqueue() {
+	suite.expectEnqueue(1)
+
+	suite.NoError(suite.enqueue())
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestEnqueue_AlreadyQueued() {
+	suite.expectEnqueue(0)
+
+	suite.ErrorIs(suite.enqueue(), domain.ErrResourceExists)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestDequeue() {
+	suite.redisMock.
+		ExpectEvalSha(dequeueScript.Hash(), []string{"matchmaking:entry:user-id"}, "matchmaking:queue:", "user-id").
+		SetVal(int64(1))
+
+	err := suite.repository.Dequeue(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestDequeue_NotQueued() {
+	suite.redisMock.
+		ExpectEvalSha(dequeueScript.Hash(), []string{"matchmaking:entry:user-id"}, "matchmaking:queue:", "user-id").
+		SetVal(int64(0))
+
+	err := suite.repository.Dequeue(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestGetEntry() {
+	suite.redisMock.
+		ExpectGet("matchmaking:entry:user-id").
+		SetVal(entryValue)
+
+	entry, err := suite.repository.GetEntry(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.Equal(domain.QueueEntry{
+		UserID:   "user-id",
+		Queue:    duel,
+		Rating:   1500,
+		JoinedAt: joinedAt,
+	}, entry)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestListEntries() {
+	suite.redisMock.
+		ExpectZRange("matchmaking:queue:duel:eu:2", 0, -1).
+		SetVal([]string{"user-id", "user-id-2", "user-id-3"})
+
+	// user-id-2 has waited too long and user-id-3 has joined another queue
+	// since, both are removed from the queue.
+	suite.redisMock.
+		ExpectMGet("matchmaking:entry:user-id", "matchmaking:entry:user-id-2", "matchmaking:entry:user-id-3").
+		SetVal([]interface{}{
+			entryValue,
+			nil,
+			`{"mode":"duel","region":"us","matchSize":2,"rating":1700,"joinedAt":1700000000000}`,
+		})
+
+	suite.redisMock.
+		ExpectZRem("matchmaking:queue:duel:eu:2", "user-id-2", "user-id-3").
+		SetVal(2)
+
+	entries, err := suite.repository.ListEntries(context.Background(), duel)
+	suite.NoError(err)
+
+	suite.Equal([]domain.QueueEntry{
+		{UserID: "user-id", Queue: duel, Rating: 1500, JoinedAt: joinedAt},
+	}, entries)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestListEntries_Empty() {
+	suite.redisMock.
+		ExpectZRange("matchmaking:queue:duel:eu:2", 0, -1).
+		SetVal([]string{})
+
+	entries, err := suite.repository.ListEntries(context.Background(), duel)
+	suite.NoError(err)
+	suite.Empty(entries)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) expectCreateMatch(result int64) {
+	suite.redisMock.
+		ExpectEvalSha(createMatchScript.Hash(), []string{
+			"matchmaking:queue:duel:eu:2",
+			"matchmaking:entry:user-id",
+			"matchmaking:match:user-id",
+			"matchmaking:entry:user-id-2",
+			"matchmaking:match:user-id-2",
+		}, ticketValue, int64(120000), "user-id", "user-id-2").
+		SetVal(result)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) ticket() domain.MatchTicket {
+	return domain.MatchTicket{
+		MatchID:   "match-1",
+		Mode:      "duel",
+		Region:    "eu",
+		UserIDs:   []string{"user-id", "user-id-2"},
+		CreatedAt: joinedAt,
+		ExpiresAt: joinedAt.Add(2 * time.Minute),
+		Signature: []byte("signature"),
+	}
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestCreateMatch() {
+	suite.expectCreateMatch(1)
+
+	created, err := suite.repository.CreateMatch(context.Background(), duel, suite.ticket())
+	suite.NoError(err)
+	suite.True(created)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestCreateMatch_PlayerLeft() {
+	suite.expectCreateMatch(0)
+
+	created, err := suite.repository.CreateMatch(context.Background(), duel, suite.ticket())
+	suite.NoError(err)
+	suite.False(created)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestGetMatch() {
+	suite.redisMock.
+		ExpectGet("matchmaking:match:user-id").
+		SetVal(ticketValue)
+
+	ticket, err := suite.repository.GetMatch(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Equal(suite.ticket(), ticket)
+}
+
+func (suite *RedisMatchmakingRepositoryTestSuite) TestGetMatch_NotMatched() {
+	suite.redisMock.
+		ExpectGet("matchmaking:match:user-id").
+		RedisNil()
+
+	_, err := suite.repository.GetMatch(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
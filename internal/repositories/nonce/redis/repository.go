@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	nonceKeyPrefix = "nonce:"
+)
+
+type RedisNonceRepositoryDependencies struct {
+	Client *redis.Client
+}
+
+type RedisNonceRepository struct {
+	client *redis.Client
+}
+
+func NewRedisNonceRepository(deps RedisNonceRepositoryDependencies) *RedisNonceRepository {
+	return &RedisNonceRepository{
+		client: deps.Client,
+	}
+}
+
+func (repo *RedisNonceRepository) Claim(ctx context.Context, scope, nonce string, ttl time.Duration) (bool, error) {
+	claimed, err := repo.client.SetNX(ctx, nonceKeyPrefix+scope+":"+nonce, 1, ttl).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return claimed, nil
+}
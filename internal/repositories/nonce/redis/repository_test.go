@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedisNonceRepositoryTestSuite struct {
+	suite.Suite
+
+	repository *RedisNonceRepository
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisNonceRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisNonceRepositoryTestSuite))
+}
+
+func (suite *RedisNonceRepositoryTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.repository = NewRedisNonceRepository(RedisNonceRepositoryDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisNonceRepositoryTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisNonceRepositoryTestSuite) TestClaim() {
+	suite.redisMock.
+		ExpectSetNX("nonce:scope:nonce", 1, time.Minute).
+		SetVal(true)
+
+	claimed, err := suite.repository.Claim(context.Background(), "scope", "nonce", time.Minute)
+	suite.NoError(err)
+	suite.True(claimed)
+}
+
+func (suite *RedisNonceRepositoryTestSuite) TestClaim_AlreadyClaimed() {
+	suite.redisMock.
+		ExpectSetNX("nonce:scope:nonce", 1, time.Minute).
+		SetVal(false)
+
+	claimed, err := suite.repository.Claim(context.Background(), "scope", "nonce", time.Minute)
+	suite.NoError(err)
+	suite.False(claimed)
+}
+
+func (suite *RedisNonceRepositoryTestSuite) TestClaim_SetNXFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectSetNX("nonce:scope:nonce", 1, time.Minute).
+		SetErr(someError)
+
+	_, err := suite.repository.Claim(context.Background(), "scope", "nonce", time.Minute)
+	suite.ErrorIs(err, someError)
+}
@@ -0,0 +1,15 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type quarantinedScoreRecord struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      string             `bson:"userID"`
+	Score       float64            `bson:"score"`
+	Reason      string             `bson:"reason"`
+	SubmittedAt time.Time          `bson:"submittedAt"`
+}
@@ -0,0 +1,97 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidID = fmt.Errorf("%w, invalid record ID", domain.ErrInternal)
+)
+
+type MongoQuarantinedScoreRepositoryDependencies struct {
+	QuarantinedScoresCollection *mongo.Collection
+}
+
+type MongoQuarantinedScoreRepository struct {
+	quarantinedScoresCollection *mongo.Collection
+}
+
+func NewMongoQuarantinedScoreRepository(deps MongoQuarantinedScoreRepositoryDependencies) *MongoQuarantinedScoreRepository {
+	return &MongoQuarantinedScoreRepository{
+		quarantinedScoresCollection: deps.QuarantinedScoresCollection,
+	}
+}
+
+func (repo *MongoQuarantinedScoreRepository) Create(ctx context.Context, score domain.QuarantinedScore) (domain.QuarantinedScore, error) {
+	result, err := repo.quarantinedScoresCollection.InsertOne(ctx, quarantinedScoreRecord{
+		UserID:      score.UserID,
+		Score:       score.Score,
+		Reason:      score.Reason,
+		SubmittedAt: score.SubmittedAt,
+	})
+	if err != nil {
+		return domain.QuarantinedScore{}, err
+	}
+
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return domain.QuarantinedScore{}, ErrInvalidID
+	}
+
+	score.ID = id.Hex()
+
+	return score, nil
+}
+
+func (repo *MongoQuarantinedScoreRepository) ListByUserID(ctx context.Context, userID string) ([]domain.QuarantinedScore, error) {
+	cursor, err := repo.quarantinedScoresCollection.Find(ctx, bson.M{
+		"userID": userID,
+	}, options.Find().SetSort(bson.D{{Key: "submittedAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var scores []domain.QuarantinedScore
+
+	for cursor.Next(ctx) {
+		var record quarantinedScoreRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		scores = append(scores, domain.QuarantinedScore{
+			ID:          record.ID.Hex(),
+			UserID:      record.UserID,
+			Score:       record.Score,
+			Reason:      record.Reason,
+			SubmittedAt: record.SubmittedAt,
+		})
+	}
+
+	return scores, nil
+}
+
+func (repo *MongoQuarantinedScoreRepository) AnonymizeUser(ctx context.Context, userID, anonymousID string) error {
+	_, err := repo.quarantinedScoresCollection.UpdateMany(ctx, bson.M{
+		"userID": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"userID": anonymousID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+type MongoRewardGrantRepositoryDependencies struct {
+	RewardGrantsCollection *mongo.Collection
+}
+
+type MongoRewardGrantRepository struct {
+	rewardGrantsCollection *mongo.Collection
+}
+
+func NewMongoRewardGrantRepository(deps MongoRewardGrantRepositoryDependencies) *MongoRewardGrantRepository {
+	return &MongoRewardGrantRepository{
+		rewardGrantsCollection: deps.RewardGrantsCollection,
+	}
+}
+
+// CreateMany upserts the grants with $setOnInsert, so ending a season again
+// neither duplicates the grants nor resets the claimed ones.
+func (repo *MongoRewardGrantRepository) CreateMany(ctx context.Context, grants []domain.RewardGrant) (int64, error) {
+	if len(grants) == 0 {
+		return 0, nil
+	}
+
+	var models []mongo.WriteModel
+
+	for _, grant := range grants {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": grantID(grant.SeasonID, grant.UserID)}).
+			SetUpdate(bson.M{"$setOnInsert": rewardGrantRecord{
+				ID:         grantID(grant.SeasonID, grant.UserID),
+				SeasonID:   grant.SeasonID,
+				UserID:     grant.UserID,
+				Bracket:    grant.Bracket,
+				Reward:     grant.Reward,
+				Rank:       grant.Rank,
+				Percentile: grant.Percentile,
+				Score:      grant.Score,
+				CreatedAt:  grant.CreatedAt,
+			}}).
+			SetUpsert(true))
+	}
+
+	result, err := repo.rewardGrantsCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.UpsertedCount, nil
+}
+
+func (repo *MongoRewardGrantRepository) ListByUserID(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	cursor, err := repo.rewardGrantsCollection.Find(ctx, bson.M{
+		"userID": userID,
+	}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []domain.RewardGrant
+
+	for cursor.Next(ctx) {
+		var record rewardGrantRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		grants = append(grants, domain.RewardGrant{
+			ID:         record.ID,
+			SeasonID:   record.SeasonID,
+			UserID:     record.UserID,
+			Bracket:    record.Bracket,
+			Reward:     record.Reward,
+			Rank:       record.Rank,
+			Percentile: record.Percentile,
+			Score:      record.Score,
+			CreatedAt:  record.CreatedAt,
+			ClaimedAt:  record.ClaimedAt,
+		})
+	}
+
+	return grants, nil
+}
+
+// Claim only updates the grant while it has not been claimed, so two
+// concurrent claims can not both succeed.
+func (repo *MongoRewardGrantRepository) Claim(ctx context.Context, id string, claimedAt time.Time) (bool, error) {
+	result, err := repo.rewardGrantsCollection.UpdateOne(ctx, bson.M{
+		"_id":       id,
+		"claimedAt": nil,
+	}, bson.M{
+		"$set": bson.M{
+			"claimedAt": claimedAt,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if result.ModifiedCount == 0 {
+		count, err := repo.rewardGrantsCollection.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			return false, err
+		}
+
+		if count == 0 {
+			return false, domain.ErrResourceNotFound
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (repo *MongoRewardGrantRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := repo.rewardGrantsCollection.DeleteMany(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func grantID(seasonID, userID string) string {
+	return seasonID + ":" + userID
+}
@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+)
+
+// rewardGrantRecord is stored with the season and the user ID as its ID, so
+// a user can only be granted one reward per season.
+type rewardGrantRecord struct {
+	ID         string     `bson:"_id"`
+	SeasonID   string     `bson:"seasonID"`
+	UserID     string     `bson:"userID"`
+	Bracket    string     `bson:"bracket"`
+	Reward     string     `bson:"reward"`
+	Rank       int64      `bson:"rank"`
+	Percentile float64    `bson:"percentile"`
+	Score      float64    `bson:"score"`
+	CreatedAt  time.Time  `bson:"createdAt"`
+	ClaimedAt  *time.Time `bson:"claimedAt"`
+}
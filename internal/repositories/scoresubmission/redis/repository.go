@@ -0,0 +1,212 @@
+package redis
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+const (
+	lastSubmissionKeyPrefix = "score_submission:"
+	statisticsKey           = "score_statistics"
+
+	fieldScore        = "score"
+	fieldSubmittedAt  = "submitted_at"
+	fieldAttemptedAt  = "attempted_at"
+	fieldCount        = "count"
+	fieldSum          = "sum"
+	fieldSumOfSquares = "sum_of_squares"
+)
+
+// reserveSubmissionScript records the time of the submission as the last
+// attempt unless the last attempt has been made less than the minimum
+// interval before it. It returns 0 when the submission is rejected, 1
+// otherwise, followed by the score and the time of the last submission that
+// has passed the validation when there is one. The arguments are the time of
+// the submission and the minimum interval, both in milliseconds.
+const reserveSubmissionScriptSource = `
+local last = redis.call("HMGET", KEYS[1], "score", "submitted_at", "attempted_at")
+
+if last[3] and tonumber(ARGV[2]) > 0 and tonumber(ARGV[1]) - tonumber(last[3]) < tonumber(ARGV[2]) then
+	return {0}
+end
+
+redis.call("HSET", KEYS[1], "attempted_at", ARGV[1])
+
+if last[1] and last[2] then
+	return {1, last[1], last[2]}
+end
+
+return {1}
+`
+
+var reserveSubmissionScript = redis.NewScript(reserveSubmissionScriptSource)
+
+type RedisScoreSubmissionRepositoryDependencies struct {
+	Client *redis.Client
+}
+
+type RedisScoreSubmissionRepository struct {
+	client *redis.Client
+}
+
+func NewRedisScoreSubmissionRepository(deps RedisScoreSubmissionRepositoryDependencies) *RedisScoreSubmissionRepository {
+	return &RedisScoreSubmissionRepository{
+		client: deps.Client,
+	}
+}
+
+func (repo *RedisScoreSubmissionRepository) GetLastSubmission(ctx context.Context, userID string) (domain.ScoreSubmission, error) {
+	values, err := repo.client.HMGet(ctx, lastSubmissionKeyPrefix+userID, fieldScore, fieldSubmittedAt).Result()
+	if err != nil {
+		return domain.ScoreSubmission{}, err
+	}
+
+	if values[0] == nil || values[1] == nil {
+		return domain.ScoreSubmission{}, domain.ErrResourceNotFound
+	}
+
+	score, err := parseFloat(values[0])
+	if err != nil {
+		return domain.ScoreSubmission{}, err
+	}
+
+	submittedAt, err := parseFloat(values[1])
+	if err != nil {
+		return domain.ScoreSubmission{}, err
+	}
+
+	return domain.ScoreSubmission{
+		UserID:      userID,
+		Score:       score,
+		SubmittedAt: time.UnixMilli(int64(submittedAt)),
+	}, nil
+}
+
+func (repo *RedisScoreSubmissionRepository) ReserveSubmission(
+	ctx context.Context, submission domain.ScoreSubmission, minInterval time.Duration,
+) (*domain.ScoreSubmission, error) {
+	result, err := reserveSubmissionScript.Run(ctx, repo.client,
+		[]string{lastSubmissionKeyPrefix + submission.UserID},
+		submission.SubmittedAt.UnixMilli(), minInterval.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, ok := result[0].(int64)
+	if !ok {
+		return nil, domain.ErrInternal
+	}
+
+	if reserved == 0 {
+		return nil, domain.ErrSubmissionTooFrequent
+	}
+
+	var last *domain.ScoreSubmission
+
+	if len(result) == 3 {
+		score, err := parseFloat(result[1])
+		if err != nil {
+			return nil, err
+		}
+
+		submittedAt, err := parseFloat(result[2])
+		if err != nil {
+			return nil, err
+		}
+
+		last = &domain.ScoreSubmission{
+			UserID:      submission.UserID,
+			Score:       score,
+			SubmittedAt: time.UnixMilli(int64(submittedAt)),
+		}
+	}
+
+	return last, nil
+}
+
+func (repo *RedisScoreSubmissionRepository) SaveLastSubmission(ctx context.Context, submission domain.ScoreSubmission) error {
+	_, err := repo.client.HSet(ctx, lastSubmissionKeyPrefix+submission.UserID,
+		fieldScore, submission.Score,
+		fieldSubmittedAt, submission.SubmittedAt.UnixMilli(),
+	).Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisScoreSubmissionRepository) GetStatistics(ctx context.Context) (domain.ScoreStatistics, error) {
+	values, err := repo.client.HMGet(ctx, statisticsKey, fieldCount, fieldSum, fieldSumOfSquares).Result()
+	if err != nil {
+		return domain.ScoreStatistics{}, err
+	}
+
+	var parsed [3]float64
+
+	for i, value := range values {
+		if value == nil {
+			return domain.ScoreStatistics{}, nil
+		}
+
+		parsed[i], err = parseFloat(value)
+		if err != nil {
+			return domain.ScoreStatistics{}, err
+		}
+	}
+
+	count, sum, sumOfSquares := parsed[0], parsed[1], parsed[2]
+
+	if count == 0 {
+		return domain.ScoreStatistics{}, nil
+	}
+
+	mean := sum / count
+	variance := math.Max(sumOfSquares/count-mean*mean, 0)
+
+	return domain.ScoreStatistics{
+		Count:  int64(count),
+		Mean:   mean,
+		StdDev: math.Sqrt(variance),
+	}, nil
+}
+
+func (repo *RedisScoreSubmissionRepository) AddToStatistics(ctx context.Context, score float64) error {
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, statisticsKey, fieldCount, 1)
+		pipe.HIncrByFloat(ctx, statisticsKey, fieldSum, score)
+		pipe.HIncrByFloat(ctx, statisticsKey, fieldSumOfSquares, score*score)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisScoreSubmissionRepository) DeleteLastSubmission(ctx context.Context, userID string) error {
+	_, err := repo.client.Del(ctx, lastSubmissionKeyPrefix+userID).Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseFloat(value interface{}) (float64, error) {
+	str, ok := value.(string)
+	if !ok {
+		return 0, domain.ErrInternal
+	}
+
+	return strconv.ParseFloat(str, 64)
+}
@@ -0,0 +1,184 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+)
+
+type RedisScoreSubmissionRepositoryTestSuite struct {
+	suite.Suite
+
+	repository *RedisScoreSubmissionRepository
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisScoreSubmissionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisScoreSubmissionRepositoryTestSuite))
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.repository = NewRedisScoreSubmissionRepository(RedisScoreSubmissionRepositoryDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestGetLastSubmission() {
+	suite.redisMock.
+		ExpectHMGet("score_submission:user-id", "score", "submitted_at").
+		SetVal([]interface{}{"100", "1700000000000"})
+
+	submission, err := suite.repository.GetLastSubmission(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.Equal(domain.ScoreSubmission{
+		UserID:      "user-id",
+		Score:       100,
+		SubmittedAt: time.UnixMilli(1700000000000),
+	}, submission)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestGetLastSubmission_NotFound() {
+	suite.redisMock.
+		ExpectHMGet("score_submission:user-id", "score", "submitted_at").
+		SetVal([]interface{}{nil, nil})
+
+	_, err := suite.repository.GetLastSubmission(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestGetLastSubmission_HMGetFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectHMGet("score_submission:user-id", "score", "submitted_at").
+		SetErr(someError)
+
+	_, err := suite.repository.GetLastSubmission(context.Background(), "user-id")
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) expectReserve() *redismock.ExpectedCmd {
+	return suite.redisMock.ExpectEvalSha(reserveSubmissionScript.Hash(), []string{"score_submission:user-id"},
+		int64(1700000060000), int64(60000),
+	)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) reserve() (*domain.ScoreSubmission, error) {
+	return suite.repository.ReserveSubmission(context.Background(), domain.ScoreSubmission{
+		UserID:      "user-id",
+		Score:       100,
+		SubmittedAt: time.UnixMilli(1700000060000),
+	}, time.Minute)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestReserveSubmission() {
+	suite.expectReserve().SetVal([]interface{}{int64(1), "50", "1700000000000"})
+
+	last, err := suite.reserve()
+	suite.NoError(err)
+
+	suite.Equal(&domain.ScoreSubmission{
+		UserID:      "user-id",
+		Score:       50,
+		SubmittedAt: time.UnixMilli(1700000000000),
+	}, last)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestReserveSubmission_First() {
+	suite.expectReserve().SetVal([]interface{}{int64(1)})
+
+	last, err := suite.reserve()
+	suite.NoError(err)
+	suite.Nil(last)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestReserveSubmission_TooFrequent() {
+	suite.expectReserve().SetVal([]interface{}{int64(0)})
+
+	last, err := suite.reserve()
+	suite.ErrorIs(err, domain.ErrSubmissionTooFrequent)
+	suite.Nil(last)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestReserveSubmission_EvalFailed() {
+	someError := errors.New("some error")
+
+	suite.expectReserve().SetErr(someError)
+
+	_, err := suite.reserve()
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestSaveLastSubmission() {
+	suite.redisMock.
+		ExpectHSet("score_submission:user-id", "score", float64(100), "submitted_at", int64(1700000060000)).
+		SetVal(2)
+
+	err := suite.repository.SaveLastSubmission(context.Background(), domain.ScoreSubmission{
+		UserID:      "user-id",
+		Score:       100,
+		SubmittedAt: time.UnixMilli(1700000060000),
+	})
+	suite.NoError(err)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestGetStatistics() {
+	suite.redisMock.
+		ExpectHMGet("score_statistics", "count", "sum", "sum_of_squares").
+		SetVal([]interface{}{"2", "30", "500"})
+
+	statistics, err := suite.repository.GetStatistics(context.Background())
+	suite.NoError(err)
+
+	suite.Equal(domain.ScoreStatistics{
+		Count:  2,
+		Mean:   15,
+		StdDev: 5,
+	}, statistics)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestGetStatistics_Empty() {
+	suite.redisMock.
+		ExpectHMGet("score_statistics", "count", "sum", "sum_of_squares").
+		SetVal([]interface{}{nil, nil, nil})
+
+	statistics, err := suite.repository.GetStatistics(context.Background())
+	suite.NoError(err)
+	suite.Empty(statistics)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestAddToStatistics() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.ExpectHIncrBy("score_statistics", "count", 1).SetVal(1)
+	suite.redisMock.ExpectHIncrByFloat("score_statistics", "sum", 10).SetVal(10)
+	suite.redisMock.ExpectHIncrByFloat("score_statistics", "sum_of_squares", 100).SetVal(100)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.AddToStatistics(context.Background(), 10)
+	suite.NoError(err)
+}
+
+func (suite *RedisScoreSubmissionRepositoryTestSuite) TestDeleteLastSubmission() {
+	suite.redisMock.
+		ExpectDel("score_submission:user-id").
+		SetVal(1)
+
+	err := suite.repository.DeleteLastSubmission(context.Background(), "user-id")
+	suite.NoError(err)
+}
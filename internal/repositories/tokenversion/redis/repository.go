@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	tokenVersionKeyPrefix = "token_version:"
+)
+
+type RedisTokenVersionRepositoryDependencies struct {
+	Client *redis.Client
+}
+
+type RedisTokenVersionRepository struct {
+	client *redis.Client
+}
+
+func NewRedisTokenVersionRepository(deps RedisTokenVersionRepositoryDependencies) *RedisTokenVersionRepository {
+	return &RedisTokenVersionRepository{
+		client: deps.Client,
+	}
+}
+
+func (repo *RedisTokenVersionRepository) GetTokenVersion(ctx context.Context, userID string) (int64, error) {
+	version, err := repo.client.Get(ctx, tokenVersionKeyPrefix+userID).Int64()
+	if err != nil {
+		// users whose tokens have never been revoked have no version yet.
+		if err == redis.Nil {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (repo *RedisTokenVersionRepository) IncrementTokenVersion(ctx context.Context, userID string) (int64, error) {
+	version, err := repo.client.Incr(ctx, tokenVersionKeyPrefix+userID).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedisTokenVersionRepositoryTestSuite struct {
+	suite.Suite
+
+	repository *RedisTokenVersionRepository
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisTokenVersionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisTokenVersionRepositoryTestSuite))
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.repository = NewRedisTokenVersionRepository(RedisTokenVersionRepositoryDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) TestGetTokenVersion() {
+	suite.redisMock.
+		ExpectGet("token_version:user-id").
+		SetVal("3")
+
+	version, err := suite.repository.GetTokenVersion(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Equal(int64(3), version)
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) TestGetTokenVersion_NotFound() {
+	suite.redisMock.
+		ExpectGet("token_version:user-id").
+		RedisNil()
+
+	version, err := suite.repository.GetTokenVersion(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Zero(version)
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) TestGetTokenVersion_GetFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectGet("token_version:user-id").
+		SetErr(someError)
+
+	_, err := suite.repository.GetTokenVersion(context.Background(), "user-id")
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisTokenVersionRepositoryTestSuite) TestIncrementTokenVersion() {
+	suite.redisMock.
+		ExpectIncr("token_version:user-id").
+		SetVal(4)
+
+	version, err := suite.repository.IncrementTokenVersion(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Equal(int64(4), version)
+}
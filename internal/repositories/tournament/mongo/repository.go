@@ -0,0 +1,282 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidID = fmt.Errorf("%w, invalid record ID", domain.ErrInternal)
+)
+
+type MongoTournamentRepositoryDependencies struct {
+	TournamentsCollection *mongo.Collection
+}
+
+type MongoTournamentRepository struct {
+	tournamentsCollection *mongo.Collection
+}
+
+func NewMongoTournamentRepository(deps MongoTournamentRepositoryDependencies) *MongoTournamentRepository {
+	return &MongoTournamentRepository{
+		tournamentsCollection: deps.TournamentsCollection,
+	}
+}
+
+func (repo *MongoTournamentRepository) Create(ctx context.Context, tournament domain.Tournament) (domain.Tournament, error) {
+	result, err := repo.tournamentsCollection.InsertOne(ctx, toTournamentRecord(tournament))
+	if err != nil {
+		return domain.Tournament{}, err
+	}
+
+	id, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return domain.Tournament{}, ErrInvalidID
+	}
+
+	tournament.ID = id.Hex()
+
+	return tournament, nil
+}
+
+func (repo *MongoTournamentRepository) GetByID(ctx context.Context, id string) (domain.Tournament, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.Tournament{}, domain.ErrResourceNotFound
+	}
+
+	result := repo.tournamentsCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.Tournament{}, domain.ErrResourceNotFound
+		}
+
+		return domain.Tournament{}, result.Err()
+	}
+
+	var record tournamentRecord
+
+	err = result.Decode(&record)
+	if err != nil {
+		return domain.Tournament{}, err
+	}
+
+	return toTournament(record), nil
+}
+
+func (repo *MongoTournamentRepository) List(ctx context.Context, status string) ([]domain.Tournament, error) {
+	return repo.find(ctx, bson.M{
+		"status": status,
+	})
+}
+
+func (repo *MongoTournamentRepository) ListDue(ctx context.Context, now time.Time) ([]domain.Tournament, error) {
+	return repo.find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{
+				"status":   domain.TournamentRegistering,
+				"startsAt": bson.M{"$lte": now},
+			},
+			bson.M{
+				"status":        domain.TournamentRunning,
+				"roundDeadline": bson.M{"$lte": now},
+			},
+		},
+	})
+}
+
+func (repo *MongoTournamentRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Tournament, error) {
+	return repo.find(ctx, bson.M{
+		"players.userID": userID,
+	})
+}
+
+func (repo *MongoTournamentRepository) Update(ctx context.Context, tournament domain.Tournament) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(tournament.ID)
+	if err != nil {
+		return false, ErrInvalidID
+	}
+
+	record := toTournamentRecord(tournament)
+	record.ID = objectID
+	record.Version = tournament.Version + 1
+
+	result, err := repo.tournamentsCollection.ReplaceOne(ctx, bson.M{
+		"_id":     objectID,
+		"version": tournament.Version,
+	}, record)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// AnonymizeUser increments the versions of the tournaments, so an Update of a
+// tournament read before does not write the user ID back.
+func (repo *MongoTournamentRepository) AnonymizeUser(ctx context.Context, userID, anonymousID string) error {
+	_, err := repo.tournamentsCollection.UpdateMany(ctx, bson.M{
+		"players.userID": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"players.$[player].userID":          anonymousID,
+			"standings.$[standing].userID":      anonymousID,
+			"matches.$[].playerIDs.$[playerID]": anonymousID,
+			"matches.$[match].winnerID":         anonymousID,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}, options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{
+			bson.M{"player.userID": userID},
+			bson.M{"standing.userID": userID},
+			bson.M{"playerID": userID},
+			bson.M{"match.winnerID": userID},
+		},
+	}))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoTournamentRepository) find(ctx context.Context, filter bson.M) ([]domain.Tournament, error) {
+	cursor, err := repo.tournamentsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "startsAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var tournaments []domain.Tournament
+
+	for cursor.Next(ctx) {
+		var record tournamentRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		tournaments = append(tournaments, toTournament(record))
+	}
+
+	return tournaments, nil
+}
+
+func toTournamentRecord(tournament domain.Tournament) tournamentRecord {
+	record := tournamentRecord{
+		Name:          tournament.Name,
+		Format:        tournament.Format,
+		Status:        tournament.Status,
+		MaxPlayers:    tournament.MaxPlayers,
+		Rounds:        tournament.Rounds,
+		RoundDuration: tournament.RoundDuration,
+		StartsAt:      tournament.StartsAt,
+		CreatedAt:     tournament.CreatedAt,
+		CurrentRound:  tournament.CurrentRound,
+		RoundDeadline: tournament.RoundDeadline,
+		Players:       make([]playerRecord, 0, len(tournament.Players)),
+		Matches:       make([]matchRecord, 0, len(tournament.Matches)),
+		Standings:     make([]standingRecord, 0, len(tournament.Standings)),
+		Version:       tournament.Version,
+	}
+
+	for _, player := range tournament.Players {
+		record.Players = append(record.Players, playerRecord{
+			UserID:       player.UserID,
+			Seed:         player.Seed,
+			RegisteredAt: player.RegisteredAt,
+			Losses:       player.Losses,
+			Points:       player.Points,
+			Score:        player.Score,
+			Scored:       player.Scored,
+			EliminatedIn: player.EliminatedIn,
+		})
+	}
+
+	for _, match := range tournament.Matches {
+		playerIDs := make([]string, 0, len(match.PlayerIDs))
+		playerIDs = append(playerIDs, match.PlayerIDs...)
+
+		record.Matches = append(record.Matches, matchRecord{
+			ID:        match.ID,
+			Round:     match.Round,
+			Bracket:   match.Bracket,
+			PlayerIDs: playerIDs,
+			WinnerID:  match.WinnerID,
+			Completed: match.Completed,
+		})
+	}
+
+	for _, standing := range tournament.Standings {
+		record.Standings = append(record.Standings, standingRecord{
+			UserID: standing.UserID,
+			Place:  standing.Place,
+			Points: standing.Points,
+		})
+	}
+
+	return record
+}
+
+func toTournament(record tournamentRecord) domain.Tournament {
+	tournament := domain.Tournament{
+		ID:            record.ID.Hex(),
+		Name:          record.Name,
+		Format:        record.Format,
+		Status:        record.Status,
+		MaxPlayers:    record.MaxPlayers,
+		Rounds:        record.Rounds,
+		RoundDuration: record.RoundDuration,
+		StartsAt:      record.StartsAt,
+		CreatedAt:     record.CreatedAt,
+		CurrentRound:  record.CurrentRound,
+		RoundDeadline: record.RoundDeadline,
+		Version:       record.Version,
+	}
+
+	for _, player := range record.Players {
+		tournament.Players = append(tournament.Players, domain.TournamentPlayer{
+			UserID:       player.UserID,
+			Seed:         player.Seed,
+			RegisteredAt: player.RegisteredAt,
+			Losses:       player.Losses,
+			Points:       player.Points,
+			Score:        player.Score,
+			Scored:       player.Scored,
+			EliminatedIn: player.EliminatedIn,
+		})
+	}
+
+	for _, match := range record.Matches {
+		tournament.Matches = append(tournament.Matches, domain.TournamentMatch{
+			ID:        match.ID,
+			Round:     match.Round,
+			Bracket:   match.Bracket,
+			PlayerIDs: match.PlayerIDs,
+			WinnerID:  match.WinnerID,
+			Completed: match.Completed,
+		})
+	}
+
+	for _, standing := range record.Standings {
+		tournament.Standings = append(tournament.Standings, domain.TournamentStanding{
+			UserID: standing.UserID,
+			Place:  standing.Place,
+			Points: standing.Points,
+		})
+	}
+
+	return tournament
+}
@@ -0,0 +1,55 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// tournamentRecord keeps the players, the matches and the standings in the
+// document of the tournament, so every update of a tournament is atomic.
+// The arrays are never stored as null, the anonymization updates them with
+// array filters.
+type tournamentRecord struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Name          string             `bson:"name"`
+	Format        string             `bson:"format"`
+	Status        string             `bson:"status"`
+	MaxPlayers    int64              `bson:"maxPlayers"`
+	Rounds        int64              `bson:"rounds"`
+	RoundDuration time.Duration      `bson:"roundDuration"`
+	StartsAt      time.Time          `bson:"startsAt"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+	CurrentRound  int64              `bson:"currentRound"`
+	RoundDeadline time.Time          `bson:"roundDeadline"`
+	Players       []playerRecord     `bson:"players"`
+	Matches       []matchRecord      `bson:"matches"`
+	Standings     []standingRecord   `bson:"standings"`
+	Version       int64              `bson:"version"`
+}
+
+type playerRecord struct {
+	UserID       string    `bson:"userID"`
+	Seed         int64     `bson:"seed"`
+	RegisteredAt time.Time `bson:"registeredAt"`
+	Losses       int64     `bson:"losses"`
+	Points       float64   `bson:"points"`
+	Score        float64   `bson:"score"`
+	Scored       bool      `bson:"scored"`
+	EliminatedIn int64     `bson:"eliminatedIn"`
+}
+
+type matchRecord struct {
+	ID        string   `bson:"id"`
+	Round     int64    `bson:"round"`
+	Bracket   string   `bson:"bracket"`
+	PlayerIDs []string `bson:"playerIDs"`
+	WinnerID  string   `bson:"winnerID"`
+	Completed bool     `bson:"completed"`
+}
+
+type standingRecord struct {
+	UserID string  `bson:"userID"`
+	Place  int64   `bson:"place"`
+	Points float64 `bson:"points"`
+}
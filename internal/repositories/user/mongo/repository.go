@@ -50,6 +50,33 @@ func (repo *MongoUserRepository) Create(ctx context.Context, username, passwordH
 	}, nil
 }
 
+func (repo *MongoUserRepository) GetByID(ctx context.Context, id string) (domain.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.User{}, ErrInvalidID
+	}
+
+	result := repo.usersCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return domain.User{}, domain.ErrResourceNotFound
+		}
+
+		return domain.User{}, result.Err()
+	}
+
+	var user userRecord
+
+	err = result.Decode(&user)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return toUser(user), nil
+}
+
 func (repo *MongoUserRepository) GetByName(ctx context.Context, username string) (domain.User, error) {
 	result := repo.usersCollection.FindOne(ctx, bson.M{
 		"username": username,
@@ -69,11 +96,7 @@ func (repo *MongoUserRepository) GetByName(ctx context.Context, username string)
 		return domain.User{}, err
 	}
 
-	return domain.User{
-		ID:           user.ID.Hex(),
-		Name:         user.Username,
-		PasswordHash: user.PasswordHash,
-	}, nil
+	return toUser(user), nil
 }
 
 func (repo *MongoUserRepository) CheckExistsByName(ctx context.Context, username string) (bool, error) {
@@ -104,15 +127,17 @@ func (repo *MongoUserRepository) CheckExistsByID(ctx context.Context, id string)
 }
 
 func (repo *MongoUserRepository) GetUsersByIDs(ctx context.Context, ids []string) ([]domain.User, error) {
-	objectIDs := make([]primitive.ObjectID, len(ids))
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
 
-	for i, id := range ids {
+	// an invalid id can not belong to a user, it is skipped rather than
+	// failing the lookup of all the other ids.
+	for _, id := range ids {
 		objectID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, ErrInvalidID
+			continue
 		}
 
-		objectIDs[i] = objectID
+		objectIDs = append(objectIDs, objectID)
 	}
 
 	cursor, err := repo.usersCollection.Find(ctx, bson.M{
@@ -134,12 +159,92 @@ func (repo *MongoUserRepository) GetUsersByIDs(ctx context.Context, ids []string
 			return nil, err
 		}
 
-		users = append(users, domain.User{
-			ID:           userRecord.ID.Hex(),
-			Name:         userRecord.Username,
-			PasswordHash: userRecord.PasswordHash,
-		})
+		users = append(users, toUser(userRecord))
 	}
 
 	return users, nil
 }
+
+func (repo *MongoUserRepository) SetBanned(ctx context.Context, id string, banned bool) error {
+	return repo.update(ctx, id, bson.M{
+		"banned": banned,
+	})
+}
+
+func toUser(record userRecord) domain.User {
+	return domain.User{
+		ID:           record.ID.Hex(),
+		Name:         record.Username,
+		PasswordHash: record.PasswordHash,
+		Banned:       record.Banned,
+		Profile: domain.UserProfile{
+			DisplayName: record.DisplayName,
+			CountryCode: record.CountryCode,
+			AvatarURL:   record.AvatarURL,
+			Metadata:    record.Metadata,
+		},
+	}
+}
+
+func (repo *MongoUserRepository) UpdateUsername(ctx context.Context, id, username string) error {
+	return repo.update(ctx, id, bson.M{
+		"username": username,
+	})
+}
+
+func (repo *MongoUserRepository) UpdatePasswordHash(ctx context.Context, id, passwordHash string) error {
+	return repo.update(ctx, id, bson.M{
+		"passwordHash": passwordHash,
+	})
+}
+
+func (repo *MongoUserRepository) UpdateProfile(ctx context.Context, id string, profile domain.UserProfile) error {
+	return repo.update(ctx, id, bson.M{
+		"displayName": profile.DisplayName,
+		"countryCode": profile.CountryCode,
+		"avatarURL":   profile.AvatarURL,
+		"metadata":    profile.Metadata,
+	})
+}
+
+func (repo *MongoUserRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := repo.usersCollection.DeleteOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (repo *MongoUserRepository) update(ctx context.Context, id string, fields bson.M) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := repo.usersCollection.UpdateOne(ctx, bson.M{
+		"_id": objectID,
+	}, bson.M{
+		"$set": fields,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrResourceNotFound
+	}
+
+	return nil
+}
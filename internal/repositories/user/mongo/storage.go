@@ -0,0 +1,17 @@
+package mongo
+
+import "time"
+
+// storageObjectRecord is stored with the user ID, the namespace and the key
+// as its ID, so a key is unique in the namespace of a user.
+type storageObjectRecord struct {
+	ID         string    `bson:"_id"`
+	UserID     string    `bson:"userID"`
+	Namespace  string    `bson:"namespace"`
+	Key        string    `bson:"key"`
+	Value      []byte    `bson:"value"`
+	Permission string    `bson:"permission"`
+	Version    int64     `bson:"version"`
+	CreatedAt  time.Time `bson:"createdAt"`
+	UpdatedAt  time.Time `bson:"updatedAt"`
+}
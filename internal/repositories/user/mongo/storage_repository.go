@@ -0,0 +1,201 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"game/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoStorageRepositoryDependencies struct {
+	StorageObjectsCollection *mongo.Collection
+}
+
+type MongoStorageRepository struct {
+	storageObjectsCollection *mongo.Collection
+}
+
+func NewMongoStorageRepository(deps MongoStorageRepositoryDependencies) *MongoStorageRepository {
+	return &MongoStorageRepository{
+		storageObjectsCollection: deps.StorageObjectsCollection,
+	}
+}
+
+// Put inserts the object at version 1 when version is 0, the ID makes a
+// second insert fail. Otherwise the object is only updated while it is at
+// version, so of two concurrent writes of the same version only one
+// succeeds.
+func (repo *MongoStorageRepository) Put(ctx context.Context, object domain.StorageObject, version int64) (domain.StorageObject, bool, error) {
+	id := storageObjectID(object.UserID, object.Namespace, object.Key)
+
+	if version == 0 {
+		object.Version = 1
+		object.CreatedAt = object.UpdatedAt
+
+		_, err := repo.storageObjectsCollection.InsertOne(ctx, storageObjectRecord{
+			ID:         id,
+			UserID:     object.UserID,
+			Namespace:  object.Namespace,
+			Key:        object.Key,
+			Value:      object.Value,
+			Permission: object.Permission,
+			Version:    object.Version,
+			CreatedAt:  object.CreatedAt,
+			UpdatedAt:  object.UpdatedAt,
+		})
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return domain.StorageObject{}, false, nil
+			}
+
+			return domain.StorageObject{}, false, err
+		}
+
+		return object, true, nil
+	}
+
+	var record storageObjectRecord
+
+	err := repo.storageObjectsCollection.FindOneAndUpdate(ctx, bson.M{
+		"_id":     id,
+		"version": version,
+	}, bson.M{
+		"$set": bson.M{
+			"value":      object.Value,
+			"permission": object.Permission,
+			"updatedAt":  object.UpdatedAt,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.StorageObject{}, false, nil
+		}
+
+		return domain.StorageObject{}, false, err
+	}
+
+	return toStorageObject(record), true, nil
+}
+
+func (repo *MongoStorageRepository) Get(ctx context.Context, userID, namespace, key string) (domain.StorageObject, error) {
+	var record storageObjectRecord
+
+	err := repo.storageObjectsCollection.FindOne(ctx, bson.M{
+		"_id": storageObjectID(userID, namespace, key),
+	}).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.StorageObject{}, domain.ErrResourceNotFound
+		}
+
+		return domain.StorageObject{}, err
+	}
+
+	return toStorageObject(record), nil
+}
+
+func (repo *MongoStorageRepository) List(ctx context.Context, userID, namespace string) ([]domain.StorageObject, error) {
+	return repo.find(ctx, bson.M{
+		"userID":    userID,
+		"namespace": namespace,
+	})
+}
+
+func (repo *MongoStorageRepository) Delete(ctx context.Context, userID, namespace, key string, version int64) (bool, error) {
+	id := storageObjectID(userID, namespace, key)
+
+	result, err := repo.storageObjectsCollection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"version": version,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if result.DeletedCount == 0 {
+		count, err := repo.storageObjectsCollection.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			return false, err
+		}
+
+		if count == 0 {
+			return false, domain.ErrResourceNotFound
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (repo *MongoStorageRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	return repo.storageObjectsCollection.CountDocuments(ctx, bson.M{
+		"userID": userID,
+	})
+}
+
+func (repo *MongoStorageRepository) ListByUserID(ctx context.Context, userID string) ([]domain.StorageObject, error) {
+	return repo.find(ctx, bson.M{
+		"userID": userID,
+	})
+}
+
+func (repo *MongoStorageRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := repo.storageObjectsCollection.DeleteMany(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoStorageRepository) find(ctx context.Context, filter bson.M) ([]domain.StorageObject, error) {
+	cursor, err := repo.storageObjectsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "namespace", Value: 1},
+		{Key: "key", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []domain.StorageObject
+
+	for cursor.Next(ctx) {
+		var record storageObjectRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, toStorageObject(record))
+	}
+
+	return objects, nil
+}
+
+func storageObjectID(userID, namespace, key string) string {
+	return userID + ":" + namespace + ":" + key
+}
+
+func toStorageObject(record storageObjectRecord) domain.StorageObject {
+	return domain.StorageObject{
+		UserID:     record.UserID,
+		Namespace:  record.Namespace,
+		Key:        record.Key,
+		Value:      record.Value,
+		Permission: record.Permission,
+		Version:    record.Version,
+		CreatedAt:  record.CreatedAt,
+		UpdatedAt:  record.UpdatedAt,
+	}
+}
@@ -6,4 +6,9 @@ type userRecord struct {
 	ID           primitive.ObjectID `bson:"_id"`
 	Username     string             `bson:"username"`
 	PasswordHash string             `bson:"passwordHash"`
+	Banned       bool               `bson:"banned"`
+	DisplayName  string             `bson:"displayName,omitempty"`
+	CountryCode  string             `bson:"countryCode,omitempty"`
+	AvatarURL    string             `bson:"avatarURL,omitempty"`
+	Metadata     map[string]string  `bson:"metadata,omitempty"`
 }
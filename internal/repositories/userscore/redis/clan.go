@@ -0,0 +1,198 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+const (
+	// userClansKey maps the user IDs to their clan, clanMemberScoresKey to
+	// the score they add to the aggregates of the clan.
+	userClansKey        = "leaderboard:clans"
+	clanMemberScoresKey = "leaderboard:clans:member_scores"
+	// clanScoredMembersKey maps the clan IDs to the number of their members
+	// with a score, the average is taken over them.
+	clanScoredMembersKey      = "leaderboard:clans:scored_members"
+	clanSumLeaderboardKey     = "leaderboard:clans:sum"
+	clanAverageLeaderboardKey = "leaderboard:clans:average"
+)
+
+var clanScoreKeys = []string{
+	userClansKey,
+	clanMemberScoresKey,
+	leaderboardKey,
+	clanSumLeaderboardKey,
+	clanAverageLeaderboardKey,
+	clanScoredMembersKey,
+}
+
+// clanScoreScript brings the aggregates of the clan of a user up to date
+// with its score on the global leaderboard. Only the difference to the
+// score the user has added before is applied, so the aggregates are never
+// recomputed from all the members.
+//
+// ARGV[1] is the user ID. When ARGV[2] is "1" the user is moved to the
+// clan ARGV[3] first, an empty ARGV[3] takes the user out of its clan.
+const clanScoreScriptSource = `
+local user_id = ARGV[1]
+
+local old_clan = redis.call("HGET", KEYS[1], user_id)
+local old_score = tonumber(redis.call("HGET", KEYS[2], user_id)) or 0
+
+local clan = old_clan
+if ARGV[2] == "1" then
+	clan = ARGV[3]
+	if clan == "" then
+		clan = false
+	end
+end
+
+local score = 0
+if clan then
+	score = tonumber(redis.call("ZSCORE", KEYS[3], user_id)) or 0
+end
+
+if clan == old_clan and score == old_score then
+	return 0
+end
+
+local function refresh(clan_id)
+	local count = tonumber(redis.call("HGET", KEYS[6], clan_id)) or 0
+	if count <= 0 then
+		redis.call("HDEL", KEYS[6], clan_id)
+		redis.call("ZADD", KEYS[4], 0, clan_id)
+		redis.call("ZADD", KEYS[5], 0, clan_id)
+		return
+	end
+
+	local sum = tonumber(redis.call("ZSCORE", KEYS[4], clan_id)) or 0
+	redis.call("ZADD", KEYS[5], sum / count, clan_id)
+end
+
+if old_clan and old_score > 0 then
+	redis.call("ZINCRBY", KEYS[4], -old_score, old_clan)
+	redis.call("HINCRBY", KEYS[6], old_clan, -1)
+end
+
+if clan then
+	redis.call("ZINCRBY", KEYS[4], score, clan)
+	redis.call("HSET", KEYS[1], user_id, clan)
+
+	if score > 0 then
+		redis.call("HINCRBY", KEYS[6], clan, 1)
+		redis.call("HSET", KEYS[2], user_id, score)
+	else
+		redis.call("HDEL", KEYS[2], user_id)
+	end
+else
+	redis.call("HDEL", KEYS[1], user_id)
+	redis.call("HDEL", KEYS[2], user_id)
+end
+
+if old_clan and old_clan ~= clan then
+	refresh(old_clan)
+end
+
+if clan then
+	refresh(clan)
+end
+
+return 1
+`
+
+var clanScoreScript = redis.NewScript(clanScoreScriptSource)
+
+// updateClanScore queues the update of the aggregates of the clan of the
+// user, it runs after the score of the user has been written. The source of
+// the script is sent as EVALSHA can not fall back to it inside a
+// transaction.
+func updateClanScore(ctx context.Context, pipe redis.Pipeliner, userID string) {
+	pipe.Eval(ctx, clanScoreScriptSource, clanScoreKeys, userID, "0", "")
+}
+
+func (repo *RedisUserScoreRepository) SetUserClan(ctx context.Context, userID, clanID string) error {
+	err := clanScoreScript.Run(ctx, repo.client, clanScoreKeys, userID, "1", clanID).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) RemoveClan(ctx context.Context, clanID string) error {
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, clanSumLeaderboardKey, clanID)
+		pipe.ZRem(ctx, clanAverageLeaderboardKey, clanID)
+		pipe.HDel(ctx, clanScoredMembersKey, clanID)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) GetClanLeaderboard(ctx context.Context, aggregate string) ([]domain.ClanScore, error) {
+	var key string
+
+	switch aggregate {
+	case domain.ClanAggregateSum:
+		key = clanSumLeaderboardKey
+	case domain.ClanAggregateAverage:
+		key = clanAverageLeaderboardKey
+	default:
+		return nil, fmt.Errorf("%w, unknown clan aggregate: %s", domain.ErrInternal, aggregate)
+	}
+
+	scores, err := repo.client.ZRevRangeWithScores(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	clanScores := make([]domain.ClanScore, 0, len(scores))
+
+	if len(scores) == 0 {
+		return clanScores, nil
+	}
+
+	var clanIDs []string
+
+	for _, score := range scores {
+		clanID, ok := score.Member.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w, invalid clan id type: %T", domain.ErrInternal, score.Member)
+		}
+
+		clanIDs = append(clanIDs, clanID)
+	}
+
+	counts, err := repo.client.HMGet(ctx, clanScoredMembersKey, clanIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, score := range scores {
+		clanScore := domain.ClanScore{
+			ClanID: clanIDs[i],
+			Score:  score.Score,
+		}
+
+		if count, ok := counts[i].(string); ok {
+			clanScore.ScoredMembers, err = strconv.ParseInt(count, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w, invalid scored members count: %s", domain.ErrInternal, count)
+			}
+		}
+
+		clanScores = append(clanScores, clanScore)
+	}
+
+	return clanScores, nil
+}
@@ -0,0 +1,185 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+// The board of an event is a sorted set of the best scores of the users
+// ranked the same way as the leaderboard, the attempts the users have used
+// are counted in a hash next to it. The board is frozen by setting its
+// frozen key. eventsKey is the set of the events with a score, so the event
+// scores of a user can be found without reading the events collection.
+const (
+	eventKeyPrefix = "event:"
+	eventsKey      = "events"
+)
+
+func eventKey(eventID string) string {
+	return eventKeyPrefix + eventID
+}
+
+func eventAchievedAtKey(eventID string) string {
+	return eventKeyPrefix + eventID + ":achieved_at"
+}
+
+func eventAttemptsKey(eventID string) string {
+	return eventKeyPrefix + eventID + ":attempts"
+}
+
+func eventFrozenKey(eventID string) string {
+	return eventKeyPrefix + eventID + ":frozen"
+}
+
+// submitEventScoreScript uses an attempt of the user and keeps the score
+// when it beats the best score of the user, ARGV[6] is the tie break. It
+// returns -1 when the board is frozen, 0 when the user has no attempt left
+// and 1 when the attempt has been used, followed by the number of attempts
+// the user has used.
+const submitEventScoreScriptSource = rankScriptFunctions + `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return {-1, 0}
+end
+
+local attempts = tonumber(redis.call("HGET", KEYS[2], ARGV[1]) or "0")
+
+if attempts >= tonumber(ARGV[4]) then
+	return {0, attempts}
+end
+
+attempts = redis.call("HINCRBY", KEYS[2], ARGV[1], 1)
+redis.call("SADD", KEYS[5], ARGV[5])
+
+local best = redis.call("ZSCORE", KEYS[3], ARGV[1])
+
+if not best or tonumber(ARGV[2]) > tonumber(best) then
+	unindex_rank(KEYS[6], KEYS[4], ARGV[1])
+
+	redis.call("ZADD", KEYS[3], ARGV[2], ARGV[1])
+	redis.call("HSET", KEYS[4], ARGV[1], ARGV[3])
+
+	index_rank(KEYS[3], KEYS[6], KEYS[4], ARGV[1], ARGV[6])
+end
+
+return {1, attempts}
+`
+
+var submitEventScoreScript = redis.NewScript(submitEventScoreScriptSource)
+
+func (repo *RedisUserScoreRepository) SubmitEventScore(
+	ctx context.Context,
+	eventID, userID string,
+	score float64,
+	submittedAt time.Time,
+	maxAttempts int64,
+) (domain.EventSubmission, error) {
+	result, err := submitEventScoreScript.Run(ctx, repo.client, []string{
+		eventFrozenKey(eventID),
+		eventAttemptsKey(eventID),
+		eventKey(eventID),
+		eventAchievedAtKey(eventID),
+		eventsKey,
+		ranksKey(eventKey(eventID)),
+	}, userID, formatScore(score), submittedAt.UnixMilli(), maxAttempts, eventID, repo.tieBreak).Int64Slice()
+	if err != nil {
+		return domain.EventSubmission{}, err
+	}
+
+	if len(result) != 2 {
+		return domain.EventSubmission{}, fmt.Errorf("%w, invalid event submission: %v", domain.ErrInternal, result)
+	}
+
+	return domain.EventSubmission{
+		Recorded: result[0] == 1,
+		Frozen:   result[0] == -1,
+		Attempts: result[1],
+	}, nil
+}
+
+func (repo *RedisUserScoreRepository) GetEventLeaderboard(ctx context.Context, eventID string, count int64) (domain.Leaderboard, error) {
+	return repo.getLeaderboard(ctx, eventKey(eventID), 0, count-1)
+}
+
+func (repo *RedisUserScoreRepository) FreezeEventBoard(ctx context.Context, eventID string) error {
+	return repo.client.Set(ctx, eventFrozenKey(eventID), "1", 0).Err()
+}
+
+func (repo *RedisUserScoreRepository) GetUserEventScores(ctx context.Context, userID string) ([]domain.EventScore, error) {
+	eventIDs, err := repo.getEventIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventScores []domain.EventScore
+
+	for _, eventID := range eventIDs {
+		score, err := repo.client.ZScore(ctx, eventKey(eventID), userID).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		value, err := repo.client.HGet(ctx, eventAttemptsKey(eventID), userID).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, err
+		}
+
+		attempts, _ := strconv.ParseInt(value, 10, 64)
+
+		eventScores = append(eventScores, domain.EventScore{
+			EventID:  eventID,
+			UserID:   userID,
+			Score:    score,
+			Attempts: attempts,
+		})
+	}
+
+	return eventScores, nil
+}
+
+func (repo *RedisUserScoreRepository) RemoveUserEventScores(ctx context.Context, userID string) error {
+	eventIDs, err := repo.getEventIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	_, err = repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, eventID := range eventIDs {
+			pipe.Eval(ctx, removeScoreScriptSource, rankKeys(eventKey(eventID)), userID)
+			pipe.HDel(ctx, eventAttemptsKey(eventID), userID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) getEventIDs(ctx context.Context) ([]string, error) {
+	eventIDs, err := repo.client.SMembers(ctx, eventsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(eventIDs)
+
+	return eventIDs, nil
+}
@@ -0,0 +1,280 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+// The ratings of a mode are kept in a sorted set ranked the same way as the
+// leaderboard, the ties are broken by the time the ratings have been
+// updated at. ratingModesKey is the set of the modes with a rating, so the
+// ratings of a user can be found without knowing the modes.
+const (
+	ratingKeyPrefix = "rating:"
+	ratingModesKey  = "rating:modes"
+	// reportedMatchTTL is how long the IDs of the matches the ratings have
+	// been updated with are kept, a match reported again within it is
+	// rejected.
+	reportedMatchTTL = 30 * 24 * time.Hour
+)
+
+func ratingKey(mode string) string {
+	return ratingKeyPrefix + mode
+}
+
+func ratingPlayersKey(mode string) string {
+	return ratingKeyPrefix + mode + ":players"
+}
+
+func ratingAchievedAtKey(mode string) string {
+	return ratingKeyPrefix + mode + ":achieved_at"
+}
+
+func reportedMatchKey(mode, matchID string) string {
+	return ratingKeyPrefix + mode + ":match:" + matchID
+}
+
+type ratingRecord struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+	Matches    int64   `json:"matches"`
+	// UpdatedAt is a unix time in milliseconds.
+	UpdatedAt int64 `json:"updatedAt"`
+}
+
+// saveRatingsScript stores the ratings of a match only when the match has
+// not been stored yet and the stored match count of every player is the one
+// the new rating has been computed from. It returns -1 when the match has
+// been stored and 0 when a rating has changed. The ratings are passed after
+// the mode, the TTL of the match and the tie break as the user ID, the
+// previous match count, the record, the rating and the time it has been
+// updated at.
+const saveRatingsScriptSource = rankScriptFunctions + `
+if redis.call("EXISTS", KEYS[5]) == 1 then
+	return -1
+end
+
+local count = (#ARGV - 3) / 5
+
+for i = 0, count - 1 do
+	local base = 4 + i * 5
+	local stored = redis.call("HGET", KEYS[1], ARGV[base])
+	local matches = 0
+
+	if stored then
+		matches = cjson.decode(stored).matches
+	end
+
+	if matches ~= tonumber(ARGV[base + 1]) then
+		return 0
+	end
+end
+
+for i = 0, count - 1 do
+	local base = 4 + i * 5
+
+	unindex_rank(KEYS[6], KEYS[3], ARGV[base])
+
+	redis.call("HSET", KEYS[1], ARGV[base], ARGV[base + 2])
+	redis.call("ZADD", KEYS[2], ARGV[base + 3], ARGV[base])
+	redis.call("HSET", KEYS[3], ARGV[base], ARGV[base + 4])
+
+	index_rank(KEYS[2], KEYS[6], KEYS[3], ARGV[base], ARGV[3])
+end
+
+redis.call("SADD", KEYS[4], ARGV[1])
+redis.call("SET", KEYS[5], "1", "EX", ARGV[2])
+
+return 1
+`
+
+var saveRatingsScript = redis.NewScript(saveRatingsScriptSource)
+
+func (repo *RedisUserScoreRepository) GetRatings(ctx context.Context, mode string, userIDs []string) (map[string]domain.Rating, error) {
+	ratings := make(map[string]domain.Rating)
+
+	if len(userIDs) == 0 {
+		return ratings, nil
+	}
+
+	values, err := repo.client.HMGet(ctx, ratingPlayersKey(mode), userIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		value, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		rating, err := toRating(userIDs[i], mode, value)
+		if err != nil {
+			return nil, err
+		}
+
+		ratings[userIDs[i]] = rating
+	}
+
+	return ratings, nil
+}
+
+func (repo *RedisUserScoreRepository) SaveRatings(ctx context.Context, matchID, mode string, ratings []domain.Rating) (bool, error) {
+	args := []interface{}{mode, int64(reportedMatchTTL / time.Second), repo.tieBreak}
+
+	for _, rating := range ratings {
+		record, err := json.Marshal(ratingRecord{
+			Rating:     rating.Rating,
+			Deviation:  rating.Deviation,
+			Volatility: rating.Volatility,
+			Matches:    rating.Matches,
+			UpdatedAt:  rating.UpdatedAt.UnixMilli(),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		args = append(args, rating.UserID, rating.Matches-1, string(record), formatScore(rating.Rating), rating.UpdatedAt.UnixMilli())
+	}
+
+	saved, err := saveRatingsScript.Run(ctx, repo.client, []string{
+		ratingPlayersKey(mode),
+		ratingKey(mode),
+		ratingAchievedAtKey(mode),
+		ratingModesKey,
+		reportedMatchKey(mode, matchID),
+		ranksKey(ratingKey(mode)),
+	}, args...).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	if saved == -1 {
+		return false, domain.ErrResourceExists
+	}
+
+	return saved == 1, nil
+}
+
+func (repo *RedisUserScoreRepository) GetRatingLeaderboard(ctx context.Context, mode string, count int64) (domain.Leaderboard, error) {
+	return repo.getLeaderboard(ctx, ratingKey(mode), 0, count-1)
+}
+
+func (repo *RedisUserScoreRepository) GetRatingRank(ctx context.Context, mode, userID string) (int64, error) {
+	rank, err := repo.getRank(ctx, ratingKey(mode), userID, -1)
+	if err != nil {
+		return 0, err
+	}
+
+	return rank.rank + 1, nil
+}
+
+func (repo *RedisUserScoreRepository) GetUserRatings(ctx context.Context, userID string) ([]domain.Rating, error) {
+	modes, err := repo.getRatingModes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ratings []domain.Rating
+
+	for _, mode := range modes {
+		value, err := repo.client.HGet(ctx, ratingPlayersKey(mode), userID).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		rating, err := toRating(userID, mode, value)
+		if err != nil {
+			return nil, err
+		}
+
+		ratings = append(ratings, rating)
+	}
+
+	return ratings, nil
+}
+
+func (repo *RedisUserScoreRepository) RemoveUserRatings(ctx context.Context, userID string) error {
+	modes, err := repo.getRatingModes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(modes) == 0 {
+		return nil
+	}
+
+	_, err = repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, mode := range modes {
+			pipe.HDel(ctx, ratingPlayersKey(mode), userID)
+			pipe.Eval(ctx, removeScoreScriptSource, rankKeys(ratingKey(mode)), userID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) getRatingModes(ctx context.Context) ([]string, error) {
+	modes, err := repo.client.SMembers(ctx, ratingModesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(modes)
+
+	return modes, nil
+}
+
+func toRating(userID, mode, value string) (domain.Rating, error) {
+	var record ratingRecord
+
+	err := json.Unmarshal([]byte(value), &record)
+	if err != nil {
+		return domain.Rating{}, fmt.Errorf("%w, invalid rating: %s", domain.ErrInternal, value)
+	}
+
+	return domain.Rating{
+		UserID:     userID,
+		Mode:       mode,
+		Rating:     record.Rating,
+		Deviation:  record.Deviation,
+		Volatility: record.Volatility,
+		Matches:    record.Matches,
+		UpdatedAt:  time.UnixMilli(record.UpdatedAt),
+	}, nil
+}
+
+// achievedAtKey returns the hash of the times that break the ties on the
+// sorted set of the key.
+func achievedAtKey(key string) string {
+	mode, ok := strings.CutPrefix(key, ratingKeyPrefix)
+	if ok {
+		return ratingAchievedAtKey(mode)
+	}
+
+	eventID, ok := strings.CutPrefix(key, eventKeyPrefix)
+	if ok {
+		return eventAchievedAtKey(eventID)
+	}
+
+	return userAchievedAtKey
+}
@@ -2,7 +2,9 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 
@@ -11,23 +13,146 @@ import (
 
 const (
 	leaderboardKey = "leaderboard"
+	// userCountriesKey maps the user IDs to the country leaderboard they
+	// are on, so their score can be moved or removed without reading the
+	// users collection.
+	userCountriesKey         = "leaderboard:countries"
+	countryLeaderboardPrefix = "leaderboard:country:"
 )
 
+var userScoreKeys = []string{
+	leaderboardKey,
+	userAchievedAtKey,
+	userCountriesKey,
+	ranksKey(leaderboardKey),
+}
+
+// userScoreScriptFunctions are shared by the scripts that write the scores
+// of the users. set_country moves the score of a user to the leaderboard of
+// the country, an empty country takes it off the leaderboards of the
+// countries. The country the user is on is read in the same script, so a
+// concurrent write can not leave the user on two of them. unindex_user and
+// index_user update the ranks of the global leaderboard and of the
+// leaderboard of the country the user is on. The leaderboards of the
+// countries can only be named in the script, so the scripts that use them
+// require a single redis node.
+const userScoreScriptFunctions = rankScriptFunctions + `
+local country_prefix = "` + countryLeaderboardPrefix + `"
+
+local function set_country(user_id, country, score)
+	local old_country = redis.call("HGET", KEYS[3], user_id)
+
+	if old_country and old_country ~= country then
+		redis.call("ZREM", country_prefix .. old_country, user_id)
+	end
+
+	if country == "" then
+		if old_country then
+			redis.call("HDEL", KEYS[3], user_id)
+		end
+
+		return
+	end
+
+	redis.call("ZADD", country_prefix .. country, score, user_id)
+
+	if old_country ~= country then
+		redis.call("HSET", KEYS[3], user_id, country)
+	end
+end
+
+local function unindex_user(user_id)
+	unindex_rank(KEYS[4], KEYS[2], user_id)
+
+	local country = redis.call("HGET", KEYS[3], user_id)
+	if country then
+		unindex_rank(country_prefix .. country .. ":ranks", KEYS[2], user_id)
+	end
+end
+
+local function index_user(user_id, tie_break)
+	index_rank(KEYS[1], KEYS[4], KEYS[2], user_id, tie_break)
+
+	local country = redis.call("HGET", KEYS[3], user_id)
+	if country then
+		index_rank(country_prefix .. country, country_prefix .. country .. ":ranks", KEYS[2], user_id, tie_break)
+	end
+end
+`
+
+// updateUserTopScoreScript writes the score of the user ARGV[1] to the
+// global leaderboard and to the leaderboard of its country. ARGV[2] is the
+// score, ARGV[3] the time it has been reached at, ARGV[4] the country and
+// ARGV[5] the tie break.
+const updateUserTopScoreScriptSource = userScoreScriptFunctions + `
+unindex_user(ARGV[1])
+
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+redis.call("HSET", KEYS[2], ARGV[1], ARGV[3])
+
+set_country(ARGV[1], ARGV[4], ARGV[2])
+index_user(ARGV[1], ARGV[5])
+
+return 1
+`
+
+// setUserCountryScript moves the score of the user ARGV[1] to the
+// leaderboard of the country ARGV[2], ARGV[3] is the tie break. It returns
+// 0 when the user is not on the leaderboard.
+const setUserCountryScriptSource = userScoreScriptFunctions + `
+local score = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if not score then
+	return 0
+end
+
+unindex_user(ARGV[1])
+set_country(ARGV[1], ARGV[2], score)
+index_user(ARGV[1], ARGV[3])
+
+return 1
+`
+
+// removeUserScoreScript takes the user ARGV[1] off the global leaderboard
+// and off the leaderboard of its country.
+const removeUserScoreScriptSource = userScoreScriptFunctions + `
+unindex_user(ARGV[1])
+
+redis.call("ZREM", KEYS[1], ARGV[1])
+redis.call("HDEL", KEYS[2], ARGV[1])
+
+set_country(ARGV[1], "", 0)
+
+return 1
+`
+
+var setUserCountryScript = redis.NewScript(setUserCountryScriptSource)
+
 type RedisUserScoreRepositoryDependencies struct {
 	Client *redis.Client
 
 	UserRepository domain.UserRepository
+	UserCache      domain.UserCache
+
+	// TieBreak ranks the users with the same score, it is either
+	// domain.TieBreakEarliest or domain.TieBreakLatest.
+	TieBreak string
 }
 
 type RedisUserScoreRepository struct {
 	client         *redis.Client
 	userRepository domain.UserRepository
+	userCache      domain.UserCache
+
+	tieBreak string
 }
 
 func NewRedisUserScoreRepository(deps RedisUserScoreRepositoryDependencies) *RedisUserScoreRepository {
 	return &RedisUserScoreRepository{
 		client:         deps.Client,
 		userRepository: deps.UserRepository,
+		userCache:      deps.UserCache,
+
+		tieBreak: deps.TieBreak,
 	}
 }
 
@@ -47,11 +172,18 @@ func (repo *RedisUserScoreRepository) GetUserTopScore(ctx context.Context, userI
 	}, nil
 }
 
-func (repo *RedisUserScoreRepository) UpdateUserTopScore(ctx context.Context, userID string, score float64) error {
-	_, err := repo.client.ZAdd(ctx, leaderboardKey, &redis.Z{
-		Score:  score,
-		Member: userID,
-	}).Result()
+// UpdateUserTopScore writes the score to the global leaderboard, to the
+// leaderboard of the country and to the aggregates of the clan of the user
+// in one transaction, the score is moved when the user was on the
+// leaderboard of another country. The sources of the scripts are sent as
+// EVALSHA can not fall back to them inside a transaction.
+func (repo *RedisUserScoreRepository) UpdateUserTopScore(ctx context.Context, userID, countryCode string, score float64, achievedAt time.Time) error {
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Eval(ctx, updateUserTopScoreScriptSource, userScoreKeys, userID, formatScore(score), achievedAt.UnixMilli(), countryCode, repo.tieBreak)
+		updateClanScore(ctx, pipe, userID)
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -59,14 +191,196 @@ func (repo *RedisUserScoreRepository) UpdateUserTopScore(ctx context.Context, us
 	return nil
 }
 
+// SetUserCountry moves the score of the user to the leaderboard of the new
+// country, it does nothing when the user is not on the leaderboard.
+func (repo *RedisUserScoreRepository) SetUserCountry(ctx context.Context, userID, countryCode string) error {
+	err := setUserCountryScript.Run(ctx, repo.client, userScoreKeys, userID, countryCode, repo.tieBreak).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) RemoveUserScore(ctx context.Context, userID string) error {
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Eval(ctx, removeUserScoreScriptSource, userScoreKeys, userID)
+		updateClanScore(ctx, pipe, userID)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetUserCountries returns the countries whose leaderboard the users are
+// on, the users that are on none are left out.
+func (repo *RedisUserScoreRepository) GetUserCountries(ctx context.Context, userIDs []string) (map[string]string, error) {
+	countries := make(map[string]string)
+
+	if len(userIDs) == 0 {
+		return countries, nil
+	}
+
+	values, err := repo.client.HMGet(ctx, userCountriesKey, userIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		if countryCode, ok := value.(string); ok {
+			countries[userIDs[i]] = countryCode
+		}
+	}
+
+	return countries, nil
+}
+
+// getUserCountry returns an empty string when the user is not on the
+// leaderboard of any country.
+func (repo *RedisUserScoreRepository) getUserCountry(ctx context.Context, userID string) (string, error) {
+	countryCode, err := repo.client.HGet(ctx, userCountriesKey, userID).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return countryCode, nil
+}
+
 func (repo *RedisUserScoreRepository) GetLeaderboard(ctx context.Context) (domain.Leaderboard, error) {
-	userScores, err := repo.client.ZRevRangeWithScores(ctx, leaderboardKey, 0, -1).Result()
+	return repo.getLeaderboard(ctx, leaderboardKey, 0, -1)
+}
+
+func (repo *RedisUserScoreRepository) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	return repo.getLeaderboard(ctx, leaderboardKey, 0, count-1)
+}
+
+func (repo *RedisUserScoreRepository) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	return repo.getLeaderboard(ctx, countryLeaderboardPrefix+countryCode, 0, -1)
+}
+
+// GetUsersLeaderboard reads the scores of the users with a single ZMSCORE.
+// It is sent as a raw command since ZMScore reads the users that are not on
+// the leaderboard as a score of 0, and scores of 0 or below are valid.
+func (repo *RedisUserScoreRepository) GetUsersLeaderboard(ctx context.Context, userIDs []string) (domain.Leaderboard, error) {
+	leaderboard := domain.Leaderboard{
+		UserScores: []domain.UserScore{},
+	}
+
+	if len(userIDs) == 0 {
+		return leaderboard, nil
+	}
+
+	args := []interface{}{"zmscore", leaderboardKey}
+
+	for _, userID := range userIDs {
+		args = append(args, userID)
+	}
+
+	scores, err := repo.client.Do(ctx, args...).Slice()
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	var userScores []redis.Z
+
+	for i, userID := range userIDs {
+		if scores[i] == nil {
+			continue
+		}
+
+		score, err := parseScore(scores[i])
+		if err != nil {
+			return domain.Leaderboard{}, err
+		}
+
+		userScores = append(userScores, redis.Z{
+			Score:  score,
+			Member: userID,
+		})
+	}
+
+	err = repo.sortUserScores(ctx, leaderboardKey, userScores)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	return repo.toLeaderboard(ctx, userScores)
+}
+
+// GetUserRank returns the ranks of the user along with the users ranked
+// within count places of it on the global leaderboard.
+func (repo *RedisUserScoreRepository) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	rank, err := repo.getRank(ctx, leaderboardKey, userID, count)
+	if err != nil {
+		return domain.UserRank{}, err
+	}
+
+	neighbours, err := repo.toLeaderboard(ctx, rank.neighbours)
+	if err != nil {
+		return domain.UserRank{}, err
+	}
+
+	userRank := domain.UserRank{
+		UserID:              userID,
+		Score:               rank.score,
+		Rank:                rank.rank + 1,
+		Neighbours:          neighbours.UserScores,
+		NeighboursStartRank: rank.neighboursStart + 1,
+		Counts:              rank.counts,
+		NeighboursCounts:    rank.neighboursCounts,
+	}
+
+	userRank.CountryCode, err = repo.getUserCountry(ctx, userID)
+	if err != nil {
+		return domain.UserRank{}, err
+	}
+
+	if userRank.CountryCode != "" {
+		countryRank, err := repo.getRank(ctx, countryLeaderboardPrefix+userRank.CountryCode, userID, -1)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return domain.UserRank{}, err
+		}
+
+		if err == nil {
+			userRank.CountryRank = countryRank.rank + 1
+			userRank.CountryCounts = countryRank.counts
+		}
+	}
+
+	return userRank, nil
+}
+
+// getLeaderboard reads the users ranked from start to stop, both inclusive
+// and starting at 0, on the sorted set of the key.
+func (repo *RedisUserScoreRepository) getLeaderboard(ctx context.Context, key string, start, stop int64) (domain.Leaderboard, error) {
+	userScores, total, err := repo.getRankRange(ctx, key, start, stop)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	leaderboard, err := repo.toLeaderboard(ctx, userScores)
 	if err != nil {
 		return domain.Leaderboard{}, err
 	}
 
+	leaderboard.Total = total
+
+	return leaderboard, nil
+}
+
+// toLeaderboard resolves the users of the scores, the scores have to be
+// sorted already.
+func (repo *RedisUserScoreRepository) toLeaderboard(ctx context.Context, userScores []redis.Z) (domain.Leaderboard, error) {
 	leaderboard := domain.Leaderboard{
-		UserScores: make([]domain.UserScore, len(userScores)),
+		UserScores: make([]domain.UserScore, 0, len(userScores)),
 	}
 
 	var userIDs []string
@@ -80,29 +394,107 @@ func (repo *RedisUserScoreRepository) GetLeaderboard(ctx context.Context) (domai
 		userIDs = append(userIDs, userID)
 	}
 
-	users, err := repo.userRepository.GetUsersByIDs(ctx, userIDs)
+	users, missingUserIDs, err := repo.getUsers(ctx, userIDs)
 	if err != nil {
 		return domain.Leaderboard{}, err
 	}
 
+	for _, userScore := range userScores {
+		userID := userScore.Member.(string)
+
+		result := domain.UserScore{
+			UserID: userID,
+			Score:  userScore.Score,
+		}
+
+		user, ok := users[userID]
+
+		switch {
+		case ok:
+			result.Username = user.Name
+			result.Profile = user.Profile
+		case missingUserIDs[userID]:
+			result.Username = domain.UnknownUsername
+			result.Missing = true
+		default:
+			// banned users are removed from the sorted set when they are
+			// banned, this only hides the ones whose removal has failed.
+			continue
+		}
+
+		leaderboard.UserScores = append(leaderboard.UserScores, result)
+	}
+
+	return leaderboard, nil
+}
+
+// getUsers resolves the public users from the user cache and only reads the
+// users collection for the ones that are missing from it. Banned users are
+// neither cached nor returned, users that do not exist are returned
+// separately.
+func (repo *RedisUserScoreRepository) getUsers(ctx context.Context, userIDs []string) (map[string]domain.PublicUser, map[string]bool, error) {
+	publicUsers, err := repo.userCache.Get(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uncachedUserIDs []string
+
+	for _, userID := range userIDs {
+		if _, ok := publicUsers[userID]; !ok {
+			uncachedUserIDs = append(uncachedUserIDs, userID)
+		}
+	}
+
+	if len(uncachedUserIDs) == 0 {
+		return publicUsers, nil, nil
+	}
+
+	users, err := repo.userRepository.GetUsersByIDs(ctx, uncachedUserIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	userByID := make(map[string]domain.User)
 
 	for _, user := range users {
 		userByID[user.ID] = user
 	}
 
-	for i, userScore := range userScores {
-		user, ok := userByID[userScore.Member.(string)]
+	uncachedUsers := make(map[string]domain.PublicUser)
+	missingUserIDs := make(map[string]bool)
+
+	for _, userID := range uncachedUserIDs {
+		user, ok := userByID[userID]
 		if !ok {
-			return domain.Leaderboard{}, fmt.Errorf("%w, user not found: %s", domain.ErrInternal, userScore.Member.(string))
+			missingUserIDs[userID] = true
+			continue
 		}
 
-		leaderboard.UserScores[i] = domain.UserScore{
-			UserID:   user.ID,
-			Username: user.Name,
-			Score:    userScore.Score,
+		if user.Banned {
+			continue
 		}
+
+		uncachedUsers[user.ID] = user.Public()
 	}
 
-	return leaderboard, nil
+	err = repo.userCache.Set(ctx, uncachedUsers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for userID, user := range uncachedUsers {
+		publicUsers[userID] = user
+	}
+
+	return publicUsers, missingUserIDs, nil
+}
+
+func (repo *RedisUserScoreRepository) GetRankedUserIDs(ctx context.Context) ([]string, error) {
+	userIDs, err := repo.client.ZRange(ctx, leaderboardKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
 }
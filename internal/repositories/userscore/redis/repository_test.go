@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/go-redis/redismock/v8"
@@ -21,6 +22,7 @@ type RedisUserScoreRepositoryTestSuite struct {
 
 	redisMock          redismock.ClientMock
 	mockUserRepository *mocks.MockUserRepository
+	mockUserCache      *mocks.MockUserCache
 }
 
 func TestRedisUserScoreRepositoryTestSuite(t *testing.T) {
@@ -32,10 +34,12 @@ func (suite *RedisUserScoreRepositoryTestSuite) SetupTest() {
 
 	suite.redisMock = mock
 	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserCache = mocks.NewMockUserCache(suite.T())
 
 	suite.repository = NewRedisUserScoreRepository(RedisUserScoreRepositoryDependencies{
 		Client:         db,
 		UserRepository: suite.mockUserRepository,
+		UserCache:      suite.mockUserCache,
 	})
 }
 
@@ -43,6 +47,19 @@ func (suite *RedisUserScoreRepositoryTestSuite) TearDownTest() {
 	suite.NoError(suite.redisMock.ExpectationsWereMet())
 }
 
+// achievedAt is the time the scores updated in the tests have been reached at.
+var achievedAt = time.UnixMilli(1700000000000)
+
+// rankMember is the member of the user in the ranks, ranked at the
+// achievedAt time with the earliest tie break.
+func rankMember(userID string) string {
+	return "998299999999999:" + userID
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) expectClanScoreUpdate(userID string) {
+	suite.redisMock.ExpectEval(clanScoreScriptSource, clanScoreKeys, userID, "0", "").SetVal(int64(0))
+}
+
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserTopScore() {
 	suite.redisMock.
 		ExpectZScore("leaderboard", "user-id").
@@ -73,44 +90,290 @@ func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserTopScore_ZScoreNotFou
 }
 
 func (suite *RedisUserScoreRepositoryTestSuite) TestUpdateUserTopScore() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectEval(updateUserTopScoreScriptSource, userScoreKeys, "user-id", "900", achievedAt.UnixMilli(), "DE", "").
+		SetVal(int64(1))
+	suite.expectClanScoreUpdate("user-id")
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.UpdateUserTopScore(context.Background(), "user-id", "DE", 900, achievedAt)
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestUpdateUserTopScore_NoCountry() {
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZAdd("leaderboard", &redis.Z{
-			Score:  900,
-			Member: "user-id",
-		}).
-		SetVal(900)
+		ExpectEval(updateUserTopScoreScriptSource, userScoreKeys, "user-id", "900", achievedAt.UnixMilli(), "", "").
+		SetVal(int64(1))
+	suite.expectClanScoreUpdate("user-id")
+	suite.redisMock.ExpectTxPipelineExec()
 
-	err := suite.repository.UpdateUserTopScore(context.Background(), "user-id", 900)
+	err := suite.repository.UpdateUserTopScore(context.Background(), "user-id", "", 900, achievedAt)
 	suite.NoError(err)
 }
 
-func (suite *RedisUserScoreRepositoryTestSuite) TestUpdateUserTopScore_ZAddFailed() {
+func (suite *RedisUserScoreRepositoryTestSuite) TestUpdateUserTopScore_Failed() {
 	someError := errors.New("some error")
 
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZAdd("leaderboard", &redis.Z{
-			Score:  900,
-			Member: "user-id",
-		}).
+		ExpectEval(updateUserTopScoreScriptSource, userScoreKeys, "user-id", "900", achievedAt.UnixMilli(), "DE", "").
 		SetErr(someError)
 
-	err := suite.repository.UpdateUserTopScore(context.Background(), "user-id", 900)
-	suite.ErrorIs(err, someError)
+	err := suite.repository.UpdateUserTopScore(context.Background(), "user-id", "DE", 900, achievedAt)
+	suite.Error(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSetUserCountry() {
+	suite.redisMock.
+		ExpectEvalSha(setUserCountryScript.Hash(), userScoreKeys, "user-id", "", "").
+		SetVal(int64(1))
+
+	err := suite.repository.SetUserCountry(context.Background(), "user-id", "")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSetUserCountry_NoScore() {
+	suite.redisMock.
+		ExpectEvalSha(setUserCountryScript.Hash(), userScoreKeys, "user-id", "DE", "").
+		SetVal(int64(0))
+
+	err := suite.repository.SetUserCountry(context.Background(), "user-id", "DE")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserCountries() {
+	suite.redisMock.
+		ExpectHMGet("leaderboard:countries", "user-id-1", "user-id-2").
+		SetVal([]interface{}{"DE", nil})
+
+	countries, err := suite.repository.GetUserCountries(context.Background(), []string{"user-id-1", "user-id-2"})
+	suite.NoError(err)
+	suite.Equal(map[string]string{"user-id-1": "DE"}, countries)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetCountryLeaderboard() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:country:DE:ranks", 0, -1).
+		SetVal([]redis.Z{{Score: 900, Member: rankMember("user-id-1")}})
+	suite.redisMock.ExpectZCard("leaderboard:country:DE:ranks").SetVal(1)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, nil)
+
+	leaderboard, err := suite.repository.GetCountryLeaderboard(context.Background(), "DE")
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-1", Username: "user-1", Score: 900},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUsersLeaderboard() {
+	suite.redisMock.
+		ExpectDo("zmscore", "leaderboard", "user-id-1", "user-id-2", "user-id-3", "user-id-4").
+		SetVal([]interface{}{"800", nil, "900", "800"})
+
+	suite.redisMock.
+		ExpectHMGet("leaderboard:achieved_at", "user-id-1", "user-id-4").
+		SetVal([]interface{}{"1000", "2000"})
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-3", "user-id-1", "user-id-4"}).
+		Return(map[string]domain.PublicUser{
+			"user-id-1": {Name: "user-1"},
+			"user-id-3": {Name: "user-3"},
+			"user-id-4": {Name: "user-4"},
+		}, nil)
+
+	leaderboard, err := suite.repository.GetUsersLeaderboard(context.Background(), []string{"user-id-1", "user-id-2", "user-id-3", "user-id-4"})
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-3", Username: "user-3", Score: 900},
+		{UserID: "user-id-1", Username: "user-1", Score: 800},
+		{UserID: "user-id-4", Username: "user-4", Score: 800},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUsersLeaderboard_ZeroScore() {
+	suite.redisMock.
+		ExpectDo("zmscore", "leaderboard", "user-id-1", "user-id-2", "user-id-3").
+		SetVal([]interface{}{"0", nil, "-10"})
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-3"}).
+		Return(map[string]domain.PublicUser{
+			"user-id-1": {Name: "user-1"},
+			"user-id-3": {Name: "user-3"},
+		}, nil)
+
+	leaderboard, err := suite.repository.GetUsersLeaderboard(context.Background(), []string{"user-id-1", "user-id-2", "user-id-3"})
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-1", Username: "user-1", Score: 0},
+		{UserID: "user-id-3", Username: "user-3", Score: -10},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUsersLeaderboard_ZMScoreFailed() {
+	suite.redisMock.
+		ExpectDo("zmscore", "leaderboard", "user-id-1").
+		SetErr(errors.New("unexpected error"))
+
+	_, err := suite.repository.GetUsersLeaderboard(context.Background(), []string{"user-id-1"})
+	suite.Error(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserRank() {
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("leaderboard"), "user-id-2", "", int64(1)).
+		SetVal([]interface{}{
+			int64(1), "800", []interface{}{int64(1), int64(1), int64(2)},
+			int64(0), []interface{}{int64(0), int64(0), int64(2)},
+			[]interface{}{rankMember("user-id-1"), "900", rankMember("user-id-2"), "800"},
+		})
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}, "user-id-2": {Name: "user-2"}}, nil)
+
+	suite.redisMock.ExpectHGet("leaderboard:countries", "user-id-2").SetVal("DE")
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("leaderboard:country:DE"), "user-id-2", "", int64(-1)).
+		SetVal([]interface{}{int64(0), "800", []interface{}{int64(0), int64(0), int64(1)}})
+
+	userRank, err := suite.repository.GetUserRank(context.Background(), "user-id-2", 1)
+	suite.NoError(err)
+
+	suite.Equal(domain.UserRank{
+		UserID:      "user-id-2",
+		Score:       800,
+		Rank:        2,
+		CountryCode: "DE",
+		CountryRank: 1,
+		Neighbours: []domain.UserScore{
+			{UserID: "user-id-1", Username: "user-1", Score: 900},
+			{UserID: "user-id-2", Username: "user-2", Score: 800},
+		},
+		NeighboursStartRank: 1,
+		Counts:              domain.RankCounts{Above: 1, DistinctAbove: 1, Total: 2},
+		CountryCounts:       domain.RankCounts{Total: 1},
+		NeighboursCounts:    domain.RankCounts{Total: 2},
+	}, userRank)
+}
+
+// TestGetUserRank_Tied ranks a user tied with others by the position of its
+// member in the ranks, the rank and the neighbours have to agree.
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserRank_Tied() {
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("leaderboard"), "user-id-3", "", int64(0)).
+		SetVal([]interface{}{
+			int64(1), "800", []interface{}{int64(0), int64(0), int64(3)},
+			int64(1), []interface{}{int64(0), int64(0), int64(3)},
+			[]interface{}{rankMember("user-id-3"), "800"},
+		})
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-3"}).
+		Return(map[string]domain.PublicUser{"user-id-3": {Name: "user-3"}}, nil)
+
+	suite.redisMock.ExpectHGet("leaderboard:countries", "user-id-3").RedisNil()
+
+	userRank, err := suite.repository.GetUserRank(context.Background(), "user-id-3", 0)
+	suite.NoError(err)
+
+	suite.Equal(int64(2), userRank.Rank)
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-3", Username: "user-3", Score: 800},
+	}, userRank.Neighbours)
+	suite.Equal(int64(2), userRank.NeighboursStartRank)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserRank_TieBreakLatest() {
+	suite.repository.tieBreak = domain.TieBreakLatest
+
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("leaderboard"), "user-id", domain.TieBreakLatest, int64(1)).
+		RedisNil()
+
+	_, err := suite.repository.GetUserRank(context.Background(), "user-id", 1)
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_TieBreakLatest() {
+	suite.repository.tieBreak = domain.TieBreakLatest
+
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
+		SetVal([]redis.Z{
+			{Score: 900, Member: "001700000000002:user-id-1"},
+			{Score: 900, Member: "001700000000001:user-id-2"},
+			{Score: 800, Member: "001700000000000:user-id-3"},
+		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(3)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2", "user-id-3"}).
+		Return(map[string]domain.PublicUser{
+			"user-id-1": {Name: "user-1"},
+			"user-id-2": {Name: "user-2"},
+			"user-id-3": {Name: "user-3"},
+		}, nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-1", Username: "user-1", Score: 900},
+		{UserID: "user-id-2", Username: "user-2", Score: 900},
+		{UserID: "user-id-3", Username: "user-3", Score: 800},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserRank_NotRanked() {
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("leaderboard"), "user-id", "", int64(1)).
+		RedisNil()
+
+	_, err := suite.repository.GetUserRank(context.Background(), "user-id", 1)
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
 }
 
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard() {
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZRevRangeWithScores("leaderboard", 0, -1).
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
 		SetVal([]redis.Z{
 			{
 				Score:  900,
-				Member: "user-id-1",
+				Member: rankMember("user-id-1"),
 			},
 			{
 				Score:  800,
-				Member: "user-id-2",
+				Member: rankMember("user-id-2"),
 			},
 		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{}, nil)
 
 	suite.mockUserRepository.
 		EXPECT().
@@ -128,15 +391,43 @@ func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard() {
 			},
 		}, nil)
 
-	_, err := suite.repository.GetLeaderboard(context.Background())
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}, "user-id-2": {Name: "user-2"}}).
+		Return(nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+	suite.Equal(int64(2), leaderboard.Total)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetTopLeaderboard() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, 0).
+		SetVal([]redis.Z{{Score: 900, Member: rankMember("user-id-1")}})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(3)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, nil)
+
+	leaderboard, err := suite.repository.GetTopLeaderboard(context.Background(), 1)
 	suite.NoError(err)
+	suite.Equal(domain.Leaderboard{
+		UserScores: []domain.UserScore{{UserID: "user-id-1", Username: "user-1", Score: 900}},
+		Total:      3,
+	}, leaderboard)
 }
 
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_ZRevRangeWithScoresFailed() {
 	someError := errors.New("some error")
 
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZRevRangeWithScores("leaderboard", 0, -1).
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
 		SetErr(someError)
 
 	_, err := suite.repository.GetLeaderboard(context.Background())
@@ -146,18 +437,26 @@ func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_ZRevRangeWith
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_GetUsersByIDsFailed() {
 	someError := errors.New("some error")
 
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZRevRangeWithScores("leaderboard", 0, -1).
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
 		SetVal([]redis.Z{
 			{
 				Score:  900,
-				Member: "user-id-1",
+				Member: rankMember("user-id-1"),
 			},
 			{
 				Score:  800,
-				Member: "user-id-2",
+				Member: rankMember("user-id-2"),
 			},
 		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{}, nil)
 
 	suite.mockUserRepository.
 		EXPECT().
@@ -169,47 +468,580 @@ func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_GetUsersByIDs
 }
 
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_GetUsersByIDsNotFound() {
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZRevRangeWithScores("leaderboard", 0, -1).
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
 		SetVal([]redis.Z{
 			{
 				Score:  900,
-				Member: "user-id-1",
+				Member: rankMember("user-id-1"),
 			},
 			{
 				Score:  800,
-				Member: "user-id-2",
+				Member: rankMember("user-id-2"),
 			},
 		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{}, nil)
 
 	suite.mockUserRepository.
 		EXPECT().
 		GetUsersByIDs(mock.Anything, []string{"user-id-1", "user-id-2"}).
-		Return(nil, nil)
+		Return([]domain.User{
+			{
+				ID:   "user-id-1",
+				Name: "user-1",
+			},
+		}, nil)
 
-	_, err := suite.repository.GetLeaderboard(context.Background())
-	suite.ErrorIs(err, domain.ErrInternal)
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}).
+		Return(nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{
+			UserID:   "user-id-1",
+			Username: "user-1",
+			Score:    900,
+		},
+		{
+			UserID:   "user-id-2",
+			Username: domain.UnknownUsername,
+			Score:    800,
+			Missing:  true,
+		},
+	}, leaderboard.UserScores)
 }
 
 func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_GetUsersByIDsEmpty() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
+		SetVal([]redis.Z{
+			{
+				Score:  900,
+				Member: rankMember("user-id-1"),
+			},
+		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(1)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]domain.PublicUser{}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1"}).
+		Return(nil, nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{}).
+		Return(nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{
+			UserID:   "user-id-1",
+			Username: domain.UnknownUsername,
+			Score:    900,
+			Missing:  true,
+		},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetRankedUserIDs() {
+	suite.redisMock.
+		ExpectZRange("leaderboard", 0, -1).
+		SetVal([]string{"user-id-1", "user-id-2"})
+
+	userIDs, err := suite.repository.GetRankedUserIDs(context.Background())
+	suite.NoError(err)
+	suite.Equal([]string{"user-id-1", "user-id-2"}, userIDs)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestRemoveUserScore() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.ExpectEval(removeUserScoreScriptSource, userScoreKeys, "user-id").SetVal(int64(1))
+	suite.expectClanScoreUpdate("user-id")
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.RemoveUserScore(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_SkipsBannedUsers() {
+	suite.redisMock.ExpectTxPipeline()
 	suite.redisMock.
-		ExpectZRevRangeWithScores("leaderboard", 0, -1).
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
 		SetVal([]redis.Z{
 			{
 				Score:  900,
-				Member: "user-id-1",
+				Member: rankMember("user-id-1"),
 			},
 			{
 				Score:  800,
-				Member: "user-id-2",
+				Member: rankMember("user-id-2"),
 			},
 		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{}, nil)
 
 	suite.mockUserRepository.
 		EXPECT().
 		GetUsersByIDs(mock.Anything, []string{"user-id-1", "user-id-2"}).
-		Return([]domain.User{}, nil)
+		Return([]domain.User{
+			{
+				ID:     "user-id-1",
+				Name:   "user-1",
+				Banned: true,
+			},
+			{
+				ID:   "user-id-2",
+				Name: "user-2",
+			},
+		}, nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-2": {Name: "user-2"}}).
+		Return(nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{
+			UserID:   "user-id-2",
+			Username: "user-2",
+			Score:    800,
+		},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_UsersCached() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
+		SetVal([]redis.Z{
+			{
+				Score:  900,
+				Member: rankMember("user-id-1"),
+			},
+			{
+				Score:  800,
+				Member: rankMember("user-id-2"),
+			},
+		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{
+			"user-id-1": {Name: "user-1", Profile: domain.UserProfile{DisplayName: "User One", CountryCode: "DE"}},
+			"user-id-2": {Name: "user-2"},
+		}, nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Equal([]domain.UserScore{
+		{
+			UserID:   "user-id-1",
+			Username: "user-1",
+			Score:    900,
+			Profile:  domain.UserProfile{DisplayName: "User One", CountryCode: "DE"},
+		},
+		{
+			UserID:   "user-id-2",
+			Username: "user-2",
+			Score:    800,
+		},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_UsersPartiallyCached() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
+		SetVal([]redis.Z{
+			{
+				Score:  900,
+				Member: rankMember("user-id-1"),
+			},
+			{
+				Score:  800,
+				Member: rankMember("user-id-2"),
+			},
+		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-2"}).
+		Return([]domain.User{
+			{
+				ID:   "user-id-2",
+				Name: "user-2",
+			},
+		}, nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-2": {Name: "user-2"}}).
+		Return(nil)
+
+	leaderboard, err := suite.repository.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	suite.Len(leaderboard.UserScores, 2)
+	suite.Equal("user-2", leaderboard.UserScores[1].Username)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetLeaderboard_UserCacheFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:ranks", 0, -1).
+		SetVal([]redis.Z{
+			{
+				Score:  900,
+				Member: rankMember("user-id-1"),
+			},
+		})
+	suite.redisMock.ExpectZCard("leaderboard:ranks").SetVal(1)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(nil, someError)
 
 	_, err := suite.repository.GetLeaderboard(context.Background())
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSetUserClan() {
+	suite.redisMock.
+		ExpectEvalSha(clanScoreScript.Hash(), clanScoreKeys, "user-id", "1", "clan-id").
+		SetVal(int64(1))
+
+	err := suite.repository.SetUserClan(context.Background(), "user-id", "clan-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestRemoveClan() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.ExpectZRem("leaderboard:clans:sum", "clan-id").SetVal(1)
+	suite.redisMock.ExpectZRem("leaderboard:clans:average", "clan-id").SetVal(1)
+	suite.redisMock.ExpectHDel("leaderboard:clans:scored_members", "clan-id").SetVal(1)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.RemoveClan(context.Background(), "clan-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetClanLeaderboard() {
+	suite.redisMock.
+		ExpectZRevRangeWithScores("leaderboard:clans:average", 0, -1).
+		SetVal([]redis.Z{
+			{
+				Score:  450,
+				Member: "clan-id-1",
+			},
+			{
+				Score:  0,
+				Member: "clan-id-2",
+			},
+		})
+
+	suite.redisMock.
+		ExpectHMGet("leaderboard:clans:scored_members", "clan-id-1", "clan-id-2").
+		SetVal([]interface{}{"2", nil})
+
+	clanScores, err := suite.repository.GetClanLeaderboard(context.Background(), domain.ClanAggregateAverage)
+	suite.NoError(err)
+	suite.Equal([]domain.ClanScore{
+		{ClanID: "clan-id-1", Score: 450, ScoredMembers: 2},
+		{ClanID: "clan-id-2", Score: 0},
+	}, clanScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetClanLeaderboard_UnknownAggregate() {
+	_, err := suite.repository.GetClanLeaderboard(context.Background(), "median")
 	suite.ErrorIs(err, domain.ErrInternal)
 }
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetRatings() {
+	suite.redisMock.
+		ExpectHMGet("rating:duel:players", "user-id-1", "user-id-2").
+		SetVal([]interface{}{`{"rating":1620.5,"deviation":80,"volatility":0.06,"matches":12,"updatedAt":1700000000000}`, nil})
+
+	ratings, err := suite.repository.GetRatings(context.Background(), "duel", []string{"user-id-1", "user-id-2"})
+	suite.NoError(err)
+	suite.Equal(map[string]domain.Rating{
+		"user-id-1": {
+			UserID:     "user-id-1",
+			Mode:       "duel",
+			Rating:     1620.5,
+			Deviation:  80,
+			Volatility: 0.06,
+			Matches:    12,
+			UpdatedAt:  achievedAt,
+		},
+	}, ratings)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) expectSaveRatings(result int64) {
+	suite.redisMock.
+		ExpectEvalSha(saveRatingsScript.Hash(), []string{
+			"rating:duel:players",
+			"rating:duel",
+			"rating:duel:achieved_at",
+			"rating:modes",
+			"rating:duel:match:match-1",
+			"rating:duel:ranks",
+		}, "duel", int64(2592000), "",
+			"user-id", int64(0), `{"rating":1662.3,"deviation":290.3,"volatility":0.06,"matches":1,"updatedAt":1700000000000}`, "1662.3", int64(1700000000000),
+		).
+		SetVal(result)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) saveRatings() (bool, error) {
+	return suite.repository.SaveRatings(context.Background(), "match-1", "duel", []domain.Rating{
+		{UserID: "user-id", Mode: "duel", Rating: 1662.3, Deviation: 290.3, Volatility: 0.06, Matches: 1, UpdatedAt: achievedAt},
+	})
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSaveRatings() {
+	suite.expectSaveRatings(1)
+
+	saved, err := suite.saveRatings()
+	suite.NoError(err)
+	suite.True(saved)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSaveRatings_Conflict() {
+	suite.expectSaveRatings(0)
+
+	saved, err := suite.saveRatings()
+	suite.NoError(err)
+	suite.False(saved)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSaveRatings_MatchSaved() {
+	suite.expectSaveRatings(-1)
+
+	_, err := suite.saveRatings()
+	suite.ErrorIs(err, domain.ErrResourceExists)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetRatingRank() {
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("rating:duel"), "user-id-2", "", int64(-1)).
+		SetVal([]interface{}{int64(1), "1600", []interface{}{int64(1), int64(1), int64(2)}})
+
+	rank, err := suite.repository.GetRatingRank(context.Background(), "duel", "user-id-2")
+	suite.NoError(err)
+	suite.Equal(int64(2), rank)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetRatingRank_NotRated() {
+	suite.redisMock.
+		ExpectEvalSha(rankScript.Hash(), rankKeys("rating:duel"), "user-id", "", int64(-1)).
+		RedisNil()
+
+	_, err := suite.repository.GetRatingRank(context.Background(), "duel", "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestRemoveUserRatings() {
+	suite.redisMock.
+		ExpectSMembers("rating:modes").
+		SetVal([]string{"free_for_all", "duel"})
+
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.ExpectHDel("rating:duel:players", "user-id").SetVal(1)
+	suite.redisMock.ExpectEval(removeScoreScriptSource, rankKeys("rating:duel"), "user-id").SetVal(int64(1))
+	suite.redisMock.ExpectHDel("rating:free_for_all:players", "user-id").SetVal(0)
+	suite.redisMock.ExpectEval(removeScoreScriptSource, rankKeys("rating:free_for_all"), "user-id").SetVal(int64(1))
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.RemoveUserRatings(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) expectSubmitEventScore(result []interface{}) {
+	suite.redisMock.
+		ExpectEvalSha(submitEventScoreScript.Hash(), []string{
+			"event:event-id:frozen",
+			"event:event-id:attempts",
+			"event:event-id",
+			"event:event-id:achieved_at",
+			"events",
+			"event:event-id:ranks",
+		}, "user-id", "1250", int64(1700000000000), int64(3), "event-id", "").
+		SetVal(result)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) submitEventScore() (domain.EventSubmission, error) {
+	return suite.repository.SubmitEventScore(context.Background(), "event-id", "user-id", 1250, achievedAt, 3)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSubmitEventScore() {
+	suite.expectSubmitEventScore([]interface{}{int64(1), int64(2)})
+
+	submission, err := suite.submitEventScore()
+	suite.NoError(err)
+	suite.Equal(domain.EventSubmission{Recorded: true, Attempts: 2}, submission)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSubmitEventScore_NoAttemptsLeft() {
+	suite.expectSubmitEventScore([]interface{}{int64(0), int64(3)})
+
+	submission, err := suite.submitEventScore()
+	suite.NoError(err)
+	suite.Equal(domain.EventSubmission{Attempts: 3}, submission)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestSubmitEventScore_Frozen() {
+	suite.expectSubmitEventScore([]interface{}{int64(-1), int64(0)})
+
+	submission, err := suite.submitEventScore()
+	suite.NoError(err)
+	suite.Equal(domain.EventSubmission{Frozen: true}, submission)
+}
+
+// TestGetEventLeaderboard_Tied reads the ties in the order of the ranks,
+// the scores are not sorted again.
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetEventLeaderboard_Tied() {
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.
+		ExpectZRevRangeWithScores("event:event-id:ranks", 0, 9).
+		SetVal([]redis.Z{
+			{Score: 1250, Member: rankMember("user-id-2")},
+			{Score: 1250, Member: rankMember("user-id-1")},
+		})
+	suite.redisMock.ExpectZCard("event:event-id:ranks").SetVal(2)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-2", "user-id-1"}).
+		Return(map[string]domain.PublicUser{
+			"user-id-1": {Name: "user-1"},
+			"user-id-2": {Name: "user-2"},
+		}, nil)
+
+	leaderboard, err := suite.repository.GetEventLeaderboard(context.Background(), "event-id", 10)
+	suite.NoError(err)
+	suite.Equal([]domain.UserScore{
+		{UserID: "user-id-2", Username: "user-2", Score: 1250},
+		{UserID: "user-id-1", Username: "user-1", Score: 1250},
+	}, leaderboard.UserScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestFreezeEventBoard() {
+	suite.redisMock.
+		ExpectSet("event:event-id:frozen", "1", 0).
+		SetVal("OK")
+
+	err := suite.repository.FreezeEventBoard(context.Background(), "event-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestGetUserEventScores() {
+	suite.redisMock.
+		ExpectSMembers("events").
+		SetVal([]string{"event-id-2", "event-id-1"})
+
+	suite.redisMock.
+		ExpectZScore("event:event-id-1", "user-id").
+		SetVal(1250)
+
+	suite.redisMock.
+		ExpectHGet("event:event-id-1:attempts", "user-id").
+		SetVal("2")
+
+	suite.redisMock.
+		ExpectZScore("event:event-id-2", "user-id").
+		RedisNil()
+
+	eventScores, err := suite.repository.GetUserEventScores(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Equal([]domain.EventScore{
+		{EventID: "event-id-1", UserID: "user-id", Score: 1250, Attempts: 2},
+	}, eventScores)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestRemoveUserEventScores() {
+	suite.redisMock.
+		ExpectSMembers("events").
+		SetVal([]string{"event-id"})
+
+	suite.redisMock.ExpectTxPipeline()
+	suite.redisMock.ExpectEval(removeScoreScriptSource, rankKeys("event:event-id"), "user-id").SetVal(int64(1))
+	suite.redisMock.ExpectHDel("event:event-id:attempts", "user-id").SetVal(1)
+	suite.redisMock.ExpectTxPipelineExec()
+
+	err := suite.repository.RemoveUserEventScores(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *RedisUserScoreRepositoryTestSuite) TestIndexRanks() {
+	suite.redisMock.
+		ExpectHVals("leaderboard:countries").
+		SetVal([]string{"DE", "DE"})
+	suite.redisMock.
+		ExpectSMembers("rating:modes").
+		SetVal([]string{"duel"})
+	suite.redisMock.
+		ExpectSMembers("events").
+		SetVal([]string{"event-id"})
+
+	suite.redisMock.
+		ExpectEvalSha(indexRanksScript.Hash(), rankKeys("leaderboard"), "", int64(0), int64(499)).
+		SetVal(int64(500))
+	suite.redisMock.
+		ExpectEvalSha(indexRanksScript.Hash(), rankKeys("leaderboard"), "", int64(500), int64(999)).
+		SetVal(int64(1))
+	suite.redisMock.
+		ExpectEvalSha(indexRanksScript.Hash(), rankKeys("leaderboard:country:DE"), "", int64(0), int64(499)).
+		SetVal(int64(1))
+	suite.redisMock.
+		ExpectEvalSha(indexRanksScript.Hash(), rankKeys("rating:duel"), "", int64(0), int64(499)).
+		SetVal(int64(0))
+	suite.redisMock.
+		ExpectEvalSha(indexRanksScript.Hash(), rankKeys("event:event-id"), "", int64(0), int64(499)).
+		SetVal(int64(2))
+
+	err := suite.repository.IndexRanks(context.Background())
+	suite.NoError(err)
+}
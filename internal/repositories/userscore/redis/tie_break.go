@@ -0,0 +1,471 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+// userAchievedAtKey maps the user IDs to the unix time in milliseconds they
+// have reached their top score at, it breaks the ties on every leaderboard
+// of the scores.
+const userAchievedAtKey = "leaderboard:achieved_at"
+
+// indexRanksBatchSize is the number of users indexed by one run of the
+// index ranks script, so a large leaderboard does not block redis.
+const indexRanksBatchSize = 500
+
+// Every sorted set of scores has a sorted set of ranks next to it, with the
+// same scores and the ties already broken. Its members are the time the
+// user has reached the score at followed by the user ID, so the members
+// with the same score are ordered the way the ties are broken and
+// ZREVRANK and ZREVRANGE return the ranks directly. The times are encoded
+// as 15 digits, subtracted from the largest of them when the earliest time
+// ranks first, since the members with the same score are read in reverse.
+// The distinct scores of the ranks are kept in a sorted set of their own,
+// which numbers the dense ranks.
+func ranksKey(key string) string {
+	return key + ":ranks"
+}
+
+// rankScriptFunctions are shared by the scripts that write the scores.
+// unindex_rank removes the user from the ranks with either tie break, so it
+// has to run before the time of the user changes, and removes its score
+// from the distinct scores when no other user has it. index_rank adds the
+// user to the ranks with its score in the sorted set, when it has one.
+const rankScriptFunctions = `
+local function scores_key(ranks_key)
+	return ranks_key .. ":scores"
+end
+
+local function rank_member(times_key, user_id, tie_break)
+	local achieved_at = tonumber(redis.call("HGET", times_key, user_id)) or 0
+
+	if tie_break == "` + domain.TieBreakLatest + `" then
+		return string.format("%015.0f", achieved_at) .. ":" .. user_id
+	end
+
+	return string.format("%015.0f", 999999999999999 - achieved_at) .. ":" .. user_id
+end
+
+local function unindex_rank(ranks_key, times_key, user_id)
+	for _, tie_break in ipairs({"` + domain.TieBreakEarliest + `", "` + domain.TieBreakLatest + `"}) do
+		local member = rank_member(times_key, user_id, tie_break)
+		local score = redis.call("ZSCORE", ranks_key, member)
+
+		if score then
+			redis.call("ZREM", ranks_key, member)
+
+			if redis.call("ZCOUNT", ranks_key, score, score) == 0 then
+				redis.call("ZREM", scores_key(ranks_key), score)
+			end
+		end
+	end
+end
+
+local function index_rank(key, ranks_key, times_key, user_id, tie_break)
+	local score = redis.call("ZSCORE", key, user_id)
+
+	if score then
+		redis.call("ZADD", ranks_key, score, rank_member(times_key, user_id, tie_break))
+		redis.call("ZADD", scores_key(ranks_key), score, score)
+	end
+end
+`
+
+// indexRanksScript indexes the users from ARGV[2] to ARGV[3] of the sorted
+// set KEYS[1] in its ranks KEYS[3], KEYS[2] holds their times and ARGV[1] is
+// the tie break. It returns the number of users it has indexed.
+const indexRanksScriptSource = rankScriptFunctions + `
+local user_ids = redis.call("ZRANGE", KEYS[1], ARGV[2], ARGV[3])
+
+for _, user_id in ipairs(user_ids) do
+	unindex_rank(KEYS[3], KEYS[2], user_id)
+	index_rank(KEYS[1], KEYS[3], KEYS[2], user_id, ARGV[1])
+end
+
+return #user_ids
+`
+
+// removeScoreScript removes the user ARGV[1] from the sorted set KEYS[1],
+// from its times KEYS[2] and from its ranks KEYS[3].
+const removeScoreScriptSource = rankScriptFunctions + `
+unindex_rank(KEYS[3], KEYS[2], ARGV[1])
+
+redis.call("ZREM", KEYS[1], ARGV[1])
+redis.call("HDEL", KEYS[2], ARGV[1])
+
+return 1
+`
+
+// rankScript returns the rank of the user ARGV[1] starting at 0, its score
+// on the sorted set KEYS[1] and the counts its rank is numbered from, read
+// from its ranks KEYS[3] in the same script so they always agree. KEYS[2]
+// holds the times and ARGV[2] is the tie break. When ARGV[3] is not
+// negative, it also returns the users ranked within that many places of the
+// user, the rank of the first of them and the counts of its score.
+const rankScriptSource = rankScriptFunctions + `
+local function counts(score)
+	return {
+		redis.call("ZCOUNT", KEYS[3], "(" .. score, "+inf"),
+		redis.call("ZCOUNT", scores_key(KEYS[3]), "(" .. score, "+inf"),
+		redis.call("ZCARD", KEYS[3]),
+	}
+end
+
+local score = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if not score then
+	return false
+end
+
+local rank = redis.call("ZREVRANK", KEYS[3], rank_member(KEYS[2], ARGV[1], ARGV[2]))
+if not rank then
+	return false
+end
+
+local result = {rank, score, counts(score)}
+
+local count = tonumber(ARGV[3])
+if count >= 0 then
+	local start = math.max(rank - count, 0)
+	local neighbours = redis.call("ZREVRANGE", KEYS[3], start, rank + count, "WITHSCORES")
+
+	table.insert(result, start)
+	table.insert(result, counts(neighbours[2]))
+	table.insert(result, neighbours)
+end
+
+return result
+`
+
+var (
+	indexRanksScript = redis.NewScript(indexRanksScriptSource)
+	rankScript       = redis.NewScript(rankScriptSource)
+)
+
+func rankKeys(key string) []string {
+	return []string{key, achievedAtKey(key), ranksKey(key)}
+}
+
+// IndexRanks indexes the ranks of every user on every sorted set of
+// scores, ratings and events. It indexes the users that have been ranked
+// before the ranks were indexed, and the ones ranked under another tie
+// break.
+func (repo *RedisUserScoreRepository) IndexRanks(ctx context.Context) error {
+	keys := []string{leaderboardKey}
+
+	countryCodes, err := repo.client.HVals(ctx, userCountriesKey).Result()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+
+	for _, countryCode := range countryCodes {
+		if !seen[countryCode] {
+			seen[countryCode] = true
+			keys = append(keys, countryLeaderboardPrefix+countryCode)
+		}
+	}
+
+	modes, err := repo.getRatingModes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, mode := range modes {
+		keys = append(keys, ratingKey(mode))
+	}
+
+	eventIDs, err := repo.getEventIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, eventID := range eventIDs {
+		keys = append(keys, eventKey(eventID))
+	}
+
+	for _, key := range keys {
+		err = repo.indexRanks(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repo *RedisUserScoreRepository) indexRanks(ctx context.Context, key string) error {
+	for start := int64(0); ; start += indexRanksBatchSize {
+		indexed, err := indexRanksScript.Run(ctx, repo.client, rankKeys(key), repo.tieBreak, start, start+indexRanksBatchSize-1).Int64()
+		if err != nil {
+			return err
+		}
+
+		if indexed < indexRanksBatchSize {
+			return nil
+		}
+	}
+}
+
+// rankResult is the rank of a user read by the rank script. The neighbours are
+// only read when they have been asked for, their members are the user IDs.
+type rankResult struct {
+	rank   int64
+	score  float64
+	counts domain.RankCounts
+
+	neighboursStart  int64
+	neighboursCounts domain.RankCounts
+	neighbours       []redis.Z
+}
+
+// getRank returns the rank of the user on the sorted set of the key,
+// starting at 0, along with the users ranked within count places of it.
+// A negative count reads no neighbours.
+func (repo *RedisUserScoreRepository) getRank(ctx context.Context, key, userID string, count int64) (rankResult, error) {
+	result, err := rankScript.Run(ctx, repo.client, rankKeys(key), userID, repo.tieBreak, count).Slice()
+	if err != nil {
+		if err == redis.Nil {
+			return rankResult{}, domain.ErrResourceNotFound
+		}
+
+		return rankResult{}, err
+	}
+
+	if len(result) != 3 && len(result) != 6 {
+		return rankResult{}, fmt.Errorf("%w, invalid rank: %v", domain.ErrInternal, result)
+	}
+
+	var userRank rankResult
+
+	userRank.rank, err = parseInt(result[0])
+	if err != nil {
+		return rankResult{}, err
+	}
+
+	userRank.score, err = parseScore(result[1])
+	if err != nil {
+		return rankResult{}, err
+	}
+
+	userRank.counts, err = parseRankCounts(result[2])
+	if err != nil {
+		return rankResult{}, err
+	}
+
+	if len(result) == 3 {
+		return userRank, nil
+	}
+
+	userRank.neighboursStart, err = parseInt(result[3])
+	if err != nil {
+		return rankResult{}, err
+	}
+
+	userRank.neighboursCounts, err = parseRankCounts(result[4])
+	if err != nil {
+		return rankResult{}, err
+	}
+
+	neighbours, ok := result[5].([]interface{})
+	if !ok || len(neighbours)%2 != 0 {
+		return rankResult{}, fmt.Errorf("%w, invalid neighbours: %v", domain.ErrInternal, result[5])
+	}
+
+	for i := 0; i < len(neighbours); i += 2 {
+		member, _ := neighbours[i].(string)
+
+		score, err := parseScore(neighbours[i+1])
+		if err != nil {
+			return rankResult{}, err
+		}
+
+		userID, err := rankMemberID(member)
+		if err != nil {
+			return rankResult{}, err
+		}
+
+		userRank.neighbours = append(userRank.neighbours, redis.Z{Score: score, Member: userID})
+	}
+
+	return userRank, nil
+}
+
+func parseInt(value interface{}) (int64, error) {
+	n, ok := value.(int64)
+	if !ok {
+		return 0, fmt.Errorf("%w, invalid integer: %v", domain.ErrInternal, value)
+	}
+
+	return n, nil
+}
+
+func parseScore(value interface{}) (float64, error) {
+	s, _ := value.(string)
+
+	score, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w, invalid score: %v", domain.ErrInternal, value)
+	}
+
+	return score, nil
+}
+
+func parseRankCounts(value interface{}) (domain.RankCounts, error) {
+	values, ok := value.([]interface{})
+	if !ok || len(values) != 3 {
+		return domain.RankCounts{}, fmt.Errorf("%w, invalid rank counts: %v", domain.ErrInternal, value)
+	}
+
+	var counts [3]int64
+
+	for i, value := range values {
+		n, err := parseInt(value)
+		if err != nil {
+			return domain.RankCounts{}, err
+		}
+
+		counts[i] = n
+	}
+
+	return domain.RankCounts{Above: counts[0], DistinctAbove: counts[1], Total: counts[2]}, nil
+}
+
+// rankMemberID returns the user ID of a member of the ranks.
+func rankMemberID(member string) (string, error) {
+	_, userID, ok := strings.Cut(member, ":")
+	if !ok {
+		return "", fmt.Errorf("%w, invalid rank member: %s", domain.ErrInternal, member)
+	}
+
+	return userID, nil
+}
+
+// getRankRange reads the users ranked from start to stop, both inclusive
+// and starting at 0, from the ranks of the sorted set of the key, along
+// with the number of users ranked there. The members of the returned
+// scores are the user IDs.
+func (repo *RedisUserScoreRepository) getRankRange(ctx context.Context, key string, start, stop int64) ([]redis.Z, int64, error) {
+	var rangeCmd *redis.ZSliceCmd
+	var totalCmd *redis.IntCmd
+
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		rangeCmd = pipe.ZRevRangeWithScores(ctx, ranksKey(key), start, stop)
+		totalCmd = pipe.ZCard(ctx, ranksKey(key))
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	userScores := rangeCmd.Val()
+
+	for i, userScore := range userScores {
+		member, _ := userScore.Member.(string)
+
+		userScores[i].Member, err = rankMemberID(member)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return userScores, totalCmd.Val(), nil
+}
+
+// sortUserScores sorts the scores of the sorted set of the key the way they
+// are ranked, the times are only read for the users that share their score
+// with another one. It ranks the users that are not read from the ranks.
+func (repo *RedisUserScoreRepository) sortUserScores(ctx context.Context, key string, userScores []redis.Z) error {
+	sort.SliceStable(userScores, func(i, j int) bool {
+		return userScores[i].Score > userScores[j].Score
+	})
+
+	var tiedUserIDs []string
+
+	for i, userScore := range userScores {
+		tiedAbove := i > 0 && userScores[i-1].Score == userScore.Score
+		tiedBelow := i < len(userScores)-1 && userScores[i+1].Score == userScore.Score
+
+		if tiedAbove || tiedBelow {
+			tiedUserIDs = append(tiedUserIDs, memberID(userScore))
+		}
+	}
+
+	if len(tiedUserIDs) == 0 {
+		return nil
+	}
+
+	achievedAt, err := repo.getAchievedAt(ctx, achievedAtKey(key), tiedUserIDs)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(userScores, func(i, j int) bool {
+		return repo.rankedAbove(userScores[i], userScores[j], achievedAt)
+	})
+
+	return nil
+}
+
+// rankedAbove reports whether a is ranked above b, the same way as the
+// ranks are ordered. Users without a time have reached their score before
+// the times were recorded, so they count as the earliest ones.
+func (repo *RedisUserScoreRepository) rankedAbove(a, b redis.Z, achievedAt map[string]int64) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+
+	aID, bID := memberID(a), memberID(b)
+
+	if achievedAt[aID] != achievedAt[bID] {
+		if repo.tieBreak == domain.TieBreakLatest {
+			return achievedAt[aID] > achievedAt[bID]
+		}
+
+		return achievedAt[aID] < achievedAt[bID]
+	}
+
+	return aID > bID
+}
+
+func (repo *RedisUserScoreRepository) getAchievedAt(ctx context.Context, key string, userIDs []string) (map[string]int64, error) {
+	values, err := repo.client.HMGet(ctx, key, userIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	achievedAt := make(map[string]int64)
+
+	for i, value := range values {
+		value, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		achievedAt[userIDs[i]], err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w, invalid achieved at time: %s", domain.ErrInternal, value)
+		}
+	}
+
+	return achievedAt, nil
+}
+
+func memberID(userScore redis.Z) string {
+	id, _ := userScore.Member.(string)
+
+	return id
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
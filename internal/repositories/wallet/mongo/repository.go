@@ -0,0 +1,290 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"game/internal/domain"
+)
+
+// errInsufficientWallet aborts the transaction of a spend the wallet does
+// not hold enough for.
+var errInsufficientWallet = errors.New("insufficient wallet")
+
+type MongoWalletRepositoryDependencies struct {
+	// Client starts the sessions of the transactions that update the
+	// wallets and the ledger together.
+	Client *mongo.Client
+
+	WalletsCollection       *mongo.Collection
+	LedgerEntriesCollection *mongo.Collection
+}
+
+type MongoWalletRepository struct {
+	client *mongo.Client
+
+	walletsCollection       *mongo.Collection
+	ledgerEntriesCollection *mongo.Collection
+}
+
+func NewMongoWalletRepository(deps MongoWalletRepositoryDependencies) *MongoWalletRepository {
+	return &MongoWalletRepository{
+		client:                  deps.Client,
+		walletsCollection:       deps.WalletsCollection,
+		ledgerEntriesCollection: deps.LedgerEntriesCollection,
+	}
+}
+
+// Apply updates the wallet and appends the entry to the ledger in a single
+// transaction, neither is written without the other. A spend only matches
+// the wallet while it holds enough of every currency and item, so
+// concurrent spends can not take a balance below zero.
+func (repo *MongoWalletRepository) Apply(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, bool, error) {
+	session, err := repo.client.StartSession()
+	if err != nil {
+		return domain.LedgerEntry{}, false, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return repo.apply(sessionCtx, entry)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errInsufficientWallet):
+			return domain.LedgerEntry{}, false, nil
+		case errors.Is(err, domain.ErrResourceExists):
+			existing, ok := result.(domain.LedgerEntry)
+			if !ok {
+				return domain.LedgerEntry{}, false, domain.ErrInternal
+			}
+
+			return existing, false, domain.ErrResourceExists
+		case mongo.IsDuplicateKeyError(err):
+			// the key has been used by a concurrent transaction that has
+			// committed first.
+			existing, err := repo.findEntry(ctx, entryID(entry.UserID, entry.IdempotencyKey))
+			if err != nil {
+				return domain.LedgerEntry{}, false, err
+			}
+
+			return existing, false, domain.ErrResourceExists
+		default:
+			return domain.LedgerEntry{}, false, err
+		}
+	}
+
+	applied, ok := result.(domain.LedgerEntry)
+	if !ok {
+		return domain.LedgerEntry{}, false, domain.ErrInternal
+	}
+
+	return applied, true, nil
+}
+
+func (repo *MongoWalletRepository) apply(ctx mongo.SessionContext, entry domain.LedgerEntry) (interface{}, error) {
+	id := entryID(entry.UserID, entry.IdempotencyKey)
+
+	existing, err := repo.findEntry(ctx, id)
+	if err == nil {
+		return existing, domain.ErrResourceExists
+	}
+
+	if !errors.Is(err, domain.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": entry.UserID}
+	increments := bson.M{}
+
+	for currency, amount := range entry.Currencies {
+		addIncrement(filter, increments, "balances."+currency, amount, entry.Type)
+	}
+
+	for itemID, quantity := range entry.Items {
+		addIncrement(filter, increments, "items."+itemID, quantity, entry.Type)
+	}
+
+	var record walletRecord
+
+	err = repo.walletsCollection.FindOneAndUpdate(ctx, filter, bson.M{
+		"$inc": increments,
+		"$set": bson.M{
+			"updatedAt": entry.CreatedAt,
+		},
+	}, options.FindOneAndUpdate().
+		SetUpsert(entry.Type == domain.LedgerEntryGrant).
+		SetReturnDocument(options.After),
+	).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errInsufficientWallet
+		}
+
+		return nil, err
+	}
+
+	entry.ID = id
+	entry.Wallet = toWallet(record)
+
+	_, err = repo.ledgerEntriesCollection.InsertOne(ctx, ledgerEntryRecord{
+		ID:             id,
+		UserID:         entry.UserID,
+		IdempotencyKey: entry.IdempotencyKey,
+		Type:           entry.Type,
+		Reason:         entry.Reason,
+		Currencies:     entry.Currencies,
+		Items:          entry.Items,
+		WalletBalances: record.Balances,
+		WalletItems:    record.Items,
+		CreatedAt:      entry.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (repo *MongoWalletRepository) GetWallet(ctx context.Context, userID string) (domain.Wallet, error) {
+	var record walletRecord
+
+	err := repo.walletsCollection.FindOne(ctx, bson.M{
+		"_id": userID,
+	}).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.Wallet{
+				UserID:   userID,
+				Balances: map[string]int64{},
+				Items:    map[string]int64{},
+			}, nil
+		}
+
+		return domain.Wallet{}, err
+	}
+
+	return toWallet(record), nil
+}
+
+func (repo *MongoWalletRepository) ListEntries(ctx context.Context, userID string) ([]domain.LedgerEntry, error) {
+	cursor, err := repo.ledgerEntriesCollection.Find(ctx, bson.M{
+		"userID": userID,
+	}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []domain.LedgerEntry
+
+	for cursor.Next(ctx) {
+		var record ledgerEntryRecord
+
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, toLedgerEntry(record))
+	}
+
+	return entries, nil
+}
+
+func (repo *MongoWalletRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := repo.ledgerEntriesCollection.DeleteMany(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.walletsCollection.DeleteOne(ctx, bson.M{
+		"_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoWalletRepository) findEntry(ctx context.Context, id string) (domain.LedgerEntry, error) {
+	var record ledgerEntryRecord
+
+	err := repo.ledgerEntriesCollection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.LedgerEntry{}, domain.ErrResourceNotFound
+		}
+
+		return domain.LedgerEntry{}, err
+	}
+
+	return toLedgerEntry(record), nil
+}
+
+// addIncrement adds the quantity to the field of the wallet, a spend
+// subtracts it and only matches a wallet holding at least the quantity.
+func addIncrement(filter, increments bson.M, field string, quantity int64, entryType string) {
+	if entryType == domain.LedgerEntrySpend {
+		filter[field] = bson.M{"$gte": quantity}
+		increments[field] = -quantity
+
+		return
+	}
+
+	increments[field] = quantity
+}
+
+func entryID(userID, idempotencyKey string) string {
+	return userID + ":" + idempotencyKey
+}
+
+// toWallet leaves out the items the user has spent all of, the currencies
+// are kept with a zero balance.
+func toWallet(record walletRecord) domain.Wallet {
+	wallet := domain.Wallet{
+		UserID:    record.UserID,
+		Balances:  map[string]int64{},
+		Items:     map[string]int64{},
+		UpdatedAt: record.UpdatedAt,
+	}
+
+	for currency, balance := range record.Balances {
+		wallet.Balances[currency] = balance
+	}
+
+	for itemID, quantity := range record.Items {
+		if quantity > 0 {
+			wallet.Items[itemID] = quantity
+		}
+	}
+
+	return wallet
+}
+
+func toLedgerEntry(record ledgerEntryRecord) domain.LedgerEntry {
+	return domain.LedgerEntry{
+		ID:             record.ID,
+		UserID:         record.UserID,
+		IdempotencyKey: record.IdempotencyKey,
+		Type:           record.Type,
+		Reason:         record.Reason,
+		Currencies:     record.Currencies,
+		Items:          record.Items,
+		Wallet: toWallet(walletRecord{
+			UserID:    record.UserID,
+			Balances:  record.WalletBalances,
+			Items:     record.WalletItems,
+			UpdatedAt: record.CreatedAt,
+		}),
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package mongo
+
+import (
+	"time"
+)
+
+// walletRecord is stored with the user ID as its ID, the currencies and the
+// item IDs are the keys of the balances and the items.
+type walletRecord struct {
+	UserID    string           `bson:"_id"`
+	Balances  map[string]int64 `bson:"balances"`
+	Items     map[string]int64 `bson:"items"`
+	UpdatedAt time.Time        `bson:"updatedAt"`
+}
+
+// ledgerEntryRecord is stored with the user ID and the idempotency key as
+// its ID, so a user can only use an idempotency key once. WalletBalances
+// and WalletItems are the ones of the wallet once the entry is applied.
+type ledgerEntryRecord struct {
+	ID             string           `bson:"_id"`
+	UserID         string           `bson:"userID"`
+	IdempotencyKey string           `bson:"idempotencyKey"`
+	Type           string           `bson:"type"`
+	Reason         string           `bson:"reason"`
+	Currencies     map[string]int64 `bson:"currencies"`
+	Items          map[string]int64 `bson:"items"`
+	WalletBalances map[string]int64 `bson:"walletBalances"`
+	WalletItems    map[string]int64 `bson:"walletItems"`
+	CreatedAt      time.Time        `bson:"createdAt"`
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"game/internal/domain"
+)
+
+// achievementEvaluator unlocks the achievements the users reach. It does
+// nothing when there are no achievements, the submissions are not counted
+// then either.
+type achievementEvaluator struct {
+	achievements          []domain.Achievement
+	achievementRepository domain.AchievementRepository
+	userScoreRepository   domain.UserScoreRepository
+}
+
+// evaluateSubmission counts the submission of the score and unlocks the
+// achievements of the user, it runs after the top score of the user has
+// been updated so the ranks include the score.
+func (evaluator *achievementEvaluator) evaluateSubmission(ctx context.Context, userID string, score float64) error {
+	if len(evaluator.achievements) == 0 {
+		return nil
+	}
+
+	submissions, err := evaluator.achievementRepository.IncrementSubmissions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	unlocked, err := evaluator.getUnlocked(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	progress := domain.AchievementProgress{
+		Score:       score,
+		Submissions: submissions,
+	}
+
+	// the ranks are only read when there is a rank achievement left to
+	// unlock.
+	if evaluator.hasLocked(unlocked, domain.AchievementRank) {
+		userRank, err := evaluator.userScoreRepository.GetUserRank(ctx, userID, 0)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return err
+		}
+
+		progress.Rank = userRank.Rank
+		progress.CountryRank = userRank.CountryRank
+	}
+
+	return evaluator.unlock(ctx, userID, unlocked, progress)
+}
+
+// evaluateRanks unlocks the rank achievements of the users at the top of
+// the global leaderboard, it runs after a score has been removed or lowered
+// since the users ranked below it move up without submitting a score.
+func (evaluator *achievementEvaluator) evaluateRanks(ctx context.Context) error {
+	var maxRank int64
+
+	for _, achievement := range evaluator.achievements {
+		if achievement.Kind == domain.AchievementRank && int64(achievement.Threshold) > maxRank {
+			maxRank = int64(achievement.Threshold)
+		}
+	}
+
+	if maxRank == 0 {
+		return nil
+	}
+
+	leaderboard, err := evaluator.userScoreRepository.GetTopLeaderboard(ctx, maxRank)
+	if err != nil {
+		return err
+	}
+
+	// the users are sorted with the ties broken, so their positions are
+	// the ranks returned by GetUserRank.
+	for i, userScore := range leaderboard.UserScores {
+		if userScore.Missing {
+			continue
+		}
+
+		unlocked, err := evaluator.getUnlocked(ctx, userScore.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = evaluator.unlock(ctx, userScore.UserID, unlocked, domain.AchievementProgress{
+			Rank: int64(i) + 1,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (evaluator *achievementEvaluator) getUnlocked(ctx context.Context, userID string) (map[string]bool, error) {
+	userAchievements, err := evaluator.achievementRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked := make(map[string]bool, len(userAchievements))
+
+	for _, userAchievement := range userAchievements {
+		unlocked[userAchievement.AchievementID] = true
+	}
+
+	return unlocked, nil
+}
+
+func (evaluator *achievementEvaluator) hasLocked(unlocked map[string]bool, kind string) bool {
+	for _, achievement := range evaluator.achievements {
+		if achievement.Kind == kind && !unlocked[achievement.ID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (evaluator *achievementEvaluator) unlock(
+	ctx context.Context, userID string, unlocked map[string]bool, progress domain.AchievementProgress,
+) error {
+	now := time.Now()
+
+	var userAchievements []domain.UserAchievement
+
+	for _, achievement := range evaluator.achievements {
+		if unlocked[achievement.ID] || !achievement.Unlocked(progress) {
+			continue
+		}
+
+		userAchievements = append(userAchievements, domain.UserAchievement{
+			UserID:        userID,
+			AchievementID: achievement.ID,
+			UnlockedAt:    now,
+		})
+	}
+
+	if len(userAchievements) == 0 {
+		return nil
+	}
+
+	_, err := evaluator.achievementRepository.Unlock(ctx, userAchievements)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
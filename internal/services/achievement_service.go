@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"game/internal/domain"
+)
+
+var ErrInvalidAchievement = errors.New("invalid achievement")
+
+//go:generate mockery --name AchievementService --structname MockAchievementService --outpkg mocks --filename achievement_service_mock.go --output ./mocks/. --with-expecter
+type AchievementService interface {
+	ListAchievements(ctx context.Context) ([]domain.Achievement, error)
+	// GetUserAchievements returns the achievements unlocked by the user, the
+	// first unlocked first.
+	GetUserAchievements(ctx context.Context, userID string) ([]UnlockedAchievement, error)
+}
+
+// UnlockedAchievement is an achievement unlocked by a user. Achievement only
+// has its ID when it is not defined anymore.
+type UnlockedAchievement struct {
+	Achievement     domain.Achievement
+	UserAchievement domain.UserAchievement
+}
+
+type AchievementServiceDependencies struct {
+	UserRepository        domain.UserRepository
+	AchievementRepository domain.AchievementRepository
+
+	Achievements []domain.Achievement
+}
+
+type achievementService struct {
+	userRepository        domain.UserRepository
+	achievementRepository domain.AchievementRepository
+
+	achievements []domain.Achievement
+}
+
+func NewAchievementService(deps AchievementServiceDependencies) *achievementService {
+	return &achievementService{
+		userRepository:        deps.UserRepository,
+		achievementRepository: deps.AchievementRepository,
+		achievements:          deps.Achievements,
+	}
+}
+
+func (service *achievementService) ListAchievements(ctx context.Context) ([]domain.Achievement, error) {
+	return service.achievements, nil
+}
+
+func (service *achievementService) GetUserAchievements(ctx context.Context, userID string) ([]UnlockedAchievement, error) {
+	_, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	userAchievements, err := service.achievementRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	achievements := make(map[string]domain.Achievement, len(service.achievements))
+
+	for _, achievement := range service.achievements {
+		achievements[achievement.ID] = achievement
+	}
+
+	unlockedAchievements := []UnlockedAchievement{}
+
+	for _, userAchievement := range userAchievements {
+		achievement, ok := achievements[userAchievement.AchievementID]
+		if !ok {
+			achievement = domain.Achievement{ID: userAchievement.AchievementID}
+		}
+
+		unlockedAchievements = append(unlockedAchievements, UnlockedAchievement{
+			Achievement:     achievement,
+			UserAchievement: userAchievement,
+		})
+	}
+
+	return unlockedAchievements, nil
+}
+
+// ParseAchievements parses the achievements written as "id:kind:threshold:name",
+// e.g. "score_10000:score:10000:Ten Thousand Club" or "top_10:rank:10:Top Ten".
+func ParseAchievements(values []string) ([]domain.Achievement, error) {
+	var achievements []domain.Achievement
+
+	ids := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) != 4 || parts[3] == "" {
+			return nil, fmt.Errorf("%w, %q is not id:kind:threshold:name", ErrInvalidAchievement, value)
+		}
+
+		if !isIdentifier(parts[0]) {
+			return nil, fmt.Errorf("%w, invalid id in %q", ErrInvalidAchievement, value)
+		}
+
+		if ids[parts[0]] {
+			return nil, fmt.Errorf("%w, id of %q is used twice", ErrInvalidAchievement, value)
+		}
+
+		switch parts[1] {
+		case domain.AchievementScore, domain.AchievementRank, domain.AchievementSubmissions:
+		default:
+			return nil, fmt.Errorf("%w, unknown kind in %q", ErrInvalidAchievement, value)
+		}
+
+		threshold, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf("%w, threshold in %q has to be a positive number", ErrInvalidAchievement, value)
+		}
+
+		ids[parts[0]] = true
+
+		achievements = append(achievements, domain.Achievement{
+			ID:        parts[0],
+			Name:      parts[3],
+			Kind:      parts[1],
+			Threshold: threshold,
+		})
+	}
+
+	return achievements, nil
+}
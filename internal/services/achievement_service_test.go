@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type AchievementServiceTestSuite struct {
+	suite.Suite
+
+	service *achievementService
+
+	mockUserRepository        *mocks.MockUserRepository
+	mockAchievementRepository *mocks.MockAchievementRepository
+
+	achievements []domain.Achievement
+}
+
+func TestAchievementServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AchievementServiceTestSuite))
+}
+
+func (suite *AchievementServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockAchievementRepository = mocks.NewMockAchievementRepository(suite.T())
+
+	suite.achievements = []domain.Achievement{
+		{ID: "score_10000", Name: "Ten Thousand Club", Kind: domain.AchievementScore, Threshold: 10000},
+		{ID: "top_10", Name: "Top Ten", Kind: domain.AchievementRank, Threshold: 10},
+	}
+
+	suite.service = NewAchievementService(AchievementServiceDependencies{
+		UserRepository:        suite.mockUserRepository,
+		AchievementRepository: suite.mockAchievementRepository,
+		Achievements:          suite.achievements,
+	})
+}
+
+func (suite *AchievementServiceTestSuite) TestListAchievements() {
+	achievements, err := suite.service.ListAchievements(context.Background())
+	suite.NoError(err)
+	suite.Equal(suite.achievements, achievements)
+}
+
+func (suite *AchievementServiceTestSuite) TestGetUserAchievements() {
+	unlockedAt := time.Unix(1700000000, 0)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.UserAchievement{
+			{UserID: "user-id", AchievementID: "top_10", UnlockedAt: unlockedAt},
+			{UserID: "user-id", AchievementID: "retired", UnlockedAt: unlockedAt},
+		}, nil)
+
+	achievements, err := suite.service.GetUserAchievements(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.Len(achievements, 2)
+	suite.Equal(suite.achievements[1], achievements[0].Achievement)
+	suite.Equal(unlockedAt, achievements[0].UserAchievement.UnlockedAt)
+	// achievements that are not defined anymore are returned with their ID.
+	suite.Equal(domain.Achievement{ID: "retired"}, achievements[1].Achievement)
+}
+
+func (suite *AchievementServiceTestSuite) TestGetUserAchievements_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
+
+	_, err := suite.service.GetUserAchievements(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *AchievementServiceTestSuite) TestParseAchievements() {
+	achievements, err := ParseAchievements([]string{
+		"score_10000:score:10000:Ten Thousand Club",
+		"veteran:submissions:100:Veteran",
+	})
+	suite.NoError(err)
+
+	suite.Equal([]domain.Achievement{
+		{ID: "score_10000", Name: "Ten Thousand Club", Kind: domain.AchievementScore, Threshold: 10000},
+		{ID: "veteran", Name: "Veteran", Kind: domain.AchievementSubmissions, Threshold: 100},
+	}, achievements)
+}
+
+func (suite *AchievementServiceTestSuite) TestParseAchievements_Invalid() {
+	for _, values := range [][]string{
+		{"score_10000:score:10000"},
+		{"score 10000:score:10000:Ten Thousand Club"},
+		{"score_10000:time:10000:Ten Thousand Club"},
+		{"score_10000:score:-1:Ten Thousand Club"},
+		{"top:rank:10:Top Ten", "top:rank:3:Top Three"},
+	} {
+		_, err := ParseAchievements(values)
+		suite.ErrorIs(err, ErrInvalidAchievement, values)
+	}
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"game/internal/domain"
+)
+
+//go:generate mockery --name AuditLogService --structname MockAuditLogService --outpkg mocks --filename audit_log_service_mock.go --output ./mocks/. --with-expecter
+type AuditLogService interface {
+	Query(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditEvent, error)
+}
+
+type AuditLogServiceDependencies struct {
+	AuditLog domain.AuditLog
+}
+
+type auditLogService struct {
+	auditLog domain.AuditLog
+}
+
+func NewAuditLogService(deps AuditLogServiceDependencies) *auditLogService {
+	return &auditLogService{
+		auditLog: deps.AuditLog,
+	}
+}
+
+func (service *auditLogService) Query(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditEvent, error) {
+	events, err := service.auditLog.Query(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"expvar"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+)
+
+// auditRecorder records the audit events of writes that have already been
+// committed. The write can not be undone anymore, so a failed record is
+// logged and counted in the failures of the metrics instead of failing the
+// request.
+type auditRecorder struct {
+	auditLog domain.AuditLog
+	metrics  *expvar.Map
+	logger   *logrus.Logger
+}
+
+func (recorder *auditRecorder) record(ctx context.Context, event domain.AuditEvent) {
+	err := recorder.auditLog.Record(ctx, event)
+	if err == nil {
+		return
+	}
+
+	recorder.metrics.Add("failures", 1)
+
+	recorder.logger.
+		WithError(err).
+		WithFields(logrus.Fields{
+			"type":      event.Type,
+			"requestID": event.RequestID,
+		}).
+		Error("failed to record the audit event")
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"game/internal/domain"
+)
+
+const (
+	snapshotKey         = "snapshot"
+	snapshotLoadTimeout = 10 * time.Second
+)
+
+type CachedLeaderboardServiceDependencies struct {
+	Next                   LeaderboardService
+	UserScoreRepository    domain.UserScoreRepository
+	LeaderboardInvalidator domain.LeaderboardInvalidator
+
+	// Size is the number of users kept in the snapshot.
+	Size int
+	// TTL is how long the snapshot is served before it is refreshed, a stale
+	// snapshot is still served while it is being refreshed.
+	TTL time.Duration
+
+	Logger *logrus.Logger
+}
+
+// cachedLeaderboardService serves the top of the leaderboard from an in-memory
+// snapshot. The snapshot is refreshed in the background once it expires or
+// when any replica reports that a submission has changed the top of the
+// leaderboard.
+type cachedLeaderboardService struct {
+	next                   LeaderboardService
+	userScoreRepository    domain.UserScoreRepository
+	leaderboardInvalidator domain.LeaderboardInvalidator
+
+	size int
+	ttl  time.Duration
+
+	logger *logrus.Logger
+
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	snapshot  *domain.Leaderboard
+	expiresAt time.Time
+	// version is increased on every invalidation so that a refresh that has
+	// started before it does not mark its snapshot as fresh.
+	version uint64
+}
+
+func NewCachedLeaderboardService(deps CachedLeaderboardServiceDependencies) *cachedLeaderboardService {
+	return &cachedLeaderboardService{
+		next:                   deps.Next,
+		userScoreRepository:    deps.UserScoreRepository,
+		leaderboardInvalidator: deps.LeaderboardInvalidator,
+		size:                   deps.Size,
+		ttl:                    deps.TTL,
+		logger:                 deps.Logger,
+	}
+}
+
+func (service *cachedLeaderboardService) GetLeaderboard(ctx context.Context) (domain.Leaderboard, error) {
+	service.mu.RLock()
+	snapshot, expiresAt := service.snapshot, service.expiresAt
+	service.mu.RUnlock()
+
+	// the first load is shared by every caller waiting for it, so it is not
+	// canceled with the context of any of them.
+	if snapshot == nil {
+		select {
+		case result := <-service.group.DoChan(snapshotKey, service.loadDetached):
+			if result.Err != nil {
+				return domain.Leaderboard{}, result.Err
+			}
+
+			return result.Val.(domain.Leaderboard), nil
+		case <-ctx.Done():
+			return domain.Leaderboard{}, ctx.Err()
+		}
+	}
+
+	if time.Now().After(expiresAt) {
+		service.refreshInBackground()
+	}
+
+	return *snapshot, nil
+}
+
+// GetTopLeaderboard is not cached, the snapshot only holds the top Size
+// users.
+func (service *cachedLeaderboardService) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	return service.next.GetTopLeaderboard(ctx, count)
+}
+
+// GetCountryLeaderboard is not cached, the leaderboards of the countries are
+// smaller and read less often than the global one.
+func (service *cachedLeaderboardService) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	return service.next.GetCountryLeaderboard(ctx, countryCode)
+}
+
+func (service *cachedLeaderboardService) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	return service.next.GetUserRank(ctx, userID, count)
+}
+
+func (service *cachedLeaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64, eventID, mode string) error {
+	err := service.next.SubmitUserScore(ctx, userID, score, eventID, mode)
+	if err != nil {
+		return err
+	}
+
+	service.invalidateIfTopChanged(ctx, userID)
+
+	return nil
+}
+
+func (service *cachedLeaderboardService) SubmitVerifiedScore(ctx context.Context, score domain.SignedScore) error {
+	err := service.next.SubmitVerifiedScore(ctx, score)
+	if err != nil {
+		return err
+	}
+
+	service.invalidateIfTopChanged(ctx, score.UserID)
+
+	return nil
+}
+
+// Run invalidates the snapshot on the invalidations of every replica until
+// the context is done.
+func (service *cachedLeaderboardService) Run(ctx context.Context) {
+	for range service.leaderboardInvalidator.Subscribe(ctx) {
+		service.invalidate()
+	}
+}
+
+func (service *cachedLeaderboardService) load(ctx context.Context) (domain.Leaderboard, error) {
+	service.mu.RLock()
+	version := service.version
+	service.mu.RUnlock()
+
+	leaderboard, err := service.next.GetTopLeaderboard(ctx, int64(service.size))
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.snapshot = &leaderboard
+
+	if version == service.version {
+		service.expiresAt = time.Now().Add(service.ttl)
+	} else {
+		service.expiresAt = time.Time{}
+	}
+
+	return leaderboard, nil
+}
+
+// loadDetached loads the snapshot with a context of its own, the load is
+// shared by the callers of GetLeaderboard and by the refreshes.
+func (service *cachedLeaderboardService) loadDetached() (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotLoadTimeout)
+	defer cancel()
+
+	return service.load(ctx)
+}
+
+func (service *cachedLeaderboardService) refreshInBackground() {
+	// the result is read by the callers of GetLeaderboard from the snapshot,
+	// the channel is buffered so it can be left unread.
+	service.group.DoChan(snapshotKey, func() (interface{}, error) {
+		leaderboard, err := service.loadDetached()
+		if err != nil {
+			service.logger.
+				WithError(err).
+				Error("failed to refresh the leaderboard snapshot")
+
+			return nil, err
+		}
+
+		return leaderboard, nil
+	})
+}
+
+func (service *cachedLeaderboardService) invalidate() {
+	service.mu.Lock()
+	service.version++
+	service.expiresAt = time.Time{}
+	hasSnapshot := service.snapshot != nil
+	service.mu.Unlock()
+
+	if hasSnapshot {
+		service.refreshInBackground()
+	}
+}
+
+// invalidateIfTopChanged invalidates the snapshots when the current score of
+// the user changes the top of the leaderboard. The submission has already been
+// accepted, so failures are only logged and the snapshots expire on their own.
+func (service *cachedLeaderboardService) invalidateIfTopChanged(ctx context.Context, userID string) {
+	userScore, err := service.userScoreRepository.GetUserTopScore(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrResourceNotFound) {
+			service.logger.
+				WithError(err).
+				WithField("user_id", userID).
+				Warn("failed to get the user score to invalidate the leaderboard snapshot")
+		}
+
+		return
+	}
+
+	if !service.changesTop(userScore) {
+		return
+	}
+
+	service.invalidate()
+
+	err = service.leaderboardInvalidator.Invalidate(ctx)
+	if err != nil {
+		service.logger.
+			WithError(err).
+			Warn("failed to publish the leaderboard snapshot invalidation")
+	}
+}
+
+// changesTop compares the score with the local snapshot, the snapshots of the
+// other replicas are built from the same leaderboard so they agree with it.
+func (service *cachedLeaderboardService) changesTop(userScore domain.UserScore) bool {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	if service.snapshot == nil {
+		return true
+	}
+
+	userScores := service.snapshot.UserScores
+
+	for _, snapshotUserScore := range userScores {
+		if snapshotUserScore.UserID == userScore.UserID {
+			return snapshotUserScore.Score != userScore.Score
+		}
+	}
+
+	if len(userScores) < service.size {
+		return true
+	}
+
+	return userScore.Score >= userScores[len(userScores)-1].Score
+}
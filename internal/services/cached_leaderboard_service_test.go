@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+// nextLeaderboardService mocks the decorated service, the generated mocks of
+// this package can not be imported from its own tests.
+type nextLeaderboardService struct {
+	mock.Mock
+}
+
+func (service *nextLeaderboardService) GetLeaderboard(ctx context.Context) (domain.Leaderboard, error) {
+	args := service.Called(ctx)
+	return args.Get(0).(domain.Leaderboard), args.Error(1)
+}
+
+func (service *nextLeaderboardService) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	args := service.Called(ctx, count)
+	return args.Get(0).(domain.Leaderboard), args.Error(1)
+}
+
+func (service *nextLeaderboardService) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	args := service.Called(ctx, countryCode)
+	return args.Get(0).(domain.Leaderboard), args.Error(1)
+}
+
+func (service *nextLeaderboardService) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	args := service.Called(ctx, userID, count)
+	return args.Get(0).(domain.UserRank), args.Error(1)
+}
+
+func (service *nextLeaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64, eventID, mode string) error {
+	return service.Called(ctx, userID, score, eventID, mode).Error(0)
+}
+
+func (service *nextLeaderboardService) SubmitVerifiedScore(ctx context.Context, score domain.SignedScore) error {
+	return service.Called(ctx, score).Error(0)
+}
+
+type CachedLeaderboardServiceTestSuite struct {
+	suite.Suite
+
+	service *cachedLeaderboardService
+
+	mockLeaderboardService     *nextLeaderboardService
+	mockUserScoreRepository    *mocks.MockUserScoreRepository
+	mockLeaderboardInvalidator *mocks.MockLeaderboardInvalidator
+
+	leaderboard domain.Leaderboard
+}
+
+func TestCachedLeaderboardServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedLeaderboardServiceTestSuite))
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) SetupTest() {
+	suite.mockLeaderboardService = &nextLeaderboardService{}
+	suite.mockLeaderboardService.Test(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockLeaderboardInvalidator = mocks.NewMockLeaderboardInvalidator(suite.T())
+
+	suite.service = NewCachedLeaderboardService(CachedLeaderboardServiceDependencies{
+		Next:                   suite.mockLeaderboardService,
+		UserScoreRepository:    suite.mockUserScoreRepository,
+		LeaderboardInvalidator: suite.mockLeaderboardInvalidator,
+		Size:                   2,
+		TTL:                    time.Minute,
+		Logger:                 logrus.New(),
+	})
+
+	suite.leaderboard = domain.Leaderboard{
+		UserScores: []domain.UserScore{
+			{UserID: "user-id-1", Score: 300},
+			{UserID: "user-id-2", Score: 200},
+		},
+		Total:       3,
+		GeneratedAt: time.Now(),
+	}
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TearDownTest() {
+	suite.mockLeaderboardService.AssertExpectations(suite.T())
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestGetLeaderboard() {
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Return(suite.leaderboard, nil).
+		Once()
+
+	for i := 0; i < 2; i++ {
+		leaderboard, err := suite.service.GetLeaderboard(context.Background())
+		suite.NoError(err)
+
+		suite.Equal(suite.leaderboard, leaderboard)
+	}
+}
+
+// TestGetLeaderboard_CallerCanceled loads the snapshot with a context of
+// its own, so the callers waiting for the same load do not fail with the
+// first one.
+func (suite *CachedLeaderboardServiceTestSuite) TestGetLeaderboard_CallerCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Run(func(args mock.Arguments) {
+			cancel()
+			suite.NoError(args.Get(0).(context.Context).Err())
+		}).
+		Return(suite.leaderboard, nil).
+		Once()
+
+	_, _ = suite.service.GetLeaderboard(ctx)
+
+	leaderboard, err := suite.service.GetLeaderboard(context.Background())
+	suite.NoError(err)
+	suite.Equal(suite.leaderboard, leaderboard)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestGetLeaderboard_NextFailed() {
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Return(domain.Leaderboard{}, domain.ErrInternal)
+
+	_, err := suite.service.GetLeaderboard(context.Background())
+	suite.ErrorIs(err, domain.ErrInternal)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestGetLeaderboard_StaleWhileRevalidate() {
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Return(suite.leaderboard, nil).
+		Once()
+
+	_, err := suite.service.GetLeaderboard(context.Background())
+	suite.NoError(err)
+
+	refreshed := make(chan struct{})
+
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Run(func(args mock.Arguments) {
+			<-refreshed
+		}).
+		Return(domain.Leaderboard{GeneratedAt: time.Now()}, nil).
+		Once()
+
+	suite.service.invalidate()
+
+	leaderboard, err := suite.service.GetLeaderboard(context.Background())
+	suite.NoError(err)
+	suite.Len(leaderboard.UserScores, 2)
+
+	close(refreshed)
+
+	suite.Eventually(func() bool {
+		leaderboard, err := suite.service.GetLeaderboard(context.Background())
+		return err == nil && len(leaderboard.UserScores) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestSubmitUserScore_TopChanged() {
+	suite.service.snapshot = &domain.Leaderboard{UserScores: suite.leaderboard.UserScores[:2]}
+	suite.service.expiresAt = time.Now().Add(time.Minute)
+
+	suite.mockLeaderboardService.
+		On("SubmitUserScore", mock.Anything, "user-id-3", float64(250), "", "").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id-3").
+		Return(domain.UserScore{UserID: "user-id-3", Score: 250}, nil)
+
+	suite.mockLeaderboardInvalidator.
+		EXPECT().
+		Invalidate(mock.Anything).
+		Return(nil)
+
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Return(suite.leaderboard, nil).
+		Maybe()
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id-3", 250, "", "")
+	suite.NoError(err)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestSubmitUserScore_TopUnchanged() {
+	suite.service.snapshot = &domain.Leaderboard{UserScores: suite.leaderboard.UserScores[:2]}
+	suite.service.expiresAt = time.Now().Add(time.Minute)
+
+	suite.mockLeaderboardService.
+		On("SubmitUserScore", mock.Anything, "user-id-3", float64(50), "", "").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id-3").
+		Return(domain.UserScore{UserID: "user-id-3", Score: 100}, nil)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id-3", 50, "", "")
+	suite.NoError(err)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestSubmitUserScore_Failed() {
+	suite.mockLeaderboardService.
+		On("SubmitUserScore", mock.Anything, "user-id-1", float64(50), "", "").
+		Return(ErrInvalidScore)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id-1", 50, "", "")
+	suite.ErrorIs(err, ErrInvalidScore)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestSubmitUserScore_EventTopChanged() {
+	suite.service.snapshot = &domain.Leaderboard{UserScores: suite.leaderboard.UserScores[:2]}
+	suite.service.expiresAt = time.Now().Add(time.Minute)
+
+	suite.mockLeaderboardService.
+		On("SubmitUserScore", mock.Anything, "user-id-3", float64(250), "event-id", "duel").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id-3").
+		Return(domain.UserScore{UserID: "user-id-3", Score: 250}, nil)
+
+	suite.mockLeaderboardInvalidator.
+		EXPECT().
+		Invalidate(mock.Anything).
+		Return(nil)
+
+	suite.mockLeaderboardService.
+		On("GetTopLeaderboard", mock.Anything, int64(2)).
+		Return(suite.leaderboard, nil).
+		Maybe()
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id-3", 250, "event-id", "duel")
+	suite.NoError(err)
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestChangesTop() {
+	suite.True(suite.service.changesTop(domain.UserScore{UserID: "user-id-1", Score: 300}))
+
+	suite.service.snapshot = &domain.Leaderboard{UserScores: suite.leaderboard.UserScores[:2]}
+
+	suite.False(suite.service.changesTop(domain.UserScore{UserID: "user-id-1", Score: 300}))
+	suite.True(suite.service.changesTop(domain.UserScore{UserID: "user-id-1", Score: 400}))
+	suite.True(suite.service.changesTop(domain.UserScore{UserID: "user-id-3", Score: 200}))
+	suite.False(suite.service.changesTop(domain.UserScore{UserID: "user-id-3", Score: 199}))
+
+	suite.service.snapshot = &domain.Leaderboard{UserScores: suite.leaderboard.UserScores[:1]}
+
+	suite.True(suite.service.changesTop(domain.UserScore{UserID: "user-id-3", Score: 1}))
+}
+
+func (suite *CachedLeaderboardServiceTestSuite) TestRun() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	invalidations := make(chan struct{}, 1)
+	invalidations <- struct{}{}
+	close(invalidations)
+
+	suite.mockLeaderboardInvalidator.
+		EXPECT().
+		Subscribe(ctx).
+		Return(invalidations)
+
+	suite.service.expiresAt = time.Now().Add(time.Minute)
+
+	suite.service.Run(ctx)
+	cancel()
+
+	suite.Equal(uint64(1), suite.service.version)
+	suite.True(suite.service.expiresAt.IsZero())
+}
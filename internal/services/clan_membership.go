@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrNotInClan = errors.New("not in clan")
+)
+
+// clanMembership moves users out of their clan, it is shared by the clan
+// service and the erasure of users.
+type clanMembership struct {
+	clanRepository      domain.ClanRepository
+	userScoreRepository domain.UserScoreRepository
+}
+
+// leave removes the user from its clan. The leadership passes to the
+// officer that has joined first, or to the member that has joined first
+// when there is no officer. The clan is deleted along with its aggregates
+// once its last member has left.
+func (membership *clanMembership) leave(ctx context.Context, userID string) error {
+	member, err := membership.clanRepository.GetMember(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return ErrNotInClan
+		}
+
+		return err
+	}
+
+	err = membership.remove(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	members, err := membership.clanRepository.ListMembers(ctx, member.ClanID)
+	if err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		err = membership.clanRepository.Delete(ctx, member.ClanID)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return err
+		}
+
+		return membership.removeClan(ctx, member.ClanID)
+	}
+
+	if member.Role != domain.ClanRoleLeader {
+		return nil
+	}
+
+	successor := members[0]
+
+	for _, other := range members {
+		if other.Role == domain.ClanRoleOfficer {
+			successor = other
+			break
+		}
+	}
+
+	return membership.clanRepository.UpdateMemberRole(ctx, successor.UserID, domain.ClanRoleLeader)
+}
+
+// removeClan removes the members that have joined the deleted clan while
+// it was being deleted, and then the aggregates of the clan.
+func (membership *clanMembership) removeClan(ctx context.Context, clanID string) error {
+	members, err := membership.clanRepository.ListMembers(ctx, clanID)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		err = membership.remove(ctx, member.UserID)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return err
+		}
+	}
+
+	return membership.userScoreRepository.RemoveClan(ctx, clanID)
+}
+
+// remove takes the user out of the clan and its score out of the aggregates
+// of the clan.
+func (membership *clanMembership) remove(ctx context.Context, userID string) error {
+	err := membership.clanRepository.RemoveMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return membership.userScoreRepository.SetUserClan(ctx, userID, "")
+}
@@ -0,0 +1,420 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidClanName      = errors.New("invalid clan name")
+	ErrClanNameExists       = errors.New("clan name exists")
+	ErrAlreadyInClan        = errors.New("already in clan")
+	ErrClanFull             = errors.New("clan full")
+	ErrNotClanMember        = errors.New("not a member of the clan")
+	ErrClanPermissionDenied = errors.New("clan permission denied")
+	ErrInvalidClanRole      = errors.New("invalid clan role")
+	ErrInvalidClanAggregate = errors.New("invalid clan aggregate")
+)
+
+const (
+	minClanNameLength = 3
+	maxClanNameLength = 24
+)
+
+// clanRoleRanks orders the roles, a member can only kick the members ranked
+// below it.
+var clanRoleRanks = map[string]int{
+	domain.ClanRoleMember:  1,
+	domain.ClanRoleOfficer: 2,
+	domain.ClanRoleLeader:  3,
+}
+
+//go:generate mockery --name ClanService --structname MockClanService --outpkg mocks --filename clan_service_mock.go --output ./mocks/. --with-expecter
+type ClanService interface {
+	CreateClan(ctx context.Context, userID, name string) (domain.Clan, error)
+	GetClan(ctx context.Context, clanID string) (ClanDetails, error)
+	JoinClan(ctx context.Context, userID, clanID string) error
+	LeaveClan(ctx context.Context, userID string) error
+	KickMember(ctx context.Context, userID, memberID string) error
+	// SetMemberRole is only allowed to the leader, making another member
+	// the leader makes the current leader an officer.
+	SetMemberRole(ctx context.Context, userID, memberID, role string) error
+	GetClanLeaderboard(ctx context.Context, aggregate string) (domain.ClanLeaderboard, error)
+}
+
+type ClanDetails struct {
+	Clan    domain.Clan
+	Members []ClanMemberDetails
+}
+
+type ClanMemberDetails struct {
+	Member domain.ClanMember
+	User   domain.PublicUser
+}
+
+type ClanServiceDependencies struct {
+	UserRepository      domain.UserRepository
+	UserScoreRepository domain.UserScoreRepository
+	ClanRepository      domain.ClanRepository
+
+	// MaxMembers is the number of members a clan can have, zero disables
+	// the limit.
+	MaxMembers int
+}
+
+type clanService struct {
+	userRepository      domain.UserRepository
+	userScoreRepository domain.UserScoreRepository
+	clanRepository      domain.ClanRepository
+
+	membership *clanMembership
+
+	maxMembers int
+}
+
+func NewClanService(deps ClanServiceDependencies) *clanService {
+	return &clanService{
+		userRepository:      deps.UserRepository,
+		userScoreRepository: deps.UserScoreRepository,
+		clanRepository:      deps.ClanRepository,
+
+		membership: &clanMembership{
+			clanRepository:      deps.ClanRepository,
+			userScoreRepository: deps.UserScoreRepository,
+		},
+
+		maxMembers: deps.MaxMembers,
+	}
+}
+
+func (service *clanService) CreateClan(ctx context.Context, userID, name string) (domain.Clan, error) {
+	name, err := validateClanName(name)
+	if err != nil {
+		return domain.Clan{}, err
+	}
+
+	err = service.checkNotInClan(ctx, userID)
+	if err != nil {
+		return domain.Clan{}, err
+	}
+
+	_, err = service.clanRepository.GetByName(ctx, name)
+	if err == nil {
+		return domain.Clan{}, ErrClanNameExists
+	}
+
+	if !errors.Is(err, domain.ErrResourceNotFound) {
+		return domain.Clan{}, err
+	}
+
+	clan, err := service.clanRepository.Create(ctx, domain.Clan{
+		Name:      name,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceExists) {
+			return domain.Clan{}, ErrClanNameExists
+		}
+
+		return domain.Clan{}, err
+	}
+
+	err = service.addMember(ctx, clan.ID, userID, domain.ClanRoleLeader)
+	if err != nil {
+		// the clan would have no leader, the user has joined another clan
+		// in the meantime.
+		deleteErr := service.clanRepository.Delete(ctx, clan.ID)
+		if deleteErr != nil {
+			return domain.Clan{}, errors.Join(err, deleteErr)
+		}
+
+		return domain.Clan{}, err
+	}
+
+	return clan, nil
+}
+
+func (service *clanService) GetClan(ctx context.Context, clanID string) (ClanDetails, error) {
+	clan, err := service.clanRepository.GetByID(ctx, clanID)
+	if err != nil {
+		return ClanDetails{}, err
+	}
+
+	members, err := service.clanRepository.ListMembers(ctx, clanID)
+	if err != nil {
+		return ClanDetails{}, err
+	}
+
+	details := ClanDetails{
+		Clan:    clan,
+		Members: []ClanMemberDetails{},
+	}
+
+	if len(members) == 0 {
+		return details, nil
+	}
+
+	var userIDs []string
+
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	users, err := service.userRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return ClanDetails{}, err
+	}
+
+	userByID := make(map[string]domain.User)
+
+	for _, user := range users {
+		userByID[user.ID] = user
+	}
+
+	for _, member := range members {
+		memberDetails := ClanMemberDetails{
+			Member: member,
+		}
+
+		user, ok := userByID[member.UserID]
+		if ok {
+			memberDetails.User = user.Public()
+		} else {
+			memberDetails.User.Name = domain.UnknownUsername
+		}
+
+		details.Members = append(details.Members, memberDetails)
+	}
+
+	return details, nil
+}
+
+func (service *clanService) JoinClan(ctx context.Context, userID, clanID string) error {
+	err := service.checkNotInClan(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = service.clanRepository.GetByID(ctx, clanID)
+	if err != nil {
+		return err
+	}
+
+	if service.maxMembers > 0 {
+		members, err := service.clanRepository.ListMembers(ctx, clanID)
+		if err != nil {
+			return err
+		}
+
+		if len(members) >= service.maxMembers {
+			return ErrClanFull
+		}
+	}
+
+	err = service.addMember(ctx, clanID, userID, domain.ClanRoleMember)
+	if err != nil {
+		return err
+	}
+
+	// the last member may have left and deleted the clan in the meantime,
+	// the membership would be left behind without a clan.
+	_, err = service.clanRepository.GetByID(ctx, clanID)
+	if errors.Is(err, domain.ErrResourceNotFound) {
+		removeErr := service.membership.removeClan(ctx, clanID)
+		if removeErr != nil {
+			return errors.Join(err, removeErr)
+		}
+	}
+
+	return err
+}
+
+func (service *clanService) LeaveClan(ctx context.Context, userID string) error {
+	return service.membership.leave(ctx, userID)
+}
+
+func (service *clanService) KickMember(ctx context.Context, userID, memberID string) error {
+	if userID == memberID {
+		return ErrSameUser
+	}
+
+	member, target, err := service.getMembers(ctx, userID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if clanRoleRanks[member.Role] <= clanRoleRanks[target.Role] {
+		return ErrClanPermissionDenied
+	}
+
+	return service.membership.remove(ctx, memberID)
+}
+
+func (service *clanService) SetMemberRole(ctx context.Context, userID, memberID, role string) error {
+	if _, ok := clanRoleRanks[role]; !ok {
+		return ErrInvalidClanRole
+	}
+
+	if userID == memberID {
+		return ErrSameUser
+	}
+
+	member, target, err := service.getMembers(ctx, userID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role != domain.ClanRoleLeader {
+		return ErrClanPermissionDenied
+	}
+
+	if target.Role == role {
+		return nil
+	}
+
+	err = service.clanRepository.UpdateMemberRole(ctx, memberID, role)
+	if err != nil {
+		return err
+	}
+
+	if role != domain.ClanRoleLeader {
+		return nil
+	}
+
+	return service.clanRepository.UpdateMemberRole(ctx, userID, domain.ClanRoleOfficer)
+}
+
+// GetClanLeaderboard leaves out the clans that have been deleted while the
+// leaderboard was read.
+func (service *clanService) GetClanLeaderboard(ctx context.Context, aggregate string) (domain.ClanLeaderboard, error) {
+	if aggregate != domain.ClanAggregateSum && aggregate != domain.ClanAggregateAverage {
+		return domain.ClanLeaderboard{}, ErrInvalidClanAggregate
+	}
+
+	clanScores, err := service.userScoreRepository.GetClanLeaderboard(ctx, aggregate)
+	if err != nil {
+		return domain.ClanLeaderboard{}, err
+	}
+
+	leaderboard := domain.ClanLeaderboard{
+		Aggregate:   aggregate,
+		ClanScores:  []domain.ClanScore{},
+		GeneratedAt: time.Now(),
+	}
+
+	if len(clanScores) == 0 {
+		return leaderboard, nil
+	}
+
+	var clanIDs []string
+
+	for _, clanScore := range clanScores {
+		clanIDs = append(clanIDs, clanScore.ClanID)
+	}
+
+	clans, err := service.clanRepository.GetByIDs(ctx, clanIDs)
+	if err != nil {
+		return domain.ClanLeaderboard{}, err
+	}
+
+	clanByID := make(map[string]domain.Clan)
+
+	for _, clan := range clans {
+		clanByID[clan.ID] = clan
+	}
+
+	for _, clanScore := range clanScores {
+		clan, ok := clanByID[clanScore.ClanID]
+		if !ok {
+			continue
+		}
+
+		clanScore.Name = clan.Name
+
+		leaderboard.ClanScores = append(leaderboard.ClanScores, clanScore)
+	}
+
+	return leaderboard, nil
+}
+
+func (service *clanService) checkNotInClan(ctx context.Context, userID string) error {
+	_, err := service.clanRepository.GetMember(ctx, userID)
+	if err == nil {
+		return ErrAlreadyInClan
+	}
+
+	if !errors.Is(err, domain.ErrResourceNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// addMember adds the user to the clan and its score to the aggregates of
+// the clan.
+func (service *clanService) addMember(ctx context.Context, clanID, userID, role string) error {
+	err := service.clanRepository.AddMember(ctx, domain.ClanMember{
+		ClanID:   clanID,
+		UserID:   userID,
+		Role:     role,
+		JoinedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceExists) {
+			return ErrAlreadyInClan
+		}
+
+		return err
+	}
+
+	return service.userScoreRepository.SetUserClan(ctx, userID, clanID)
+}
+
+// getMembers returns the membership of the user and of the other member,
+// they have to be in the same clan.
+func (service *clanService) getMembers(ctx context.Context, userID, memberID string) (domain.ClanMember, domain.ClanMember, error) {
+	member, err := service.clanRepository.GetMember(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return domain.ClanMember{}, domain.ClanMember{}, ErrNotInClan
+		}
+
+		return domain.ClanMember{}, domain.ClanMember{}, err
+	}
+
+	target, err := service.clanRepository.GetMember(ctx, memberID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return domain.ClanMember{}, domain.ClanMember{}, err
+	}
+
+	if err != nil || target.ClanID != member.ClanID {
+		return domain.ClanMember{}, domain.ClanMember{}, ErrNotClanMember
+	}
+
+	return member, target, nil
+}
+
+// validateClanName returns the name trimmed, it has to be 3 to 24
+// characters long without control characters.
+func validateClanName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	length := utf8.RuneCountInString(name)
+
+	if length < minClanNameLength || length > maxClanNameLength {
+		return "", fmt.Errorf("%w, name has to be %d to %d characters long", ErrInvalidClanName, minClanNameLength, maxClanNameLength)
+	}
+
+	if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return "", fmt.Errorf("%w, name contains control characters", ErrInvalidClanName)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,458 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type ClanServiceTestSuite struct {
+	suite.Suite
+
+	service *clanService
+
+	mockUserRepository      *mocks.MockUserRepository
+	mockUserScoreRepository *mocks.MockUserScoreRepository
+	mockClanRepository      *mocks.MockClanRepository
+}
+
+func TestClanServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ClanServiceTestSuite))
+}
+
+func (suite *ClanServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockClanRepository = mocks.NewMockClanRepository(suite.T())
+
+	suite.service = NewClanService(ClanServiceDependencies{
+		UserRepository:      suite.mockUserRepository,
+		UserScoreRepository: suite.mockUserScoreRepository,
+		ClanRepository:      suite.mockClanRepository,
+		MaxMembers:          2,
+	})
+}
+
+func (suite *ClanServiceTestSuite) expectMember(userID, role string) {
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, userID).
+		Return(domain.ClanMember{ClanID: "clan-id", UserID: userID, Role: role}, nil)
+}
+
+func (suite *ClanServiceTestSuite) expectNoMember(userID string) {
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, userID).
+		Return(domain.ClanMember{}, domain.ErrResourceNotFound)
+}
+
+func (suite *ClanServiceTestSuite) TestCreateClan() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByName(mock.Anything, "The Clan").
+		Return(domain.Clan{}, domain.ErrResourceNotFound)
+
+	suite.mockClanRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(clan domain.Clan) bool {
+			return clan.Name == "The Clan"
+		})).
+		RunAndReturn(func(ctx context.Context, clan domain.Clan) (domain.Clan, error) {
+			clan.ID = "clan-id"
+			return clan, nil
+		})
+
+	suite.mockClanRepository.
+		EXPECT().
+		AddMember(mock.Anything, mock.MatchedBy(func(member domain.ClanMember) bool {
+			return member.ClanID == "clan-id" &&
+				member.UserID == "user-id" &&
+				member.Role == domain.ClanRoleLeader
+		})).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "clan-id").
+		Return(nil)
+
+	clan, err := suite.service.CreateClan(context.Background(), "user-id", "  The Clan ")
+	suite.NoError(err)
+	suite.Equal("clan-id", clan.ID)
+}
+
+func (suite *ClanServiceTestSuite) TestCreateClan_InvalidName() {
+	_, err := suite.service.CreateClan(context.Background(), "user-id", "ab")
+	suite.ErrorIs(err, ErrInvalidClanName)
+}
+
+func (suite *ClanServiceTestSuite) TestCreateClan_AlreadyInClan() {
+	suite.expectMember("user-id", domain.ClanRoleMember)
+
+	_, err := suite.service.CreateClan(context.Background(), "user-id", "The Clan")
+	suite.ErrorIs(err, ErrAlreadyInClan)
+}
+
+func (suite *ClanServiceTestSuite) TestCreateClan_NameExists() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByName(mock.Anything, "The Clan").
+		Return(domain.Clan{ID: "other-clan-id"}, nil)
+
+	_, err := suite.service.CreateClan(context.Background(), "user-id", "The Clan")
+	suite.ErrorIs(err, ErrClanNameExists)
+}
+
+func (suite *ClanServiceTestSuite) TestCreateClan_JoinedAnotherClan() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByName(mock.Anything, "The Clan").
+		Return(domain.Clan{}, domain.ErrResourceNotFound)
+
+	suite.mockClanRepository.
+		EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(domain.Clan{ID: "clan-id", Name: "The Clan"}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		AddMember(mock.Anything, mock.Anything).
+		Return(domain.ErrResourceExists)
+
+	suite.mockClanRepository.
+		EXPECT().
+		Delete(mock.Anything, "clan-id").
+		Return(nil)
+
+	_, err := suite.service.CreateClan(context.Background(), "user-id", "The Clan")
+	suite.ErrorIs(err, ErrAlreadyInClan)
+}
+
+func (suite *ClanServiceTestSuite) TestJoinClan() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByID(mock.Anything, "clan-id").
+		Return(domain.Clan{ID: "clan-id"}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return([]domain.ClanMember{{UserID: "leader-id", Role: domain.ClanRoleLeader}}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		AddMember(mock.Anything, mock.MatchedBy(func(member domain.ClanMember) bool {
+			return member.UserID == "user-id" && member.Role == domain.ClanRoleMember
+		})).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "clan-id").
+		Return(nil)
+
+	err := suite.service.JoinClan(context.Background(), "user-id", "clan-id")
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestJoinClan_ClanDeleted() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByID(mock.Anything, "clan-id").
+		Return(domain.Clan{ID: "clan-id"}, nil).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return(nil, nil).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		AddMember(mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "clan-id").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByID(mock.Anything, "clan-id").
+		Return(domain.Clan{}, domain.ErrResourceNotFound).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return([]domain.ClanMember{{ClanID: "clan-id", UserID: "user-id"}}, nil).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveClan(mock.Anything, "clan-id").
+		Return(nil)
+
+	err := suite.service.JoinClan(context.Background(), "user-id", "clan-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *ClanServiceTestSuite) TestJoinClan_Full() {
+	suite.expectNoMember("user-id")
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByID(mock.Anything, "clan-id").
+		Return(domain.Clan{ID: "clan-id"}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return(make([]domain.ClanMember, 2), nil)
+
+	err := suite.service.JoinClan(context.Background(), "user-id", "clan-id")
+	suite.ErrorIs(err, ErrClanFull)
+}
+
+func (suite *ClanServiceTestSuite) TestLeaveClan_LeaderPassesLeadership() {
+	suite.expectMember("user-id", domain.ClanRoleLeader)
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return([]domain.ClanMember{
+			{UserID: "member-id", Role: domain.ClanRoleMember},
+			{UserID: "officer-id", Role: domain.ClanRoleOfficer},
+		}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		UpdateMemberRole(mock.Anything, "officer-id", domain.ClanRoleLeader).
+		Return(nil)
+
+	err := suite.service.LeaveClan(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestLeaveClan_LastMember() {
+	suite.expectMember("user-id", domain.ClanRoleLeader)
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return(nil, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		Delete(mock.Anything, "clan-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveClan(mock.Anything, "clan-id").
+		Return(nil)
+
+	err := suite.service.LeaveClan(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestLeaveClan_LastMemberRemovesJoinedMember() {
+	suite.expectMember("user-id", domain.ClanRoleLeader)
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return(nil, nil).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		Delete(mock.Anything, "clan-id").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return([]domain.ClanMember{{ClanID: "clan-id", UserID: "joined-id"}}, nil).
+		Once()
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "joined-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "joined-id", "").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveClan(mock.Anything, "clan-id").
+		Return(nil)
+
+	err := suite.service.LeaveClan(context.Background(), "user-id")
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestLeaveClan_NotInClan() {
+	suite.expectNoMember("user-id")
+
+	err := suite.service.LeaveClan(context.Background(), "user-id")
+	suite.ErrorIs(err, ErrNotInClan)
+}
+
+func (suite *ClanServiceTestSuite) TestKickMember() {
+	suite.expectMember("user-id", domain.ClanRoleOfficer)
+	suite.expectMember("member-id", domain.ClanRoleMember)
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "member-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "member-id", "").
+		Return(nil)
+
+	err := suite.service.KickMember(context.Background(), "user-id", "member-id")
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestKickMember_SameRole() {
+	suite.expectMember("user-id", domain.ClanRoleOfficer)
+	suite.expectMember("member-id", domain.ClanRoleOfficer)
+
+	err := suite.service.KickMember(context.Background(), "user-id", "member-id")
+	suite.ErrorIs(err, ErrClanPermissionDenied)
+}
+
+func (suite *ClanServiceTestSuite) TestKickMember_OtherClan() {
+	suite.expectMember("user-id", domain.ClanRoleLeader)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, "member-id").
+		Return(domain.ClanMember{ClanID: "other-clan-id", UserID: "member-id", Role: domain.ClanRoleMember}, nil)
+
+	err := suite.service.KickMember(context.Background(), "user-id", "member-id")
+	suite.ErrorIs(err, ErrNotClanMember)
+}
+
+func (suite *ClanServiceTestSuite) TestSetMemberRole_TransfersLeadership() {
+	suite.expectMember("user-id", domain.ClanRoleLeader)
+	suite.expectMember("member-id", domain.ClanRoleOfficer)
+
+	suite.mockClanRepository.
+		EXPECT().
+		UpdateMemberRole(mock.Anything, "member-id", domain.ClanRoleLeader).
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		UpdateMemberRole(mock.Anything, "user-id", domain.ClanRoleOfficer).
+		Return(nil)
+
+	err := suite.service.SetMemberRole(context.Background(), "user-id", "member-id", domain.ClanRoleLeader)
+	suite.NoError(err)
+}
+
+func (suite *ClanServiceTestSuite) TestSetMemberRole_NotLeader() {
+	suite.expectMember("user-id", domain.ClanRoleOfficer)
+	suite.expectMember("member-id", domain.ClanRoleMember)
+
+	err := suite.service.SetMemberRole(context.Background(), "user-id", "member-id", domain.ClanRoleOfficer)
+	suite.ErrorIs(err, ErrClanPermissionDenied)
+}
+
+func (suite *ClanServiceTestSuite) TestSetMemberRole_InvalidRole() {
+	err := suite.service.SetMemberRole(context.Background(), "user-id", "member-id", "admin")
+	suite.ErrorIs(err, ErrInvalidClanRole)
+}
+
+func (suite *ClanServiceTestSuite) TestGetClanLeaderboard() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetClanLeaderboard(mock.Anything, domain.ClanAggregateAverage).
+		Return([]domain.ClanScore{
+			{ClanID: "clan-id", Score: 450, ScoredMembers: 2},
+			{ClanID: "deleted-clan-id", Score: 400, ScoredMembers: 1},
+		}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetByIDs(mock.Anything, []string{"clan-id", "deleted-clan-id"}).
+		Return([]domain.Clan{{ID: "clan-id", Name: "The Clan"}}, nil)
+
+	leaderboard, err := suite.service.GetClanLeaderboard(context.Background(), domain.ClanAggregateAverage)
+	suite.NoError(err)
+
+	suite.Equal(domain.ClanAggregateAverage, leaderboard.Aggregate)
+	suite.Equal([]domain.ClanScore{
+		{ClanID: "clan-id", Name: "The Clan", Score: 450, ScoredMembers: 2},
+	}, leaderboard.ClanScores)
+}
+
+func (suite *ClanServiceTestSuite) TestGetClanLeaderboard_InvalidAggregate() {
+	_, err := suite.service.GetClanLeaderboard(context.Background(), "median")
+	suite.ErrorIs(err, ErrInvalidClanAggregate)
+}
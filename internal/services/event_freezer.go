@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name EventFreezer --structname MockEventFreezer --outpkg mocks --filename event_freezer_mock.go --output ./mocks/. --with-expecter
+type EventFreezer interface {
+	Run(ctx context.Context)
+}
+
+type EventFreezerDependencies struct {
+	EventService EventService
+
+	Interval time.Duration
+	// Metrics receives the outcome of every run: runs, failures,
+	// frozen_events and last_run_timestamp.
+	Metrics *expvar.Map
+
+	Logger *logrus.Logger
+}
+
+type eventFreezer struct {
+	*periodicJob
+}
+
+// NewEventFreezer returns an EventFreezer that freezes the boards of the
+// events that have ended every interval until the context of Run is done.
+func NewEventFreezer(deps EventFreezerDependencies) *eventFreezer {
+	return &eventFreezer{
+		periodicJob: &periodicJob{
+			interval:      deps.Interval,
+			metrics:       deps.Metrics,
+			logger:        deps.Logger,
+			do:            deps.EventService.FreezeEndedEvents,
+			countMetric:   "frozen_events",
+			countField:    "frozen",
+			failedMessage: "failed to freeze the ended events",
+			doneMessage:   "event boards have been frozen",
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidEvent = errors.New("invalid event")
+)
+
+const (
+	maxEventNameLength = 64
+	maxEventAttempts   = 100
+)
+
+//go:generate mockery --name EventService --structname MockEventService --outpkg mocks --filename event_service_mock.go --output ./mocks/. --with-expecter
+type EventService interface {
+	CreateEvent(ctx context.Context, event domain.Event) (domain.Event, error)
+	GetEvent(ctx context.Context, id string) (domain.Event, error)
+	// ListEvents returns the events that have not ended yet, the ones that
+	// start first come first.
+	ListEvents(ctx context.Context) ([]domain.Event, error)
+	GetEventLeaderboard(ctx context.Context, id string) (domain.Leaderboard, error)
+	// FreezeEndedEvents freezes the boards of the events that have ended,
+	// it returns the number of boards that have been frozen.
+	FreezeEndedEvents(ctx context.Context) (int, error)
+}
+
+type EventServiceDependencies struct {
+	EventRepository      domain.EventRepository
+	EventBoardRepository domain.EventBoardRepository
+
+	// Modes are the modes an event can be created for.
+	Modes []string
+	// LeaderboardSize is the number of users returned on the board of an
+	// event.
+	LeaderboardSize int64
+	// RankMode numbers the ranks on the boards, see domain.RankModeStandard.
+	RankMode string
+}
+
+type eventService struct {
+	eventRepository      domain.EventRepository
+	eventBoardRepository domain.EventBoardRepository
+
+	modes           map[string]bool
+	leaderboardSize int64
+	rankMode        string
+}
+
+func NewEventService(deps EventServiceDependencies) *eventService {
+	modes := make(map[string]bool, len(deps.Modes))
+
+	for _, mode := range deps.Modes {
+		modes[mode] = true
+	}
+
+	return &eventService{
+		eventRepository:      deps.EventRepository,
+		eventBoardRepository: deps.EventBoardRepository,
+		modes:                modes,
+		leaderboardSize:      deps.LeaderboardSize,
+		rankMode:             deps.RankMode,
+	}
+}
+
+func (service *eventService) CreateEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	event, err := service.validateEvent(event)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return service.eventRepository.Create(ctx, domain.Event{
+		Name:        event.Name,
+		Mode:        event.Mode,
+		StartsAt:    event.StartsAt,
+		EndsAt:      event.EndsAt,
+		MaxAttempts: event.MaxAttempts,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func (service *eventService) GetEvent(ctx context.Context, id string) (domain.Event, error) {
+	return service.eventRepository.GetByID(ctx, id)
+}
+
+func (service *eventService) ListEvents(ctx context.Context) ([]domain.Event, error) {
+	return service.eventRepository.ListCurrent(ctx, time.Now())
+}
+
+func (service *eventService) GetEventLeaderboard(ctx context.Context, id string) (domain.Leaderboard, error) {
+	_, err := service.eventRepository.GetByID(ctx, id)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	leaderboard, err := service.eventBoardRepository.GetEventLeaderboard(ctx, id, service.leaderboardSize)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	rankLeaderboard(&leaderboard, service.rankMode)
+	leaderboard.GeneratedAt = time.Now()
+
+	return leaderboard, nil
+}
+
+// FreezeEndedEvents freezes the board before marking the event, so an event
+// whose board has failed to freeze is picked up again by the next run.
+func (service *eventService) FreezeEndedEvents(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	events, err := service.eventRepository.ListEnded(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		frozen int
+		errs   []error
+	)
+
+	for _, event := range events {
+		err := service.eventBoardRepository.FreezeEventBoard(ctx, event.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", event.ID, err))
+
+			continue
+		}
+
+		err = service.eventRepository.SetFrozen(ctx, event.ID, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", event.ID, err))
+
+			continue
+		}
+
+		frozen++
+	}
+
+	return frozen, errors.Join(errs...)
+}
+
+func (service *eventService) validateEvent(event domain.Event) (domain.Event, error) {
+	event.Name = strings.TrimSpace(event.Name)
+
+	length := utf8.RuneCountInString(event.Name)
+
+	if length == 0 || length > maxEventNameLength {
+		return domain.Event{}, fmt.Errorf("%w, name has to be 1 to %d characters long", ErrInvalidEvent, maxEventNameLength)
+	}
+
+	if strings.IndexFunc(event.Name, unicode.IsControl) >= 0 {
+		return domain.Event{}, fmt.Errorf("%w, name contains control characters", ErrInvalidEvent)
+	}
+
+	if !service.modes[event.Mode] {
+		return domain.Event{}, fmt.Errorf("%w, unknown mode %q", ErrInvalidEvent, event.Mode)
+	}
+
+	if event.MaxAttempts < 1 || event.MaxAttempts > maxEventAttempts {
+		return domain.Event{}, fmt.Errorf("%w, max attempts has to be 1 to %d", ErrInvalidEvent, maxEventAttempts)
+	}
+
+	if !event.EndsAt.After(event.StartsAt) {
+		return domain.Event{}, fmt.Errorf("%w, end time has to be after the start time", ErrInvalidEvent)
+	}
+
+	if !event.EndsAt.After(time.Now()) {
+		return domain.Event{}, fmt.Errorf("%w, end time has to be in the future", ErrInvalidEvent)
+	}
+
+	return event, nil
+}
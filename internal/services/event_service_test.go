@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type EventServiceTestSuite struct {
+	suite.Suite
+
+	service *eventService
+
+	mockEventRepository      *mocks.MockEventRepository
+	mockEventBoardRepository *mocks.MockEventBoardRepository
+}
+
+func TestEventServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EventServiceTestSuite))
+}
+
+func (suite *EventServiceTestSuite) SetupTest() {
+	suite.mockEventRepository = mocks.NewMockEventRepository(suite.T())
+	suite.mockEventBoardRepository = mocks.NewMockEventBoardRepository(suite.T())
+
+	suite.service = NewEventService(EventServiceDependencies{
+		EventRepository:      suite.mockEventRepository,
+		EventBoardRepository: suite.mockEventBoardRepository,
+		Modes:                []string{"duel", "free_for_all"},
+		LeaderboardSize:      10,
+		RankMode:             domain.RankModeStandard,
+	})
+}
+
+func (suite *EventServiceTestSuite) weekendEvent() domain.Event {
+	return domain.Event{
+		Name:        " Weekend Rush ",
+		Mode:        "duel",
+		StartsAt:    time.Now().Add(time.Hour),
+		EndsAt:      time.Now().Add(49 * time.Hour),
+		MaxAttempts: 5,
+	}
+}
+
+func (suite *EventServiceTestSuite) TestCreateEvent() {
+	event := suite.weekendEvent()
+
+	suite.mockEventRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(created domain.Event) bool {
+			return created.Name == "Weekend Rush" &&
+				created.Mode == "duel" &&
+				created.StartsAt.Equal(event.StartsAt) &&
+				created.EndsAt.Equal(event.EndsAt) &&
+				created.MaxAttempts == 5 &&
+				!created.CreatedAt.IsZero()
+		})).
+		Return(domain.Event{ID: "event-id"}, nil)
+
+	created, err := suite.service.CreateEvent(context.Background(), event)
+	suite.NoError(err)
+	suite.Equal("event-id", created.ID)
+}
+
+func (suite *EventServiceTestSuite) TestCreateEvent_Invalid() {
+	longName := suite.weekendEvent()
+	longName.Name = "Weekend Rush Weekend Rush Weekend Rush Weekend Rush Weekend Rush!"
+
+	unknownMode := suite.weekendEvent()
+	unknownMode.Mode = "battle_royale"
+
+	noAttempts := suite.weekendEvent()
+	noAttempts.MaxAttempts = 0
+
+	tooManyAttempts := suite.weekendEvent()
+	tooManyAttempts.MaxAttempts = maxEventAttempts + 1
+
+	endsBeforeStart := suite.weekendEvent()
+	endsBeforeStart.EndsAt = endsBeforeStart.StartsAt
+
+	ended := suite.weekendEvent()
+	ended.StartsAt = time.Now().Add(-2 * time.Hour)
+	ended.EndsAt = time.Now().Add(-time.Hour)
+
+	for _, event := range []domain.Event{longName, unknownMode, noAttempts, tooManyAttempts, endsBeforeStart, ended} {
+		_, err := suite.service.CreateEvent(context.Background(), event)
+		suite.ErrorIs(err, ErrInvalidEvent)
+	}
+}
+
+func (suite *EventServiceTestSuite) TestListEvents() {
+	suite.mockEventRepository.
+		EXPECT().
+		ListCurrent(mock.Anything, mock.Anything).
+		Return([]domain.Event{{ID: "event-id-1"}, {ID: "event-id-2"}}, nil)
+
+	events, err := suite.service.ListEvents(context.Background())
+	suite.NoError(err)
+	suite.Len(events, 2)
+}
+
+func (suite *EventServiceTestSuite) TestGetEventLeaderboard() {
+	suite.mockEventRepository.
+		EXPECT().
+		GetByID(mock.Anything, "event-id").
+		Return(domain.Event{ID: "event-id"}, nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		GetEventLeaderboard(mock.Anything, "event-id", int64(10)).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-1", Score: 900},
+				{UserID: "user-id-2", Score: 900},
+				{UserID: "user-id-3", Score: 800},
+			},
+		}, nil)
+
+	leaderboard, err := suite.service.GetEventLeaderboard(context.Background(), "event-id")
+	suite.NoError(err)
+
+	suite.Equal(int64(1), leaderboard.UserScores[1].Rank)
+	suite.Equal(int64(3), leaderboard.UserScores[2].Rank)
+	suite.False(leaderboard.GeneratedAt.IsZero())
+}
+
+func (suite *EventServiceTestSuite) TestGetEventLeaderboard_Truncated() {
+	suite.mockEventRepository.
+		EXPECT().
+		GetByID(mock.Anything, "event-id").
+		Return(domain.Event{ID: "event-id"}, nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		GetEventLeaderboard(mock.Anything, "event-id", int64(10)).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-1", Score: 900},
+				{UserID: "user-id-2", Score: 800},
+			},
+			Total: 20,
+		}, nil)
+
+	leaderboard, err := suite.service.GetEventLeaderboard(context.Background(), "event-id")
+	suite.NoError(err)
+
+	suite.Equal(float64(100), leaderboard.UserScores[0].Percentile)
+	suite.Equal(float64(95), leaderboard.UserScores[1].Percentile)
+}
+
+func (suite *EventServiceTestSuite) TestGetEventLeaderboard_NotFound() {
+	suite.mockEventRepository.
+		EXPECT().
+		GetByID(mock.Anything, "event-id").
+		Return(domain.Event{}, domain.ErrResourceNotFound)
+
+	_, err := suite.service.GetEventLeaderboard(context.Background(), "event-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *EventServiceTestSuite) TestFreezeEndedEvents() {
+	suite.mockEventRepository.
+		EXPECT().
+		ListEnded(mock.Anything, mock.Anything).
+		Return([]domain.Event{{ID: "event-id-1"}, {ID: "event-id-2"}}, nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		FreezeEventBoard(mock.Anything, "event-id-1").
+		Return(nil)
+
+	suite.mockEventRepository.
+		EXPECT().
+		SetFrozen(mock.Anything, "event-id-1", mock.Anything).
+		Return(nil)
+
+	// the second event is not marked, so the next run freezes it again.
+	suite.mockEventBoardRepository.
+		EXPECT().
+		FreezeEventBoard(mock.Anything, "event-id-2").
+		Return(domain.ErrInternal)
+
+	frozen, err := suite.service.FreezeEndedEvents(context.Background())
+	suite.ErrorIs(err, domain.ErrInternal)
+	suite.Equal(1, frozen)
+}
+
+func (suite *EventServiceTestSuite) TestFreezerRunOnce_ReportsMetrics() {
+	metrics := new(expvar.Map)
+
+	freezer := NewEventFreezer(EventFreezerDependencies{
+		EventService: suite.service,
+		Metrics:      metrics,
+		Logger:       logrus.New(),
+	})
+
+	suite.mockEventRepository.
+		EXPECT().
+		ListEnded(mock.Anything, mock.Anything).
+		Return([]domain.Event{{ID: "event-id"}}, nil).
+		Once()
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		FreezeEventBoard(mock.Anything, "event-id").
+		Return(nil)
+
+	suite.mockEventRepository.
+		EXPECT().
+		SetFrozen(mock.Anything, "event-id", mock.Anything).
+		Return(nil)
+
+	freezer.runOnce(context.Background())
+
+	suite.Equal("1", metrics.Get("runs").String())
+	suite.Equal("1", metrics.Get("frozen_events").String())
+	suite.Nil(metrics.Get("failures"))
+	suite.NotNil(metrics.Get("last_run_timestamp"))
+
+	suite.mockEventRepository.
+		EXPECT().
+		ListEnded(mock.Anything, mock.Anything).
+		Return(nil, domain.ErrInternal).
+		Once()
+
+	freezer.runOnce(context.Background())
+
+	suite.Equal("2", metrics.Get("runs").String())
+	suite.Equal("1", metrics.Get("failures").String())
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"game/internal/domain"
+)
+
+const hmacSecretSize = 32
+
+var (
+	ErrInvalidGameServerKey = errors.New("invalid game server key")
+	ErrGameServerKeyExists  = errors.New("game server key exists")
+)
+
+//go:generate mockery --name GameServerKeyService --structname MockGameServerKeyService --outpkg mocks --filename game_server_key_service_mock.go --output ./mocks/. --with-expecter
+type GameServerKeyService interface {
+	CreateKey(ctx context.Context, serverID string, algorithm string, publicKey []byte) (domain.GameServerKey, error)
+	ListKeys(ctx context.Context) ([]domain.GameServerKey, error)
+	RevokeKey(ctx context.Context, serverID string) error
+}
+
+type GameServerKeyServiceDependencies struct {
+	GameServerKeyRepository domain.GameServerKeyRepository
+}
+
+type gameServerKeyService struct {
+	gameServerKeyRepository domain.GameServerKeyRepository
+}
+
+func NewGameServerKeyService(deps GameServerKeyServiceDependencies) *gameServerKeyService {
+	return &gameServerKeyService{
+		gameServerKeyRepository: deps.GameServerKeyRepository,
+	}
+}
+
+// CreateKey registers the key of a game server. HMAC secrets are generated
+// here and only returned once, Ed25519 keys are generated by the game server
+// and only the public key is sent.
+func (service *gameServerKeyService) CreateKey(
+	ctx context.Context, serverID string, algorithm string, publicKey []byte,
+) (domain.GameServerKey, error) {
+	key := domain.GameServerKey{
+		ServerID:  serverID,
+		Algorithm: algorithm,
+		CreatedAt: time.Now(),
+	}
+
+	switch algorithm {
+	case domain.GameServerKeyAlgorithmHMACSHA256:
+		key.Key = make([]byte, hmacSecretSize)
+
+		_, err := rand.Read(key.Key)
+		if err != nil {
+			return domain.GameServerKey{}, err
+		}
+	case domain.GameServerKeyAlgorithmEd25519:
+		if len(publicKey) != ed25519.PublicKeySize {
+			return domain.GameServerKey{}, ErrInvalidGameServerKey
+		}
+
+		key.Key = publicKey
+	default:
+		return domain.GameServerKey{}, ErrInvalidGameServerKey
+	}
+
+	key, err := service.gameServerKeyRepository.Create(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceExists) {
+			return domain.GameServerKey{}, ErrGameServerKeyExists
+		}
+
+		return domain.GameServerKey{}, err
+	}
+
+	return key, nil
+}
+
+func (service *gameServerKeyService) ListKeys(ctx context.Context) ([]domain.GameServerKey, error) {
+	keys, err := service.gameServerKeyRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (service *gameServerKeyService) RevokeKey(ctx context.Context, serverID string) error {
+	err := service.gameServerKeyRepository.Delete(ctx, serverID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
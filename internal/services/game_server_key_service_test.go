@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type GameServerKeyServiceTestSuite struct {
+	suite.Suite
+
+	service *gameServerKeyService
+
+	mockGameServerKeyRepository *mocks.MockGameServerKeyRepository
+}
+
+func TestGameServerKeyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(GameServerKeyServiceTestSuite))
+}
+
+func (suite *GameServerKeyServiceTestSuite) SetupTest() {
+	suite.mockGameServerKeyRepository = mocks.NewMockGameServerKeyRepository(suite.T())
+
+	suite.service = NewGameServerKeyService(GameServerKeyServiceDependencies{
+		GameServerKeyRepository: suite.mockGameServerKeyRepository,
+	})
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestCreateKey_HMAC() {
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(key domain.GameServerKey) bool {
+			return key.ServerID == "server-id" && len(key.Key) == hmacSecretSize
+		})).
+		RunAndReturn(func(ctx context.Context, key domain.GameServerKey) (domain.GameServerKey, error) {
+			return key, nil
+		})
+
+	key, err := suite.service.CreateKey(context.Background(), "server-id", domain.GameServerKeyAlgorithmHMACSHA256, nil)
+	suite.NoError(err)
+	suite.Len(key.Key, hmacSecretSize)
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestCreateKey_Ed25519() {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	suite.Require().NoError(err)
+
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(key domain.GameServerKey) bool {
+			return key.ServerID == "server-id" && string(key.Key) == string(publicKey)
+		})).
+		RunAndReturn(func(ctx context.Context, key domain.GameServerKey) (domain.GameServerKey, error) {
+			return key, nil
+		})
+
+	_, err = suite.service.CreateKey(context.Background(), "server-id", domain.GameServerKeyAlgorithmEd25519, publicKey)
+	suite.NoError(err)
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestCreateKey_InvalidEd25519Key() {
+	_, err := suite.service.CreateKey(context.Background(), "server-id", domain.GameServerKeyAlgorithmEd25519, []byte("short"))
+	suite.ErrorIs(err, ErrInvalidGameServerKey)
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestCreateKey_UnknownAlgorithm() {
+	_, err := suite.service.CreateKey(context.Background(), "server-id", "rsa", nil)
+	suite.ErrorIs(err, ErrInvalidGameServerKey)
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestCreateKey_Exists() {
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(domain.GameServerKey{}, domain.ErrResourceExists)
+
+	_, err := suite.service.CreateKey(context.Background(), "server-id", domain.GameServerKeyAlgorithmHMACSHA256, nil)
+	suite.ErrorIs(err, ErrGameServerKeyExists)
+}
+
+func (suite *GameServerKeyServiceTestSuite) TestRevokeKey() {
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		Delete(mock.Anything, "server-id").
+		Return(nil)
+
+	err := suite.service.RevokeKey(context.Background(), "server-id")
+	suite.NoError(err)
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"math"
+
+	"game/internal/domain"
+)
+
+// glicko2Scale converts the ratings and the deviations between the Glicko
+// and the Glicko-2 scale.
+const glicko2Scale = 173.7178
+
+// glicko2Epsilon is the precision the volatility is computed with.
+const glicko2Epsilon = 0.000001
+
+// glicko2Outcome is the result of a game against an opponent, score is 1 for
+// a win, 0.5 for a draw and 0 for a loss.
+type glicko2Outcome struct {
+	opponent domain.Rating
+	score    float64
+}
+
+// updateGlicko2 returns the rating of the player after a rating period with
+// the outcomes, following the steps of "Example of the Glicko-2 system" by
+// Mark Glickman. tau constrains the change of the volatility over time.
+func updateGlicko2(rating domain.Rating, outcomes []glicko2Outcome, tau float64) domain.Rating {
+	mu := (rating.Rating - domain.DefaultRating) / glicko2Scale
+	phi := rating.Deviation / glicko2Scale
+	sigma := rating.Volatility
+
+	var variance, improvement float64
+
+	for _, outcome := range outcomes {
+		opponentMu := (outcome.opponent.Rating - domain.DefaultRating) / glicko2Scale
+		opponentPhi := outcome.opponent.Deviation / glicko2Scale
+
+		g := 1 / math.Sqrt(1+3*opponentPhi*opponentPhi/(math.Pi*math.Pi))
+		expected := 1 / (1 + math.Exp(-g*(mu-opponentMu)))
+
+		variance += g * g * expected * (1 - expected)
+		improvement += g * (outcome.score - expected)
+	}
+
+	v := 1 / variance
+	delta := v * improvement
+
+	sigma = glicko2Volatility(phi, sigma, v, delta, tau)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu += phi * phi * improvement
+
+	rating.Rating = mu*glicko2Scale + domain.DefaultRating
+	rating.Deviation = math.Min(phi*glicko2Scale, domain.DefaultRatingDeviation)
+	rating.Volatility = sigma
+
+	return rating
+}
+
+// glicko2Volatility finds the new volatility with the Illinois algorithm.
+func glicko2Volatility(phi, sigma, v, delta, tau float64) float64 {
+	a := math.Log(sigma * sigma)
+
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	lower := a
+
+	var upper float64
+
+	if delta*delta > phi*phi+v {
+		upper = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+
+		upper = a - k*tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+
+	for math.Abs(upper-lower) > glicko2Epsilon {
+		c := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fC := f(c)
+
+		if fC*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+
+		upper, fUpper = c, fC
+	}
+
+	return math.Exp(lower / 2)
+}
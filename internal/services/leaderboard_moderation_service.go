@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+)
+
+//go:generate mockery --name LeaderboardModerationService --structname MockLeaderboardModerationService --outpkg mocks --filename leaderboard_moderation_service_mock.go --output ./mocks/. --with-expecter
+type LeaderboardModerationService interface {
+	RemoveScore(ctx context.Context, request ModerationRequest) error
+	SetScore(ctx context.Context, request ModerationRequest, score float64) error
+	BanUser(ctx context.Context, request ModerationRequest) error
+	UnbanUser(ctx context.Context, request ModerationRequest) error
+}
+
+// ModerationRequest identifies the player a moderation action is taken on,
+// who takes it and why.
+type ModerationRequest struct {
+	UserID    string
+	Moderator string
+	Reason    string
+}
+
+type LeaderboardModerationServiceDependencies struct {
+	UserRepository         domain.UserRepository
+	UserScoreRepository    domain.UserScoreRepository
+	AchievementRepository  domain.AchievementRepository
+	AuditLog               domain.AuditLog
+	UserCache              domain.UserCache
+	LeaderboardInvalidator domain.LeaderboardInvalidator
+
+	// Achievements are the same as the ones of the leaderboard service, the
+	// rank achievements are evaluated when a score is removed or set.
+	Achievements []domain.Achievement
+
+	// AuditMetrics counts the audit events that have failed to be recorded,
+	// the failures are logged with Logger.
+	AuditMetrics *expvar.Map
+	Logger       *logrus.Logger
+}
+
+type leaderboardModerationService struct {
+	userRepository         domain.UserRepository
+	userScoreRepository    domain.UserScoreRepository
+	userCache              domain.UserCache
+	leaderboardInvalidator domain.LeaderboardInvalidator
+	auditRecorder          *auditRecorder
+	achievementEvaluator   *achievementEvaluator
+	logger                 *logrus.Logger
+}
+
+func NewLeaderboardModerationService(deps LeaderboardModerationServiceDependencies) *leaderboardModerationService {
+	return &leaderboardModerationService{
+		userRepository:         deps.UserRepository,
+		userScoreRepository:    deps.UserScoreRepository,
+		userCache:              deps.UserCache,
+		leaderboardInvalidator: deps.LeaderboardInvalidator,
+		auditRecorder: &auditRecorder{
+			auditLog: deps.AuditLog,
+			metrics:  deps.AuditMetrics,
+			logger:   deps.Logger,
+		},
+		achievementEvaluator: &achievementEvaluator{
+			achievements:          deps.Achievements,
+			achievementRepository: deps.AchievementRepository,
+			userScoreRepository:   deps.UserScoreRepository,
+		},
+		logger: deps.Logger,
+	}
+}
+
+func (service *leaderboardModerationService) RemoveScore(ctx context.Context, request ModerationRequest) error {
+	oldScore, err := service.getUserTopScore(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = service.userScoreRepository.RemoveUserScore(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	service.evaluateRanks(ctx, request)
+	service.invalidateLeaderboard(ctx)
+	service.record(ctx, domain.AuditEventModerationRemoveScore, request, oldScore, nil)
+
+	return nil
+}
+
+func (service *leaderboardModerationService) SetScore(ctx context.Context, request ModerationRequest, score float64) error {
+	user, err := service.userRepository.GetByID(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	oldScore, err := service.getUserTopScore(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = service.userScoreRepository.UpdateUserTopScore(ctx, request.UserID, user.Profile.CountryCode, score, time.Now())
+	if err != nil {
+		return err
+	}
+
+	service.evaluateRanks(ctx, request)
+	service.invalidateLeaderboard(ctx)
+	service.record(ctx, domain.AuditEventModerationSetScore, request, oldScore, &score)
+
+	return nil
+}
+
+func (service *leaderboardModerationService) BanUser(ctx context.Context, request ModerationRequest) error {
+	oldScore, err := service.getUserTopScore(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = service.userRepository.SetBanned(ctx, request.UserID, true)
+	if err != nil {
+		return err
+	}
+
+	err = service.userCache.Delete(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = service.userScoreRepository.RemoveUserScore(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	service.evaluateRanks(ctx, request)
+	service.invalidateLeaderboard(ctx)
+	service.record(ctx, domain.AuditEventModerationBanUser, request, oldScore, nil)
+
+	return nil
+}
+
+func (service *leaderboardModerationService) UnbanUser(ctx context.Context, request ModerationRequest) error {
+	err := service.userRepository.SetBanned(ctx, request.UserID, false)
+	if err != nil {
+		return err
+	}
+
+	service.record(ctx, domain.AuditEventModerationUnbanUser, request, nil, nil)
+
+	return nil
+}
+
+// evaluateRanks unlocks the rank achievements of the users that have moved
+// up, the moderation action has already been taken and can not be undone, so
+// a failed evaluation is only logged.
+func (service *leaderboardModerationService) evaluateRanks(ctx context.Context, request ModerationRequest) {
+	err := service.achievementEvaluator.evaluateRanks(ctx)
+	if err != nil {
+		service.logger.
+			WithError(err).
+			WithField("userID", request.UserID).
+			Error("failed to evaluate the achievements")
+	}
+}
+
+// invalidateLeaderboard refreshes the leaderboard snapshots of every replica,
+// so the change does not wait for them to expire. The action has already been
+// taken, so a failure is only logged.
+func (service *leaderboardModerationService) invalidateLeaderboard(ctx context.Context) {
+	err := service.leaderboardInvalidator.Invalidate(ctx)
+	if err != nil {
+		service.logger.
+			WithError(err).
+			Warn("failed to publish the leaderboard snapshot invalidation")
+	}
+}
+
+// getUserTopScore returns nil when the user has no score on the leaderboard.
+func (service *leaderboardModerationService) getUserTopScore(ctx context.Context, userID string) (*float64, error) {
+	userScore, err := service.userScoreRepository.GetUserTopScore(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &userScore.Score, nil
+}
+
+func (service *leaderboardModerationService) record(
+	ctx context.Context, eventType string, request ModerationRequest, oldScore, newScore *float64,
+) {
+	event := domain.NewAuditEvent(ctx, eventType, request.Moderator, request.UserID)
+	event.OldScore = oldScore
+	event.NewScore = newScore
+	event.Details = map[string]string{
+		"reason": request.Reason,
+	}
+
+	service.auditRecorder.record(ctx, event)
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type LeaderboardModerationServiceTestSuite struct {
+	suite.Suite
+
+	service *leaderboardModerationService
+
+	mockUserRepository        *mocks.MockUserRepository
+	mockUserScoreRepository   *mocks.MockUserScoreRepository
+	mockAchievementRepository *mocks.MockAchievementRepository
+	mockAuditLog              *mocks.MockAuditLog
+	mockUserCache             *mocks.MockUserCache
+	mockInvalidator           *mocks.MockLeaderboardInvalidator
+
+	auditMetrics *expvar.Map
+
+	request ModerationRequest
+}
+
+func TestLeaderboardModerationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LeaderboardModerationServiceTestSuite))
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockAchievementRepository = mocks.NewMockAchievementRepository(suite.T())
+	suite.mockAuditLog = mocks.NewMockAuditLog(suite.T())
+	suite.mockUserCache = mocks.NewMockUserCache(suite.T())
+	suite.mockInvalidator = mocks.NewMockLeaderboardInvalidator(suite.T())
+	suite.auditMetrics = new(expvar.Map)
+
+	suite.service = NewLeaderboardModerationService(LeaderboardModerationServiceDependencies{
+		UserRepository:         suite.mockUserRepository,
+		UserScoreRepository:    suite.mockUserScoreRepository,
+		AchievementRepository:  suite.mockAchievementRepository,
+		AuditLog:               suite.mockAuditLog,
+		UserCache:              suite.mockUserCache,
+		LeaderboardInvalidator: suite.mockInvalidator,
+		AuditMetrics:           suite.auditMetrics,
+		Logger:                 logrus.New(),
+	})
+
+	suite.request = ModerationRequest{
+		UserID:    "user-id",
+		Moderator: "moderator",
+		Reason:    "cheating",
+	}
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) expectInvalidation() {
+	suite.mockInvalidator.
+		EXPECT().
+		Invalidate(mock.Anything).
+		Return(nil)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) expectAuditEvent(eventType string, oldScore, newScore *float64) *mocks.MockAuditLog_Record_Call {
+	return suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == eventType &&
+				event.UserID == "user-id" &&
+				event.Actor == "moderator" &&
+				event.Details["reason"] == "cheating" &&
+				suite.Equal(oldScore, event.OldScore) &&
+				suite.Equal(newScore, event.NewScore)
+		})).
+		Return(nil)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestRemoveScore() {
+	oldScore := float64(100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: oldScore}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.expectInvalidation()
+	suite.expectAuditEvent(domain.AuditEventModerationRemoveScore, &oldScore, nil)
+
+	err := suite.service.RemoveScore(context.Background(), suite.request)
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestRemoveScore_UnlocksRankAchievements() {
+	suite.service.achievementEvaluator.achievements = []domain.Achievement{
+		{ID: "score_100", Kind: domain.AchievementScore, Threshold: 100},
+		{ID: "top_3", Kind: domain.AchievementRank, Threshold: 3},
+	}
+
+	oldScore := float64(100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: oldScore}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetTopLeaderboard(mock.Anything, int64(3)).
+		Return(domain.Leaderboard{UserScores: []domain.UserScore{
+			{UserID: "user-id-2", Score: 90},
+			{UserID: "user-id-3", Missing: true},
+			{UserID: "user-id-4", Score: 70},
+		}}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id-2").
+		Return([]domain.UserAchievement{{UserID: "user-id-2", AchievementID: "top_3"}}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id-4").
+		Return(nil, nil)
+
+	// only the rank achievements are evaluated.
+	suite.mockAchievementRepository.
+		EXPECT().
+		Unlock(mock.Anything, mock.MatchedBy(func(achievements []domain.UserAchievement) bool {
+			return len(achievements) == 1 &&
+				achievements[0].UserID == "user-id-4" &&
+				achievements[0].AchievementID == "top_3"
+		})).
+		Return(1, nil)
+
+	suite.expectInvalidation()
+	suite.expectAuditEvent(domain.AuditEventModerationRemoveScore, &oldScore, nil)
+
+	err := suite.service.RemoveScore(context.Background(), suite.request)
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestRemoveScore_AchievementsFailed() {
+	suite.service.achievementEvaluator.achievements = []domain.Achievement{
+		{ID: "top_3", Kind: domain.AchievementRank, Threshold: 3},
+	}
+
+	oldScore := float64(100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: oldScore}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetTopLeaderboard(mock.Anything, int64(3)).
+		Return(domain.Leaderboard{}, domain.ErrInternal)
+
+	// the score has been removed, so the action is still recorded and
+	// succeeds.
+	suite.expectInvalidation()
+	suite.expectAuditEvent(domain.AuditEventModerationRemoveScore, &oldScore, nil)
+
+	err := suite.service.RemoveScore(context.Background(), suite.request)
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestRemoveScore_RemoveFailed() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(domain.ErrInternal)
+
+	err := suite.service.RemoveScore(context.Background(), suite.request)
+	suite.ErrorIs(err, domain.ErrInternal)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestSetScore() {
+	newScore := float64(50)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		UpdateUserTopScore(mock.Anything, "user-id", "", newScore, mock.Anything).
+		Return(nil)
+
+	suite.expectInvalidation()
+	suite.expectAuditEvent(domain.AuditEventModerationSetScore, nil, &newScore)
+
+	err := suite.service.SetScore(context.Background(), suite.request, newScore)
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestSetScore_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
+
+	err := suite.service.SetScore(context.Background(), suite.request, 50)
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestBanUser() {
+	oldScore := float64(100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: oldScore}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		SetBanned(mock.Anything, "user-id", true).
+		Return(nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.expectInvalidation()
+	suite.expectAuditEvent(domain.AuditEventModerationBanUser, &oldScore, nil)
+
+	err := suite.service.BanUser(context.Background(), suite.request)
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestBanUser_AuditLogAndInvalidationFailed() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserRepository.
+		EXPECT().
+		SetBanned(mock.Anything, "user-id", true).
+		Return(nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockInvalidator.
+		EXPECT().
+		Invalidate(mock.Anything).
+		Return(domain.ErrInternal)
+
+	suite.expectAuditEvent(domain.AuditEventModerationBanUser, nil, nil).
+		Return(domain.ErrInternal)
+
+	// the user has been banned, so the failures are only logged and counted.
+	err := suite.service.BanUser(context.Background(), suite.request)
+	suite.NoError(err)
+
+	suite.Equal("1", suite.auditMetrics.Get("failures").String())
+}
+
+func (suite *LeaderboardModerationServiceTestSuite) TestUnbanUser() {
+	suite.mockUserRepository.
+		EXPECT().
+		SetBanned(mock.Anything, "user-id", false).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventModerationUnbanUser, nil, nil)
+
+	err := suite.service.UnbanUser(context.Background(), suite.request)
+	suite.NoError(err)
+}
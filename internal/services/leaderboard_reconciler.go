@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"game/internal/domain"
+)
+
+const reconcileBatchSize = 500
+
+//go:generate mockery --name LeaderboardReconciler --structname MockLeaderboardReconciler --outpkg mocks --filename leaderboard_reconciler_mock.go --output ./mocks/. --with-expecter
+type LeaderboardReconciler interface {
+	Reconcile(ctx context.Context) (ReconcileResult, error)
+	Run(ctx context.Context)
+}
+
+type ReconcileResult struct {
+	Checked int
+	Removed int
+	// Moved is the number of users whose score has been moved to the
+	// leaderboard of the country on their profile.
+	Moved int
+}
+
+type LeaderboardReconcilerDependencies struct {
+	UserRepository      domain.UserRepository
+	UserScoreRepository domain.UserScoreRepository
+
+	Interval time.Duration
+	// Metrics receives the outcome of every run: runs, failures,
+	// checked_users, removed_orphans, moved_countries and
+	// last_run_timestamp.
+	Metrics *expvar.Map
+
+	Logger *logrus.Logger
+}
+
+type leaderboardReconciler struct {
+	userRepository      domain.UserRepository
+	userScoreRepository domain.UserScoreRepository
+
+	interval time.Duration
+	metrics  *expvar.Map
+
+	logger *logrus.Logger
+}
+
+func NewLeaderboardReconciler(deps LeaderboardReconcilerDependencies) *leaderboardReconciler {
+	return &leaderboardReconciler{
+		userRepository:      deps.UserRepository,
+		userScoreRepository: deps.UserScoreRepository,
+		interval:            deps.Interval,
+		metrics:             deps.Metrics,
+		logger:              deps.Logger,
+	}
+}
+
+// Reconcile indexes the ranks of the leaderboards, removes the leaderboard
+// entries of users that do not exist anymore and moves the scores of the
+// others to the leaderboard of the country on their profile. It backfills
+// the users ranked before the ranks were indexed and before the
+// leaderboards of the countries existed.
+func (reconciler *leaderboardReconciler) Reconcile(ctx context.Context) (ReconcileResult, error) {
+	err := reconciler.userScoreRepository.IndexRanks(ctx)
+	if err != nil {
+		return ReconcileResult{}, err
+	}
+
+	userIDs, err := reconciler.userScoreRepository.GetRankedUserIDs(ctx)
+	if err != nil {
+		return ReconcileResult{}, err
+	}
+
+	var result ReconcileResult
+
+	for start := 0; start < len(userIDs); start += reconcileBatchSize {
+		end := start + reconcileBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		batch := userIDs[start:end]
+
+		users, err := reconciler.userRepository.GetUsersByIDs(ctx, batch)
+		if err != nil {
+			return result, err
+		}
+
+		existing := make(map[string]bool, len(users))
+
+		for _, user := range users {
+			existing[user.ID] = true
+		}
+
+		moved, err := reconciler.moveCountries(ctx, users)
+		result.Moved += moved
+
+		if err != nil {
+			return result, err
+		}
+
+		for _, userID := range batch {
+			if existing[userID] {
+				continue
+			}
+
+			err = reconciler.userScoreRepository.RemoveUserScore(ctx, userID)
+			if err != nil {
+				return result, err
+			}
+
+			result.Removed++
+		}
+
+		result.Checked += len(batch)
+	}
+
+	return result, nil
+}
+
+// moveCountries moves the users that are not on the leaderboard of the
+// country on their profile, and returns how many it has moved.
+func (reconciler *leaderboardReconciler) moveCountries(ctx context.Context, users []domain.User) (int, error) {
+	userIDs := make([]string, 0, len(users))
+
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	countries, err := reconciler.userScoreRepository.GetUserCountries(ctx, userIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	var moved int
+
+	for _, user := range users {
+		if countries[user.ID] == user.Profile.CountryCode {
+			continue
+		}
+
+		err = reconciler.userScoreRepository.SetUserCountry(ctx, user.ID, user.Profile.CountryCode)
+		if err != nil {
+			return moved, err
+		}
+
+		moved++
+	}
+
+	return moved, nil
+}
+
+// Run reconciles the leaderboard right away and then every interval until
+// the context is done.
+func (reconciler *leaderboardReconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(reconciler.interval)
+	defer ticker.Stop()
+
+	reconciler.runOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reconciler.runOnce(ctx)
+		}
+	}
+}
+
+func (reconciler *leaderboardReconciler) runOnce(ctx context.Context) {
+	result, err := reconciler.Reconcile(ctx)
+
+	reconciler.metrics.Add("runs", 1)
+	reconciler.metrics.Add("checked_users", int64(result.Checked))
+	reconciler.metrics.Add("removed_orphans", int64(result.Removed))
+	reconciler.metrics.Add("moved_countries", int64(result.Moved))
+
+	lastRun := new(expvar.Int)
+	lastRun.Set(time.Now().Unix())
+	reconciler.metrics.Set("last_run_timestamp", lastRun)
+
+	if err != nil {
+		reconciler.metrics.Add("failures", 1)
+
+		reconciler.logger.
+			WithError(err).
+			Error("failed to reconcile the leaderboard")
+
+		return
+	}
+
+	reconciler.logger.
+		WithFields(logrus.Fields{
+			"checked": result.Checked,
+			"removed": result.Removed,
+			"moved":   result.Moved,
+		}).
+		Info("leaderboard has been reconciled")
+}
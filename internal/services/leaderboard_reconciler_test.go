@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type LeaderboardReconcilerTestSuite struct {
+	suite.Suite
+
+	reconciler *leaderboardReconciler
+
+	mockUserRepository      *mocks.MockUserRepository
+	mockUserScoreRepository *mocks.MockUserScoreRepository
+
+	metrics *expvar.Map
+}
+
+func TestLeaderboardReconcilerTestSuite(t *testing.T) {
+	suite.Run(t, new(LeaderboardReconcilerTestSuite))
+}
+
+func (suite *LeaderboardReconcilerTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.metrics = new(expvar.Map)
+
+	suite.reconciler = NewLeaderboardReconciler(LeaderboardReconcilerDependencies{
+		UserRepository:      suite.mockUserRepository,
+		UserScoreRepository: suite.mockUserScoreRepository,
+		Metrics:             suite.metrics,
+		Logger:              logrus.New(),
+	})
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestReconcile() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return([]string{"user-id-1", "user-id-2"}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return([]domain.User{{ID: "user-id-1"}}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserCountries(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]string{}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id-2").
+		Return(nil)
+
+	result, err := suite.reconciler.Reconcile(context.Background())
+	suite.NoError(err)
+	suite.Equal(ReconcileResult{Checked: 2, Removed: 1}, result)
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestReconcile_MovesCountries() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return([]string{"user-id-1", "user-id-2", "user-id-3"}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1", "user-id-2", "user-id-3"}).
+		Return([]domain.User{
+			{ID: "user-id-1", Profile: domain.UserProfile{CountryCode: "DE"}},
+			{ID: "user-id-2", Profile: domain.UserProfile{CountryCode: "FR"}},
+			{ID: "user-id-3"},
+		}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserCountries(mock.Anything, []string{"user-id-1", "user-id-2", "user-id-3"}).
+		Return(map[string]string{"user-id-2": "FR", "user-id-3": "NL"}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserCountry(mock.Anything, "user-id-1", "DE").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserCountry(mock.Anything, "user-id-3", "").
+		Return(nil)
+
+	result, err := suite.reconciler.Reconcile(context.Background())
+	suite.NoError(err)
+	suite.Equal(ReconcileResult{Checked: 3, Moved: 2}, result)
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestReconcile_Batches() {
+	var userIDs []string
+
+	for i := 0; i < reconcileBatchSize+1; i++ {
+		userIDs = append(userIDs, fmt.Sprintf("user-id-%d", i))
+	}
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return(userIDs, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, userIDs[:reconcileBatchSize]).
+		Return([]domain.User{}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, userIDs[reconcileBatchSize:]).
+		Return([]domain.User{{ID: userIDs[reconcileBatchSize]}}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserCountries(mock.Anything, mock.Anything).
+		Return(map[string]string{}, nil).
+		Twice()
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, mock.Anything).
+		Return(nil).
+		Times(reconcileBatchSize)
+
+	result, err := suite.reconciler.Reconcile(context.Background())
+	suite.NoError(err)
+	suite.Equal(ReconcileResult{Checked: reconcileBatchSize + 1, Removed: reconcileBatchSize}, result)
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestReconcile_GetUsersByIDsFailed() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return([]string{"user-id-1"}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1"}).
+		Return(nil, domain.ErrInternal)
+
+	_, err := suite.reconciler.Reconcile(context.Background())
+	suite.ErrorIs(err, domain.ErrInternal)
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestRunOnce_ReportsMetrics() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return([]string{"user-id-1"}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1"}).
+		Return(nil, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserCountries(mock.Anything, []string{}).
+		Return(map[string]string{}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id-1").
+		Return(nil)
+
+	suite.reconciler.runOnce(context.Background())
+
+	suite.Equal("1", suite.metrics.Get("runs").String())
+	suite.Equal("1", suite.metrics.Get("checked_users").String())
+	suite.Equal("1", suite.metrics.Get("removed_orphans").String())
+	suite.Equal("0", suite.metrics.Get("moved_countries").String())
+	suite.Nil(suite.metrics.Get("failures"))
+	suite.NotNil(suite.metrics.Get("last_run_timestamp"))
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestRunOnce_ReportsFailures() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetRankedUserIDs(mock.Anything).
+		Return(nil, domain.ErrInternal)
+
+	suite.reconciler.runOnce(context.Background())
+
+	suite.Equal("1", suite.metrics.Get("runs").String())
+	suite.Equal("1", suite.metrics.Get("failures").String())
+}
+
+func (suite *LeaderboardReconcilerTestSuite) TestReconcile_IndexRanksFailed() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		IndexRanks(mock.Anything).
+		Return(domain.ErrInternal)
+
+	_, err := suite.reconciler.Reconcile(context.Background())
+	suite.ErrorIs(err, domain.ErrInternal)
+}
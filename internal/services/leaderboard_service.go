@@ -2,30 +2,130 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"expvar"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
 
 	"game/internal/domain"
 )
 
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrStaleScore       = errors.New("stale score")
+	ErrReplayedNonce    = errors.New("replayed nonce")
+	ErrUserBanned       = errors.New("user banned")
+	ErrInvalidCountry   = errors.New("invalid country")
+	ErrEventNotFound    = errors.New("event not found")
+	ErrIneligibleMode   = errors.New("ineligible mode")
+	ErrEventNotStarted  = errors.New("event not started")
+	ErrEventClosed      = errors.New("event closed")
+	ErrNoAttemptsLeft   = errors.New("no attempts left")
+)
+
 //go:generate mockery --name LeaderboardService --structname MockLeaderboardService --outpkg mocks --filename leaderboard_service_mock.go --output ./mocks/. --with-expecter
 type LeaderboardService interface {
 	GetLeaderboard(ctx context.Context) (domain.Leaderboard, error)
-	SubmitUserScore(ctx context.Context, userID string, score float64) error
+	// GetTopLeaderboard returns the top count users of the global
+	// leaderboard, ranked over all of it.
+	GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error)
+	GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error)
+	GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error)
+	// SubmitUserScore routes the score to the board of the event as well
+	// when eventID is set, the mode has to be the one of the event and the
+	// score has to be submitted within the window of the event.
+	SubmitUserScore(ctx context.Context, userID string, score float64, eventID, mode string) error
+	SubmitVerifiedScore(ctx context.Context, score domain.SignedScore) error
 }
 
 type LeaderboardServiceDependencies struct {
-	UserScoreRepository domain.UserScoreRepository
-	UserRepository      domain.UserRepository
+	UserScoreRepository        domain.UserScoreRepository
+	UserRepository             domain.UserRepository
+	ScoreSubmissionRepository  domain.ScoreSubmissionRepository
+	QuarantinedScoreRepository domain.QuarantinedScoreRepository
+	GameServerKeyRepository    domain.GameServerKeyRepository
+	NonceRepository            domain.NonceRepository
+	AchievementRepository      domain.AchievementRepository
+	EventRepository            domain.EventRepository
+	EventBoardRepository       domain.EventBoardRepository
+	AuditLog                   domain.AuditLog
+
+	// AuditMetrics counts the audit events that have failed to be recorded,
+	// the failures are logged with Logger.
+	AuditMetrics *expvar.Map
+	Logger       *logrus.Logger
+
+	ScoreValidationRules domain.ScoreValidationRules
+
+	// Achievements are evaluated on every published submission, see
+	// ParseAchievements.
+	Achievements []domain.Achievement
+
+	// SignedScoreMaxAge is how far the timestamp of a signed score may be
+	// from the current time before it is rejected as stale.
+	SignedScoreMaxAge time.Duration
+
+	// RankMode and CountryRankMode number the ranks on the global and on
+	// the country leaderboards, see domain.RankModeStandard.
+	RankMode        string
+	CountryRankMode string
 }
 
 type leaderboardService struct {
-	userScoreRepository domain.UserScoreRepository
-	userRepository      domain.UserRepository
+	userScoreRepository        domain.UserScoreRepository
+	userRepository             domain.UserRepository
+	scoreSubmissionRepository  domain.ScoreSubmissionRepository
+	quarantinedScoreRepository domain.QuarantinedScoreRepository
+	gameServerKeyRepository    domain.GameServerKeyRepository
+	nonceRepository            domain.NonceRepository
+	eventRepository            domain.EventRepository
+	eventBoardRepository       domain.EventBoardRepository
+
+	auditRecorder        *auditRecorder
+	scoreValidator       *scoreValidator
+	achievementEvaluator *achievementEvaluator
+	signedScoreMaxAge    time.Duration
+
+	logger *logrus.Logger
+
+	rankMode        string
+	countryRankMode string
 }
 
 func NewLeaderboardService(deps LeaderboardServiceDependencies) *leaderboardService {
 	return &leaderboardService{
-		userScoreRepository: deps.UserScoreRepository,
-		userRepository:      deps.UserRepository,
+		userScoreRepository:        deps.UserScoreRepository,
+		userRepository:             deps.UserRepository,
+		scoreSubmissionRepository:  deps.ScoreSubmissionRepository,
+		quarantinedScoreRepository: deps.QuarantinedScoreRepository,
+		gameServerKeyRepository:    deps.GameServerKeyRepository,
+		nonceRepository:            deps.NonceRepository,
+		eventRepository:            deps.EventRepository,
+		eventBoardRepository:       deps.EventBoardRepository,
+		auditRecorder: &auditRecorder{
+			auditLog: deps.AuditLog,
+			metrics:  deps.AuditMetrics,
+			logger:   deps.Logger,
+		},
+		scoreValidator: &scoreValidator{
+			rules:                     deps.ScoreValidationRules,
+			scoreSubmissionRepository: deps.ScoreSubmissionRepository,
+		},
+		achievementEvaluator: &achievementEvaluator{
+			achievements:          deps.Achievements,
+			achievementRepository: deps.AchievementRepository,
+			userScoreRepository:   deps.UserScoreRepository,
+		},
+		signedScoreMaxAge: deps.SignedScoreMaxAge,
+		logger:            deps.Logger,
+		rankMode:          deps.RankMode,
+		countryRankMode:   deps.CountryRankMode,
 	}
 }
 
@@ -35,32 +135,262 @@ func (service *leaderboardService) GetLeaderboard(ctx context.Context) (domain.L
 		return domain.Leaderboard{}, err
 	}
 
+	rankLeaderboard(&leaderboard, service.rankMode)
+	leaderboard.GeneratedAt = time.Now()
+
+	return leaderboard, nil
+}
+
+func (service *leaderboardService) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	leaderboard, err := service.userScoreRepository.GetTopLeaderboard(ctx, count)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	rankLeaderboard(&leaderboard, service.rankMode)
+	leaderboard.GeneratedAt = time.Now()
+
+	return leaderboard, nil
+}
+
+func (service *leaderboardService) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	countryCode = strings.ToUpper(countryCode)
+
+	if !isCountryCode(countryCode) {
+		return domain.Leaderboard{}, ErrInvalidCountry
+	}
+
+	leaderboard, err := service.userScoreRepository.GetCountryLeaderboard(ctx, countryCode)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	rankLeaderboard(&leaderboard, service.countryRankMode)
+	leaderboard.GeneratedAt = time.Now()
+
 	return leaderboard, nil
 }
 
-func (service *leaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64) error {
-	exists, err := service.userRepository.CheckExistsByID(ctx, userID)
+func (service *leaderboardService) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	userRank, err := service.userScoreRepository.GetUserRank(ctx, userID, count)
+	if err != nil {
+		return domain.UserRank{}, err
+	}
+
+	rankUserRank(&userRank, service.rankMode, service.countryRankMode)
+
+	return userRank, nil
+}
+
+func (service *leaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64, eventID, mode string) error {
+	if eventID == "" {
+		return service.submitScore(ctx, userID, userID, score, nil)
+	}
+
+	gameEvent, err := service.getOpenEvent(ctx, eventID, mode)
 	if err != nil {
 		return err
 	}
 
-	if !exists {
-		return domain.ErrResourceNotFound
+	return service.submitScore(ctx, userID, userID, score, &gameEvent)
+}
+
+// getOpenEvent returns the event when the mode is the one of the event and
+// it is within the window of the event.
+func (service *leaderboardService) getOpenEvent(ctx context.Context, eventID, mode string) (domain.Event, error) {
+	// the user may not exist either, the event is told apart from it.
+	gameEvent, err := service.eventRepository.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return domain.Event{}, ErrEventNotFound
+		}
+
+		return domain.Event{}, err
+	}
+
+	if gameEvent.Mode != mode {
+		return domain.Event{}, ErrIneligibleMode
 	}
 
-	userTopScore, err := service.userScoreRepository.GetUserTopScore(ctx, userID)
-	if err != nil && err != domain.ErrResourceNotFound {
+	now := time.Now()
+
+	if now.Before(gameEvent.StartsAt) {
+		return domain.Event{}, ErrEventNotStarted
+	}
+
+	if !now.Before(gameEvent.EndsAt) || !gameEvent.FrozenAt.IsZero() {
+		return domain.Event{}, ErrEventClosed
+	}
+
+	return gameEvent, nil
+}
+
+// submitScore publishes the score of the user, actor is recorded in the audit
+// log as the one who submitted it. The score uses an attempt on the board of
+// gameEvent when it is not nil.
+func (service *leaderboardService) submitScore(ctx context.Context, actor, userID string, score float64, gameEvent *domain.Event) error {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Banned {
+		return ErrUserBanned
+	}
+
+	submission := domain.ScoreSubmission{
+		UserID:      userID,
+		Score:       score,
+		SubmittedAt: time.Now(),
+	}
+
+	suspiciousReason, err := service.scoreValidator.validate(ctx, submission)
+	if err != nil {
 		return err
 	}
 
-	if userTopScore.Score > score {
+	// suspicious submissions are kept aside for review and never reach the
+	// leaderboard, the user is not told about it to not help cheaters.
+	if suspiciousReason != "" {
+		_, err = service.quarantinedScoreRepository.Create(ctx, domain.QuarantinedScore{
+			UserID:      submission.UserID,
+			Score:       submission.Score,
+			Reason:      suspiciousReason,
+			SubmittedAt: submission.SubmittedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		event := domain.NewAuditEvent(ctx, domain.AuditEventScoreQuarantined, actor, userID)
+		event.NewScore = &submission.Score
+		event.Details = map[string]string{
+			"reason": suspiciousReason,
+		}
+
+		service.auditRecorder.record(ctx, event)
+
 		return nil
 	}
 
-	err = service.userScoreRepository.UpdateUserTopScore(ctx, userID, score)
+	// the board of the event is checked first, a score without an attempt
+	// left is rejected and does not reach the leaderboard either.
+	var eventSubmission domain.EventSubmission
+
+	if gameEvent != nil {
+		eventSubmission, err = service.eventBoardRepository.SubmitEventScore(ctx, gameEvent.ID, userID, score, submission.SubmittedAt, gameEvent.MaxAttempts)
+		if err != nil {
+			return err
+		}
+
+		if eventSubmission.Frozen {
+			return ErrEventClosed
+		}
+
+		if !eventSubmission.Recorded {
+			return ErrNoAttemptsLeft
+		}
+	}
+
+	err = service.scoreSubmissionRepository.AddToStatistics(ctx, score)
 	if err != nil {
 		return err
 	}
 
+	event := domain.NewAuditEvent(ctx, domain.AuditEventScoreSubmitted, actor, userID)
+	event.NewScore = &submission.Score
+
+	userTopScore, err := service.userScoreRepository.GetUserTopScore(ctx, userID)
+	if err != nil && err != domain.ErrResourceNotFound {
+		return err
+	}
+
+	if err == nil {
+		event.OldScore = &userTopScore.Score
+	}
+
+	// reaching the top score again does not update it, so the time it has
+	// been reached at keeps breaking the ties.
+	topScoreUpdated := err != nil || userTopScore.Score < score
+
+	if topScoreUpdated {
+		err = service.userScoreRepository.UpdateUserTopScore(ctx, userID, user.Profile.CountryCode, score, submission.SubmittedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	event.Details = map[string]string{
+		"topScoreUpdated": strconv.FormatBool(topScoreUpdated),
+	}
+
+	if gameEvent != nil {
+		event.Details["eventID"] = gameEvent.ID
+		event.Details["eventAttempts"] = strconv.FormatInt(eventSubmission.Attempts, 10)
+	}
+
+	service.auditRecorder.record(ctx, event)
+
+	// the score is already on the leaderboard and the submission can not be
+	// undone, a failed evaluation is only logged.
+	err = service.achievementEvaluator.evaluateSubmission(ctx, userID, score)
+	if err != nil {
+		service.logger.
+			WithError(err).
+			WithField("userID", userID).
+			Error("failed to evaluate the achievements")
+	}
+
 	return nil
 }
+
+func (service *leaderboardService) SubmitVerifiedScore(ctx context.Context, score domain.SignedScore) error {
+	key, err := service.gameServerKeyRepository.GetByServerID(ctx, score.ServerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return ErrInvalidSignature
+		}
+
+		return err
+	}
+
+	if !verifySignature(key, score.Payload(), score.Signature) {
+		return ErrInvalidSignature
+	}
+
+	age := time.Since(score.Timestamp)
+	if age > service.signedScoreMaxAge || age < -service.signedScoreMaxAge {
+		return ErrStaleScore
+	}
+
+	// a nonce only has to be remembered while its timestamp is accepted,
+	// which is at most twice the max age when the clocks are skewed.
+	claimed, err := service.nonceRepository.Claim(ctx, "game_server:"+score.ServerID, score.Nonce, 2*service.signedScoreMaxAge)
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		return ErrReplayedNonce
+	}
+
+	return service.submitScore(ctx, "game_server:"+score.ServerID, score.UserID, score.Score, nil)
+}
+
+func verifySignature(key domain.GameServerKey, payload, signature []byte) bool {
+	switch key.Algorithm {
+	case domain.GameServerKeyAlgorithmHMACSHA256:
+		mac := hmac.New(sha256.New, key.Key)
+		mac.Write(payload)
+
+		return hmac.Equal(mac.Sum(nil), signature)
+	case domain.GameServerKeyAlgorithmEd25519:
+		if len(key.Key) != ed25519.PublicKeySize {
+			return false
+		}
+
+		return ed25519.Verify(ed25519.PublicKey(key.Key), payload, signature)
+	default:
+		return false
+	}
+}
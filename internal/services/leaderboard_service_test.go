@@ -2,8 +2,16 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"expvar"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -16,8 +24,18 @@ type LeaderboardServiceTestSuite struct {
 
 	service *leaderboardService
 
-	mockUserRepository      *mocks.MockUserRepository
-	mockUserScoreRepository *mocks.MockUserScoreRepository
+	mockUserRepository             *mocks.MockUserRepository
+	mockUserScoreRepository        *mocks.MockUserScoreRepository
+	mockScoreSubmissionRepository  *mocks.MockScoreSubmissionRepository
+	mockQuarantinedScoreRepository *mocks.MockQuarantinedScoreRepository
+	mockGameServerKeyRepository    *mocks.MockGameServerKeyRepository
+	mockNonceRepository            *mocks.MockNonceRepository
+	mockAchievementRepository      *mocks.MockAchievementRepository
+	mockEventRepository            *mocks.MockEventRepository
+	mockEventBoardRepository       *mocks.MockEventBoardRepository
+	mockAuditLog                   *mocks.MockAuditLog
+
+	auditMetrics *expvar.Map
 }
 
 func TestLeaderboardServiceTestSuite(t *testing.T) {
@@ -27,13 +45,72 @@ func TestLeaderboardServiceTestSuite(t *testing.T) {
 func (suite *LeaderboardServiceTestSuite) SetupTest() {
 	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
 	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockScoreSubmissionRepository = mocks.NewMockScoreSubmissionRepository(suite.T())
+	suite.mockQuarantinedScoreRepository = mocks.NewMockQuarantinedScoreRepository(suite.T())
+	suite.mockGameServerKeyRepository = mocks.NewMockGameServerKeyRepository(suite.T())
+	suite.mockNonceRepository = mocks.NewMockNonceRepository(suite.T())
+	suite.mockAchievementRepository = mocks.NewMockAchievementRepository(suite.T())
+	suite.mockEventRepository = mocks.NewMockEventRepository(suite.T())
+	suite.mockEventBoardRepository = mocks.NewMockEventBoardRepository(suite.T())
+	suite.mockAuditLog = mocks.NewMockAuditLog(suite.T())
+	suite.auditMetrics = new(expvar.Map)
+
+	suite.service = suite.newService(domain.ScoreValidationRules{})
+}
 
-	suite.service = NewLeaderboardService(LeaderboardServiceDependencies{
-		UserRepository:      suite.mockUserRepository,
-		UserScoreRepository: suite.mockUserScoreRepository,
+func (suite *LeaderboardServiceTestSuite) newService(rules domain.ScoreValidationRules) *leaderboardService {
+	return NewLeaderboardService(LeaderboardServiceDependencies{
+		UserRepository:             suite.mockUserRepository,
+		UserScoreRepository:        suite.mockUserScoreRepository,
+		ScoreSubmissionRepository:  suite.mockScoreSubmissionRepository,
+		QuarantinedScoreRepository: suite.mockQuarantinedScoreRepository,
+		GameServerKeyRepository:    suite.mockGameServerKeyRepository,
+		NonceRepository:            suite.mockNonceRepository,
+		AchievementRepository:      suite.mockAchievementRepository,
+		EventRepository:            suite.mockEventRepository,
+		EventBoardRepository:       suite.mockEventBoardRepository,
+		AuditLog:                   suite.mockAuditLog,
+		AuditMetrics:               suite.auditMetrics,
+		Logger:                     logrus.New(),
+		ScoreValidationRules:       rules,
+		SignedScoreMaxAge:          time.Minute,
 	})
 }
 
+func (suite *LeaderboardServiceTestSuite) expectScoreAccepted(userID string, score float64) {
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.MatchedBy(func(submission domain.ScoreSubmission) bool {
+			return submission.UserID == userID && submission.Score == score
+		}), time.Duration(0)).
+		Return(nil, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		SaveLastSubmission(mock.Anything, mock.MatchedBy(func(submission domain.ScoreSubmission) bool {
+			return submission.UserID == userID && submission.Score == score
+		})).
+		Return(nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		AddToStatistics(mock.Anything, score).
+		Return(nil)
+}
+
+func (suite *LeaderboardServiceTestSuite) expectAuditEvent(eventType, actor string, score float64, details map[string]string) *mocks.MockAuditLog_Record_Call {
+	return suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == eventType &&
+				event.Actor == actor &&
+				event.UserID == "user-id" &&
+				event.NewScore != nil && *event.NewScore == score &&
+				suite.Equal(details, event.Details)
+		})).
+		Return(nil)
+}
+
 func (suite *LeaderboardServiceTestSuite) TestGetLeaderboard() {
 	suite.mockUserScoreRepository.
 		EXPECT().
@@ -44,6 +121,51 @@ func (suite *LeaderboardServiceTestSuite) TestGetLeaderboard() {
 	suite.NoError(err)
 }
 
+func (suite *LeaderboardServiceTestSuite) TestGetLeaderboard_RankModes() {
+	scores := []float64{900, 800, 800, 700}
+
+	tests := []struct {
+		mode  string
+		ranks []int64
+	}{
+		{mode: domain.RankModeStandard, ranks: []int64{1, 2, 2, 4}},
+		{mode: domain.RankModeDense, ranks: []int64{1, 2, 2, 3}},
+		{mode: domain.RankModeOrdinal, ranks: []int64{1, 2, 3, 4}},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.mode, func() {
+			suite.SetupTest()
+			suite.service.rankMode = test.mode
+
+			var userScores []domain.UserScore
+
+			for _, score := range scores {
+				userScores = append(userScores, domain.UserScore{Score: score})
+			}
+
+			suite.mockUserScoreRepository.
+				EXPECT().
+				GetLeaderboard(mock.Anything).
+				Return(domain.Leaderboard{UserScores: userScores}, nil)
+
+			leaderboard, err := suite.service.GetLeaderboard(context.Background())
+			suite.NoError(err)
+
+			var ranks []int64
+			var percentiles []float64
+
+			for _, userScore := range leaderboard.UserScores {
+				ranks = append(ranks, userScore.Rank)
+				percentiles = append(percentiles, userScore.Percentile)
+			}
+
+			suite.Equal(test.ranks, ranks)
+			suite.Equal([]float64{100, 75, 75, 25}, percentiles)
+		})
+	}
+}
+
 func (suite *LeaderboardServiceTestSuite) TestGetLeaderboard_RepositoryFailed() {
 	suite.mockUserScoreRepository.
 		EXPECT().
@@ -54,11 +176,163 @@ func (suite *LeaderboardServiceTestSuite) TestGetLeaderboard_RepositoryFailed()
 	suite.Error(err)
 }
 
+func (suite *LeaderboardServiceTestSuite) TestGetTopLeaderboard() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetTopLeaderboard(mock.Anything, int64(2)).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{{Score: 900}, {Score: 800}},
+			Total:      4,
+		}, nil)
+
+	leaderboard, err := suite.service.GetTopLeaderboard(context.Background(), 2)
+	suite.NoError(err)
+	suite.Equal(float64(75), leaderboard.UserScores[1].Percentile)
+	suite.False(leaderboard.GeneratedAt.IsZero())
+}
+
+func (suite *LeaderboardServiceTestSuite) TestGetCountryLeaderboard() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetCountryLeaderboard(mock.Anything, "DE").
+		Return(domain.Leaderboard{}, nil)
+
+	_, err := suite.service.GetCountryLeaderboard(context.Background(), "de")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestGetCountryLeaderboard_RankMode() {
+	suite.service.rankMode = domain.RankModeOrdinal
+	suite.service.countryRankMode = domain.RankModeDense
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetCountryLeaderboard(mock.Anything, "DE").
+		Return(domain.Leaderboard{UserScores: []domain.UserScore{{Score: 900}, {Score: 900}, {Score: 800}}}, nil)
+
+	leaderboard, err := suite.service.GetCountryLeaderboard(context.Background(), "DE")
+	suite.NoError(err)
+
+	suite.Equal(int64(1), leaderboard.UserScores[1].Rank)
+	suite.Equal(int64(2), leaderboard.UserScores[2].Rank)
+	suite.InDelta(100.0/3, leaderboard.UserScores[2].Percentile, 1e-9)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestGetCountryLeaderboard_InvalidCountry() {
+	_, err := suite.service.GetCountryLeaderboard(context.Background(), "xyz")
+	suite.ErrorIs(err, ErrInvalidCountry)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestGetCountryLeaderboard_UnassignedCountry() {
+	_, err := suite.service.GetCountryLeaderboard(context.Background(), "zz")
+	suite.ErrorIs(err, ErrInvalidCountry)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestGetUserRank() {
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserRank(mock.Anything, "user-id", int64(5)).
+		Return(domain.UserRank{UserID: "user-id", Rank: 1}, nil)
+
+	userRank, err := suite.service.GetUserRank(context.Background(), "user-id", 5)
+	suite.NoError(err)
+	suite.Equal(int64(1), userRank.Rank)
+}
+
+// TestGetUserRank_RankModes ranks the fifth of six users with the scores
+// 1000, 900, 800, 800, 800 and 700, along with the neighbours around it. The
+// user is the third on the leaderboard of its country, below one higher
+// score.
+func (suite *LeaderboardServiceTestSuite) TestGetUserRank_RankModes() {
+	tests := []struct {
+		mode            string
+		rank            int64
+		countryRank     int64
+		neighboursRanks []int64
+	}{
+		{mode: domain.RankModeStandard, rank: 3, countryRank: 2, neighboursRanks: []int64{3, 3, 6}},
+		{mode: domain.RankModeDense, rank: 3, countryRank: 2, neighboursRanks: []int64{3, 3, 4}},
+		{mode: domain.RankModeOrdinal, rank: 5, countryRank: 3, neighboursRanks: []int64{4, 5, 6}},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.mode, func() {
+			suite.SetupTest()
+			suite.service.rankMode = test.mode
+			suite.service.countryRankMode = test.mode
+
+			suite.mockUserScoreRepository.
+				EXPECT().
+				GetUserRank(mock.Anything, "user-id", int64(1)).
+				Return(domain.UserRank{
+					UserID:      "user-id",
+					Score:       800,
+					Rank:        5,
+					CountryCode: "DE",
+					CountryRank: 3,
+					Neighbours: []domain.UserScore{
+						{UserID: "user-id-4", Score: 800},
+						{UserID: "user-id", Score: 800},
+						{UserID: "user-id-6", Score: 700},
+					},
+					NeighboursStartRank: 4,
+					Counts:              domain.RankCounts{Above: 2, DistinctAbove: 2, Total: 6},
+					CountryCounts:       domain.RankCounts{Above: 1, DistinctAbove: 1, Total: 3},
+					NeighboursCounts:    domain.RankCounts{Above: 2, DistinctAbove: 2, Total: 6},
+				}, nil)
+
+			userRank, err := suite.service.GetUserRank(context.Background(), "user-id", 1)
+			suite.NoError(err)
+			suite.Equal(test.rank, userRank.Rank)
+			suite.Equal(test.countryRank, userRank.CountryRank)
+
+			var ranks []int64
+			var percentiles []float64
+
+			for _, userScore := range userRank.Neighbours {
+				ranks = append(ranks, userScore.Rank)
+				percentiles = append(percentiles, userScore.Percentile)
+			}
+
+			suite.Equal(test.neighboursRanks, ranks)
+			suite.Equal([]float64{100 * 4.0 / 6, 100 * 4.0 / 6, 100 * 1.0 / 6}, percentiles)
+		})
+	}
+}
+
 func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(true, nil)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id", Profile: domain.UserProfile{CountryCode: "DE"}}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		UpdateUserTopScore(mock.Anything, "user-id", "DE", float64(10), mock.Anything).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 10, map[string]string{
+		"topScoreUpdated": "true",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_AuditLogFailed() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
 
 	suite.mockUserScoreRepository.
 		EXPECT().
@@ -67,33 +341,44 @@ func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore() {
 
 	suite.mockUserScoreRepository.
 		EXPECT().
-		UpdateUserTopScore(mock.Anything, "user-id", float64(10)).
+		UpdateUserTopScore(mock.Anything, "user-id", "", float64(10), mock.Anything).
 		Return(nil)
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+	suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.Anything).
+		Return(errors.New("audit log error"))
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.NoError(err)
+
+	suite.Equal("1", suite.auditMetrics.Get("failures").String())
 }
 
 func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_GetUserTopScoreFailed() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(true, nil)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
 
 	suite.mockUserScoreRepository.
 		EXPECT().
 		GetUserTopScore(mock.Anything, "user-id").
 		Return(domain.UserScore{}, domain.ErrInternal)
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.Error(err)
 }
 
 func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UpdateUserTopScoreFailed() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(true, nil)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
 
 	suite.mockUserScoreRepository.
 		EXPECT().
@@ -102,18 +387,20 @@ func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UpdateUserTopScore
 
 	suite.mockUserScoreRepository.
 		EXPECT().
-		UpdateUserTopScore(mock.Anything, "user-id", float64(10)).
+		UpdateUserTopScore(mock.Anything, "user-id", "", float64(10), mock.Anything).
 		Return(domain.ErrInternal)
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.Error(err)
 }
 
 func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UpdateUserTopScoreSkipped() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(true, nil)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
 
 	suite.mockUserScoreRepository.
 		EXPECT().
@@ -122,26 +409,653 @@ func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UpdateUserTopScore
 			Score: 20,
 		}, nil)
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 10, map[string]string{
+		"topScoreUpdated": "false",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_SameScoreSkipped() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{
+			Score: 10,
+		}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 10, map[string]string{
+		"topScoreUpdated": "false",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UnlocksAchievements() {
+	suite.service.achievementEvaluator.achievements = []domain.Achievement{
+		{ID: "score_50", Kind: domain.AchievementScore, Threshold: 50},
+		{ID: "score_100", Kind: domain.AchievementScore, Threshold: 100},
+		{ID: "score_1000", Kind: domain.AchievementScore, Threshold: 1000},
+		{ID: "top_1", Kind: domain.AchievementRank, Threshold: 1},
+		{ID: "matches_2", Kind: domain.AchievementSubmissions, Threshold: 2},
+		{ID: "matches_5", Kind: domain.AchievementSubmissions, Threshold: 5},
+	}
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id", Profile: domain.UserProfile{CountryCode: "DE"}}, nil)
+
+	suite.expectScoreAccepted("user-id", 100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{Score: 50}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		UpdateUserTopScore(mock.Anything, "user-id", "DE", float64(100), mock.Anything).
+		Return(nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		IncrementSubmissions(mock.Anything, "user-id").
+		Return(2, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.UserAchievement{{UserID: "user-id", AchievementID: "score_50"}}, nil)
+
+	// the user is third on the global leaderboard but first in its country.
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserRank(mock.Anything, "user-id", int64(0)).
+		Return(domain.UserRank{Rank: 3, CountryRank: 1}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		Unlock(mock.Anything, mock.MatchedBy(func(achievements []domain.UserAchievement) bool {
+			var ids []string
+
+			for _, achievement := range achievements {
+				ids = append(ids, achievement.AchievementID)
+			}
+
+			return suite.Equal([]string{"score_100", "top_1", "matches_2"}, ids)
+		})).
+		Return(3, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 100, map[string]string{
+		"topScoreUpdated": "true",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 100, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_AchievementsFailed() {
+	suite.service.achievementEvaluator.achievements = []domain.Achievement{
+		{ID: "score_100", Kind: domain.AchievementScore, Threshold: 100},
+	}
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 100)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		UpdateUserTopScore(mock.Anything, "user-id", "", float64(100), mock.Anything).
+		Return(nil)
+
+	var recorded bool
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 100, map[string]string{
+		"topScoreUpdated": "true",
+	}).Run(func(ctx context.Context, event domain.AuditEvent) {
+		recorded = true
+	})
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		IncrementSubmissions(mock.Anything, "user-id").
+		RunAndReturn(func(ctx context.Context, userID string) (int64, error) {
+			suite.True(recorded, "the audit event is recorded before the achievements")
+
+			return 0, errors.New("achievement repository error")
+		})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 100, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_AchievementsAlreadyUnlocked() {
+	suite.service.achievementEvaluator.achievements = []domain.Achievement{
+		{ID: "top_1", Kind: domain.AchievementRank, Threshold: 1},
+	}
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{Score: 10}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		IncrementSubmissions(mock.Anything, "user-id").
+		Return(7, nil)
+
+	// the rank is not read when every rank achievement is unlocked.
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.UserAchievement{{UserID: "user-id", AchievementID: "top_1"}}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 10, map[string]string{
+		"topScoreUpdated": "false",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.NoError(err)
 }
 
 func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UserNotFound() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(false, nil)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.ErrorIs(err, domain.ErrResourceNotFound)
 }
 
-func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_CheckExistsByIDFailed() {
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_UserBanned() {
 	suite.mockUserRepository.
 		EXPECT().
-		CheckExistsByID(mock.Anything, "user-id").
-		Return(false, domain.ErrInternal)
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id", Banned: true}, nil)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
+	suite.ErrorIs(err, ErrUserBanned)
+}
 
-	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10)
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_GetByIDFailed() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrInternal)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
 	suite.Error(err)
 }
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_InvalidScore() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		MinScore: 1,
+		MaxScore: 100,
+	})
+
+	for _, score := range []float64{0, 101, math.NaN(), math.Inf(1)} {
+		suite.mockUserRepository.
+			EXPECT().
+			GetByID(mock.Anything, "user-id").
+			Return(domain.User{ID: "user-id"}, nil)
+
+		err := suite.service.SubmitUserScore(context.Background(), "user-id", score, "", "")
+		suite.ErrorIs(err, ErrInvalidScore)
+	}
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_TooFrequent() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		MinSubmissionInterval: time.Minute,
+	})
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Minute).
+		Return(nil, domain.ErrSubmissionTooFrequent)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "", "")
+	suite.ErrorIs(err, ErrSubmissionTooFrequent)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_ScoreGainQuarantined() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		MaxScoreGain:      100,
+		ScoreGainInterval: time.Hour,
+	})
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Duration(0)).
+		Return(&domain.ScoreSubmission{
+			UserID:      "user-id",
+			Score:       10,
+			SubmittedAt: time.Now().Add(-time.Minute),
+		}, nil)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(score domain.QuarantinedScore) bool {
+			return score.UserID == "user-id" && score.Score == 500 && score.Reason == SuspiciousReasonScoreGain
+		})).
+		Return(domain.QuarantinedScore{ID: "quarantined-score-id"}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreQuarantined, "user-id", 500, map[string]string{
+		"reason": SuspiciousReasonScoreGain,
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 500, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_ResubmittedScoreStillQuarantined() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		MinSubmissionInterval: time.Minute,
+		MaxScoreGain:          100,
+		ScoreGainInterval:     time.Hour,
+	})
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	// the quarantined score is never saved, so the resubmission is still
+	// checked against the last accepted one.
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Minute).
+		Return(&domain.ScoreSubmission{
+			UserID:      "user-id",
+			Score:       10,
+			SubmittedAt: time.Now().Add(-time.Minute),
+		}, nil).
+		Times(2)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(score domain.QuarantinedScore) bool {
+			return score.Score == 500 && score.Reason == SuspiciousReasonScoreGain
+		})).
+		Return(domain.QuarantinedScore{ID: "quarantined-score-id"}, nil).
+		Times(2)
+
+	suite.expectAuditEvent(domain.AuditEventScoreQuarantined, "user-id", 500, map[string]string{
+		"reason": SuspiciousReasonScoreGain,
+	}).Times(2)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 500, "", "")
+	suite.NoError(err)
+
+	err = suite.service.SubmitUserScore(context.Background(), "user-id", 500, "", "")
+	suite.NoError(err)
+
+	suite.mockScoreSubmissionRepository.AssertNotCalled(suite.T(), "SaveLastSubmission", mock.Anything, mock.Anything)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_FirstScoreGainQuarantined() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		MaxScoreGain:      100,
+		ScoreGainInterval: time.Hour,
+	})
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Duration(0)).
+		Return(nil, nil)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(score domain.QuarantinedScore) bool {
+			return score.Score == 500 && score.Reason == SuspiciousReasonScoreGain
+		})).
+		Return(domain.QuarantinedScore{ID: "quarantined-score-id"}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreQuarantined, "user-id", 500, map[string]string{
+		"reason": SuspiciousReasonScoreGain,
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 500, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_OutlierQuarantined() {
+	suite.service = suite.newService(domain.ScoreValidationRules{
+		OutlierZScore:     3,
+		OutlierMinSamples: 10,
+	})
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Duration(0)).
+		Return(nil, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		GetStatistics(mock.Anything).
+		Return(domain.ScoreStatistics{
+			Count:  50,
+			Mean:   100,
+			StdDev: 10,
+		}, nil)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(score domain.QuarantinedScore) bool {
+			return score.Reason == SuspiciousReasonOutlier
+		})).
+		Return(domain.QuarantinedScore{ID: "quarantined-score-id"}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreQuarantined, "user-id", 200, map[string]string{
+		"reason": SuspiciousReasonOutlier,
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 200, "", "")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) expectEvent(startsAt, endsAt time.Time) {
+	suite.mockEventRepository.
+		EXPECT().
+		GetByID(mock.Anything, "event-id").
+		Return(domain.Event{
+			ID:          "event-id",
+			Mode:        "duel",
+			StartsAt:    startsAt,
+			EndsAt:      endsAt,
+			MaxAttempts: 3,
+		}, nil)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_Event() {
+	suite.expectEvent(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		SubmitEventScore(mock.Anything, "event-id", "user-id", float64(10), mock.Anything, int64(3)).
+		Return(domain.EventSubmission{Recorded: true, Attempts: 2}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{Score: 20}, nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "user-id", 10, map[string]string{
+		"topScoreUpdated": "false",
+		"eventID":         "event-id",
+		"eventAttempts":   "2",
+	})
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventNoAttemptsLeft() {
+	suite.expectEvent(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Duration(0)).
+		Return(nil, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		SaveLastSubmission(mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		SubmitEventScore(mock.Anything, "event-id", "user-id", float64(10), mock.Anything, int64(3)).
+		Return(domain.EventSubmission{Attempts: 3}, nil)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.ErrorIs(err, ErrNoAttemptsLeft)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventBoardFrozen() {
+	suite.expectEvent(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		ReserveSubmission(mock.Anything, mock.Anything, time.Duration(0)).
+		Return(nil, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		SaveLastSubmission(mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		SubmitEventScore(mock.Anything, "event-id", "user-id", float64(10), mock.Anything, int64(3)).
+		Return(domain.EventSubmission{Frozen: true}, nil)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.ErrorIs(err, ErrEventClosed)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventLate() {
+	suite.expectEvent(time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.ErrorIs(err, ErrEventClosed)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventNotStarted() {
+	suite.expectEvent(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.ErrorIs(err, ErrEventNotStarted)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventIneligibleMode() {
+	suite.expectEvent(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "free_for_all")
+	suite.ErrorIs(err, ErrIneligibleMode)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitUserScore_EventEventNotFound() {
+	suite.mockEventRepository.
+		EXPECT().
+		GetByID(mock.Anything, "event-id").
+		Return(domain.Event{}, domain.ErrResourceNotFound)
+
+	err := suite.service.SubmitUserScore(context.Background(), "user-id", 10, "event-id", "duel")
+	suite.ErrorIs(err, ErrEventNotFound)
+}
+
+func (suite *LeaderboardServiceTestSuite) newSignedScore() domain.SignedScore {
+	return domain.SignedScore{
+		ServerID:  "server-id",
+		MatchID:   "match-id",
+		UserID:    "user-id",
+		Score:     10,
+		Timestamp: time.Now(),
+		Nonce:     "nonce",
+	}
+}
+
+func (suite *LeaderboardServiceTestSuite) signWithHMAC(score domain.SignedScore, secret []byte) domain.SignedScore {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(score.Payload())
+
+	score.Signature = mac.Sum(nil)
+
+	return score
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitVerifiedScore_HMAC() {
+	secret := []byte("secret")
+
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		GetByServerID(mock.Anything, "server-id").
+		Return(domain.GameServerKey{
+			ServerID:  "server-id",
+			Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+			Key:       secret,
+		}, nil)
+
+	suite.mockNonceRepository.
+		EXPECT().
+		Claim(mock.Anything, "game_server:server-id", "nonce", 2*time.Minute).
+		Return(true, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id"}, nil)
+
+	suite.expectScoreAccepted("user-id", 10)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		UpdateUserTopScore(mock.Anything, "user-id", "", float64(10), mock.Anything).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventScoreSubmitted, "game_server:server-id", 10, map[string]string{
+		"topScoreUpdated": "true",
+	})
+
+	err := suite.service.SubmitVerifiedScore(context.Background(), suite.signWithHMAC(suite.newSignedScore(), secret))
+	suite.NoError(err)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitVerifiedScore_Ed25519() {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	suite.Require().NoError(err)
+
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		GetByServerID(mock.Anything, "server-id").
+		Return(domain.GameServerKey{
+			ServerID:  "server-id",
+			Algorithm: domain.GameServerKeyAlgorithmEd25519,
+			Key:       publicKey,
+		}, nil)
+
+	suite.mockNonceRepository.
+		EXPECT().
+		Claim(mock.Anything, "game_server:server-id", "nonce", 2*time.Minute).
+		Return(false, nil)
+
+	score := suite.newSignedScore()
+	score.Signature = ed25519.Sign(privateKey, score.Payload())
+
+	err = suite.service.SubmitVerifiedScore(context.Background(), score)
+	suite.ErrorIs(err, ErrReplayedNonce)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitVerifiedScore_InvalidSignature() {
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		GetByServerID(mock.Anything, "server-id").
+		Return(domain.GameServerKey{
+			ServerID:  "server-id",
+			Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+			Key:       []byte("secret"),
+		}, nil)
+
+	score := suite.signWithHMAC(suite.newSignedScore(), []byte("a-different-secret"))
+
+	err := suite.service.SubmitVerifiedScore(context.Background(), score)
+	suite.ErrorIs(err, ErrInvalidSignature)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitVerifiedScore_UnknownServer() {
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		GetByServerID(mock.Anything, "server-id").
+		Return(domain.GameServerKey{}, domain.ErrResourceNotFound)
+
+	err := suite.service.SubmitVerifiedScore(context.Background(), suite.newSignedScore())
+	suite.ErrorIs(err, ErrInvalidSignature)
+}
+
+func (suite *LeaderboardServiceTestSuite) TestSubmitVerifiedScore_Stale() {
+	secret := []byte("secret")
+
+	suite.mockGameServerKeyRepository.
+		EXPECT().
+		GetByServerID(mock.Anything, "server-id").
+		Return(domain.GameServerKey{
+			ServerID:  "server-id",
+			Algorithm: domain.GameServerKeyAlgorithmHMACSHA256,
+			Key:       secret,
+		}, nil)
+
+	score := suite.newSignedScore()
+	score.Timestamp = time.Now().Add(-time.Hour)
+
+	err := suite.service.SubmitVerifiedScore(context.Background(), suite.signWithHMAC(score, secret))
+	suite.ErrorIs(err, ErrStaleScore)
+}
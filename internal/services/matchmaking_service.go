@@ -0,0 +1,381 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidMatchQueue = errors.New("invalid match queue")
+	ErrAlreadyQueued     = errors.New("already queued")
+	ErrNotQueued         = errors.New("not queued")
+	ErrQueueTimeout      = errors.New("queue timeout")
+
+	ErrInvalidTicketSigningKey = errors.New("invalid ticket signing key")
+)
+
+//go:generate mockery --name MatchmakingService --structname MockMatchmakingService --outpkg mocks --filename matchmaking_service_mock.go --output ./mocks/. --with-expecter
+type MatchmakingService interface {
+	JoinQueue(ctx context.Context, userID string, queue domain.MatchQueue) (domain.QueueEntry, error)
+	LeaveQueue(ctx context.Context, userID string) error
+	// WaitForMatch looks for a match for the player until it is matched and
+	// sends an update every time it has looked, the last update holds the
+	// ticket of the match. The player leaves the queue when it stops
+	// waiting before it is matched.
+	WaitForMatch(ctx context.Context, userID string, send func(MatchmakingUpdate) error) error
+	// GetTicketPublicKey returns the Ed25519 public key the game servers
+	// verify the tickets with.
+	GetTicketPublicKey(ctx context.Context) ed25519.PublicKey
+}
+
+// MatchmakingUpdate is the state of a waiting player, Ticket is only set
+// once the player has been matched.
+type MatchmakingUpdate struct {
+	Waited      time.Duration
+	RatingRange float64
+	Matched     bool
+	Ticket      domain.MatchTicket
+}
+
+type MatchmakingServiceDependencies struct {
+	RatingRepository      domain.RatingRepository
+	MatchmakingRepository domain.MatchmakingRepository
+
+	// Modes and Regions are the ones a player can queue for, players are
+	// only matched with the players of the same mode, region and match size.
+	Modes        []string
+	Regions      []string
+	MaxMatchSize int64
+
+	// A player is matched with the players whose ratings are within its
+	// rating range and theirs. The range starts at InitialRatingRange and
+	// widens by RatingRangeGrowth every second up to MaxRatingRange.
+	InitialRatingRange float64
+	RatingRangeGrowth  float64
+	MaxRatingRange     float64
+
+	// QueueTimeout is how long a player waits for a match before it leaves
+	// the queue, PollInterval is how often a waiting player looks for one.
+	QueueTimeout time.Duration
+	PollInterval time.Duration
+
+	// TicketTTL is how long the ticket of a match is valid, the tickets are
+	// signed with Ed25519 and TicketSigningKey, see ParseTicketSigningKey.
+	TicketTTL        time.Duration
+	TicketSigningKey ed25519.PrivateKey
+}
+
+type matchmakingService struct {
+	ratingRepository      domain.RatingRepository
+	matchmakingRepository domain.MatchmakingRepository
+
+	modes        map[string]bool
+	regions      map[string]bool
+	maxMatchSize int64
+
+	initialRatingRange float64
+	ratingRangeGrowth  float64
+	maxRatingRange     float64
+
+	queueTimeout time.Duration
+	pollInterval time.Duration
+
+	ticketTTL        time.Duration
+	ticketSigningKey ed25519.PrivateKey
+}
+
+func NewMatchmakingService(deps MatchmakingServiceDependencies) *matchmakingService {
+	modes := make(map[string]bool, len(deps.Modes))
+
+	for _, mode := range deps.Modes {
+		modes[mode] = true
+	}
+
+	regions := make(map[string]bool, len(deps.Regions))
+
+	for _, region := range deps.Regions {
+		regions[region] = true
+	}
+
+	return &matchmakingService{
+		ratingRepository:      deps.RatingRepository,
+		matchmakingRepository: deps.MatchmakingRepository,
+		modes:                 modes,
+		regions:               regions,
+		maxMatchSize:          deps.MaxMatchSize,
+		initialRatingRange:    deps.InitialRatingRange,
+		ratingRangeGrowth:     deps.RatingRangeGrowth,
+		maxRatingRange:        deps.MaxRatingRange,
+		queueTimeout:          deps.QueueTimeout,
+		pollInterval:          deps.PollInterval,
+		ticketTTL:             deps.TicketTTL,
+		ticketSigningKey:      deps.TicketSigningKey,
+	}
+}
+
+// JoinQueue queues the player with its current rating in the mode, a
+// player that has not played the mode yet is queued with the default one.
+func (service *matchmakingService) JoinQueue(ctx context.Context, userID string, queue domain.MatchQueue) (domain.QueueEntry, error) {
+	err := service.validateQueue(queue)
+	if err != nil {
+		return domain.QueueEntry{}, err
+	}
+
+	ratings, err := service.ratingRepository.GetRatings(ctx, queue.Mode, []string{userID})
+	if err != nil {
+		return domain.QueueEntry{}, err
+	}
+
+	rating, ok := ratings[userID]
+	if !ok {
+		rating = domain.NewRating(userID, queue.Mode)
+	}
+
+	entry := domain.QueueEntry{
+		UserID:   userID,
+		Queue:    queue,
+		Rating:   rating.Rating,
+		JoinedAt: time.Now(),
+	}
+
+	err = service.matchmakingRepository.Enqueue(ctx, entry, service.queueTimeout)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceExists) {
+			return domain.QueueEntry{}, ErrAlreadyQueued
+		}
+
+		return domain.QueueEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (service *matchmakingService) LeaveQueue(ctx context.Context, userID string) error {
+	err := service.matchmakingRepository.Dequeue(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return ErrNotQueued
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (service *matchmakingService) WaitForMatch(ctx context.Context, userID string, send func(MatchmakingUpdate) error) error {
+	ticker := time.NewTicker(service.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		matched, err := service.poll(ctx, userID, send)
+		if err != nil {
+			if ctx.Err() != nil {
+				return service.stopWaiting(userID, ctx.Err())
+			}
+
+			return err
+		}
+
+		if matched {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return service.stopWaiting(userID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll looks for a match for the player once, it reports whether the
+// player has been matched, by itself or by another player.
+func (service *matchmakingService) poll(ctx context.Context, userID string, send func(MatchmakingUpdate) error) (bool, error) {
+	ticket, err := service.matchmakingRepository.GetMatch(ctx, userID)
+	if err == nil {
+		return true, send(MatchmakingUpdate{Matched: true, Ticket: ticket})
+	}
+
+	if !errors.Is(err, domain.ErrResourceNotFound) {
+		return false, err
+	}
+
+	entry, err := service.matchmakingRepository.GetEntry(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return false, ErrNotQueued
+		}
+
+		return false, err
+	}
+
+	waited := time.Since(entry.JoinedAt)
+
+	if waited >= service.queueTimeout {
+		err = service.matchmakingRepository.Dequeue(ctx, userID)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return false, err
+		}
+
+		return false, ErrQueueTimeout
+	}
+
+	ticket, matched, err := service.findMatch(ctx, entry)
+	if err != nil {
+		return false, err
+	}
+
+	if matched {
+		return true, send(MatchmakingUpdate{Waited: waited, Matched: true, Ticket: ticket})
+	}
+
+	return false, send(MatchmakingUpdate{
+		Waited:      waited,
+		RatingRange: service.ratingRange(waited),
+	})
+}
+
+// findMatch matches the player with the players of its queue that are the
+// closest to its rating, the ones that have waited the longest first when
+// they are as close. It reports false when there are not enough players in
+// range or when one of them has been matched meanwhile.
+func (service *matchmakingService) findMatch(ctx context.Context, entry domain.QueueEntry) (domain.MatchTicket, bool, error) {
+	entries, err := service.matchmakingRepository.ListEntries(ctx, entry.Queue)
+	if err != nil {
+		return domain.MatchTicket{}, false, err
+	}
+
+	now := time.Now()
+	ratingRange := service.ratingRange(now.Sub(entry.JoinedAt))
+
+	var candidates []domain.QueueEntry
+
+	for _, candidate := range entries {
+		waited := now.Sub(candidate.JoinedAt)
+
+		if candidate.UserID == entry.UserID || waited >= service.queueTimeout {
+			continue
+		}
+
+		difference := math.Abs(candidate.Rating - entry.Rating)
+
+		if difference <= ratingRange && difference <= service.ratingRange(waited) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if int64(len(candidates)) < entry.Queue.MatchSize-1 {
+		return domain.MatchTicket{}, false, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].Rating-entry.Rating) < math.Abs(candidates[j].Rating-entry.Rating)
+	})
+
+	userIDs := []string{entry.UserID}
+
+	for _, candidate := range candidates[:entry.Queue.MatchSize-1] {
+		userIDs = append(userIDs, candidate.UserID)
+	}
+
+	matchID, err := newMatchID()
+	if err != nil {
+		return domain.MatchTicket{}, false, err
+	}
+
+	ticket := domain.MatchTicket{
+		MatchID:   matchID,
+		Mode:      entry.Queue.Mode,
+		Region:    entry.Queue.Region,
+		UserIDs:   userIDs,
+		CreatedAt: now,
+		ExpiresAt: now.Add(service.ticketTTL),
+	}
+
+	ticket.Signature = service.sign(ticket)
+
+	created, err := service.matchmakingRepository.CreateMatch(ctx, entry.Queue, ticket)
+	if err != nil || !created {
+		return domain.MatchTicket{}, false, err
+	}
+
+	return ticket, true, nil
+}
+
+// stopWaiting takes the player out of the queue once it has stopped
+// waiting, so that no match is made with a player that is gone.
+func (service *matchmakingService) stopWaiting(userID string, cause error) error {
+	err := service.matchmakingRepository.Dequeue(context.Background(), userID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return err
+	}
+
+	return cause
+}
+
+func (service *matchmakingService) ratingRange(waited time.Duration) float64 {
+	return math.Min(service.initialRatingRange+service.ratingRangeGrowth*waited.Seconds(), service.maxRatingRange)
+}
+
+func (service *matchmakingService) validateQueue(queue domain.MatchQueue) error {
+	if !service.modes[queue.Mode] {
+		return fmt.Errorf("%w, unknown mode", ErrInvalidMatchQueue)
+	}
+
+	if !service.regions[queue.Region] {
+		return fmt.Errorf("%w, unknown region", ErrInvalidMatchQueue)
+	}
+
+	if queue.MatchSize < 2 || queue.MatchSize > service.maxMatchSize {
+		return fmt.Errorf("%w, a match has between 2 and %d players", ErrInvalidMatchQueue, service.maxMatchSize)
+	}
+
+	return nil
+}
+
+func (service *matchmakingService) GetTicketPublicKey(ctx context.Context) ed25519.PublicKey {
+	return service.ticketSigningKey.Public().(ed25519.PublicKey)
+}
+
+func (service *matchmakingService) sign(ticket domain.MatchTicket) []byte {
+	return ed25519.Sign(service.ticketSigningKey, ticket.Payload())
+}
+
+// ParseTicketSigningKey parses the base64 encoded 32 byte Ed25519 seed the
+// tickets are signed with.
+func ParseTicketSigningKey(value string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%w, expected a base64 encoded %d byte seed", ErrInvalidTicketSigningKey, ed25519.SeedSize)
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// ValidateMatchmakingRegions checks the regions the players can queue in,
+// the regions are part of the keys the queues are stored at.
+func ValidateMatchmakingRegions(regions []string) error {
+	return validateIdentifiers(regions, ErrInvalidMatchQueue)
+}
+
+func newMatchID() (string, error) {
+	bytes := make([]byte, 16)
+
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type MatchmakingServiceTestSuite struct {
+	suite.Suite
+
+	service *matchmakingService
+
+	ticketSigningKey ed25519.PrivateKey
+
+	mockRatingRepository      *mocks.MockRatingRepository
+	mockMatchmakingRepository *mocks.MockMatchmakingRepository
+}
+
+func TestMatchmakingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MatchmakingServiceTestSuite))
+}
+
+func (suite *MatchmakingServiceTestSuite) SetupTest() {
+	suite.mockRatingRepository = mocks.NewMockRatingRepository(suite.T())
+	suite.mockMatchmakingRepository = mocks.NewMockMatchmakingRepository(suite.T())
+	suite.ticketSigningKey = ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+
+	suite.service = NewMatchmakingService(MatchmakingServiceDependencies{
+		RatingRepository:      suite.mockRatingRepository,
+		MatchmakingRepository: suite.mockMatchmakingRepository,
+		Modes:                 []string{"duel", "free_for_all"},
+		Regions:               []string{"eu", "us"},
+		MaxMatchSize:          8,
+		InitialRatingRange:    100,
+		RatingRangeGrowth:     10,
+		MaxRatingRange:        500,
+		QueueTimeout:          5 * time.Minute,
+		PollInterval:          time.Millisecond,
+		TicketTTL:             2 * time.Minute,
+		TicketSigningKey:      suite.ticketSigningKey,
+	})
+}
+
+func (suite *MatchmakingServiceTestSuite) duel() domain.MatchQueue {
+	return domain.MatchQueue{Mode: "duel", Region: "eu", MatchSize: 2}
+}
+
+func (suite *MatchmakingServiceTestSuite) TestJoinQueue() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id"}).
+		Return(map[string]domain.Rating{
+			"user-id": {UserID: "user-id", Mode: "duel", Rating: 1620, Deviation: 80},
+		}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Enqueue(mock.Anything, mock.MatchedBy(func(entry domain.QueueEntry) bool {
+			return entry.UserID == "user-id" && entry.Queue == suite.duel() && entry.Rating == 1620
+		}), 5*time.Minute).
+		Return(nil)
+
+	entry, err := suite.service.JoinQueue(context.Background(), "user-id", suite.duel())
+	suite.NoError(err)
+
+	suite.Equal(1620.0, entry.Rating)
+	suite.WithinDuration(time.Now(), entry.JoinedAt, time.Second)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestJoinQueue_NotRated() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id"}).
+		Return(map[string]domain.Rating{}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Enqueue(mock.Anything, mock.Anything, 5*time.Minute).
+		Return(nil)
+
+	entry, err := suite.service.JoinQueue(context.Background(), "user-id", suite.duel())
+	suite.NoError(err)
+	suite.Equal(float64(domain.DefaultRating), entry.Rating)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestJoinQueue_AlreadyQueued() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id"}).
+		Return(map[string]domain.Rating{}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Enqueue(mock.Anything, mock.Anything, 5*time.Minute).
+		Return(domain.ErrResourceExists)
+
+	_, err := suite.service.JoinQueue(context.Background(), "user-id", suite.duel())
+	suite.ErrorIs(err, ErrAlreadyQueued)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestJoinQueue_InvalidQueue() {
+	for _, queue := range []domain.MatchQueue{
+		{Mode: "chess", Region: "eu", MatchSize: 2},
+		{Mode: "duel", Region: "mars", MatchSize: 2},
+		{Mode: "duel", Region: "eu", MatchSize: 1},
+		{Mode: "duel", Region: "eu", MatchSize: 9},
+	} {
+		_, err := suite.service.JoinQueue(context.Background(), "user-id", queue)
+		suite.ErrorIs(err, ErrInvalidMatchQueue, queue)
+	}
+}
+
+func (suite *MatchmakingServiceTestSuite) TestLeaveQueue() {
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Dequeue(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.NoError(suite.service.LeaveQueue(context.Background(), "user-id"))
+}
+
+func (suite *MatchmakingServiceTestSuite) TestLeaveQueue_NotQueued() {
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Dequeue(mock.Anything, "user-id").
+		Return(domain.ErrResourceNotFound)
+
+	suite.ErrorIs(suite.service.LeaveQueue(context.Background(), "user-id"), ErrNotQueued)
+}
+
+func (suite *MatchmakingServiceTestSuite) expectWaiting(entry domain.QueueEntry) {
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		GetMatch(mock.Anything, entry.UserID).
+		Return(domain.MatchTicket{}, domain.ErrResourceNotFound)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		GetEntry(mock.Anything, entry.UserID).
+		Return(entry, nil)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch() {
+	now := time.Now()
+	entry := domain.QueueEntry{UserID: "user-id", Queue: suite.duel(), Rating: 1500, JoinedAt: now.Add(-20 * time.Second)}
+
+	suite.expectWaiting(entry)
+
+	// the range of the player is 300 after 20 seconds. user-id-4 is within
+	// it but the player is not within the range of user-id-4, and user-id-5
+	// has waited too long, so user-id-3 is the closest player.
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		ListEntries(mock.Anything, suite.duel()).
+		Return([]domain.QueueEntry{
+			{UserID: "user-id-2", Queue: suite.duel(), Rating: 1750, JoinedAt: now.Add(-time.Minute)},
+			entry,
+			{UserID: "user-id-3", Queue: suite.duel(), Rating: 1300, JoinedAt: now.Add(-30 * time.Second)},
+			{UserID: "user-id-4", Queue: suite.duel(), Rating: 1650, JoinedAt: now},
+			{UserID: "user-id-5", Queue: suite.duel(), Rating: 1500, JoinedAt: now.Add(-time.Hour)},
+		}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		CreateMatch(mock.Anything, suite.duel(), mock.Anything).
+		Return(true, nil)
+
+	var updates []MatchmakingUpdate
+
+	err := suite.service.WaitForMatch(context.Background(), "user-id", func(update MatchmakingUpdate) error {
+		updates = append(updates, update)
+
+		return nil
+	})
+	suite.NoError(err)
+
+	suite.Len(updates, 1)
+	suite.True(updates[0].Matched)
+
+	ticket := updates[0].Ticket
+
+	suite.Equal([]string{"user-id", "user-id-3"}, ticket.UserIDs)
+	suite.Equal("duel", ticket.Mode)
+	suite.Equal("eu", ticket.Region)
+	suite.True(isIdentifier(ticket.MatchID))
+	suite.Equal(2*time.Minute, ticket.ExpiresAt.Sub(ticket.CreatedAt))
+
+	publicKey := suite.service.GetTicketPublicKey(context.Background())
+
+	suite.True(ed25519.Verify(publicKey, ticket.Payload(), ticket.Signature))
+
+	ticket.UserIDs = []string{"user-id", "user-id-2"}
+
+	suite.False(ed25519.Verify(publicKey, ticket.Payload(), ticket.Signature))
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch_MatchedByAnotherPlayer() {
+	ticket := domain.MatchTicket{MatchID: "match-1", Mode: "duel", Region: "eu", UserIDs: []string{"user-id-2", "user-id"}}
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		GetMatch(mock.Anything, "user-id").
+		Return(ticket, nil)
+
+	var updates []MatchmakingUpdate
+
+	err := suite.service.WaitForMatch(context.Background(), "user-id", func(update MatchmakingUpdate) error {
+		updates = append(updates, update)
+
+		return nil
+	})
+	suite.NoError(err)
+
+	suite.Equal([]MatchmakingUpdate{{Matched: true, Ticket: ticket}}, updates)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch_StopWaiting() {
+	entry := domain.QueueEntry{UserID: "user-id", Queue: suite.duel(), Rating: 1500, JoinedAt: time.Now()}
+
+	suite.expectWaiting(entry)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		ListEntries(mock.Anything, suite.duel()).
+		Return([]domain.QueueEntry{
+			entry,
+			{UserID: "user-id-2", Queue: suite.duel(), Rating: 1800, JoinedAt: time.Now()},
+		}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Dequeue(mock.Anything, "user-id").
+		Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var updates []MatchmakingUpdate
+
+	err := suite.service.WaitForMatch(ctx, "user-id", func(update MatchmakingUpdate) error {
+		updates = append(updates, update)
+		cancel()
+
+		return nil
+	})
+	suite.ErrorIs(err, context.Canceled)
+
+	suite.Len(updates, 1)
+	suite.False(updates[0].Matched)
+	suite.InDelta(100, updates[0].RatingRange, 1)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch_PlayerMatchedMeanwhile() {
+	entry := domain.QueueEntry{UserID: "user-id", Queue: suite.duel(), Rating: 1500, JoinedAt: time.Now()}
+
+	suite.expectWaiting(entry)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		ListEntries(mock.Anything, suite.duel()).
+		Return([]domain.QueueEntry{
+			entry,
+			{UserID: "user-id-2", Queue: suite.duel(), Rating: 1500, JoinedAt: time.Now()},
+		}, nil)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		CreateMatch(mock.Anything, suite.duel(), mock.Anything).
+		Return(false, nil)
+
+	sendErr := errors.New("stream closed")
+
+	err := suite.service.WaitForMatch(context.Background(), "user-id", func(update MatchmakingUpdate) error {
+		suite.False(update.Matched)
+
+		return sendErr
+	})
+	suite.ErrorIs(err, sendErr)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch_Timeout() {
+	entry := domain.QueueEntry{UserID: "user-id", Queue: suite.duel(), Rating: 1500, JoinedAt: time.Now().Add(-10 * time.Minute)}
+
+	suite.expectWaiting(entry)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		Dequeue(mock.Anything, "user-id").
+		Return(nil)
+
+	err := suite.service.WaitForMatch(context.Background(), "user-id", func(update MatchmakingUpdate) error {
+		suite.Fail("no update is expected")
+
+		return nil
+	})
+	suite.ErrorIs(err, ErrQueueTimeout)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestWaitForMatch_NotQueued() {
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		GetMatch(mock.Anything, "user-id").
+		Return(domain.MatchTicket{}, domain.ErrResourceNotFound)
+
+	suite.mockMatchmakingRepository.
+		EXPECT().
+		GetEntry(mock.Anything, "user-id").
+		Return(domain.QueueEntry{}, domain.ErrResourceNotFound)
+
+	err := suite.service.WaitForMatch(context.Background(), "user-id", func(update MatchmakingUpdate) error {
+		return nil
+	})
+	suite.ErrorIs(err, ErrNotQueued)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestValidateMatchmakingRegions() {
+	suite.NoError(ValidateMatchmakingRegions([]string{"eu", "us-east"}))
+	suite.ErrorIs(ValidateMatchmakingRegions([]string{"eu", "eu"}), ErrInvalidMatchQueue)
+}
+
+func (suite *MatchmakingServiceTestSuite) TestGetTicketPublicKey() {
+	suite.Equal(suite.ticketSigningKey.Public(), suite.service.GetTicketPublicKey(context.Background()))
+}
+
+func (suite *MatchmakingServiceTestSuite) TestParseTicketSigningKey() {
+	seed := make([]byte, ed25519.SeedSize)
+
+	key, err := ParseTicketSigningKey(base64.StdEncoding.EncodeToString(seed))
+	suite.NoError(err)
+	suite.Equal(ed25519.NewKeyFromSeed(seed), key)
+
+	_, err = ParseTicketSigningKey(base64.StdEncoding.EncodeToString(seed[:16]))
+	suite.ErrorIs(err, ErrInvalidTicketSigningKey)
+
+	_, err = ParseTicketSigningKey("my_match_ticket_signing_key")
+	suite.ErrorIs(err, ErrInvalidTicketSigningKey)
+}
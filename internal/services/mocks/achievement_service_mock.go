@@ -0,0 +1,149 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockAchievementService is an autogenerated mock type for the AchievementService type
+type MockAchievementService struct {
+	mock.Mock
+}
+
+type MockAchievementService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAchievementService) EXPECT() *MockAchievementService_Expecter {
+	return &MockAchievementService_Expecter{mock: &_m.Mock}
+}
+
+// GetUserAchievements provides a mock function with given fields: ctx, userID
+func (_m *MockAchievementService) GetUserAchievements(ctx context.Context, userID string) ([]services.UnlockedAchievement, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []services.UnlockedAchievement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]services.UnlockedAchievement, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []services.UnlockedAchievement); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]services.UnlockedAchievement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementService_GetUserAchievements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAchievements'
+type MockAchievementService_GetUserAchievements_Call struct {
+	*mock.Call
+}
+
+// GetUserAchievements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAchievementService_Expecter) GetUserAchievements(ctx interface{}, userID interface{}) *MockAchievementService_GetUserAchievements_Call {
+	return &MockAchievementService_GetUserAchievements_Call{Call: _e.mock.On("GetUserAchievements", ctx, userID)}
+}
+
+func (_c *MockAchievementService_GetUserAchievements_Call) Run(run func(ctx context.Context, userID string)) *MockAchievementService_GetUserAchievements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAchievementService_GetUserAchievements_Call) Return(_a0 []services.UnlockedAchievement, _a1 error) *MockAchievementService_GetUserAchievements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementService_GetUserAchievements_Call) RunAndReturn(run func(context.Context, string) ([]services.UnlockedAchievement, error)) *MockAchievementService_GetUserAchievements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAchievements provides a mock function with given fields: ctx
+func (_m *MockAchievementService) ListAchievements(ctx context.Context) ([]domain.Achievement, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Achievement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Achievement, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Achievement); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Achievement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAchievementService_ListAchievements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAchievements'
+type MockAchievementService_ListAchievements_Call struct {
+	*mock.Call
+}
+
+// ListAchievements is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAchievementService_Expecter) ListAchievements(ctx interface{}) *MockAchievementService_ListAchievements_Call {
+	return &MockAchievementService_ListAchievements_Call{Call: _e.mock.On("ListAchievements", ctx)}
+}
+
+func (_c *MockAchievementService_ListAchievements_Call) Run(run func(ctx context.Context)) *MockAchievementService_ListAchievements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAchievementService_ListAchievements_Call) Return(_a0 []domain.Achievement, _a1 error) *MockAchievementService_ListAchievements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAchievementService_ListAchievements_Call) RunAndReturn(run func(context.Context) ([]domain.Achievement, error)) *MockAchievementService_ListAchievements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockAchievementService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockAchievementService creates a new instance of MockAchievementService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockAchievementService(t mockConstructorTestingTNewMockAchievementService) *MockAchievementService {
+	mock := &MockAchievementService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuditLogService is an autogenerated mock type for the AuditLogService type
+type MockAuditLogService struct {
+	mock.Mock
+}
+
+type MockAuditLogService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditLogService) EXPECT() *MockAuditLogService_Expecter {
+	return &MockAuditLogService_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function with given fields: ctx, filter
+func (_m *MockAuditLogService) Query(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditEvent, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []domain.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditLogFilter) ([]domain.AuditEvent, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditLogFilter) []domain.AuditEvent); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuditLogFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuditLogService_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockAuditLogService_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AuditLogFilter
+func (_e *MockAuditLogService_Expecter) Query(ctx interface{}, filter interface{}) *MockAuditLogService_Query_Call {
+	return &MockAuditLogService_Query_Call{Call: _e.mock.On("Query", ctx, filter)}
+}
+
+func (_c *MockAuditLogService_Query_Call) Run(run func(ctx context.Context, filter domain.AuditLogFilter)) *MockAuditLogService_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditLogFilter))
+	})
+	return _c
+}
+
+func (_c *MockAuditLogService_Query_Call) Return(_a0 []domain.AuditEvent, _a1 error) *MockAuditLogService_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuditLogService_Query_Call) RunAndReturn(run func(context.Context, domain.AuditLogFilter) ([]domain.AuditEvent, error)) *MockAuditLogService_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockAuditLogService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockAuditLogService creates a new instance of MockAuditLogService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockAuditLogService(t mockConstructorTestingTNewMockAuditLogService) *MockAuditLogService {
+	mock := &MockAuditLogService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
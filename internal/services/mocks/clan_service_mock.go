@@ -0,0 +1,376 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockClanService is an autogenerated mock type for the ClanService type
+type MockClanService struct {
+	mock.Mock
+}
+
+type MockClanService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClanService) EXPECT() *MockClanService_Expecter {
+	return &MockClanService_Expecter{mock: &_m.Mock}
+}
+
+// CreateClan provides a mock function with given fields: ctx, userID, name
+func (_m *MockClanService) CreateClan(ctx context.Context, userID string, name string) (domain.Clan, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	var r0 domain.Clan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.Clan, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.Clan); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Get(0).(domain.Clan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanService_CreateClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClan'
+type MockClanService_CreateClan_Call struct {
+	*mock.Call
+}
+
+// CreateClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockClanService_Expecter) CreateClan(ctx interface{}, userID interface{}, name interface{}) *MockClanService_CreateClan_Call {
+	return &MockClanService_CreateClan_Call{Call: _e.mock.On("CreateClan", ctx, userID, name)}
+}
+
+func (_c *MockClanService_CreateClan_Call) Run(run func(ctx context.Context, userID string, name string)) *MockClanService_CreateClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_CreateClan_Call) Return(_a0 domain.Clan, _a1 error) *MockClanService_CreateClan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanService_CreateClan_Call) RunAndReturn(run func(context.Context, string, string) (domain.Clan, error)) *MockClanService_CreateClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClan provides a mock function with given fields: ctx, clanID
+func (_m *MockClanService) GetClan(ctx context.Context, clanID string) (services.ClanDetails, error) {
+	ret := _m.Called(ctx, clanID)
+
+	var r0 services.ClanDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (services.ClanDetails, error)); ok {
+		return rf(ctx, clanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) services.ClanDetails); ok {
+		r0 = rf(ctx, clanID)
+	} else {
+		r0 = ret.Get(0).(services.ClanDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, clanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanService_GetClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClan'
+type MockClanService_GetClan_Call struct {
+	*mock.Call
+}
+
+// GetClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clanID string
+func (_e *MockClanService_Expecter) GetClan(ctx interface{}, clanID interface{}) *MockClanService_GetClan_Call {
+	return &MockClanService_GetClan_Call{Call: _e.mock.On("GetClan", ctx, clanID)}
+}
+
+func (_c *MockClanService_GetClan_Call) Run(run func(ctx context.Context, clanID string)) *MockClanService_GetClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_GetClan_Call) Return(_a0 services.ClanDetails, _a1 error) *MockClanService_GetClan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanService_GetClan_Call) RunAndReturn(run func(context.Context, string) (services.ClanDetails, error)) *MockClanService_GetClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClanLeaderboard provides a mock function with given fields: ctx, aggregate
+func (_m *MockClanService) GetClanLeaderboard(ctx context.Context, aggregate string) (domain.ClanLeaderboard, error) {
+	ret := _m.Called(ctx, aggregate)
+
+	var r0 domain.ClanLeaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.ClanLeaderboard, error)); ok {
+		return rf(ctx, aggregate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.ClanLeaderboard); ok {
+		r0 = rf(ctx, aggregate)
+	} else {
+		r0 = ret.Get(0).(domain.ClanLeaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, aggregate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClanService_GetClanLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClanLeaderboard'
+type MockClanService_GetClanLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetClanLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregate string
+func (_e *MockClanService_Expecter) GetClanLeaderboard(ctx interface{}, aggregate interface{}) *MockClanService_GetClanLeaderboard_Call {
+	return &MockClanService_GetClanLeaderboard_Call{Call: _e.mock.On("GetClanLeaderboard", ctx, aggregate)}
+}
+
+func (_c *MockClanService_GetClanLeaderboard_Call) Run(run func(ctx context.Context, aggregate string)) *MockClanService_GetClanLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_GetClanLeaderboard_Call) Return(_a0 domain.ClanLeaderboard, _a1 error) *MockClanService_GetClanLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClanService_GetClanLeaderboard_Call) RunAndReturn(run func(context.Context, string) (domain.ClanLeaderboard, error)) *MockClanService_GetClanLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinClan provides a mock function with given fields: ctx, userID, clanID
+func (_m *MockClanService) JoinClan(ctx context.Context, userID string, clanID string) error {
+	ret := _m.Called(ctx, userID, clanID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clanID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanService_JoinClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinClan'
+type MockClanService_JoinClan_Call struct {
+	*mock.Call
+}
+
+// JoinClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clanID string
+func (_e *MockClanService_Expecter) JoinClan(ctx interface{}, userID interface{}, clanID interface{}) *MockClanService_JoinClan_Call {
+	return &MockClanService_JoinClan_Call{Call: _e.mock.On("JoinClan", ctx, userID, clanID)}
+}
+
+func (_c *MockClanService_JoinClan_Call) Run(run func(ctx context.Context, userID string, clanID string)) *MockClanService_JoinClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_JoinClan_Call) Return(_a0 error) *MockClanService_JoinClan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanService_JoinClan_Call) RunAndReturn(run func(context.Context, string, string) error) *MockClanService_JoinClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KickMember provides a mock function with given fields: ctx, userID, memberID
+func (_m *MockClanService) KickMember(ctx context.Context, userID string, memberID string) error {
+	ret := _m.Called(ctx, userID, memberID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanService_KickMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KickMember'
+type MockClanService_KickMember_Call struct {
+	*mock.Call
+}
+
+// KickMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - memberID string
+func (_e *MockClanService_Expecter) KickMember(ctx interface{}, userID interface{}, memberID interface{}) *MockClanService_KickMember_Call {
+	return &MockClanService_KickMember_Call{Call: _e.mock.On("KickMember", ctx, userID, memberID)}
+}
+
+func (_c *MockClanService_KickMember_Call) Run(run func(ctx context.Context, userID string, memberID string)) *MockClanService_KickMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_KickMember_Call) Return(_a0 error) *MockClanService_KickMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanService_KickMember_Call) RunAndReturn(run func(context.Context, string, string) error) *MockClanService_KickMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveClan provides a mock function with given fields: ctx, userID
+func (_m *MockClanService) LeaveClan(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanService_LeaveClan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveClan'
+type MockClanService_LeaveClan_Call struct {
+	*mock.Call
+}
+
+// LeaveClan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockClanService_Expecter) LeaveClan(ctx interface{}, userID interface{}) *MockClanService_LeaveClan_Call {
+	return &MockClanService_LeaveClan_Call{Call: _e.mock.On("LeaveClan", ctx, userID)}
+}
+
+func (_c *MockClanService_LeaveClan_Call) Run(run func(ctx context.Context, userID string)) *MockClanService_LeaveClan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_LeaveClan_Call) Return(_a0 error) *MockClanService_LeaveClan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanService_LeaveClan_Call) RunAndReturn(run func(context.Context, string) error) *MockClanService_LeaveClan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberRole provides a mock function with given fields: ctx, userID, memberID, role
+func (_m *MockClanService) SetMemberRole(ctx context.Context, userID string, memberID string, role string) error {
+	ret := _m.Called(ctx, userID, memberID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, memberID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClanService_SetMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberRole'
+type MockClanService_SetMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - memberID string
+//   - role string
+func (_e *MockClanService_Expecter) SetMemberRole(ctx interface{}, userID interface{}, memberID interface{}, role interface{}) *MockClanService_SetMemberRole_Call {
+	return &MockClanService_SetMemberRole_Call{Call: _e.mock.On("SetMemberRole", ctx, userID, memberID, role)}
+}
+
+func (_c *MockClanService_SetMemberRole_Call) Run(run func(ctx context.Context, userID string, memberID string, role string)) *MockClanService_SetMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockClanService_SetMemberRole_Call) Return(_a0 error) *MockClanService_SetMemberRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClanService_SetMemberRole_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockClanService_SetMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockClanService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockClanService creates a new instance of MockClanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockClanService(t mockConstructorTestingTNewMockClanService) *MockClanService {
+	mock := &MockClanService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,70 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEventFreezer is an autogenerated mock type for the EventFreezer type
+type MockEventFreezer struct {
+	mock.Mock
+}
+
+type MockEventFreezer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventFreezer) EXPECT() *MockEventFreezer_Expecter {
+	return &MockEventFreezer_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockEventFreezer) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockEventFreezer_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockEventFreezer_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEventFreezer_Expecter) Run(ctx interface{}) *MockEventFreezer_Run_Call {
+	return &MockEventFreezer_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockEventFreezer_Run_Call) Run(run func(ctx context.Context)) *MockEventFreezer_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEventFreezer_Run_Call) Return() *MockEventFreezer_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEventFreezer_Run_Call) RunAndReturn(run func(context.Context)) *MockEventFreezer_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockEventFreezer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockEventFreezer creates a new instance of MockEventFreezer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockEventFreezer(t mockConstructorTestingTNewMockEventFreezer) *MockEventFreezer {
+	mock := &MockEventFreezer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
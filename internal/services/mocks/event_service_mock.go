@@ -0,0 +1,303 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEventService is an autogenerated mock type for the EventService type
+type MockEventService struct {
+	mock.Mock
+}
+
+type MockEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventService) EXPECT() *MockEventService_Expecter {
+	return &MockEventService_Expecter{mock: &_m.Mock}
+}
+
+// CreateEvent provides a mock function with given fields: ctx, event
+func (_m *MockEventService) CreateEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	ret := _m.Called(ctx, event)
+
+	var r0 domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event) (domain.Event, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event) domain.Event); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Event) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventService_CreateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEvent'
+type MockEventService_CreateEvent_Call struct {
+	*mock.Call
+}
+
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.Event
+func (_e *MockEventService_Expecter) CreateEvent(ctx interface{}, event interface{}) *MockEventService_CreateEvent_Call {
+	return &MockEventService_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, event)}
+}
+
+func (_c *MockEventService_CreateEvent_Call) Run(run func(ctx context.Context, event domain.Event)) *MockEventService_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Event))
+	})
+	return _c
+}
+
+func (_c *MockEventService_CreateEvent_Call) Return(_a0 domain.Event, _a1 error) *MockEventService_CreateEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventService_CreateEvent_Call) RunAndReturn(run func(context.Context, domain.Event) (domain.Event, error)) *MockEventService_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FreezeEndedEvents provides a mock function with given fields: ctx
+func (_m *MockEventService) FreezeEndedEvents(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventService_FreezeEndedEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeEndedEvents'
+type MockEventService_FreezeEndedEvents_Call struct {
+	*mock.Call
+}
+
+// FreezeEndedEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEventService_Expecter) FreezeEndedEvents(ctx interface{}) *MockEventService_FreezeEndedEvents_Call {
+	return &MockEventService_FreezeEndedEvents_Call{Call: _e.mock.On("FreezeEndedEvents", ctx)}
+}
+
+func (_c *MockEventService_FreezeEndedEvents_Call) Run(run func(ctx context.Context)) *MockEventService_FreezeEndedEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEventService_FreezeEndedEvents_Call) Return(_a0 int, _a1 error) *MockEventService_FreezeEndedEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventService_FreezeEndedEvents_Call) RunAndReturn(run func(context.Context) (int, error)) *MockEventService_FreezeEndedEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvent provides a mock function with given fields: ctx, id
+func (_m *MockEventService) GetEvent(ctx context.Context, id string) (domain.Event, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Event, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Event); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventService_GetEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvent'
+type MockEventService_GetEvent_Call struct {
+	*mock.Call
+}
+
+// GetEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventService_Expecter) GetEvent(ctx interface{}, id interface{}) *MockEventService_GetEvent_Call {
+	return &MockEventService_GetEvent_Call{Call: _e.mock.On("GetEvent", ctx, id)}
+}
+
+func (_c *MockEventService_GetEvent_Call) Run(run func(ctx context.Context, id string)) *MockEventService_GetEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventService_GetEvent_Call) Return(_a0 domain.Event, _a1 error) *MockEventService_GetEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventService_GetEvent_Call) RunAndReturn(run func(context.Context, string) (domain.Event, error)) *MockEventService_GetEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventLeaderboard provides a mock function with given fields: ctx, id
+func (_m *MockEventService) GetEventLeaderboard(ctx context.Context, id string) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Leaderboard, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Leaderboard); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventService_GetEventLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventLeaderboard'
+type MockEventService_GetEventLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetEventLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEventService_Expecter) GetEventLeaderboard(ctx interface{}, id interface{}) *MockEventService_GetEventLeaderboard_Call {
+	return &MockEventService_GetEventLeaderboard_Call{Call: _e.mock.On("GetEventLeaderboard", ctx, id)}
+}
+
+func (_c *MockEventService_GetEventLeaderboard_Call) Run(run func(ctx context.Context, id string)) *MockEventService_GetEventLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventService_GetEventLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockEventService_GetEventLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventService_GetEventLeaderboard_Call) RunAndReturn(run func(context.Context, string) (domain.Leaderboard, error)) *MockEventService_GetEventLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEvents provides a mock function with given fields: ctx
+func (_m *MockEventService) ListEvents(ctx context.Context) ([]domain.Event, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Event, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Event); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventService_ListEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEvents'
+type MockEventService_ListEvents_Call struct {
+	*mock.Call
+}
+
+// ListEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEventService_Expecter) ListEvents(ctx interface{}) *MockEventService_ListEvents_Call {
+	return &MockEventService_ListEvents_Call{Call: _e.mock.On("ListEvents", ctx)}
+}
+
+func (_c *MockEventService_ListEvents_Call) Run(run func(ctx context.Context)) *MockEventService_ListEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEventService_ListEvents_Call) Return(_a0 []domain.Event, _a1 error) *MockEventService_ListEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventService_ListEvents_Call) RunAndReturn(run func(context.Context) ([]domain.Event, error)) *MockEventService_ListEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockEventService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockEventService creates a new instance of MockEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockEventService(t mockConstructorTestingTNewMockEventService) *MockEventService {
+	mock := &MockEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,190 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGameServerKeyService is an autogenerated mock type for the GameServerKeyService type
+type MockGameServerKeyService struct {
+	mock.Mock
+}
+
+type MockGameServerKeyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGameServerKeyService) EXPECT() *MockGameServerKeyService_Expecter {
+	return &MockGameServerKeyService_Expecter{mock: &_m.Mock}
+}
+
+// CreateKey provides a mock function with given fields: ctx, serverID, algorithm, publicKey
+func (_m *MockGameServerKeyService) CreateKey(ctx context.Context, serverID string, algorithm string, publicKey []byte) (domain.GameServerKey, error) {
+	ret := _m.Called(ctx, serverID, algorithm, publicKey)
+
+	var r0 domain.GameServerKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) (domain.GameServerKey, error)); ok {
+		return rf(ctx, serverID, algorithm, publicKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) domain.GameServerKey); ok {
+		r0 = rf(ctx, serverID, algorithm, publicKey)
+	} else {
+		r0 = ret.Get(0).(domain.GameServerKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) error); ok {
+		r1 = rf(ctx, serverID, algorithm, publicKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGameServerKeyService_CreateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKey'
+type MockGameServerKeyService_CreateKey_Call struct {
+	*mock.Call
+}
+
+// CreateKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID string
+//   - algorithm string
+//   - publicKey []byte
+func (_e *MockGameServerKeyService_Expecter) CreateKey(ctx interface{}, serverID interface{}, algorithm interface{}, publicKey interface{}) *MockGameServerKeyService_CreateKey_Call {
+	return &MockGameServerKeyService_CreateKey_Call{Call: _e.mock.On("CreateKey", ctx, serverID, algorithm, publicKey)}
+}
+
+func (_c *MockGameServerKeyService_CreateKey_Call) Run(run func(ctx context.Context, serverID string, algorithm string, publicKey []byte)) *MockGameServerKeyService_CreateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyService_CreateKey_Call) Return(_a0 domain.GameServerKey, _a1 error) *MockGameServerKeyService_CreateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGameServerKeyService_CreateKey_Call) RunAndReturn(run func(context.Context, string, string, []byte) (domain.GameServerKey, error)) *MockGameServerKeyService_CreateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKeys provides a mock function with given fields: ctx
+func (_m *MockGameServerKeyService) ListKeys(ctx context.Context) ([]domain.GameServerKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.GameServerKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.GameServerKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.GameServerKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GameServerKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGameServerKeyService_ListKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeys'
+type MockGameServerKeyService_ListKeys_Call struct {
+	*mock.Call
+}
+
+// ListKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGameServerKeyService_Expecter) ListKeys(ctx interface{}) *MockGameServerKeyService_ListKeys_Call {
+	return &MockGameServerKeyService_ListKeys_Call{Call: _e.mock.On("ListKeys", ctx)}
+}
+
+func (_c *MockGameServerKeyService_ListKeys_Call) Run(run func(ctx context.Context)) *MockGameServerKeyService_ListKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyService_ListKeys_Call) Return(_a0 []domain.GameServerKey, _a1 error) *MockGameServerKeyService_ListKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGameServerKeyService_ListKeys_Call) RunAndReturn(run func(context.Context) ([]domain.GameServerKey, error)) *MockGameServerKeyService_ListKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeKey provides a mock function with given fields: ctx, serverID
+func (_m *MockGameServerKeyService) RevokeKey(ctx context.Context, serverID string) error {
+	ret := _m.Called(ctx, serverID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGameServerKeyService_RevokeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeKey'
+type MockGameServerKeyService_RevokeKey_Call struct {
+	*mock.Call
+}
+
+// RevokeKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID string
+func (_e *MockGameServerKeyService_Expecter) RevokeKey(ctx interface{}, serverID interface{}) *MockGameServerKeyService_RevokeKey_Call {
+	return &MockGameServerKeyService_RevokeKey_Call{Call: _e.mock.On("RevokeKey", ctx, serverID)}
+}
+
+func (_c *MockGameServerKeyService_RevokeKey_Call) Run(run func(ctx context.Context, serverID string)) *MockGameServerKeyService_RevokeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGameServerKeyService_RevokeKey_Call) Return(_a0 error) *MockGameServerKeyService_RevokeKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGameServerKeyService_RevokeKey_Call) RunAndReturn(run func(context.Context, string) error) *MockGameServerKeyService_RevokeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockGameServerKeyService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockGameServerKeyService creates a new instance of MockGameServerKeyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockGameServerKeyService(t mockConstructorTestingTNewMockGameServerKeyService) *MockGameServerKeyService {
+	mock := &MockGameServerKeyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,211 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	services "game/internal/services"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLeaderboardModerationService is an autogenerated mock type for the LeaderboardModerationService type
+type MockLeaderboardModerationService struct {
+	mock.Mock
+}
+
+type MockLeaderboardModerationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaderboardModerationService) EXPECT() *MockLeaderboardModerationService_Expecter {
+	return &MockLeaderboardModerationService_Expecter{mock: &_m.Mock}
+}
+
+// BanUser provides a mock function with given fields: ctx, request
+func (_m *MockLeaderboardModerationService) BanUser(ctx context.Context, request services.ModerationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, services.ModerationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardModerationService_BanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BanUser'
+type MockLeaderboardModerationService_BanUser_Call struct {
+	*mock.Call
+}
+
+// BanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ModerationRequest
+func (_e *MockLeaderboardModerationService_Expecter) BanUser(ctx interface{}, request interface{}) *MockLeaderboardModerationService_BanUser_Call {
+	return &MockLeaderboardModerationService_BanUser_Call{Call: _e.mock.On("BanUser", ctx, request)}
+}
+
+func (_c *MockLeaderboardModerationService_BanUser_Call) Run(run func(ctx context.Context, request services.ModerationRequest)) *MockLeaderboardModerationService_BanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(services.ModerationRequest))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_BanUser_Call) Return(_a0 error) *MockLeaderboardModerationService_BanUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_BanUser_Call) RunAndReturn(run func(context.Context, services.ModerationRequest) error) *MockLeaderboardModerationService_BanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveScore provides a mock function with given fields: ctx, request
+func (_m *MockLeaderboardModerationService) RemoveScore(ctx context.Context, request services.ModerationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, services.ModerationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardModerationService_RemoveScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveScore'
+type MockLeaderboardModerationService_RemoveScore_Call struct {
+	*mock.Call
+}
+
+// RemoveScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ModerationRequest
+func (_e *MockLeaderboardModerationService_Expecter) RemoveScore(ctx interface{}, request interface{}) *MockLeaderboardModerationService_RemoveScore_Call {
+	return &MockLeaderboardModerationService_RemoveScore_Call{Call: _e.mock.On("RemoveScore", ctx, request)}
+}
+
+func (_c *MockLeaderboardModerationService_RemoveScore_Call) Run(run func(ctx context.Context, request services.ModerationRequest)) *MockLeaderboardModerationService_RemoveScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(services.ModerationRequest))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_RemoveScore_Call) Return(_a0 error) *MockLeaderboardModerationService_RemoveScore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_RemoveScore_Call) RunAndReturn(run func(context.Context, services.ModerationRequest) error) *MockLeaderboardModerationService_RemoveScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetScore provides a mock function with given fields: ctx, request, score
+func (_m *MockLeaderboardModerationService) SetScore(ctx context.Context, request services.ModerationRequest, score float64) error {
+	ret := _m.Called(ctx, request, score)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, services.ModerationRequest, float64) error); ok {
+		r0 = rf(ctx, request, score)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardModerationService_SetScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetScore'
+type MockLeaderboardModerationService_SetScore_Call struct {
+	*mock.Call
+}
+
+// SetScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ModerationRequest
+//   - score float64
+func (_e *MockLeaderboardModerationService_Expecter) SetScore(ctx interface{}, request interface{}, score interface{}) *MockLeaderboardModerationService_SetScore_Call {
+	return &MockLeaderboardModerationService_SetScore_Call{Call: _e.mock.On("SetScore", ctx, request, score)}
+}
+
+func (_c *MockLeaderboardModerationService_SetScore_Call) Run(run func(ctx context.Context, request services.ModerationRequest, score float64)) *MockLeaderboardModerationService_SetScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(services.ModerationRequest), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_SetScore_Call) Return(_a0 error) *MockLeaderboardModerationService_SetScore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_SetScore_Call) RunAndReturn(run func(context.Context, services.ModerationRequest, float64) error) *MockLeaderboardModerationService_SetScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnbanUser provides a mock function with given fields: ctx, request
+func (_m *MockLeaderboardModerationService) UnbanUser(ctx context.Context, request services.ModerationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, services.ModerationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardModerationService_UnbanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbanUser'
+type MockLeaderboardModerationService_UnbanUser_Call struct {
+	*mock.Call
+}
+
+// UnbanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request services.ModerationRequest
+func (_e *MockLeaderboardModerationService_Expecter) UnbanUser(ctx interface{}, request interface{}) *MockLeaderboardModerationService_UnbanUser_Call {
+	return &MockLeaderboardModerationService_UnbanUser_Call{Call: _e.mock.On("UnbanUser", ctx, request)}
+}
+
+func (_c *MockLeaderboardModerationService_UnbanUser_Call) Run(run func(ctx context.Context, request services.ModerationRequest)) *MockLeaderboardModerationService_UnbanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(services.ModerationRequest))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_UnbanUser_Call) Return(_a0 error) *MockLeaderboardModerationService_UnbanUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardModerationService_UnbanUser_Call) RunAndReturn(run func(context.Context, services.ModerationRequest) error) *MockLeaderboardModerationService_UnbanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockLeaderboardModerationService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockLeaderboardModerationService creates a new instance of MockLeaderboardModerationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockLeaderboardModerationService(t mockConstructorTestingTNewMockLeaderboardModerationService) *MockLeaderboardModerationService {
+	mock := &MockLeaderboardModerationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
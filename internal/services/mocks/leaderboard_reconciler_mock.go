@@ -0,0 +1,123 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	services "game/internal/services"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLeaderboardReconciler is an autogenerated mock type for the LeaderboardReconciler type
+type MockLeaderboardReconciler struct {
+	mock.Mock
+}
+
+type MockLeaderboardReconciler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaderboardReconciler) EXPECT() *MockLeaderboardReconciler_Expecter {
+	return &MockLeaderboardReconciler_Expecter{mock: &_m.Mock}
+}
+
+// Reconcile provides a mock function with given fields: ctx
+func (_m *MockLeaderboardReconciler) Reconcile(ctx context.Context) (services.ReconcileResult, error) {
+	ret := _m.Called(ctx)
+
+	var r0 services.ReconcileResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (services.ReconcileResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) services.ReconcileResult); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(services.ReconcileResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLeaderboardReconciler_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type MockLeaderboardReconciler_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLeaderboardReconciler_Expecter) Reconcile(ctx interface{}) *MockLeaderboardReconciler_Reconcile_Call {
+	return &MockLeaderboardReconciler_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx)}
+}
+
+func (_c *MockLeaderboardReconciler_Reconcile_Call) Run(run func(ctx context.Context)) *MockLeaderboardReconciler_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardReconciler_Reconcile_Call) Return(_a0 services.ReconcileResult, _a1 error) *MockLeaderboardReconciler_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLeaderboardReconciler_Reconcile_Call) RunAndReturn(run func(context.Context) (services.ReconcileResult, error)) *MockLeaderboardReconciler_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockLeaderboardReconciler) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockLeaderboardReconciler_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockLeaderboardReconciler_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLeaderboardReconciler_Expecter) Run(ctx interface{}) *MockLeaderboardReconciler_Run_Call {
+	return &MockLeaderboardReconciler_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockLeaderboardReconciler_Run_Call) Run(run func(ctx context.Context)) *MockLeaderboardReconciler_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardReconciler_Run_Call) Return() *MockLeaderboardReconciler_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockLeaderboardReconciler_Run_Call) RunAndReturn(run func(context.Context)) *MockLeaderboardReconciler_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockLeaderboardReconciler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockLeaderboardReconciler creates a new instance of MockLeaderboardReconciler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockLeaderboardReconciler(t mockConstructorTestingTNewMockLeaderboardReconciler) *MockLeaderboardReconciler {
+	mock := &MockLeaderboardReconciler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
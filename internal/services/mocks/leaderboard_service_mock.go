@@ -22,6 +22,59 @@ func (_m *MockLeaderboardService) EXPECT() *MockLeaderboardService_Expecter {
 	return &MockLeaderboardService_Expecter{mock: &_m.Mock}
 }
 
+// GetCountryLeaderboard provides a mock function with given fields: ctx, countryCode
+func (_m *MockLeaderboardService) GetCountryLeaderboard(ctx context.Context, countryCode string) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, countryCode)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Leaderboard, error)); ok {
+		return rf(ctx, countryCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Leaderboard); ok {
+		r0 = rf(ctx, countryCode)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, countryCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLeaderboardService_GetCountryLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountryLeaderboard'
+type MockLeaderboardService_GetCountryLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetCountryLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - countryCode string
+func (_e *MockLeaderboardService_Expecter) GetCountryLeaderboard(ctx interface{}, countryCode interface{}) *MockLeaderboardService_GetCountryLeaderboard_Call {
+	return &MockLeaderboardService_GetCountryLeaderboard_Call{Call: _e.mock.On("GetCountryLeaderboard", ctx, countryCode)}
+}
+
+func (_c *MockLeaderboardService_GetCountryLeaderboard_Call) Run(run func(ctx context.Context, countryCode string)) *MockLeaderboardService_GetCountryLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetCountryLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockLeaderboardService_GetCountryLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetCountryLeaderboard_Call) RunAndReturn(run func(context.Context, string) (domain.Leaderboard, error)) *MockLeaderboardService_GetCountryLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLeaderboard provides a mock function with given fields: ctx
 func (_m *MockLeaderboardService) GetLeaderboard(ctx context.Context) (domain.Leaderboard, error) {
 	ret := _m.Called(ctx)
@@ -74,13 +127,120 @@ func (_c *MockLeaderboardService_GetLeaderboard_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// SubmitUserScore provides a mock function with given fields: ctx, userID, score
-func (_m *MockLeaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64) error {
-	ret := _m.Called(ctx, userID, score)
+// GetTopLeaderboard provides a mock function with given fields: ctx, count
+func (_m *MockLeaderboardService) GetTopLeaderboard(ctx context.Context, count int64) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, count)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Leaderboard, error)); ok {
+		return rf(ctx, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Leaderboard); ok {
+		r0 = rf(ctx, count)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLeaderboardService_GetTopLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopLeaderboard'
+type MockLeaderboardService_GetTopLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetTopLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - count int64
+func (_e *MockLeaderboardService_Expecter) GetTopLeaderboard(ctx interface{}, count interface{}) *MockLeaderboardService_GetTopLeaderboard_Call {
+	return &MockLeaderboardService_GetTopLeaderboard_Call{Call: _e.mock.On("GetTopLeaderboard", ctx, count)}
+}
+
+func (_c *MockLeaderboardService_GetTopLeaderboard_Call) Run(run func(ctx context.Context, count int64)) *MockLeaderboardService_GetTopLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetTopLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockLeaderboardService_GetTopLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetTopLeaderboard_Call) RunAndReturn(run func(context.Context, int64) (domain.Leaderboard, error)) *MockLeaderboardService_GetTopLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRank provides a mock function with given fields: ctx, userID, count
+func (_m *MockLeaderboardService) GetUserRank(ctx context.Context, userID string, count int64) (domain.UserRank, error) {
+	ret := _m.Called(ctx, userID, count)
+
+	var r0 domain.UserRank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (domain.UserRank, error)); ok {
+		return rf(ctx, userID, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) domain.UserRank); ok {
+		r0 = rf(ctx, userID, count)
+	} else {
+		r0 = ret.Get(0).(domain.UserRank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, userID, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLeaderboardService_GetUserRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRank'
+type MockLeaderboardService_GetUserRank_Call struct {
+	*mock.Call
+}
+
+// GetUserRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - count int64
+func (_e *MockLeaderboardService_Expecter) GetUserRank(ctx interface{}, userID interface{}, count interface{}) *MockLeaderboardService_GetUserRank_Call {
+	return &MockLeaderboardService_GetUserRank_Call{Call: _e.mock.On("GetUserRank", ctx, userID, count)}
+}
+
+func (_c *MockLeaderboardService_GetUserRank_Call) Run(run func(ctx context.Context, userID string, count int64)) *MockLeaderboardService_GetUserRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetUserRank_Call) Return(_a0 domain.UserRank, _a1 error) *MockLeaderboardService_GetUserRank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetUserRank_Call) RunAndReturn(run func(context.Context, string, int64) (domain.UserRank, error)) *MockLeaderboardService_GetUserRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitUserScore provides a mock function with given fields: ctx, userID, score, eventID, mode
+func (_m *MockLeaderboardService) SubmitUserScore(ctx context.Context, userID string, score float64, eventID string, mode string) error {
+	ret := _m.Called(ctx, userID, score, eventID, mode)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
-		r0 = rf(ctx, userID, score)
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, string, string) error); ok {
+		r0 = rf(ctx, userID, score, eventID, mode)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -97,13 +257,15 @@ type MockLeaderboardService_SubmitUserScore_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - score float64
-func (_e *MockLeaderboardService_Expecter) SubmitUserScore(ctx interface{}, userID interface{}, score interface{}) *MockLeaderboardService_SubmitUserScore_Call {
-	return &MockLeaderboardService_SubmitUserScore_Call{Call: _e.mock.On("SubmitUserScore", ctx, userID, score)}
+//   - eventID string
+//   - mode string
+func (_e *MockLeaderboardService_Expecter) SubmitUserScore(ctx interface{}, userID interface{}, score interface{}, eventID interface{}, mode interface{}) *MockLeaderboardService_SubmitUserScore_Call {
+	return &MockLeaderboardService_SubmitUserScore_Call{Call: _e.mock.On("SubmitUserScore", ctx, userID, score, eventID, mode)}
 }
 
-func (_c *MockLeaderboardService_SubmitUserScore_Call) Run(run func(ctx context.Context, userID string, score float64)) *MockLeaderboardService_SubmitUserScore_Call {
+func (_c *MockLeaderboardService_SubmitUserScore_Call) Run(run func(ctx context.Context, userID string, score float64, eventID string, mode string)) *MockLeaderboardService_SubmitUserScore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -113,7 +275,50 @@ func (_c *MockLeaderboardService_SubmitUserScore_Call) Return(_a0 error) *MockLe
 	return _c
 }
 
-func (_c *MockLeaderboardService_SubmitUserScore_Call) RunAndReturn(run func(context.Context, string, float64) error) *MockLeaderboardService_SubmitUserScore_Call {
+func (_c *MockLeaderboardService_SubmitUserScore_Call) RunAndReturn(run func(context.Context, string, float64, string, string) error) *MockLeaderboardService_SubmitUserScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitVerifiedScore provides a mock function with given fields: ctx, score
+func (_m *MockLeaderboardService) SubmitVerifiedScore(ctx context.Context, score domain.SignedScore) error {
+	ret := _m.Called(ctx, score)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SignedScore) error); ok {
+		r0 = rf(ctx, score)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLeaderboardService_SubmitVerifiedScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitVerifiedScore'
+type MockLeaderboardService_SubmitVerifiedScore_Call struct {
+	*mock.Call
+}
+
+// SubmitVerifiedScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - score domain.SignedScore
+func (_e *MockLeaderboardService_Expecter) SubmitVerifiedScore(ctx interface{}, score interface{}) *MockLeaderboardService_SubmitVerifiedScore_Call {
+	return &MockLeaderboardService_SubmitVerifiedScore_Call{Call: _e.mock.On("SubmitVerifiedScore", ctx, score)}
+}
+
+func (_c *MockLeaderboardService_SubmitVerifiedScore_Call) Run(run func(ctx context.Context, score domain.SignedScore)) *MockLeaderboardService_SubmitVerifiedScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SignedScore))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardService_SubmitVerifiedScore_Call) Return(_a0 error) *MockLeaderboardService_SubmitVerifiedScore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLeaderboardService_SubmitVerifiedScore_Call) RunAndReturn(run func(context.Context, domain.SignedScore) error) *MockLeaderboardService_SubmitVerifiedScore_Call {
 	_c.Call.Return(run)
 	return _c
 }
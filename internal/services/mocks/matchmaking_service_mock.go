@@ -0,0 +1,227 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	ed25519 "crypto/ed25519"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockMatchmakingService is an autogenerated mock type for the MatchmakingService type
+type MockMatchmakingService struct {
+	mock.Mock
+}
+
+type MockMatchmakingService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMatchmakingService) EXPECT() *MockMatchmakingService_Expecter {
+	return &MockMatchmakingService_Expecter{mock: &_m.Mock}
+}
+
+// GetTicketPublicKey provides a mock function with given fields: ctx
+func (_m *MockMatchmakingService) GetTicketPublicKey(ctx context.Context) ed25519.PublicKey {
+	ret := _m.Called(ctx)
+
+	var r0 ed25519.PublicKey
+	if rf, ok := ret.Get(0).(func(context.Context) ed25519.PublicKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ed25519.PublicKey)
+		}
+	}
+
+	return r0
+}
+
+// MockMatchmakingService_GetTicketPublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTicketPublicKey'
+type MockMatchmakingService_GetTicketPublicKey_Call struct {
+	*mock.Call
+}
+
+// GetTicketPublicKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMatchmakingService_Expecter) GetTicketPublicKey(ctx interface{}) *MockMatchmakingService_GetTicketPublicKey_Call {
+	return &MockMatchmakingService_GetTicketPublicKey_Call{Call: _e.mock.On("GetTicketPublicKey", ctx)}
+}
+
+func (_c *MockMatchmakingService_GetTicketPublicKey_Call) Run(run func(ctx context.Context)) *MockMatchmakingService_GetTicketPublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingService_GetTicketPublicKey_Call) Return(_a0 ed25519.PublicKey) *MockMatchmakingService_GetTicketPublicKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchmakingService_GetTicketPublicKey_Call) RunAndReturn(run func(context.Context) ed25519.PublicKey) *MockMatchmakingService_GetTicketPublicKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinQueue provides a mock function with given fields: ctx, userID, queue
+func (_m *MockMatchmakingService) JoinQueue(ctx context.Context, userID string, queue domain.MatchQueue) (domain.QueueEntry, error) {
+	ret := _m.Called(ctx, userID, queue)
+
+	var r0 domain.QueueEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.MatchQueue) (domain.QueueEntry, error)); ok {
+		return rf(ctx, userID, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.MatchQueue) domain.QueueEntry); ok {
+		r0 = rf(ctx, userID, queue)
+	} else {
+		r0 = ret.Get(0).(domain.QueueEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.MatchQueue) error); ok {
+		r1 = rf(ctx, userID, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchmakingService_JoinQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinQueue'
+type MockMatchmakingService_JoinQueue_Call struct {
+	*mock.Call
+}
+
+// JoinQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - queue domain.MatchQueue
+func (_e *MockMatchmakingService_Expecter) JoinQueue(ctx interface{}, userID interface{}, queue interface{}) *MockMatchmakingService_JoinQueue_Call {
+	return &MockMatchmakingService_JoinQueue_Call{Call: _e.mock.On("JoinQueue", ctx, userID, queue)}
+}
+
+func (_c *MockMatchmakingService_JoinQueue_Call) Run(run func(ctx context.Context, userID string, queue domain.MatchQueue)) *MockMatchmakingService_JoinQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.MatchQueue))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingService_JoinQueue_Call) Return(_a0 domain.QueueEntry, _a1 error) *MockMatchmakingService_JoinQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchmakingService_JoinQueue_Call) RunAndReturn(run func(context.Context, string, domain.MatchQueue) (domain.QueueEntry, error)) *MockMatchmakingService_JoinQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveQueue provides a mock function with given fields: ctx, userID
+func (_m *MockMatchmakingService) LeaveQueue(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchmakingService_LeaveQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveQueue'
+type MockMatchmakingService_LeaveQueue_Call struct {
+	*mock.Call
+}
+
+// LeaveQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchmakingService_Expecter) LeaveQueue(ctx interface{}, userID interface{}) *MockMatchmakingService_LeaveQueue_Call {
+	return &MockMatchmakingService_LeaveQueue_Call{Call: _e.mock.On("LeaveQueue", ctx, userID)}
+}
+
+func (_c *MockMatchmakingService_LeaveQueue_Call) Run(run func(ctx context.Context, userID string)) *MockMatchmakingService_LeaveQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingService_LeaveQueue_Call) Return(_a0 error) *MockMatchmakingService_LeaveQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchmakingService_LeaveQueue_Call) RunAndReturn(run func(context.Context, string) error) *MockMatchmakingService_LeaveQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForMatch provides a mock function with given fields: ctx, userID, send
+func (_m *MockMatchmakingService) WaitForMatch(ctx context.Context, userID string, send func(services.MatchmakingUpdate) error) error {
+	ret := _m.Called(ctx, userID, send)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(services.MatchmakingUpdate) error) error); ok {
+		r0 = rf(ctx, userID, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchmakingService_WaitForMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForMatch'
+type MockMatchmakingService_WaitForMatch_Call struct {
+	*mock.Call
+}
+
+// WaitForMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - send func(services.MatchmakingUpdate) error
+func (_e *MockMatchmakingService_Expecter) WaitForMatch(ctx interface{}, userID interface{}, send interface{}) *MockMatchmakingService_WaitForMatch_Call {
+	return &MockMatchmakingService_WaitForMatch_Call{Call: _e.mock.On("WaitForMatch", ctx, userID, send)}
+}
+
+func (_c *MockMatchmakingService_WaitForMatch_Call) Run(run func(ctx context.Context, userID string, send func(services.MatchmakingUpdate) error)) *MockMatchmakingService_WaitForMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(func(services.MatchmakingUpdate) error))
+	})
+	return _c
+}
+
+func (_c *MockMatchmakingService_WaitForMatch_Call) Return(_a0 error) *MockMatchmakingService_WaitForMatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchmakingService_WaitForMatch_Call) RunAndReturn(run func(context.Context, string, func(services.MatchmakingUpdate) error) error) *MockMatchmakingService_WaitForMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockMatchmakingService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockMatchmakingService creates a new instance of MockMatchmakingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockMatchmakingService(t mockConstructorTestingTNewMockMatchmakingService) *MockMatchmakingService {
+	mock := &MockMatchmakingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
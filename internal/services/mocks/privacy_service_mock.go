@@ -0,0 +1,203 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockPrivacyService is an autogenerated mock type for the PrivacyService type
+type MockPrivacyService struct {
+	mock.Mock
+}
+
+type MockPrivacyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrivacyService) EXPECT() *MockPrivacyService_Expecter {
+	return &MockPrivacyService_Expecter{mock: &_m.Mock}
+}
+
+// EraseUserData provides a mock function with given fields: ctx, userID, requestedBy
+func (_m *MockPrivacyService) EraseUserData(ctx context.Context, userID string, requestedBy string) (domain.ErasureRecord, error) {
+	ret := _m.Called(ctx, userID, requestedBy)
+
+	var r0 domain.ErasureRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.ErasureRecord, error)); ok {
+		return rf(ctx, userID, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.ErasureRecord); ok {
+		r0 = rf(ctx, userID, requestedBy)
+	} else {
+		r0 = ret.Get(0).(domain.ErasureRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPrivacyService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type MockPrivacyService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - requestedBy string
+func (_e *MockPrivacyService_Expecter) EraseUserData(ctx interface{}, userID interface{}, requestedBy interface{}) *MockPrivacyService_EraseUserData_Call {
+	return &MockPrivacyService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", ctx, userID, requestedBy)}
+}
+
+func (_c *MockPrivacyService_EraseUserData_Call) Run(run func(ctx context.Context, userID string, requestedBy string)) *MockPrivacyService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPrivacyService_EraseUserData_Call) Return(_a0 domain.ErasureRecord, _a1 error) *MockPrivacyService_EraseUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPrivacyService_EraseUserData_Call) RunAndReturn(run func(context.Context, string, string) (domain.ErasureRecord, error)) *MockPrivacyService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: ctx, userID, requestedBy
+func (_m *MockPrivacyService) ExportUserData(ctx context.Context, userID string, requestedBy string) ([]byte, error) {
+	ret := _m.Called(ctx, userID, requestedBy)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return rf(ctx, userID, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, userID, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPrivacyService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type MockPrivacyService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - requestedBy string
+func (_e *MockPrivacyService_Expecter) ExportUserData(ctx interface{}, userID interface{}, requestedBy interface{}) *MockPrivacyService_ExportUserData_Call {
+	return &MockPrivacyService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", ctx, userID, requestedBy)}
+}
+
+func (_c *MockPrivacyService_ExportUserData_Call) Run(run func(ctx context.Context, userID string, requestedBy string)) *MockPrivacyService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPrivacyService_ExportUserData_Call) Return(_a0 []byte, _a1 error) *MockPrivacyService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPrivacyService_ExportUserData_Call) RunAndReturn(run func(context.Context, string, string) ([]byte, error)) *MockPrivacyService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyErasureRecord provides a mock function with given fields: ctx, id
+func (_m *MockPrivacyService) VerifyErasureRecord(ctx context.Context, id string) (services.ErasureVerification, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 services.ErasureVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (services.ErasureVerification, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) services.ErasureVerification); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(services.ErasureVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPrivacyService_VerifyErasureRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyErasureRecord'
+type MockPrivacyService_VerifyErasureRecord_Call struct {
+	*mock.Call
+}
+
+// VerifyErasureRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockPrivacyService_Expecter) VerifyErasureRecord(ctx interface{}, id interface{}) *MockPrivacyService_VerifyErasureRecord_Call {
+	return &MockPrivacyService_VerifyErasureRecord_Call{Call: _e.mock.On("VerifyErasureRecord", ctx, id)}
+}
+
+func (_c *MockPrivacyService_VerifyErasureRecord_Call) Run(run func(ctx context.Context, id string)) *MockPrivacyService_VerifyErasureRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPrivacyService_VerifyErasureRecord_Call) Return(_a0 services.ErasureVerification, _a1 error) *MockPrivacyService_VerifyErasureRecord_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPrivacyService_VerifyErasureRecord_Call) RunAndReturn(run func(context.Context, string) (services.ErasureVerification, error)) *MockPrivacyService_VerifyErasureRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockPrivacyService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockPrivacyService creates a new instance of MockPrivacyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockPrivacyService(t mockConstructorTestingTNewMockPrivacyService) *MockPrivacyService {
+	mock := &MockPrivacyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,204 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockRatingService is an autogenerated mock type for the RatingService type
+type MockRatingService struct {
+	mock.Mock
+}
+
+type MockRatingService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRatingService) EXPECT() *MockRatingService_Expecter {
+	return &MockRatingService_Expecter{mock: &_m.Mock}
+}
+
+// GetRatingLeaderboard provides a mock function with given fields: ctx, mode
+func (_m *MockRatingService) GetRatingLeaderboard(ctx context.Context, mode string) ([]domain.RatedUserScore, error) {
+	ret := _m.Called(ctx, mode)
+
+	var r0 []domain.RatedUserScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.RatedUserScore, error)); ok {
+		return rf(ctx, mode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.RatedUserScore); ok {
+		r0 = rf(ctx, mode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RatedUserScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingService_GetRatingLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatingLeaderboard'
+type MockRatingService_GetRatingLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetRatingLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mode string
+func (_e *MockRatingService_Expecter) GetRatingLeaderboard(ctx interface{}, mode interface{}) *MockRatingService_GetRatingLeaderboard_Call {
+	return &MockRatingService_GetRatingLeaderboard_Call{Call: _e.mock.On("GetRatingLeaderboard", ctx, mode)}
+}
+
+func (_c *MockRatingService_GetRatingLeaderboard_Call) Run(run func(ctx context.Context, mode string)) *MockRatingService_GetRatingLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRatingService_GetRatingLeaderboard_Call) Return(_a0 []domain.RatedUserScore, _a1 error) *MockRatingService_GetRatingLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingService_GetRatingLeaderboard_Call) RunAndReturn(run func(context.Context, string) ([]domain.RatedUserScore, error)) *MockRatingService_GetRatingLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRating provides a mock function with given fields: ctx, mode, userID
+func (_m *MockRatingService) GetUserRating(ctx context.Context, mode string, userID string) (services.UserRating, error) {
+	ret := _m.Called(ctx, mode, userID)
+
+	var r0 services.UserRating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (services.UserRating, error)); ok {
+		return rf(ctx, mode, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) services.UserRating); ok {
+		r0 = rf(ctx, mode, userID)
+	} else {
+		r0 = ret.Get(0).(services.UserRating)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, mode, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingService_GetUserRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRating'
+type MockRatingService_GetUserRating_Call struct {
+	*mock.Call
+}
+
+// GetUserRating is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mode string
+//   - userID string
+func (_e *MockRatingService_Expecter) GetUserRating(ctx interface{}, mode interface{}, userID interface{}) *MockRatingService_GetUserRating_Call {
+	return &MockRatingService_GetUserRating_Call{Call: _e.mock.On("GetUserRating", ctx, mode, userID)}
+}
+
+func (_c *MockRatingService_GetUserRating_Call) Run(run func(ctx context.Context, mode string, userID string)) *MockRatingService_GetUserRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRatingService_GetUserRating_Call) Return(_a0 services.UserRating, _a1 error) *MockRatingService_GetUserRating_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingService_GetUserRating_Call) RunAndReturn(run func(context.Context, string, string) (services.UserRating, error)) *MockRatingService_GetUserRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportMatchResult provides a mock function with given fields: ctx, result
+func (_m *MockRatingService) ReportMatchResult(ctx context.Context, result domain.MatchResult) ([]domain.Rating, error) {
+	ret := _m.Called(ctx, result)
+
+	var r0 []domain.Rating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchResult) ([]domain.Rating, error)); ok {
+		return rf(ctx, result)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MatchResult) []domain.Rating); ok {
+		r0 = rf(ctx, result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Rating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MatchResult) error); ok {
+		r1 = rf(ctx, result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRatingService_ReportMatchResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportMatchResult'
+type MockRatingService_ReportMatchResult_Call struct {
+	*mock.Call
+}
+
+// ReportMatchResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - result domain.MatchResult
+func (_e *MockRatingService_Expecter) ReportMatchResult(ctx interface{}, result interface{}) *MockRatingService_ReportMatchResult_Call {
+	return &MockRatingService_ReportMatchResult_Call{Call: _e.mock.On("ReportMatchResult", ctx, result)}
+}
+
+func (_c *MockRatingService_ReportMatchResult_Call) Run(run func(ctx context.Context, result domain.MatchResult)) *MockRatingService_ReportMatchResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MatchResult))
+	})
+	return _c
+}
+
+func (_c *MockRatingService_ReportMatchResult_Call) Return(_a0 []domain.Rating, _a1 error) *MockRatingService_ReportMatchResult_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRatingService_ReportMatchResult_Call) RunAndReturn(run func(context.Context, domain.MatchResult) ([]domain.Rating, error)) *MockRatingService_ReportMatchResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRatingService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRatingService creates a new instance of MockRatingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRatingService(t mockConstructorTestingTNewMockRatingService) *MockRatingService {
+	mock := &MockRatingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockRewardService is an autogenerated mock type for the RewardService type
+type MockRewardService struct {
+	mock.Mock
+}
+
+type MockRewardService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRewardService) EXPECT() *MockRewardService_Expecter {
+	return &MockRewardService_Expecter{mock: &_m.Mock}
+}
+
+// ClaimRewards provides a mock function with given fields: ctx, userID
+func (_m *MockRewardService) ClaimRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.RewardGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.RewardGrant, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.RewardGrant); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RewardGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardService_ClaimRewards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimRewards'
+type MockRewardService_ClaimRewards_Call struct {
+	*mock.Call
+}
+
+// ClaimRewards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRewardService_Expecter) ClaimRewards(ctx interface{}, userID interface{}) *MockRewardService_ClaimRewards_Call {
+	return &MockRewardService_ClaimRewards_Call{Call: _e.mock.On("ClaimRewards", ctx, userID)}
+}
+
+func (_c *MockRewardService_ClaimRewards_Call) Run(run func(ctx context.Context, userID string)) *MockRewardService_ClaimRewards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardService_ClaimRewards_Call) Return(_a0 []domain.RewardGrant, _a1 error) *MockRewardService_ClaimRewards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardService_ClaimRewards_Call) RunAndReturn(run func(context.Context, string) ([]domain.RewardGrant, error)) *MockRewardService_ClaimRewards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndSeason provides a mock function with given fields: ctx, seasonID
+func (_m *MockRewardService) EndSeason(ctx context.Context, seasonID string) (services.SeasonResult, error) {
+	ret := _m.Called(ctx, seasonID)
+
+	var r0 services.SeasonResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (services.SeasonResult, error)); ok {
+		return rf(ctx, seasonID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) services.SeasonResult); ok {
+		r0 = rf(ctx, seasonID)
+	} else {
+		r0 = ret.Get(0).(services.SeasonResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, seasonID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardService_EndSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndSeason'
+type MockRewardService_EndSeason_Call struct {
+	*mock.Call
+}
+
+// EndSeason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seasonID string
+func (_e *MockRewardService_Expecter) EndSeason(ctx interface{}, seasonID interface{}) *MockRewardService_EndSeason_Call {
+	return &MockRewardService_EndSeason_Call{Call: _e.mock.On("EndSeason", ctx, seasonID)}
+}
+
+func (_c *MockRewardService_EndSeason_Call) Run(run func(ctx context.Context, seasonID string)) *MockRewardService_EndSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardService_EndSeason_Call) Return(_a0 services.SeasonResult, _a1 error) *MockRewardService_EndSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardService_EndSeason_Call) RunAndReturn(run func(context.Context, string) (services.SeasonResult, error)) *MockRewardService_EndSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRewards provides a mock function with given fields: ctx, userID
+func (_m *MockRewardService) ListRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.RewardGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.RewardGrant, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.RewardGrant); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RewardGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardService_ListRewards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRewards'
+type MockRewardService_ListRewards_Call struct {
+	*mock.Call
+}
+
+// ListRewards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRewardService_Expecter) ListRewards(ctx interface{}, userID interface{}) *MockRewardService_ListRewards_Call {
+	return &MockRewardService_ListRewards_Call{Call: _e.mock.On("ListRewards", ctx, userID)}
+}
+
+func (_c *MockRewardService_ListRewards_Call) Run(run func(ctx context.Context, userID string)) *MockRewardService_ListRewards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardService_ListRewards_Call) Return(_a0 []domain.RewardGrant, _a1 error) *MockRewardService_ListRewards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardService_ListRewards_Call) RunAndReturn(run func(context.Context, string) ([]domain.RewardGrant, error)) *MockRewardService_ListRewards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRewardService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRewardService creates a new instance of MockRewardService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRewardService(t mockConstructorTestingTNewMockRewardService) *MockRewardService {
+	mock := &MockRewardService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,477 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "game/internal/services"
+)
+
+// MockSocialService is an autogenerated mock type for the SocialService type
+type MockSocialService struct {
+	mock.Mock
+}
+
+type MockSocialService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSocialService) EXPECT() *MockSocialService_Expecter {
+	return &MockSocialService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptFriendRequest provides a mock function with given fields: ctx, userID, requesterID
+func (_m *MockSocialService) AcceptFriendRequest(ctx context.Context, userID string, requesterID string) error {
+	ret := _m.Called(ctx, userID, requesterID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, requesterID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSocialService_AcceptFriendRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptFriendRequest'
+type MockSocialService_AcceptFriendRequest_Call struct {
+	*mock.Call
+}
+
+// AcceptFriendRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - requesterID string
+func (_e *MockSocialService_Expecter) AcceptFriendRequest(ctx interface{}, userID interface{}, requesterID interface{}) *MockSocialService_AcceptFriendRequest_Call {
+	return &MockSocialService_AcceptFriendRequest_Call{Call: _e.mock.On("AcceptFriendRequest", ctx, userID, requesterID)}
+}
+
+func (_c *MockSocialService_AcceptFriendRequest_Call) Run(run func(ctx context.Context, userID string, requesterID string)) *MockSocialService_AcceptFriendRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_AcceptFriendRequest_Call) Return(_a0 error) *MockSocialService_AcceptFriendRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSocialService_AcceptFriendRequest_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSocialService_AcceptFriendRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockUser provides a mock function with given fields: ctx, userID, blockedUserID
+func (_m *MockSocialService) BlockUser(ctx context.Context, userID string, blockedUserID string) error {
+	ret := _m.Called(ctx, userID, blockedUserID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, blockedUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSocialService_BlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUser'
+type MockSocialService_BlockUser_Call struct {
+	*mock.Call
+}
+
+// BlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - blockedUserID string
+func (_e *MockSocialService_Expecter) BlockUser(ctx interface{}, userID interface{}, blockedUserID interface{}) *MockSocialService_BlockUser_Call {
+	return &MockSocialService_BlockUser_Call{Call: _e.mock.On("BlockUser", ctx, userID, blockedUserID)}
+}
+
+func (_c *MockSocialService_BlockUser_Call) Run(run func(ctx context.Context, userID string, blockedUserID string)) *MockSocialService_BlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_BlockUser_Call) Return(_a0 error) *MockSocialService_BlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSocialService_BlockUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSocialService_BlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeclineFriendRequest provides a mock function with given fields: ctx, userID, requesterID
+func (_m *MockSocialService) DeclineFriendRequest(ctx context.Context, userID string, requesterID string) error {
+	ret := _m.Called(ctx, userID, requesterID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, requesterID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSocialService_DeclineFriendRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineFriendRequest'
+type MockSocialService_DeclineFriendRequest_Call struct {
+	*mock.Call
+}
+
+// DeclineFriendRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - requesterID string
+func (_e *MockSocialService_Expecter) DeclineFriendRequest(ctx interface{}, userID interface{}, requesterID interface{}) *MockSocialService_DeclineFriendRequest_Call {
+	return &MockSocialService_DeclineFriendRequest_Call{Call: _e.mock.On("DeclineFriendRequest", ctx, userID, requesterID)}
+}
+
+func (_c *MockSocialService_DeclineFriendRequest_Call) Run(run func(ctx context.Context, userID string, requesterID string)) *MockSocialService_DeclineFriendRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_DeclineFriendRequest_Call) Return(_a0 error) *MockSocialService_DeclineFriendRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSocialService_DeclineFriendRequest_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSocialService_DeclineFriendRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFriendsLeaderboard provides a mock function with given fields: ctx, userID
+func (_m *MockSocialService) GetFriendsLeaderboard(ctx context.Context, userID string) (domain.Leaderboard, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Leaderboard, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Leaderboard); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Leaderboard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSocialService_GetFriendsLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFriendsLeaderboard'
+type MockSocialService_GetFriendsLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetFriendsLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockSocialService_Expecter) GetFriendsLeaderboard(ctx interface{}, userID interface{}) *MockSocialService_GetFriendsLeaderboard_Call {
+	return &MockSocialService_GetFriendsLeaderboard_Call{Call: _e.mock.On("GetFriendsLeaderboard", ctx, userID)}
+}
+
+func (_c *MockSocialService_GetFriendsLeaderboard_Call) Run(run func(ctx context.Context, userID string)) *MockSocialService_GetFriendsLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_GetFriendsLeaderboard_Call) Return(_a0 domain.Leaderboard, _a1 error) *MockSocialService_GetFriendsLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSocialService_GetFriendsLeaderboard_Call) RunAndReturn(run func(context.Context, string) (domain.Leaderboard, error)) *MockSocialService_GetFriendsLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFriendRequests provides a mock function with given fields: ctx, userID
+func (_m *MockSocialService) ListFriendRequests(ctx context.Context, userID string) ([]services.Friend, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []services.Friend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]services.Friend, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []services.Friend); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]services.Friend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSocialService_ListFriendRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFriendRequests'
+type MockSocialService_ListFriendRequests_Call struct {
+	*mock.Call
+}
+
+// ListFriendRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockSocialService_Expecter) ListFriendRequests(ctx interface{}, userID interface{}) *MockSocialService_ListFriendRequests_Call {
+	return &MockSocialService_ListFriendRequests_Call{Call: _e.mock.On("ListFriendRequests", ctx, userID)}
+}
+
+func (_c *MockSocialService_ListFriendRequests_Call) Run(run func(ctx context.Context, userID string)) *MockSocialService_ListFriendRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_ListFriendRequests_Call) Return(_a0 []services.Friend, _a1 error) *MockSocialService_ListFriendRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSocialService_ListFriendRequests_Call) RunAndReturn(run func(context.Context, string) ([]services.Friend, error)) *MockSocialService_ListFriendRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFriends provides a mock function with given fields: ctx, userID
+func (_m *MockSocialService) ListFriends(ctx context.Context, userID string) ([]services.Friend, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []services.Friend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]services.Friend, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []services.Friend); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]services.Friend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSocialService_ListFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFriends'
+type MockSocialService_ListFriends_Call struct {
+	*mock.Call
+}
+
+// ListFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockSocialService_Expecter) ListFriends(ctx interface{}, userID interface{}) *MockSocialService_ListFriends_Call {
+	return &MockSocialService_ListFriends_Call{Call: _e.mock.On("ListFriends", ctx, userID)}
+}
+
+func (_c *MockSocialService_ListFriends_Call) Run(run func(ctx context.Context, userID string)) *MockSocialService_ListFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_ListFriends_Call) Return(_a0 []services.Friend, _a1 error) *MockSocialService_ListFriends_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSocialService_ListFriends_Call) RunAndReturn(run func(context.Context, string) ([]services.Friend, error)) *MockSocialService_ListFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFriend provides a mock function with given fields: ctx, userID, friendID
+func (_m *MockSocialService) RemoveFriend(ctx context.Context, userID string, friendID string) error {
+	ret := _m.Called(ctx, userID, friendID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, friendID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSocialService_RemoveFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFriend'
+type MockSocialService_RemoveFriend_Call struct {
+	*mock.Call
+}
+
+// RemoveFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - friendID string
+func (_e *MockSocialService_Expecter) RemoveFriend(ctx interface{}, userID interface{}, friendID interface{}) *MockSocialService_RemoveFriend_Call {
+	return &MockSocialService_RemoveFriend_Call{Call: _e.mock.On("RemoveFriend", ctx, userID, friendID)}
+}
+
+func (_c *MockSocialService_RemoveFriend_Call) Run(run func(ctx context.Context, userID string, friendID string)) *MockSocialService_RemoveFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_RemoveFriend_Call) Return(_a0 error) *MockSocialService_RemoveFriend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSocialService_RemoveFriend_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSocialService_RemoveFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendFriendRequest provides a mock function with given fields: ctx, userID, friendID
+func (_m *MockSocialService) SendFriendRequest(ctx context.Context, userID string, friendID string) (domain.Friendship, error) {
+	ret := _m.Called(ctx, userID, friendID)
+
+	var r0 domain.Friendship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.Friendship, error)); ok {
+		return rf(ctx, userID, friendID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.Friendship); ok {
+		r0 = rf(ctx, userID, friendID)
+	} else {
+		r0 = ret.Get(0).(domain.Friendship)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, friendID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSocialService_SendFriendRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendFriendRequest'
+type MockSocialService_SendFriendRequest_Call struct {
+	*mock.Call
+}
+
+// SendFriendRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - friendID string
+func (_e *MockSocialService_Expecter) SendFriendRequest(ctx interface{}, userID interface{}, friendID interface{}) *MockSocialService_SendFriendRequest_Call {
+	return &MockSocialService_SendFriendRequest_Call{Call: _e.mock.On("SendFriendRequest", ctx, userID, friendID)}
+}
+
+func (_c *MockSocialService_SendFriendRequest_Call) Run(run func(ctx context.Context, userID string, friendID string)) *MockSocialService_SendFriendRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_SendFriendRequest_Call) Return(_a0 domain.Friendship, _a1 error) *MockSocialService_SendFriendRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSocialService_SendFriendRequest_Call) RunAndReturn(run func(context.Context, string, string) (domain.Friendship, error)) *MockSocialService_SendFriendRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnblockUser provides a mock function with given fields: ctx, userID, blockedUserID
+func (_m *MockSocialService) UnblockUser(ctx context.Context, userID string, blockedUserID string) error {
+	ret := _m.Called(ctx, userID, blockedUserID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, blockedUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSocialService_UnblockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockUser'
+type MockSocialService_UnblockUser_Call struct {
+	*mock.Call
+}
+
+// UnblockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - blockedUserID string
+func (_e *MockSocialService_Expecter) UnblockUser(ctx interface{}, userID interface{}, blockedUserID interface{}) *MockSocialService_UnblockUser_Call {
+	return &MockSocialService_UnblockUser_Call{Call: _e.mock.On("UnblockUser", ctx, userID, blockedUserID)}
+}
+
+func (_c *MockSocialService_UnblockUser_Call) Run(run func(ctx context.Context, userID string, blockedUserID string)) *MockSocialService_UnblockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSocialService_UnblockUser_Call) Return(_a0 error) *MockSocialService_UnblockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSocialService_UnblockUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockSocialService_UnblockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockSocialService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockSocialService creates a new instance of MockSocialService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockSocialService(t mockConstructorTestingTNewMockSocialService) *MockSocialService {
+	mock := &MockSocialService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
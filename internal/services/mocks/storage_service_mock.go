@@ -0,0 +1,251 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStorageService is an autogenerated mock type for the StorageService type
+type MockStorageService struct {
+	mock.Mock
+}
+
+type MockStorageService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStorageService) EXPECT() *MockStorageService_Expecter {
+	return &MockStorageService_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID, namespace, key, version
+func (_m *MockStorageService) Delete(ctx context.Context, userID string, namespace string, key string, version int64) error {
+	ret := _m.Called(ctx, userID, namespace, key, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64) error); ok {
+		r0 = rf(ctx, userID, namespace, key, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStorageService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStorageService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - namespace string
+//   - key string
+//   - version int64
+func (_e *MockStorageService_Expecter) Delete(ctx interface{}, userID interface{}, namespace interface{}, key interface{}, version interface{}) *MockStorageService_Delete_Call {
+	return &MockStorageService_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, namespace, key, version)}
+}
+
+func (_c *MockStorageService_Delete_Call) Run(run func(ctx context.Context, userID string, namespace string, key string, version int64)) *MockStorageService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStorageService_Delete_Call) Return(_a0 error) *MockStorageService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStorageService_Delete_Call) RunAndReturn(run func(context.Context, string, string, string, int64) error) *MockStorageService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, readerID, ownerID, namespace, key
+func (_m *MockStorageService) Get(ctx context.Context, readerID string, ownerID string, namespace string, key string) (domain.StorageObject, error) {
+	ret := _m.Called(ctx, readerID, ownerID, namespace, key)
+
+	var r0 domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (domain.StorageObject, error)); ok {
+		return rf(ctx, readerID, ownerID, namespace, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) domain.StorageObject); ok {
+		r0 = rf(ctx, readerID, ownerID, namespace, key)
+	} else {
+		r0 = ret.Get(0).(domain.StorageObject)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, readerID, ownerID, namespace, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockStorageService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readerID string
+//   - ownerID string
+//   - namespace string
+//   - key string
+func (_e *MockStorageService_Expecter) Get(ctx interface{}, readerID interface{}, ownerID interface{}, namespace interface{}, key interface{}) *MockStorageService_Get_Call {
+	return &MockStorageService_Get_Call{Call: _e.mock.On("Get", ctx, readerID, ownerID, namespace, key)}
+}
+
+func (_c *MockStorageService_Get_Call) Run(run func(ctx context.Context, readerID string, ownerID string, namespace string, key string)) *MockStorageService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageService_Get_Call) Return(_a0 domain.StorageObject, _a1 error) *MockStorageService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageService_Get_Call) RunAndReturn(run func(context.Context, string, string, string, string) (domain.StorageObject, error)) *MockStorageService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, readerID, ownerID, namespace
+func (_m *MockStorageService) List(ctx context.Context, readerID string, ownerID string, namespace string) ([]domain.StorageObject, error) {
+	ret := _m.Called(ctx, readerID, ownerID, namespace)
+
+	var r0 []domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]domain.StorageObject, error)); ok {
+		return rf(ctx, readerID, ownerID, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []domain.StorageObject); ok {
+		r0 = rf(ctx, readerID, ownerID, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StorageObject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, readerID, ownerID, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStorageService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readerID string
+//   - ownerID string
+//   - namespace string
+func (_e *MockStorageService_Expecter) List(ctx interface{}, readerID interface{}, ownerID interface{}, namespace interface{}) *MockStorageService_List_Call {
+	return &MockStorageService_List_Call{Call: _e.mock.On("List", ctx, readerID, ownerID, namespace)}
+}
+
+func (_c *MockStorageService_List_Call) Run(run func(ctx context.Context, readerID string, ownerID string, namespace string)) *MockStorageService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorageService_List_Call) Return(_a0 []domain.StorageObject, _a1 error) *MockStorageService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageService_List_Call) RunAndReturn(run func(context.Context, string, string, string) ([]domain.StorageObject, error)) *MockStorageService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, object, version
+func (_m *MockStorageService) Put(ctx context.Context, object domain.StorageObject, version int64) (domain.StorageObject, error) {
+	ret := _m.Called(ctx, object, version)
+
+	var r0 domain.StorageObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StorageObject, int64) (domain.StorageObject, error)); ok {
+		return rf(ctx, object, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StorageObject, int64) domain.StorageObject); ok {
+		r0 = rf(ctx, object, version)
+	} else {
+		r0 = ret.Get(0).(domain.StorageObject)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StorageObject, int64) error); ok {
+		r1 = rf(ctx, object, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorageService_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockStorageService_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - object domain.StorageObject
+//   - version int64
+func (_e *MockStorageService_Expecter) Put(ctx interface{}, object interface{}, version interface{}) *MockStorageService_Put_Call {
+	return &MockStorageService_Put_Call{Call: _e.mock.On("Put", ctx, object, version)}
+}
+
+func (_c *MockStorageService_Put_Call) Run(run func(ctx context.Context, object domain.StorageObject, version int64)) *MockStorageService_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StorageObject), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStorageService_Put_Call) Return(_a0 domain.StorageObject, _a1 error) *MockStorageService_Put_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorageService_Put_Call) RunAndReturn(run func(context.Context, domain.StorageObject, int64) (domain.StorageObject, error)) *MockStorageService_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockStorageService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockStorageService creates a new instance of MockStorageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockStorageService(t mockConstructorTestingTNewMockStorageService) *MockStorageService {
+	mock := &MockStorageService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
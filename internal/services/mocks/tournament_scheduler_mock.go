@@ -0,0 +1,70 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTournamentScheduler is an autogenerated mock type for the TournamentScheduler type
+type MockTournamentScheduler struct {
+	mock.Mock
+}
+
+type MockTournamentScheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTournamentScheduler) EXPECT() *MockTournamentScheduler_Expecter {
+	return &MockTournamentScheduler_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockTournamentScheduler) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockTournamentScheduler_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockTournamentScheduler_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTournamentScheduler_Expecter) Run(ctx interface{}) *MockTournamentScheduler_Run_Call {
+	return &MockTournamentScheduler_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockTournamentScheduler_Run_Call) Run(run func(ctx context.Context)) *MockTournamentScheduler_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTournamentScheduler_Run_Call) Return() *MockTournamentScheduler_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTournamentScheduler_Run_Call) RunAndReturn(run func(context.Context)) *MockTournamentScheduler_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockTournamentScheduler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockTournamentScheduler creates a new instance of MockTournamentScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockTournamentScheduler(t mockConstructorTestingTNewMockTournamentScheduler) *MockTournamentScheduler {
+	mock := &MockTournamentScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,415 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTournamentService is an autogenerated mock type for the TournamentService type
+type MockTournamentService struct {
+	mock.Mock
+}
+
+type MockTournamentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTournamentService) EXPECT() *MockTournamentService_Expecter {
+	return &MockTournamentService_Expecter{mock: &_m.Mock}
+}
+
+// AdvanceDueTournaments provides a mock function with given fields: ctx
+func (_m *MockTournamentService) AdvanceDueTournaments(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_AdvanceDueTournaments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdvanceDueTournaments'
+type MockTournamentService_AdvanceDueTournaments_Call struct {
+	*mock.Call
+}
+
+// AdvanceDueTournaments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTournamentService_Expecter) AdvanceDueTournaments(ctx interface{}) *MockTournamentService_AdvanceDueTournaments_Call {
+	return &MockTournamentService_AdvanceDueTournaments_Call{Call: _e.mock.On("AdvanceDueTournaments", ctx)}
+}
+
+func (_c *MockTournamentService_AdvanceDueTournaments_Call) Run(run func(ctx context.Context)) *MockTournamentService_AdvanceDueTournaments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_AdvanceDueTournaments_Call) Return(_a0 int, _a1 error) *MockTournamentService_AdvanceDueTournaments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_AdvanceDueTournaments_Call) RunAndReturn(run func(context.Context) (int, error)) *MockTournamentService_AdvanceDueTournaments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTournament provides a mock function with given fields: ctx, tournament
+func (_m *MockTournamentService) CreateTournament(ctx context.Context, tournament domain.Tournament) (domain.Tournament, error) {
+	ret := _m.Called(ctx, tournament)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) (domain.Tournament, error)); ok {
+		return rf(ctx, tournament)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tournament) domain.Tournament); ok {
+		r0 = rf(ctx, tournament)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Tournament) error); ok {
+		r1 = rf(ctx, tournament)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_CreateTournament_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTournament'
+type MockTournamentService_CreateTournament_Call struct {
+	*mock.Call
+}
+
+// CreateTournament is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournament domain.Tournament
+func (_e *MockTournamentService_Expecter) CreateTournament(ctx interface{}, tournament interface{}) *MockTournamentService_CreateTournament_Call {
+	return &MockTournamentService_CreateTournament_Call{Call: _e.mock.On("CreateTournament", ctx, tournament)}
+}
+
+func (_c *MockTournamentService_CreateTournament_Call) Run(run func(ctx context.Context, tournament domain.Tournament)) *MockTournamentService_CreateTournament_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Tournament))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_CreateTournament_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentService_CreateTournament_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_CreateTournament_Call) RunAndReturn(run func(context.Context, domain.Tournament) (domain.Tournament, error)) *MockTournamentService_CreateTournament_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTournament provides a mock function with given fields: ctx, id
+func (_m *MockTournamentService) GetTournament(ctx context.Context, id string) (domain.Tournament, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Tournament, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Tournament); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_GetTournament_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTournament'
+type MockTournamentService_GetTournament_Call struct {
+	*mock.Call
+}
+
+// GetTournament is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTournamentService_Expecter) GetTournament(ctx interface{}, id interface{}) *MockTournamentService_GetTournament_Call {
+	return &MockTournamentService_GetTournament_Call{Call: _e.mock.On("GetTournament", ctx, id)}
+}
+
+func (_c *MockTournamentService_GetTournament_Call) Run(run func(ctx context.Context, id string)) *MockTournamentService_GetTournament_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_GetTournament_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentService_GetTournament_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_GetTournament_Call) RunAndReturn(run func(context.Context, string) (domain.Tournament, error)) *MockTournamentService_GetTournament_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTournaments provides a mock function with given fields: ctx, status
+func (_m *MockTournamentService) ListTournaments(ctx context.Context, status string) ([]domain.Tournament, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Tournament, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Tournament); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tournament)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_ListTournaments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTournaments'
+type MockTournamentService_ListTournaments_Call struct {
+	*mock.Call
+}
+
+// ListTournaments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+func (_e *MockTournamentService_Expecter) ListTournaments(ctx interface{}, status interface{}) *MockTournamentService_ListTournaments_Call {
+	return &MockTournamentService_ListTournaments_Call{Call: _e.mock.On("ListTournaments", ctx, status)}
+}
+
+func (_c *MockTournamentService_ListTournaments_Call) Run(run func(ctx context.Context, status string)) *MockTournamentService_ListTournaments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_ListTournaments_Call) Return(_a0 []domain.Tournament, _a1 error) *MockTournamentService_ListTournaments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_ListTournaments_Call) RunAndReturn(run func(context.Context, string) ([]domain.Tournament, error)) *MockTournamentService_ListTournaments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordResult provides a mock function with given fields: ctx, tournamentID, matchID, winnerID
+func (_m *MockTournamentService) RecordResult(ctx context.Context, tournamentID string, matchID string, winnerID string) (domain.Tournament, error) {
+	ret := _m.Called(ctx, tournamentID, matchID, winnerID)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (domain.Tournament, error)); ok {
+		return rf(ctx, tournamentID, matchID, winnerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) domain.Tournament); ok {
+		r0 = rf(ctx, tournamentID, matchID, winnerID)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, tournamentID, matchID, winnerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_RecordResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordResult'
+type MockTournamentService_RecordResult_Call struct {
+	*mock.Call
+}
+
+// RecordResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournamentID string
+//   - matchID string
+//   - winnerID string
+func (_e *MockTournamentService_Expecter) RecordResult(ctx interface{}, tournamentID interface{}, matchID interface{}, winnerID interface{}) *MockTournamentService_RecordResult_Call {
+	return &MockTournamentService_RecordResult_Call{Call: _e.mock.On("RecordResult", ctx, tournamentID, matchID, winnerID)}
+}
+
+func (_c *MockTournamentService_RecordResult_Call) Run(run func(ctx context.Context, tournamentID string, matchID string, winnerID string)) *MockTournamentService_RecordResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_RecordResult_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentService_RecordResult_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_RecordResult_Call) RunAndReturn(run func(context.Context, string, string, string) (domain.Tournament, error)) *MockTournamentService_RecordResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordScore provides a mock function with given fields: ctx, tournamentID, userID, score
+func (_m *MockTournamentService) RecordScore(ctx context.Context, tournamentID string, userID string, score float64) (domain.Tournament, error) {
+	ret := _m.Called(ctx, tournamentID, userID, score)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64) (domain.Tournament, error)); ok {
+		return rf(ctx, tournamentID, userID, score)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64) domain.Tournament); ok {
+		r0 = rf(ctx, tournamentID, userID, score)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64) error); ok {
+		r1 = rf(ctx, tournamentID, userID, score)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_RecordScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordScore'
+type MockTournamentService_RecordScore_Call struct {
+	*mock.Call
+}
+
+// RecordScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournamentID string
+//   - userID string
+//   - score float64
+func (_e *MockTournamentService_Expecter) RecordScore(ctx interface{}, tournamentID interface{}, userID interface{}, score interface{}) *MockTournamentService_RecordScore_Call {
+	return &MockTournamentService_RecordScore_Call{Call: _e.mock.On("RecordScore", ctx, tournamentID, userID, score)}
+}
+
+func (_c *MockTournamentService_RecordScore_Call) Run(run func(ctx context.Context, tournamentID string, userID string, score float64)) *MockTournamentService_RecordScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_RecordScore_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentService_RecordScore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_RecordScore_Call) RunAndReturn(run func(context.Context, string, string, float64) (domain.Tournament, error)) *MockTournamentService_RecordScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: ctx, tournamentID, userID
+func (_m *MockTournamentService) Register(ctx context.Context, tournamentID string, userID string) (domain.Tournament, error) {
+	ret := _m.Called(ctx, tournamentID, userID)
+
+	var r0 domain.Tournament
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.Tournament, error)); ok {
+		return rf(ctx, tournamentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.Tournament); ok {
+		r0 = rf(ctx, tournamentID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Tournament)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tournamentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTournamentService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockTournamentService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tournamentID string
+//   - userID string
+func (_e *MockTournamentService_Expecter) Register(ctx interface{}, tournamentID interface{}, userID interface{}) *MockTournamentService_Register_Call {
+	return &MockTournamentService_Register_Call{Call: _e.mock.On("Register", ctx, tournamentID, userID)}
+}
+
+func (_c *MockTournamentService_Register_Call) Run(run func(ctx context.Context, tournamentID string, userID string)) *MockTournamentService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTournamentService_Register_Call) Return(_a0 domain.Tournament, _a1 error) *MockTournamentService_Register_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTournamentService_Register_Call) RunAndReturn(run func(context.Context, string, string) (domain.Tournament, error)) *MockTournamentService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockTournamentService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockTournamentService creates a new instance of MockTournamentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockTournamentService(t mockConstructorTestingTNewMockTournamentService) *MockTournamentService {
+	mock := &MockTournamentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUserDataEraser is an autogenerated mock type for the UserDataEraser type
+type MockUserDataEraser struct {
+	mock.Mock
+}
+
+type MockUserDataEraser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserDataEraser) EXPECT() *MockUserDataEraser_Expecter {
+	return &MockUserDataEraser_Expecter{mock: &_m.Mock}
+}
+
+// Erase provides a mock function with given fields: ctx, user, anonymousID
+func (_m *MockUserDataEraser) Erase(ctx context.Context, user domain.User, anonymousID string) ([]string, error) {
+	ret := _m.Called(ctx, user, anonymousID)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User, string) ([]string, error)); ok {
+		return rf(ctx, user, anonymousID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User, string) []string); ok {
+		r0 = rf(ctx, user, anonymousID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.User, string) error); ok {
+		r1 = rf(ctx, user, anonymousID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserDataEraser_Erase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Erase'
+type MockUserDataEraser_Erase_Call struct {
+	*mock.Call
+}
+
+// Erase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user domain.User
+//   - anonymousID string
+func (_e *MockUserDataEraser_Expecter) Erase(ctx interface{}, user interface{}, anonymousID interface{}) *MockUserDataEraser_Erase_Call {
+	return &MockUserDataEraser_Erase_Call{Call: _e.mock.On("Erase", ctx, user, anonymousID)}
+}
+
+func (_c *MockUserDataEraser_Erase_Call) Run(run func(ctx context.Context, user domain.User, anonymousID string)) *MockUserDataEraser_Erase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.User), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserDataEraser_Erase_Call) Return(_a0 []string, _a1 error) *MockUserDataEraser_Erase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserDataEraser_Erase_Call) RunAndReturn(run func(context.Context, domain.User, string) ([]string, error)) *MockUserDataEraser_Erase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockUserDataEraser interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockUserDataEraser creates a new instance of MockUserDataEraser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockUserDataEraser(t mockConstructorTestingTNewMockUserDataEraser) *MockUserDataEraser {
+	mock := &MockUserDataEraser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
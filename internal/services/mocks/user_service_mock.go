@@ -8,7 +8,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	service "game/internal/services"
+	services "game/internal/services"
 )
 
 // MockUserService is an autogenerated mock type for the UserService type
@@ -24,19 +24,161 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
+// ChangePassword provides a mock function with given fields: ctx, userID, oldPassword, newPassword
+func (_m *MockUserService) ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error {
+	ret := _m.Called(ctx, userID, oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockUserService_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - oldPassword string
+//   - newPassword string
+func (_e *MockUserService_Expecter) ChangePassword(ctx interface{}, userID interface{}, oldPassword interface{}, newPassword interface{}) *MockUserService_ChangePassword_Call {
+	return &MockUserService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userID, oldPassword, newPassword)}
+}
+
+func (_c *MockUserService_ChangePassword_Call) Run(run func(ctx context.Context, userID string, oldPassword string, newPassword string)) *MockUserService_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) Return(_a0 error) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, userID, password
+func (_m *MockUserService) DeleteAccount(ctx context.Context, userID string, password string) error {
+	ret := _m.Called(ctx, userID, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockUserService_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - password string
+func (_e *MockUserService_Expecter) DeleteAccount(ctx interface{}, userID interface{}, password interface{}) *MockUserService_DeleteAccount_Call {
+	return &MockUserService_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, userID, password)}
+}
+
+func (_c *MockUserService_DeleteAccount_Call) Run(run func(ctx context.Context, userID string, password string)) *MockUserService_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_DeleteAccount_Call) Return(_a0 error) *MockUserService_DeleteAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_DeleteAccount_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserService_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) GetProfile(ctx context.Context, userID string) (services.Profile, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 services.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (services.Profile, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) services.Profile); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(services.Profile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockUserService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) GetProfile(ctx interface{}, userID interface{}) *MockUserService_GetProfile_Call {
+	return &MockUserService_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, userID)}
+}
+
+func (_c *MockUserService_GetProfile_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetProfile_Call) Return(_a0 services.Profile, _a1 error) *MockUserService_GetProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_GetProfile_Call) RunAndReturn(run func(context.Context, string) (services.Profile, error)) *MockUserService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: ctx, username, password
-func (_m *MockUserService) Login(ctx context.Context, username string, password string) (service.LoginResult, error) {
+func (_m *MockUserService) Login(ctx context.Context, username string, password string) (services.LoginResult, error) {
 	ret := _m.Called(ctx, username, password)
 
-	var r0 service.LoginResult
+	var r0 services.LoginResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (service.LoginResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (services.LoginResult, error)); ok {
 		return rf(ctx, username, password)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) service.LoginResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) services.LoginResult); ok {
 		r0 = rf(ctx, username, password)
 	} else {
-		r0 = ret.Get(0).(service.LoginResult)
+		r0 = ret.Get(0).(services.LoginResult)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
@@ -68,12 +210,12 @@ func (_c *MockUserService_Login_Call) Run(run func(ctx context.Context, username
 	return _c
 }
 
-func (_c *MockUserService_Login_Call) Return(_a0 service.LoginResult, _a1 error) *MockUserService_Login_Call {
+func (_c *MockUserService_Login_Call) Return(_a0 services.LoginResult, _a1 error) *MockUserService_Login_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUserService_Login_Call) RunAndReturn(run func(context.Context, string, string) (service.LoginResult, error)) *MockUserService_Login_Call {
+func (_c *MockUserService_Login_Call) RunAndReturn(run func(context.Context, string, string) (services.LoginResult, error)) *MockUserService_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -132,6 +274,94 @@ func (_c *MockUserService_Register_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateProfile provides a mock function with given fields: ctx, userID, profile
+func (_m *MockUserService) UpdateProfile(ctx context.Context, userID string, profile domain.UserProfile) error {
+	ret := _m.Called(ctx, userID, profile)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserProfile) error); ok {
+		r0 = rf(ctx, userID, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserService_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - profile domain.UserProfile
+func (_e *MockUserService_Expecter) UpdateProfile(ctx interface{}, userID interface{}, profile interface{}) *MockUserService_UpdateProfile_Call {
+	return &MockUserService_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, userID, profile)}
+}
+
+func (_c *MockUserService_UpdateProfile_Call) Run(run func(ctx context.Context, userID string, profile domain.UserProfile)) *MockUserService_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.UserProfile))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateProfile_Call) Return(_a0 error) *MockUserService_UpdateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_UpdateProfile_Call) RunAndReturn(run func(context.Context, string, domain.UserProfile) error) *MockUserService_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUsername provides a mock function with given fields: ctx, userID, username
+func (_m *MockUserService) UpdateUsername(ctx context.Context, userID string, username string) error {
+	ret := _m.Called(ctx, userID, username)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_UpdateUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsername'
+type MockUserService_UpdateUsername_Call struct {
+	*mock.Call
+}
+
+// UpdateUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - username string
+func (_e *MockUserService_Expecter) UpdateUsername(ctx interface{}, userID interface{}, username interface{}) *MockUserService_UpdateUsername_Call {
+	return &MockUserService_UpdateUsername_Call{Call: _e.mock.On("UpdateUsername", ctx, userID, username)}
+}
+
+func (_c *MockUserService_UpdateUsername_Call) Run(run func(ctx context.Context, userID string, username string)) *MockUserService_UpdateUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateUsername_Call) Return(_a0 error) *MockUserService_UpdateUsername_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_UpdateUsername_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserService_UpdateUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockUserService interface {
 	mock.TestingT
 	Cleanup(func())
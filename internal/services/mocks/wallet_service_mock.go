@@ -0,0 +1,197 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "game/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWalletService is an autogenerated mock type for the WalletService type
+type MockWalletService struct {
+	mock.Mock
+}
+
+type MockWalletService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWalletService) EXPECT() *MockWalletService_Expecter {
+	return &MockWalletService_Expecter{mock: &_m.Mock}
+}
+
+// GetBalance provides a mock function with given fields: ctx, userID
+func (_m *MockWalletService) GetBalance(ctx context.Context, userID string) (domain.Wallet, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Wallet, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Wallet); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Wallet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type MockWalletService_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockWalletService_Expecter) GetBalance(ctx interface{}, userID interface{}) *MockWalletService_GetBalance_Call {
+	return &MockWalletService_GetBalance_Call{Call: _e.mock.On("GetBalance", ctx, userID)}
+}
+
+func (_c *MockWalletService_GetBalance_Call) Run(run func(ctx context.Context, userID string)) *MockWalletService_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_GetBalance_Call) Return(_a0 domain.Wallet, _a1 error) *MockWalletService_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_GetBalance_Call) RunAndReturn(run func(context.Context, string) (domain.Wallet, error)) *MockWalletService_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Grant provides a mock function with given fields: ctx, entry
+func (_m *MockWalletService) Grant(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 domain.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) domain.LedgerEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(domain.LedgerEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LedgerEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_Grant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Grant'
+type MockWalletService_Grant_Call struct {
+	*mock.Call
+}
+
+// Grant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry domain.LedgerEntry
+func (_e *MockWalletService_Expecter) Grant(ctx interface{}, entry interface{}) *MockWalletService_Grant_Call {
+	return &MockWalletService_Grant_Call{Call: _e.mock.On("Grant", ctx, entry)}
+}
+
+func (_c *MockWalletService_Grant_Call) Run(run func(ctx context.Context, entry domain.LedgerEntry)) *MockWalletService_Grant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LedgerEntry))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_Grant_Call) Return(_a0 domain.LedgerEntry, _a1 error) *MockWalletService_Grant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_Grant_Call) RunAndReturn(run func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, error)) *MockWalletService_Grant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Spend provides a mock function with given fields: ctx, entry
+func (_m *MockWalletService) Spend(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 domain.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LedgerEntry) domain.LedgerEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(domain.LedgerEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LedgerEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_Spend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Spend'
+type MockWalletService_Spend_Call struct {
+	*mock.Call
+}
+
+// Spend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry domain.LedgerEntry
+func (_e *MockWalletService_Expecter) Spend(ctx interface{}, entry interface{}) *MockWalletService_Spend_Call {
+	return &MockWalletService_Spend_Call{Call: _e.mock.On("Spend", ctx, entry)}
+}
+
+func (_c *MockWalletService_Spend_Call) Run(run func(ctx context.Context, entry domain.LedgerEntry)) *MockWalletService_Spend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LedgerEntry))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_Spend_Call) Return(_a0 domain.LedgerEntry, _a1 error) *MockWalletService_Spend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_Spend_Call) RunAndReturn(run func(context.Context, domain.LedgerEntry) (domain.LedgerEntry, error)) *MockWalletService_Spend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockWalletService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockWalletService creates a new instance of MockWalletService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockWalletService(t mockConstructorTestingTNewMockWalletService) *MockWalletService {
+	mock := &MockWalletService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// periodicJob runs a job every interval until the context is done. The
+// outcome of every run is added to the metrics: runs, failures, the number
+// of items the job has processed under countMetric and last_run_timestamp.
+type periodicJob struct {
+	interval time.Duration
+	metrics  *expvar.Map
+	logger   *logrus.Logger
+
+	// do runs the job once and returns the number of items it has
+	// processed, they are logged with doneMessage under countField when
+	// there are any.
+	do          func(ctx context.Context) (int, error)
+	countMetric string
+	countField  string
+
+	failedMessage string
+	doneMessage   string
+}
+
+func (job *periodicJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job.runOnce(ctx)
+		}
+	}
+}
+
+func (job *periodicJob) runOnce(ctx context.Context) {
+	count, err := job.do(ctx)
+
+	job.metrics.Add("runs", 1)
+	job.metrics.Add(job.countMetric, int64(count))
+
+	lastRun := new(expvar.Int)
+	lastRun.Set(time.Now().Unix())
+	job.metrics.Set("last_run_timestamp", lastRun)
+
+	if err != nil {
+		job.metrics.Add("failures", 1)
+
+		job.logger.
+			WithError(err).
+			Error(job.failedMessage)
+
+		return
+	}
+
+	if count > 0 {
+		job.logger.
+			WithField(job.countField, count).
+			Info(job.doneMessage)
+	}
+}
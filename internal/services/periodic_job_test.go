@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+)
+
+type PeriodicJobTestSuite struct {
+	suite.Suite
+
+	job *periodicJob
+
+	count int
+	err   error
+
+	metrics *expvar.Map
+}
+
+func TestPeriodicJobTestSuite(t *testing.T) {
+	suite.Run(t, new(PeriodicJobTestSuite))
+}
+
+func (suite *PeriodicJobTestSuite) SetupTest() {
+	suite.count, suite.err = 0, nil
+	suite.metrics = new(expvar.Map)
+
+	suite.job = &periodicJob{
+		metrics: suite.metrics,
+		logger:  logrus.New(),
+		do: func(ctx context.Context) (int, error) {
+			return suite.count, suite.err
+		},
+		countMetric:   "frozen_events",
+		countField:    "frozen",
+		failedMessage: "failed to freeze the ended events",
+		doneMessage:   "event boards have been frozen",
+	}
+}
+
+func (suite *PeriodicJobTestSuite) TestRunOnce_ReportsMetrics() {
+	suite.count = 2
+
+	suite.job.runOnce(context.Background())
+	suite.job.runOnce(context.Background())
+
+	suite.Equal("2", suite.metrics.Get("runs").String())
+	suite.Equal("4", suite.metrics.Get("frozen_events").String())
+	suite.Nil(suite.metrics.Get("failures"))
+	suite.NotNil(suite.metrics.Get("last_run_timestamp"))
+}
+
+func (suite *PeriodicJobTestSuite) TestRunOnce_ReportsFailures() {
+	suite.count, suite.err = 1, domain.ErrInternal
+
+	suite.job.runOnce(context.Background())
+
+	suite.Equal("1", suite.metrics.Get("runs").String())
+	suite.Equal("1", suite.metrics.Get("frozen_events").String())
+	suite.Equal("1", suite.metrics.Get("failures").String())
+}
@@ -0,0 +1,601 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"game/internal/domain"
+)
+
+//go:generate mockery --name PrivacyService --structname MockPrivacyService --outpkg mocks --filename privacy_service_mock.go --output ./mocks/. --with-expecter
+type PrivacyService interface {
+	ExportUserData(ctx context.Context, userID, requestedBy string) ([]byte, error)
+	EraseUserData(ctx context.Context, userID, requestedBy string) (domain.ErasureRecord, error)
+	VerifyErasureRecord(ctx context.Context, id string) (ErasureVerification, error)
+}
+
+// ErasureVerification is an erasure record along with whether its signature
+// matches its content.
+type ErasureVerification struct {
+	Record domain.ErasureRecord
+	Valid  bool
+}
+
+// UserDataArchive is everything that is stored about a user, it is
+// exported as JSON. The password hash is left out, it is not personal data
+// the user could make use of and it must not leave the service.
+type UserDataArchive struct {
+	ExportedAt        time.Time                  `json:"exportedAt"`
+	User              ArchivedUser               `json:"user"`
+	Score             *float64                   `json:"score"`
+	LastSubmission    *ArchivedScoreSubmission   `json:"lastSubmission"`
+	QuarantinedScores []ArchivedQuarantinedScore `json:"quarantinedScores"`
+	Friendships       []ArchivedFriendship       `json:"friendships"`
+	ClanMembership    *ArchivedClanMembership    `json:"clanMembership"`
+	RewardGrants      []ArchivedRewardGrant      `json:"rewardGrants"`
+	Submissions       int64                      `json:"submissions"`
+	Achievements      []ArchivedAchievement      `json:"achievements"`
+	Ratings           []ArchivedRating           `json:"ratings"`
+	Tournaments       []ArchivedTournament       `json:"tournaments"`
+	EventScores       []ArchivedEventScore       `json:"eventScores"`
+	Wallet            ArchivedWallet             `json:"wallet"`
+	StorageObjects    []ArchivedStorageObject    `json:"storageObjects"`
+	AuditEvents       []ArchivedAuditEvent       `json:"auditEvents"`
+}
+
+type ArchivedUser struct {
+	ID          string            `json:"id"`
+	Username    string            `json:"username"`
+	Banned      bool              `json:"banned"`
+	DisplayName string            `json:"displayName,omitempty"`
+	CountryCode string            `json:"countryCode,omitempty"`
+	AvatarURL   string            `json:"avatarURL,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type ArchivedScoreSubmission struct {
+	Score       float64   `json:"score"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+type ArchivedQuarantinedScore struct {
+	ID          string    `json:"id"`
+	Score       float64   `json:"score"`
+	Reason      string    `json:"reason"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+type ArchivedFriendship struct {
+	RequesterID string    `json:"requesterID"`
+	AddresseeID string    `json:"addresseeID"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type ArchivedClanMembership struct {
+	ClanID   string    `json:"clanID"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+type ArchivedRewardGrant struct {
+	ID         string     `json:"id"`
+	SeasonID   string     `json:"seasonID"`
+	Bracket    string     `json:"bracket"`
+	Reward     string     `json:"reward"`
+	Rank       int64      `json:"rank"`
+	Percentile float64    `json:"percentile"`
+	Score      float64    `json:"score"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ClaimedAt  *time.Time `json:"claimedAt"`
+}
+
+type ArchivedAchievement struct {
+	AchievementID string    `json:"achievementID"`
+	UnlockedAt    time.Time `json:"unlockedAt"`
+}
+
+type ArchivedRating struct {
+	Mode       string    `json:"mode"`
+	Rating     float64   `json:"rating"`
+	Deviation  float64   `json:"deviation"`
+	Volatility float64   `json:"volatility"`
+	Matches    int64     `json:"matches"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// ArchivedTournament is a tournament the user has registered for, Place is
+// 0 until the tournament has finished.
+type ArchivedTournament struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Format       string    `json:"format"`
+	Status       string    `json:"status"`
+	Seed         int64     `json:"seed"`
+	RegisteredAt time.Time `json:"registeredAt"`
+	Place        int64     `json:"place"`
+}
+
+// ArchivedEventScore is the best score of the user on the board of an event,
+// Attempts is the number of scores it has submitted to it.
+type ArchivedEventScore struct {
+	EventID  string  `json:"eventID"`
+	Score    float64 `json:"score"`
+	Attempts int64   `json:"attempts"`
+}
+
+// ArchivedWallet is the wallet of the user and its ledger, the newest entry
+// first.
+type ArchivedWallet struct {
+	Balances map[string]int64      `json:"balances"`
+	Items    map[string]int64      `json:"items"`
+	Ledger   []ArchivedLedgerEntry `json:"ledger"`
+}
+
+type ArchivedLedgerEntry struct {
+	IdempotencyKey string           `json:"idempotencyKey"`
+	Type           string           `json:"type"`
+	Reason         string           `json:"reason,omitempty"`
+	Currencies     map[string]int64 `json:"currencies,omitempty"`
+	Items          map[string]int64 `json:"items,omitempty"`
+	CreatedAt      time.Time        `json:"createdAt"`
+}
+
+// ArchivedStorageObject value is the value the user has saved, encoded in
+// base64.
+type ArchivedStorageObject struct {
+	Namespace  string    `json:"namespace"`
+	Key        string    `json:"key"`
+	Value      []byte    `json:"value"`
+	Permission string    `json:"permission"`
+	Version    int64     `json:"version"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type ArchivedAuditEvent struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Actor     string            `json:"actor"`
+	UserID    string            `json:"userID"`
+	PeerIP    string            `json:"peerIP,omitempty"`
+	RequestID string            `json:"requestID,omitempty"`
+	OldScore  *float64          `json:"oldScore,omitempty"`
+	NewScore  *float64          `json:"newScore,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type PrivacyServiceDependencies struct {
+	UserRepository             domain.UserRepository
+	UserScoreRepository        domain.UserScoreRepository
+	ScoreSubmissionRepository  domain.ScoreSubmissionRepository
+	QuarantinedScoreRepository domain.QuarantinedScoreRepository
+	ErasureRecordRepository    domain.ErasureRecordRepository
+	FriendshipRepository       domain.FriendshipRepository
+	ClanRepository             domain.ClanRepository
+	RewardGrantRepository      domain.RewardGrantRepository
+	AchievementRepository      domain.AchievementRepository
+	RatingRepository           domain.RatingRepository
+	TournamentRepository       domain.TournamentRepository
+	EventBoardRepository       domain.EventBoardRepository
+	WalletRepository           domain.WalletRepository
+	StorageRepository          domain.StorageRepository
+	AuditLog                   domain.AuditLog
+	UserDataEraser             UserDataEraser
+
+	// SigningKey is the HMAC-SHA256 key erasure records are signed with.
+	SigningKey []byte
+	// SubjectHashKey is the HMAC-SHA256 key the user IDs are hashed with in
+	// the erasure records. It is kept apart from SigningKey, without it the
+	// hash can not be matched to a user by trying the possible user IDs.
+	SubjectHashKey []byte
+}
+
+type privacyService struct {
+	userRepository             domain.UserRepository
+	userScoreRepository        domain.UserScoreRepository
+	scoreSubmissionRepository  domain.ScoreSubmissionRepository
+	quarantinedScoreRepository domain.QuarantinedScoreRepository
+	erasureRecordRepository    domain.ErasureRecordRepository
+	friendshipRepository       domain.FriendshipRepository
+	clanRepository             domain.ClanRepository
+	rewardGrantRepository      domain.RewardGrantRepository
+	achievementRepository      domain.AchievementRepository
+	ratingRepository           domain.RatingRepository
+	tournamentRepository       domain.TournamentRepository
+	eventBoardRepository       domain.EventBoardRepository
+	walletRepository           domain.WalletRepository
+	storageRepository          domain.StorageRepository
+	auditLog                   domain.AuditLog
+
+	eraser UserDataEraser
+
+	signingKey     []byte
+	subjectHashKey []byte
+}
+
+func NewPrivacyService(deps PrivacyServiceDependencies) *privacyService {
+	return &privacyService{
+		userRepository:             deps.UserRepository,
+		userScoreRepository:        deps.UserScoreRepository,
+		scoreSubmissionRepository:  deps.ScoreSubmissionRepository,
+		quarantinedScoreRepository: deps.QuarantinedScoreRepository,
+		erasureRecordRepository:    deps.ErasureRecordRepository,
+		friendshipRepository:       deps.FriendshipRepository,
+		clanRepository:             deps.ClanRepository,
+		rewardGrantRepository:      deps.RewardGrantRepository,
+		achievementRepository:      deps.AchievementRepository,
+		ratingRepository:           deps.RatingRepository,
+		tournamentRepository:       deps.TournamentRepository,
+		eventBoardRepository:       deps.EventBoardRepository,
+		walletRepository:           deps.WalletRepository,
+		storageRepository:          deps.StorageRepository,
+		auditLog:                   deps.AuditLog,
+
+		eraser: deps.UserDataEraser,
+
+		signingKey:     deps.SigningKey,
+		subjectHashKey: deps.SubjectHashKey,
+	}
+}
+
+// ExportUserData returns a JSON encoded UserDataArchive of the user. The
+// export is recorded in the audit log after the archive has been built, so
+// it is not part of the archive itself.
+func (service *privacyService) ExportUserData(ctx context.Context, userID, requestedBy string) ([]byte, error) {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := UserDataArchive{
+		ExportedAt: time.Now(),
+		User: ArchivedUser{
+			ID:          user.ID,
+			Username:    user.Name,
+			Banned:      user.Banned,
+			DisplayName: user.Profile.DisplayName,
+			CountryCode: user.Profile.CountryCode,
+			AvatarURL:   user.Profile.AvatarURL,
+			Metadata:    user.Profile.Metadata,
+		},
+		QuarantinedScores: []ArchivedQuarantinedScore{},
+		Friendships:       []ArchivedFriendship{},
+		RewardGrants:      []ArchivedRewardGrant{},
+		Achievements:      []ArchivedAchievement{},
+		Ratings:           []ArchivedRating{},
+		Tournaments:       []ArchivedTournament{},
+		EventScores:       []ArchivedEventScore{},
+		Wallet:            ArchivedWallet{Ledger: []ArchivedLedgerEntry{}},
+		StorageObjects:    []ArchivedStorageObject{},
+		AuditEvents:       []ArchivedAuditEvent{},
+	}
+
+	userScore, err := service.userScoreRepository.GetUserTopScore(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	if err == nil {
+		archive.Score = &userScore.Score
+	}
+
+	submission, err := service.scoreSubmissionRepository.GetLastSubmission(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	if err == nil {
+		archive.LastSubmission = &ArchivedScoreSubmission{
+			Score:       submission.Score,
+			SubmittedAt: submission.SubmittedAt,
+		}
+	}
+
+	quarantinedScores, err := service.quarantinedScoreRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, score := range quarantinedScores {
+		archive.QuarantinedScores = append(archive.QuarantinedScores, ArchivedQuarantinedScore{
+			ID:          score.ID,
+			Score:       score.Score,
+			Reason:      score.Reason,
+			SubmittedAt: score.SubmittedAt,
+		})
+	}
+
+	for _, status := range []string{domain.FriendshipStatusAccepted, domain.FriendshipStatusPending, domain.FriendshipStatusBlocked} {
+		friendships, err := service.friendshipRepository.ListByUserID(ctx, user.ID, status)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, friendship := range friendships {
+			archive.Friendships = append(archive.Friendships, ArchivedFriendship{
+				RequesterID: friendship.RequesterID,
+				AddresseeID: friendship.AddresseeID,
+				Status:      friendship.Status,
+				CreatedAt:   friendship.CreatedAt,
+				UpdatedAt:   friendship.UpdatedAt,
+			})
+		}
+	}
+
+	clanMember, err := service.clanRepository.GetMember(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	if err == nil {
+		archive.ClanMembership = &ArchivedClanMembership{
+			ClanID:   clanMember.ClanID,
+			Role:     clanMember.Role,
+			JoinedAt: clanMember.JoinedAt,
+		}
+	}
+
+	grants, err := service.rewardGrantRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range grants {
+		archive.RewardGrants = append(archive.RewardGrants, ArchivedRewardGrant{
+			ID:         grant.ID,
+			SeasonID:   grant.SeasonID,
+			Bracket:    grant.Bracket,
+			Reward:     grant.Reward,
+			Rank:       grant.Rank,
+			Percentile: grant.Percentile,
+			Score:      grant.Score,
+			CreatedAt:  grant.CreatedAt,
+			ClaimedAt:  grant.ClaimedAt,
+		})
+	}
+
+	archive.Submissions, err = service.achievementRepository.GetSubmissions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userAchievements, err := service.achievementRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, userAchievement := range userAchievements {
+		archive.Achievements = append(archive.Achievements, ArchivedAchievement{
+			AchievementID: userAchievement.AchievementID,
+			UnlockedAt:    userAchievement.UnlockedAt,
+		})
+	}
+
+	ratings, err := service.ratingRepository.GetUserRatings(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range ratings {
+		archive.Ratings = append(archive.Ratings, ArchivedRating{
+			Mode:       rating.Mode,
+			Rating:     rating.Rating,
+			Deviation:  rating.Deviation,
+			Volatility: rating.Volatility,
+			Matches:    rating.Matches,
+			UpdatedAt:  rating.UpdatedAt,
+		})
+	}
+
+	tournaments, err := service.tournamentRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tournament := range tournaments {
+		player := tournament.Players[tournament.Player(user.ID)]
+
+		archived := ArchivedTournament{
+			ID:           tournament.ID,
+			Name:         tournament.Name,
+			Format:       tournament.Format,
+			Status:       tournament.Status,
+			Seed:         player.Seed,
+			RegisteredAt: player.RegisteredAt,
+		}
+
+		for _, standing := range tournament.Standings {
+			if standing.UserID == user.ID {
+				archived.Place = standing.Place
+			}
+		}
+
+		archive.Tournaments = append(archive.Tournaments, archived)
+	}
+
+	eventScores, err := service.eventBoardRepository.GetUserEventScores(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eventScore := range eventScores {
+		archive.EventScores = append(archive.EventScores, ArchivedEventScore{
+			EventID:  eventScore.EventID,
+			Score:    eventScore.Score,
+			Attempts: eventScore.Attempts,
+		})
+	}
+
+	wallet, err := service.walletRepository.GetWallet(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive.Wallet.Balances = wallet.Balances
+	archive.Wallet.Items = wallet.Items
+
+	entries, err := service.walletRepository.ListEntries(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		archive.Wallet.Ledger = append(archive.Wallet.Ledger, ArchivedLedgerEntry{
+			IdempotencyKey: entry.IdempotencyKey,
+			Type:           entry.Type,
+			Reason:         entry.Reason,
+			Currencies:     entry.Currencies,
+			Items:          entry.Items,
+			CreatedAt:      entry.CreatedAt,
+		})
+	}
+
+	storageObjects, err := service.storageRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, object := range storageObjects {
+		archive.StorageObjects = append(archive.StorageObjects, ArchivedStorageObject{
+			Namespace:  object.Namespace,
+			Key:        object.Key,
+			Value:      object.Value,
+			Permission: object.Permission,
+			Version:    object.Version,
+			CreatedAt:  object.CreatedAt,
+			UpdatedAt:  object.UpdatedAt,
+		})
+	}
+
+	events, err := service.auditLog.GetUserEvents(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		archive.AuditEvents = append(archive.AuditEvents, ArchivedAuditEvent{
+			ID:        event.ID,
+			Type:      event.Type,
+			Actor:     event.Actor,
+			UserID:    event.UserID,
+			PeerIP:    event.PeerIP,
+			RequestID: event.RequestID,
+			OldScore:  event.OldScore,
+			NewScore:  event.NewScore,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.auditLog.Record(ctx, domain.NewAuditEvent(ctx, domain.AuditEventUserDataExported, requestedBy, user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// EraseUserData erases the data of the user and returns the signed record
+// of the erasure. The record is stored before the erasure starts and is
+// updated with the completed steps, a failed erasure is kept as a failed
+// record and the erasure can be requested again.
+func (service *privacyService) EraseUserData(ctx context.Context, userID, requestedBy string) (domain.ErasureRecord, error) {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	anonymousID, err := newAnonymousID()
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	record, err := service.erasureRecordRepository.Create(ctx, domain.ErasureRecord{
+		SubjectHash: service.hashSubject(user.ID),
+		AnonymousID: anonymousID,
+		RequestedBy: requestedBy,
+		Status:      domain.ErasureStatusPending,
+		RequestedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	record.Steps, err = service.eraser.Erase(ctx, user, anonymousID)
+	if err != nil {
+		record.Status = domain.ErasureStatusFailed
+		record.Error = err.Error()
+
+		updateErr := service.erasureRecordRepository.Update(ctx, record)
+		if updateErr != nil {
+			return domain.ErasureRecord{}, errors.Join(err, updateErr)
+		}
+
+		return domain.ErasureRecord{}, err
+	}
+
+	record.Status = domain.ErasureStatusCompleted
+	record.CompletedAt = time.Now()
+	record.Signature = service.sign(record)
+
+	err = service.erasureRecordRepository.Update(ctx, record)
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	event := domain.NewAuditEvent(ctx, domain.AuditEventUserDataErased, requestedBy, anonymousID)
+	event.Details = map[string]string{
+		"erasureID": record.ID,
+	}
+
+	err = service.auditLog.Record(ctx, event)
+	if err != nil {
+		return domain.ErasureRecord{}, err
+	}
+
+	return record, nil
+}
+
+// VerifyErasureRecord checks that the record has been completed and has
+// not been altered since.
+func (service *privacyService) VerifyErasureRecord(ctx context.Context, id string) (ErasureVerification, error) {
+	record, err := service.erasureRecordRepository.GetByID(ctx, id)
+	if err != nil {
+		return ErasureVerification{}, err
+	}
+
+	return ErasureVerification{
+		Record: record,
+		Valid: record.Status == domain.ErasureStatusCompleted &&
+			hmac.Equal(service.sign(record), record.Signature),
+	}, nil
+}
+
+// hashSubject returns the hex encoded HMAC-SHA256 of the user ID, a plain
+// hash could be reversed by hashing the user IDs, they are predictable.
+func (service *privacyService) hashSubject(userID string) string {
+	mac := hmac.New(sha256.New, service.subjectHashKey)
+	mac.Write([]byte(userID))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (service *privacyService) sign(record domain.ErasureRecord) []byte {
+	mac := hmac.New(sha256.New, service.signingKey)
+	mac.Write(record.Payload())
+
+	return mac.Sum(nil)
+}
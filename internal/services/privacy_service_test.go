@@ -0,0 +1,554 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type PrivacyServiceTestSuite struct {
+	suite.Suite
+
+	service *privacyService
+
+	mockUserRepository             *mocks.MockUserRepository
+	mockUserScoreRepository        *mocks.MockUserScoreRepository
+	mockScoreSubmissionRepository  *mocks.MockScoreSubmissionRepository
+	mockQuarantinedScoreRepository *mocks.MockQuarantinedScoreRepository
+	mockFriendshipRepository       *mocks.MockFriendshipRepository
+	mockClanRepository             *mocks.MockClanRepository
+	mockRewardGrantRepository      *mocks.MockRewardGrantRepository
+	mockAchievementRepository      *mocks.MockAchievementRepository
+	mockRatingRepository           *mocks.MockRatingRepository
+	mockTournamentRepository       *mocks.MockTournamentRepository
+	mockEventBoardRepository       *mocks.MockEventBoardRepository
+	mockWalletRepository           *mocks.MockWalletRepository
+	mockStorageRepository          *mocks.MockStorageRepository
+	mockErasureRecordRepository    *mocks.MockErasureRecordRepository
+	mockAuditLog                   *mocks.MockAuditLog
+	mockTokenManager               *mocks.MockTokenManager
+	mockUserCache                  *mocks.MockUserCache
+}
+
+func TestPrivacyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PrivacyServiceTestSuite))
+}
+
+func (suite *PrivacyServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockScoreSubmissionRepository = mocks.NewMockScoreSubmissionRepository(suite.T())
+	suite.mockQuarantinedScoreRepository = mocks.NewMockQuarantinedScoreRepository(suite.T())
+	suite.mockFriendshipRepository = mocks.NewMockFriendshipRepository(suite.T())
+	suite.mockClanRepository = mocks.NewMockClanRepository(suite.T())
+	suite.mockRewardGrantRepository = mocks.NewMockRewardGrantRepository(suite.T())
+	suite.mockAchievementRepository = mocks.NewMockAchievementRepository(suite.T())
+	suite.mockRatingRepository = mocks.NewMockRatingRepository(suite.T())
+	suite.mockTournamentRepository = mocks.NewMockTournamentRepository(suite.T())
+	suite.mockEventBoardRepository = mocks.NewMockEventBoardRepository(suite.T())
+	suite.mockWalletRepository = mocks.NewMockWalletRepository(suite.T())
+	suite.mockStorageRepository = mocks.NewMockStorageRepository(suite.T())
+	suite.mockErasureRecordRepository = mocks.NewMockErasureRecordRepository(suite.T())
+	suite.mockAuditLog = mocks.NewMockAuditLog(suite.T())
+	suite.mockTokenManager = mocks.NewMockTokenManager(suite.T())
+	suite.mockUserCache = mocks.NewMockUserCache(suite.T())
+
+	suite.service = NewPrivacyService(PrivacyServiceDependencies{
+		UserRepository:             suite.mockUserRepository,
+		UserScoreRepository:        suite.mockUserScoreRepository,
+		ScoreSubmissionRepository:  suite.mockScoreSubmissionRepository,
+		QuarantinedScoreRepository: suite.mockQuarantinedScoreRepository,
+		FriendshipRepository:       suite.mockFriendshipRepository,
+		ClanRepository:             suite.mockClanRepository,
+		RewardGrantRepository:      suite.mockRewardGrantRepository,
+		AchievementRepository:      suite.mockAchievementRepository,
+		RatingRepository:           suite.mockRatingRepository,
+		TournamentRepository:       suite.mockTournamentRepository,
+		EventBoardRepository:       suite.mockEventBoardRepository,
+		WalletRepository:           suite.mockWalletRepository,
+		StorageRepository:          suite.mockStorageRepository,
+		ErasureRecordRepository:    suite.mockErasureRecordRepository,
+		AuditLog:                   suite.mockAuditLog,
+		UserDataEraser: NewUserDataEraser(UserDataEraserDependencies{
+			UserRepository:             suite.mockUserRepository,
+			UserScoreRepository:        suite.mockUserScoreRepository,
+			ScoreSubmissionRepository:  suite.mockScoreSubmissionRepository,
+			QuarantinedScoreRepository: suite.mockQuarantinedScoreRepository,
+			FriendshipRepository:       suite.mockFriendshipRepository,
+			ClanRepository:             suite.mockClanRepository,
+			RewardGrantRepository:      suite.mockRewardGrantRepository,
+			AchievementRepository:      suite.mockAchievementRepository,
+			RatingRepository:           suite.mockRatingRepository,
+			TournamentRepository:       suite.mockTournamentRepository,
+			EventBoardRepository:       suite.mockEventBoardRepository,
+			WalletRepository:           suite.mockWalletRepository,
+			StorageRepository:          suite.mockStorageRepository,
+			AuditLog:                   suite.mockAuditLog,
+			TokenManager:               suite.mockTokenManager,
+			UserCache:                  suite.mockUserCache,
+		}),
+		SigningKey:     []byte("signing-key"),
+		SubjectHashKey: []byte("subject-hash-key"),
+	})
+}
+
+func (suite *PrivacyServiceTestSuite) expectUser() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{ID: "user-id", Name: "username", PasswordHash: "password-hash"}, nil)
+}
+
+func (suite *PrivacyServiceTestSuite) TestExportUserData() {
+	suite.expectUser()
+
+	submittedAt := time.Unix(1700000000, 0).UTC()
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: 100}, nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		GetLastSubmission(mock.Anything, "user-id").
+		Return(domain.ScoreSubmission{UserID: "user-id", Score: 100, SubmittedAt: submittedAt}, nil)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.QuarantinedScore{
+			{ID: "quarantined-id", UserID: "user-id", Score: 5000, Reason: SuspiciousReasonOutlier, SubmittedAt: submittedAt},
+		}, nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", domain.FriendshipStatusAccepted).
+		Return([]domain.Friendship{
+			{ID: "friendship-id", RequesterID: "user-id", AddresseeID: "user-id-2", Status: domain.FriendshipStatusAccepted, CreatedAt: submittedAt, UpdatedAt: submittedAt},
+		}, nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", mock.Anything).
+		Return(nil, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, "user-id").
+		Return(domain.ClanMember{ClanID: "clan-id", UserID: "user-id", Role: domain.ClanRoleLeader, JoinedAt: submittedAt}, nil)
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.RewardGrant{
+			{ID: "season-1:user-id", SeasonID: "season-1", UserID: "user-id", Bracket: "gold", Reward: "gold_chest", Rank: 1, Percentile: 100, Score: 100, CreatedAt: submittedAt},
+		}, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		GetSubmissions(mock.Anything, "user-id").
+		Return(12, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.UserAchievement{
+			{UserID: "user-id", AchievementID: "top_10", UnlockedAt: submittedAt},
+		}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetUserRatings(mock.Anything, "user-id").
+		Return([]domain.Rating{
+			{UserID: "user-id", Mode: "duel", Rating: 1620, Deviation: 80, Volatility: 0.06, Matches: 12, UpdatedAt: submittedAt},
+		}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.Tournament{
+			{
+				ID:     "tournament-id",
+				Name:   "Weekly Cup",
+				Format: domain.TournamentSwiss,
+				Status: domain.TournamentFinished,
+				Players: []domain.TournamentPlayer{
+					{UserID: "user-id-2", Seed: 1, RegisteredAt: submittedAt},
+					{UserID: "user-id", Seed: 2, RegisteredAt: submittedAt},
+				},
+				Standings: []domain.TournamentStanding{
+					{UserID: "user-id", Place: 1, Points: 3},
+					{UserID: "user-id-2", Place: 2, Points: 2},
+				},
+			},
+		}, nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		GetUserEventScores(mock.Anything, "user-id").
+		Return([]domain.EventScore{
+			{EventID: "event-id", UserID: "user-id", Score: 1250, Attempts: 2},
+		}, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		GetWallet(mock.Anything, "user-id").
+		Return(domain.Wallet{
+			UserID:   "user-id",
+			Balances: map[string]int64{"coins": 150},
+			Items:    map[string]int64{"gold_chest": 1},
+		}, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		ListEntries(mock.Anything, "user-id").
+		Return([]domain.LedgerEntry{
+			{
+				ID:             "user-id:season-reward-season-1",
+				UserID:         "user-id",
+				IdempotencyKey: "season-reward-season-1",
+				Type:           domain.LedgerEntryGrant,
+				Reason:         "reward of season season-1",
+				Items:          map[string]int64{"gold_chest": 1},
+				CreatedAt:      submittedAt,
+			},
+		}, nil)
+
+	suite.mockStorageRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.StorageObject{
+			{UserID: "user-id", Namespace: "settings", Key: "audio", Value: []byte(`{"volume":80}`), Permission: domain.StoragePermissionOwnerOnly, Version: 2, CreatedAt: submittedAt, UpdatedAt: submittedAt},
+		}, nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		GetUserEvents(mock.Anything, "user-id").
+		Return([]domain.AuditEvent{
+			{ID: "event-id", Type: domain.AuditEventLoginSucceeded, Actor: "username", UserID: "user-id", CreatedAt: submittedAt},
+		}, nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == domain.AuditEventUserDataExported &&
+				event.UserID == "user-id" &&
+				event.Actor == "support"
+		})).
+		Return(nil)
+
+	data, err := suite.service.ExportUserData(context.Background(), "user-id", "support")
+	suite.NoError(err)
+	suite.NotContains(string(data), "password-hash")
+
+	var archive UserDataArchive
+
+	err = json.Unmarshal(data, &archive)
+	suite.NoError(err)
+
+	suite.Equal(ArchivedUser{ID: "user-id", Username: "username"}, archive.User)
+	suite.Equal(float64(100), *archive.Score)
+	suite.Equal(&ArchivedScoreSubmission{Score: 100, SubmittedAt: submittedAt}, archive.LastSubmission)
+	suite.Equal([]ArchivedQuarantinedScore{
+		{ID: "quarantined-id", Score: 5000, Reason: SuspiciousReasonOutlier, SubmittedAt: submittedAt},
+	}, archive.QuarantinedScores)
+	suite.Equal([]ArchivedFriendship{
+		{RequesterID: "user-id", AddresseeID: "user-id-2", Status: domain.FriendshipStatusAccepted, CreatedAt: submittedAt, UpdatedAt: submittedAt},
+	}, archive.Friendships)
+	suite.Equal(&ArchivedClanMembership{ClanID: "clan-id", Role: domain.ClanRoleLeader, JoinedAt: submittedAt}, archive.ClanMembership)
+	suite.Equal([]ArchivedRewardGrant{
+		{ID: "season-1:user-id", SeasonID: "season-1", Bracket: "gold", Reward: "gold_chest", Rank: 1, Percentile: 100, Score: 100, CreatedAt: submittedAt},
+	}, archive.RewardGrants)
+	suite.Equal(int64(12), archive.Submissions)
+	suite.Equal([]ArchivedAchievement{
+		{AchievementID: "top_10", UnlockedAt: submittedAt},
+	}, archive.Achievements)
+	suite.Equal([]ArchivedRating{
+		{Mode: "duel", Rating: 1620, Deviation: 80, Volatility: 0.06, Matches: 12, UpdatedAt: submittedAt},
+	}, archive.Ratings)
+	suite.Equal([]ArchivedTournament{
+		{ID: "tournament-id", Name: "Weekly Cup", Format: domain.TournamentSwiss, Status: domain.TournamentFinished, Seed: 2, RegisteredAt: submittedAt, Place: 1},
+	}, archive.Tournaments)
+	suite.Equal([]ArchivedEventScore{
+		{EventID: "event-id", Score: 1250, Attempts: 2},
+	}, archive.EventScores)
+	suite.Equal(ArchivedWallet{
+		Balances: map[string]int64{"coins": 150},
+		Items:    map[string]int64{"gold_chest": 1},
+		Ledger: []ArchivedLedgerEntry{
+			{IdempotencyKey: "season-reward-season-1", Type: domain.LedgerEntryGrant, Reason: "reward of season season-1", Items: map[string]int64{"gold_chest": 1}, CreatedAt: submittedAt},
+		},
+	}, archive.Wallet)
+	suite.Equal([]ArchivedStorageObject{
+		{Namespace: "settings", Key: "audio", Value: []byte(`{"volume":80}`), Permission: domain.StoragePermissionOwnerOnly, Version: 2, CreatedAt: submittedAt, UpdatedAt: submittedAt},
+	}, archive.StorageObjects)
+	suite.Len(archive.AuditEvents, 1)
+	suite.Equal("event-id", archive.AuditEvents[0].ID)
+}
+
+func (suite *PrivacyServiceTestSuite) TestExportUserData_NoScores() {
+	suite.expectUser()
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		GetLastSubmission(mock.Anything, "user-id").
+		Return(domain.ScoreSubmission{}, domain.ErrResourceNotFound)
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", mock.Anything).
+		Return(nil, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, "user-id").
+		Return(domain.ClanMember{}, domain.ErrResourceNotFound)
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		GetSubmissions(mock.Anything, "user-id").
+		Return(0, nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetUserRatings(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		GetUserEventScores(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		GetWallet(mock.Anything, "user-id").
+		Return(domain.Wallet{UserID: "user-id", Balances: map[string]int64{}, Items: map[string]int64{}}, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		ListEntries(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockStorageRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		GetUserEvents(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.Anything).
+		Return(nil)
+
+	data, err := suite.service.ExportUserData(context.Background(), "user-id", "support")
+	suite.NoError(err)
+
+	var archive UserDataArchive
+
+	err = json.Unmarshal(data, &archive)
+	suite.NoError(err)
+
+	suite.Nil(archive.Score)
+	suite.Nil(archive.LastSubmission)
+	suite.Empty(archive.QuarantinedScores)
+	suite.Empty(archive.Friendships)
+	suite.Nil(archive.ClanMembership)
+	suite.Empty(archive.RewardGrants)
+	suite.Zero(archive.Submissions)
+	suite.Empty(archive.Achievements)
+	suite.Empty(archive.Ratings)
+	suite.Empty(archive.Tournaments)
+	suite.Empty(archive.EventScores)
+	suite.Empty(archive.Wallet.Balances)
+	suite.Empty(archive.Wallet.Ledger)
+	suite.Empty(archive.StorageObjects)
+	suite.Empty(archive.AuditEvents)
+}
+
+func (suite *PrivacyServiceTestSuite) TestExportUserData_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
+
+	_, err := suite.service.ExportUserData(context.Background(), "user-id", "support")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *PrivacyServiceTestSuite) expectErasure() {
+	suite.mockUserScoreRepository.EXPECT().RemoveUserScore(mock.Anything, "user-id").Return(nil)
+	suite.mockScoreSubmissionRepository.EXPECT().DeleteLastSubmission(mock.Anything, "user-id").Return(nil)
+	suite.mockFriendshipRepository.EXPECT().DeleteByUserID(mock.Anything, "user-id").Return(nil)
+	suite.mockClanRepository.EXPECT().GetMember(mock.Anything, "user-id").Return(domain.ClanMember{}, domain.ErrResourceNotFound)
+	suite.mockRewardGrantRepository.EXPECT().DeleteByUserID(mock.Anything, "user-id").Return(nil)
+	suite.mockAchievementRepository.EXPECT().DeleteByUserID(mock.Anything, "user-id").Return(nil)
+	suite.mockRatingRepository.EXPECT().RemoveUserRatings(mock.Anything, "user-id").Return(nil)
+	suite.mockEventBoardRepository.EXPECT().RemoveUserEventScores(mock.Anything, "user-id").Return(nil)
+	suite.mockWalletRepository.EXPECT().DeleteByUserID(mock.Anything, "user-id").Return(nil)
+	suite.mockStorageRepository.EXPECT().DeleteByUserID(mock.Anything, "user-id").Return(nil)
+	suite.mockQuarantinedScoreRepository.EXPECT().AnonymizeUser(mock.Anything, "user-id", mock.Anything).Return(nil)
+	suite.mockTournamentRepository.EXPECT().AnonymizeUser(mock.Anything, "user-id", mock.Anything).Return(nil)
+	suite.mockAuditLog.EXPECT().AnonymizeUser(mock.Anything, "user-id", mock.Anything).Return(nil)
+	suite.mockTokenManager.EXPECT().RevokeUserTokens(mock.Anything, "user-id").Return(nil)
+	suite.mockUserCache.EXPECT().Delete(mock.Anything, "user-id").Return(nil)
+}
+
+func (suite *PrivacyServiceTestSuite) TestEraseUserData() {
+	suite.expectUser()
+
+	mac := hmac.New(sha256.New, []byte("subject-hash-key"))
+	mac.Write([]byte("user-id"))
+	subjectHash := mac.Sum(nil)
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(record domain.ErasureRecord) bool {
+			return record.SubjectHash == hex.EncodeToString(subjectHash[:]) &&
+				record.RequestedBy == "support" &&
+				record.Status == domain.ErasureStatusPending
+		})).
+		RunAndReturn(func(ctx context.Context, record domain.ErasureRecord) (domain.ErasureRecord, error) {
+			record.ID = "erasure-id"
+			return record, nil
+		})
+
+	suite.expectErasure()
+
+	suite.mockUserRepository.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(nil)
+
+	var stored domain.ErasureRecord
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, record domain.ErasureRecord) {
+			stored = record
+		}).
+		Return(nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == domain.AuditEventUserDataErased &&
+				event.Actor == "support" &&
+				event.Details["erasureID"] == "erasure-id"
+		})).
+		Return(nil)
+
+	record, err := suite.service.EraseUserData(context.Background(), "user-id", "support")
+	suite.NoError(err)
+
+	suite.Equal(stored, record)
+	suite.Equal(domain.ErasureStatusCompleted, record.Status)
+	suite.Contains(record.AnonymousID, "deleted-user:")
+	suite.Len(record.Steps, 16)
+	suite.Equal(domain.ErasureStepUserDeleted, record.Steps[15])
+	suite.NotEmpty(record.Signature)
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		GetByID(mock.Anything, "erasure-id").
+		Return(stored, nil)
+
+	verification, err := suite.service.VerifyErasureRecord(context.Background(), "erasure-id")
+	suite.NoError(err)
+	suite.True(verification.Valid)
+}
+
+func (suite *PrivacyServiceTestSuite) TestEraseUserData_StepFailed() {
+	suite.expectUser()
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, record domain.ErasureRecord) (domain.ErasureRecord, error) {
+			record.ID = "erasure-id"
+			return record, nil
+		})
+
+	suite.expectErasure()
+
+	suite.mockUserRepository.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(domain.ErrInternal)
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(record domain.ErasureRecord) bool {
+			return record.Status == domain.ErasureStatusFailed &&
+				len(record.Steps) == 15 &&
+				record.Signature == nil
+		})).
+		Return(nil)
+
+	_, err := suite.service.EraseUserData(context.Background(), "user-id", "support")
+	suite.ErrorIs(err, domain.ErrInternal)
+}
+
+func (suite *PrivacyServiceTestSuite) TestVerifyErasureRecord_Altered() {
+	record := domain.ErasureRecord{
+		ID:          "erasure-id",
+		SubjectHash: "subject-hash",
+		AnonymousID: "deleted-user:0011223344556677",
+		RequestedBy: "support",
+		Status:      domain.ErasureStatusCompleted,
+		Steps:       []string{domain.ErasureStepUserDeleted},
+		RequestedAt: time.Unix(1700000000, 0),
+		CompletedAt: time.Unix(1700000001, 0),
+	}
+	record.Signature = suite.service.sign(record)
+	record.RequestedBy = "someone-else"
+
+	suite.mockErasureRecordRepository.
+		EXPECT().
+		GetByID(mock.Anything, "erasure-id").
+		Return(record, nil)
+
+	verification, err := suite.service.VerifyErasureRecord(context.Background(), "erasure-id")
+	suite.NoError(err)
+	suite.False(verification.Valid)
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidProfile = errors.New("invalid profile")
+)
+
+const (
+	maxDisplayNameLength   = 32
+	maxAvatarURLLength     = 2048
+	maxMetadataEntries     = 16
+	maxMetadataKeyLength   = 32
+	maxMetadataValueLength = 256
+)
+
+// validateProfile checks the fields of a profile and returns it normalized,
+// the display name is trimmed and the country code is upper cased.
+func validateProfile(profile domain.UserProfile) (domain.UserProfile, error) {
+	profile.DisplayName = strings.TrimSpace(profile.DisplayName)
+
+	if utf8.RuneCountInString(profile.DisplayName) > maxDisplayNameLength {
+		return domain.UserProfile{}, fmt.Errorf("%w, display name is longer than %d characters", ErrInvalidProfile, maxDisplayNameLength)
+	}
+
+	if strings.IndexFunc(profile.DisplayName, unicode.IsControl) >= 0 {
+		return domain.UserProfile{}, fmt.Errorf("%w, display name contains control characters", ErrInvalidProfile)
+	}
+
+	profile.CountryCode = strings.ToUpper(profile.CountryCode)
+
+	if profile.CountryCode != "" && !isCountryCode(profile.CountryCode) {
+		return domain.UserProfile{}, fmt.Errorf("%w, country code is not an ISO 3166-1 alpha-2 code", ErrInvalidProfile)
+	}
+
+	if profile.AvatarURL != "" {
+		if len(profile.AvatarURL) > maxAvatarURLLength {
+			return domain.UserProfile{}, fmt.Errorf("%w, avatar url is longer than %d characters", ErrInvalidProfile, maxAvatarURLLength)
+		}
+
+		avatarURL, err := url.Parse(profile.AvatarURL)
+		if err != nil || avatarURL.Scheme != "https" || avatarURL.Host == "" {
+			return domain.UserProfile{}, fmt.Errorf("%w, avatar url is not an https url", ErrInvalidProfile)
+		}
+	}
+
+	if len(profile.Metadata) > maxMetadataEntries {
+		return domain.UserProfile{}, fmt.Errorf("%w, metadata has more than %d entries", ErrInvalidProfile, maxMetadataEntries)
+	}
+
+	for key, value := range profile.Metadata {
+		if key == "" || len(key) > maxMetadataKeyLength {
+			return domain.UserProfile{}, fmt.Errorf("%w, metadata keys have to be 1 to %d characters long", ErrInvalidProfile, maxMetadataKeyLength)
+		}
+
+		if len(value) > maxMetadataValueLength {
+			return domain.UserProfile{}, fmt.Errorf("%w, metadata value of %q is longer than %d characters", ErrInvalidProfile, key, maxMetadataValueLength)
+		}
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import "game/internal/domain"
+
+// rankLeaderboard numbers the users on the leaderboard by the rank mode,
+// the users are already sorted with their ties broken. The percentile does
+// not depend on the rank mode, the users with the same score share it. It
+// is taken over the whole leaderboard when only the top of it has been
+// read.
+func rankLeaderboard(leaderboard *domain.Leaderboard, mode string) {
+	total := len(leaderboard.UserScores)
+	if leaderboard.Total > int64(total) {
+		total = int(leaderboard.Total)
+	}
+
+	var standardRank, denseRank int64
+
+	for i := range leaderboard.UserScores {
+		userScore := &leaderboard.UserScores[i]
+
+		if i == 0 || userScore.Score != leaderboard.UserScores[i-1].Score {
+			standardRank = int64(i) + 1
+			denseRank++
+		}
+
+		switch mode {
+		case domain.RankModeDense:
+			userScore.Rank = denseRank
+		case domain.RankModeOrdinal:
+			userScore.Rank = int64(i) + 1
+		default:
+			userScore.Rank = standardRank
+		}
+
+		userScore.Percentile = 100 * float64(int64(total)-standardRank+1) / float64(total)
+	}
+}
+
+// rankUserRank numbers the ranks of the user on the global and on the
+// country leaderboard by their rank modes, along with the ranks and the
+// percentiles of the neighbours, from the ordinal ranks and the counts read
+// by the repository.
+func rankUserRank(userRank *domain.UserRank, mode, countryMode string) {
+	userRank.Rank = rankByMode(userRank.Rank, userRank.Counts, mode)
+
+	if userRank.CountryRank > 0 {
+		userRank.CountryRank = rankByMode(userRank.CountryRank, userRank.CountryCounts, countryMode)
+	}
+
+	total := userRank.Counts.Total
+
+	standardRank := userRank.NeighboursCounts.Above + 1
+	denseRank := userRank.NeighboursCounts.DistinctAbove + 1
+
+	for i := range userRank.Neighbours {
+		userScore := &userRank.Neighbours[i]
+
+		if i > 0 && userScore.Score != userRank.Neighbours[i-1].Score {
+			standardRank = userRank.NeighboursStartRank + int64(i)
+			denseRank++
+		}
+
+		switch mode {
+		case domain.RankModeDense:
+			userScore.Rank = denseRank
+		case domain.RankModeOrdinal:
+			userScore.Rank = userRank.NeighboursStartRank + int64(i)
+		default:
+			userScore.Rank = standardRank
+		}
+
+		userScore.Percentile = 100 * float64(total-standardRank+1) / float64(total)
+	}
+}
+
+// rankByMode numbers the ordinal rank of a user by the rank mode, from the
+// counts of its score.
+func rankByMode(ordinalRank int64, counts domain.RankCounts, mode string) int64 {
+	switch mode {
+	case domain.RankModeDense:
+		return counts.DistinctAbove + 1
+	case domain.RankModeOrdinal:
+		return ordinalRank
+	default:
+		return counts.Above + 1
+	}
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidRatingMode  = errors.New("invalid rating mode")
+	ErrInvalidMatchResult = errors.New("invalid match result")
+	ErrMatchReported      = errors.New("match reported")
+	ErrRatingConflict     = errors.New("rating conflict")
+)
+
+const (
+	maxMatchPlayers = 100
+	// maxRatingAttempts is how many times the ratings of a match are computed
+	// again when a player has played another match meanwhile.
+	maxRatingAttempts = 3
+)
+
+//go:generate mockery --name RatingService --structname MockRatingService --outpkg mocks --filename rating_service_mock.go --output ./mocks/. --with-expecter
+type RatingService interface {
+	// ReportMatchResult updates the ratings of the players of the match and
+	// returns them, a match can only be reported once.
+	ReportMatchResult(ctx context.Context, result domain.MatchResult) ([]domain.Rating, error)
+	GetRatingLeaderboard(ctx context.Context, mode string) ([]domain.RatedUserScore, error)
+	GetUserRating(ctx context.Context, mode, userID string) (UserRating, error)
+}
+
+// UserRating is the rating of a user in a mode, Rank is 0 when the user has
+// not played the mode yet and Rating is the rating it starts with then.
+type UserRating struct {
+	Rating domain.Rating
+	Rank   int64
+}
+
+type RatingServiceDependencies struct {
+	UserRepository   domain.UserRepository
+	RatingRepository domain.RatingRepository
+
+	// Modes are the modes the players are rated in, every mode has its own
+	// ratings and leaderboard.
+	Modes []string
+	// Tau is the system constant of Glicko-2 that constrains the change of
+	// the volatility, reasonable values are between 0.3 and 1.2.
+	Tau float64
+
+	// LeaderboardSize is the number of players on a rating leaderboard, the
+	// ranks on it are numbered by RankMode.
+	LeaderboardSize int64
+	RankMode        string
+}
+
+type ratingService struct {
+	userRepository   domain.UserRepository
+	ratingRepository domain.RatingRepository
+
+	modes map[string]bool
+	tau   float64
+
+	leaderboardSize int64
+	rankMode        string
+}
+
+func NewRatingService(deps RatingServiceDependencies) *ratingService {
+	modes := make(map[string]bool, len(deps.Modes))
+
+	for _, mode := range deps.Modes {
+		modes[mode] = true
+	}
+
+	return &ratingService{
+		userRepository:   deps.UserRepository,
+		ratingRepository: deps.RatingRepository,
+		modes:            modes,
+		tau:              deps.Tau,
+		leaderboardSize:  deps.LeaderboardSize,
+		rankMode:         deps.RankMode,
+	}
+}
+
+func (service *ratingService) ReportMatchResult(ctx context.Context, result domain.MatchResult) ([]domain.Rating, error) {
+	if !service.modes[result.Mode] {
+		return nil, ErrInvalidRatingMode
+	}
+
+	userIDs, err := validateMatchResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := service.userRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) != len(userIDs) {
+		return nil, domain.ErrResourceNotFound
+	}
+
+	for attempt := 0; attempt < maxRatingAttempts; attempt++ {
+		ratings, err := service.ratingRepository.GetRatings(ctx, result.Mode, userIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedRatings := service.rateMatch(result, ratings)
+
+		saved, err := service.ratingRepository.SaveRatings(ctx, result.MatchID, result.Mode, updatedRatings)
+		if err != nil {
+			if errors.Is(err, domain.ErrResourceExists) {
+				return nil, ErrMatchReported
+			}
+
+			return nil, err
+		}
+
+		if saved {
+			return updatedRatings, nil
+		}
+	}
+
+	return nil, ErrRatingConflict
+}
+
+// rateMatch treats the match as a rating period in which every player has
+// played every other one, winning against the players placed below it and
+// drawing with the ones placed the same. Every rating is computed from the
+// ratings before the match.
+func (service *ratingService) rateMatch(result domain.MatchResult, ratings map[string]domain.Rating) []domain.Rating {
+	now := time.Now()
+
+	for _, placement := range result.Placements {
+		if _, ok := ratings[placement.UserID]; !ok {
+			ratings[placement.UserID] = domain.NewRating(placement.UserID, result.Mode)
+		}
+	}
+
+	var updatedRatings []domain.Rating
+
+	for _, placement := range result.Placements {
+		var outcomes []glicko2Outcome
+
+		for _, opponent := range result.Placements {
+			if opponent.UserID == placement.UserID {
+				continue
+			}
+
+			outcome := glicko2Outcome{
+				opponent: ratings[opponent.UserID],
+				score:    0.5,
+			}
+
+			switch {
+			case placement.Place < opponent.Place:
+				outcome.score = 1
+			case placement.Place > opponent.Place:
+				outcome.score = 0
+			}
+
+			outcomes = append(outcomes, outcome)
+		}
+
+		rating := updateGlicko2(ratings[placement.UserID], outcomes, service.tau)
+		rating.Matches++
+		rating.UpdatedAt = now
+
+		updatedRatings = append(updatedRatings, rating)
+	}
+
+	return updatedRatings
+}
+
+func (service *ratingService) GetRatingLeaderboard(ctx context.Context, mode string) ([]domain.RatedUserScore, error) {
+	if !service.modes[mode] {
+		return nil, ErrInvalidRatingMode
+	}
+
+	leaderboard, err := service.ratingRepository.GetRatingLeaderboard(ctx, mode, service.leaderboardSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rankLeaderboard(&leaderboard, service.rankMode)
+
+	var userIDs []string
+
+	for _, userScore := range leaderboard.UserScores {
+		userIDs = append(userIDs, userScore.UserID)
+	}
+
+	ratings, err := service.ratingRepository.GetRatings(ctx, mode, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ratedUserScores := []domain.RatedUserScore{}
+
+	for _, userScore := range leaderboard.UserScores {
+		rating, ok := ratings[userScore.UserID]
+		if !ok {
+			rating = domain.NewRating(userScore.UserID, mode)
+			rating.Rating = userScore.Score
+		}
+
+		ratedUserScores = append(ratedUserScores, domain.RatedUserScore{
+			UserScore: userScore,
+			Rating:    rating,
+		})
+	}
+
+	return ratedUserScores, nil
+}
+
+func (service *ratingService) GetUserRating(ctx context.Context, mode, userID string) (UserRating, error) {
+	if !service.modes[mode] {
+		return UserRating{}, ErrInvalidRatingMode
+	}
+
+	ratings, err := service.ratingRepository.GetRatings(ctx, mode, []string{userID})
+	if err != nil {
+		return UserRating{}, err
+	}
+
+	rating, ok := ratings[userID]
+	if !ok {
+		return UserRating{Rating: domain.NewRating(userID, mode)}, nil
+	}
+
+	rank, err := service.ratingRepository.GetRatingRank(ctx, mode, userID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return UserRating{}, err
+	}
+
+	return UserRating{
+		Rating: rating,
+		Rank:   rank,
+	}, nil
+}
+
+// validateMatchResult returns the IDs of the players of the match.
+func validateMatchResult(result domain.MatchResult) ([]string, error) {
+	if !isIdentifier(result.MatchID) {
+		return nil, fmt.Errorf("%w, invalid match id", ErrInvalidMatchResult)
+	}
+
+	if len(result.Placements) < 2 || len(result.Placements) > maxMatchPlayers {
+		return nil, fmt.Errorf("%w, a match has between 2 and %d players", ErrInvalidMatchResult, maxMatchPlayers)
+	}
+
+	seen := make(map[string]bool, len(result.Placements))
+
+	var userIDs []string
+
+	for _, placement := range result.Placements {
+		if placement.UserID == "" || seen[placement.UserID] {
+			return nil, fmt.Errorf("%w, every player has to be placed once", ErrInvalidMatchResult)
+		}
+
+		if placement.Place < 1 {
+			return nil, fmt.Errorf("%w, places start at 1", ErrInvalidMatchResult)
+		}
+
+		seen[placement.UserID] = true
+		userIDs = append(userIDs, placement.UserID)
+	}
+
+	return userIDs, nil
+}
+
+// ValidateRatingModes checks the modes the players are rated in, the modes
+// are part of the keys the ratings are stored at.
+func ValidateRatingModes(modes []string) error {
+	return validateIdentifiers(modes, ErrInvalidRatingMode)
+}
+
+// validateIdentifiers checks that there is at least one value and that the
+// values are distinct identifiers, err is wrapped when they are not.
+func validateIdentifiers(values []string, err error) error {
+	if len(values) == 0 {
+		return fmt.Errorf("%w, at least one is required", err)
+	}
+
+	seen := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		if !isIdentifier(value) || seen[value] {
+			return fmt.Errorf("%w, %q", err, value)
+		}
+
+		seen[value] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,344 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type RatingServiceTestSuite struct {
+	suite.Suite
+
+	service *ratingService
+
+	mockUserRepository   *mocks.MockUserRepository
+	mockRatingRepository *mocks.MockRatingRepository
+}
+
+func TestRatingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RatingServiceTestSuite))
+}
+
+func (suite *RatingServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockRatingRepository = mocks.NewMockRatingRepository(suite.T())
+
+	suite.service = NewRatingService(RatingServiceDependencies{
+		UserRepository:   suite.mockUserRepository,
+		RatingRepository: suite.mockRatingRepository,
+		Modes:            []string{"duel", "free_for_all"},
+		Tau:              0.5,
+		LeaderboardSize:  100,
+		RankMode:         domain.RankModeStandard,
+	})
+}
+
+func (suite *RatingServiceTestSuite) duel() domain.MatchResult {
+	return domain.MatchResult{
+		MatchID: "match-1",
+		Mode:    "duel",
+		Placements: []domain.MatchPlacement{
+			{UserID: "user-id-1", Place: 1},
+			{UserID: "user-id-2", Place: 2},
+		},
+	}
+}
+
+func (suite *RatingServiceTestSuite) expectUsers(userIDs ...string) {
+	var users []domain.User
+
+	for _, userID := range userIDs {
+		users = append(users, domain.User{ID: userID})
+	}
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, userIDs).
+		Return(users, nil)
+}
+
+// TestUpdateGlicko2 checks the example of the Glicko-2 paper.
+func (suite *RatingServiceTestSuite) TestUpdateGlicko2() {
+	rating := updateGlicko2(domain.Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}, []glicko2Outcome{
+		{opponent: domain.Rating{Rating: 1400, Deviation: 30}, score: 1},
+		{opponent: domain.Rating{Rating: 1550, Deviation: 100}, score: 0},
+		{opponent: domain.Rating{Rating: 1700, Deviation: 300}, score: 0},
+	}, 0.5)
+
+	suite.InDelta(1464.06, rating.Rating, 0.01)
+	suite.InDelta(151.52, rating.Deviation, 0.01)
+	suite.InDelta(0.05999, rating.Volatility, 0.00001)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult() {
+	suite.expectUsers("user-id-1", "user-id-2")
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{
+			"user-id-2": {UserID: "user-id-2", Mode: "duel", Rating: 1500, Deviation: 350, Volatility: 0.06, Matches: 4},
+		}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.Anything).
+		Return(true, nil)
+
+	ratings, err := suite.service.ReportMatchResult(context.Background(), suite.duel())
+	suite.NoError(err)
+
+	suite.Len(ratings, 2)
+	suite.Equal("user-id-1", ratings[0].UserID)
+	suite.Greater(ratings[0].Rating, float64(domain.DefaultRating))
+	suite.Less(ratings[0].Deviation, float64(domain.DefaultRatingDeviation))
+	suite.Equal(int64(1), ratings[0].Matches)
+	suite.Equal("user-id-2", ratings[1].UserID)
+	suite.Less(ratings[1].Rating, float64(domain.DefaultRating))
+	suite.Equal(int64(5), ratings[1].Matches)
+	// both players had the same rating, so the changes are the same.
+	suite.InDelta(ratings[0].Rating-domain.DefaultRating, domain.DefaultRating-ratings[1].Rating, 0.0001)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_Draw() {
+	result := suite.duel()
+	result.Placements[1].Place = 1
+
+	suite.expectUsers("user-id-1", "user-id-2")
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.Anything).
+		Return(true, nil)
+
+	ratings, err := suite.service.ReportMatchResult(context.Background(), result)
+	suite.NoError(err)
+
+	suite.InDelta(domain.DefaultRating, ratings[0].Rating, 0.0001)
+	suite.InDelta(domain.DefaultRating, ratings[1].Rating, 0.0001)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_RetriesOnConflict() {
+	suite.expectUsers("user-id-1", "user-id-2")
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{}, nil).
+		Once()
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.Anything).
+		Return(false, nil).
+		Once()
+
+	// the second player has played another match meanwhile.
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{
+			"user-id-2": {UserID: "user-id-2", Mode: "duel", Rating: 1600, Deviation: 300, Volatility: 0.06, Matches: 1},
+		}, nil).
+		Once()
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.MatchedBy(func(ratings []domain.Rating) bool {
+			return ratings[1].Matches == 2
+		})).
+		Return(true, nil).
+		Once()
+
+	ratings, err := suite.service.ReportMatchResult(context.Background(), suite.duel())
+	suite.NoError(err)
+	suite.Len(ratings, 2)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_Conflict() {
+	suite.expectUsers("user-id-1", "user-id-2")
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", mock.Anything).
+		Return(map[string]domain.Rating{}, nil).
+		Times(maxRatingAttempts)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.Anything).
+		Return(false, nil).
+		Times(maxRatingAttempts)
+
+	_, err := suite.service.ReportMatchResult(context.Background(), suite.duel())
+	suite.ErrorIs(err, ErrRatingConflict)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_AlreadyReported() {
+	suite.expectUsers("user-id-1", "user-id-2")
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", mock.Anything).
+		Return(map[string]domain.Rating{}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		SaveRatings(mock.Anything, "match-1", "duel", mock.Anything).
+		Return(false, domain.ErrResourceExists)
+
+	_, err := suite.service.ReportMatchResult(context.Background(), suite.duel())
+	suite.ErrorIs(err, ErrMatchReported)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return([]domain.User{{ID: "user-id-1"}}, nil)
+
+	_, err := suite.service.ReportMatchResult(context.Background(), suite.duel())
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_InvalidMode() {
+	result := suite.duel()
+	result.Mode = "unknown"
+
+	_, err := suite.service.ReportMatchResult(context.Background(), result)
+	suite.ErrorIs(err, ErrInvalidRatingMode)
+}
+
+func (suite *RatingServiceTestSuite) TestReportMatchResult_Invalid() {
+	for _, placements := range [][]domain.MatchPlacement{
+		{{UserID: "user-id-1", Place: 1}},
+		{{UserID: "user-id-1", Place: 1}, {UserID: "user-id-1", Place: 2}},
+		{{UserID: "user-id-1", Place: 1}, {UserID: "", Place: 2}},
+		{{UserID: "user-id-1", Place: 1}, {UserID: "user-id-2", Place: 0}},
+	} {
+		result := suite.duel()
+		result.Placements = placements
+
+		_, err := suite.service.ReportMatchResult(context.Background(), result)
+		suite.ErrorIs(err, ErrInvalidMatchResult)
+	}
+
+	result := suite.duel()
+	result.MatchID = "match 1"
+
+	_, err := suite.service.ReportMatchResult(context.Background(), result)
+	suite.ErrorIs(err, ErrInvalidMatchResult)
+}
+
+func (suite *RatingServiceTestSuite) TestGetRatingLeaderboard() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatingLeaderboard(mock.Anything, "duel", int64(100)).
+		Return(domain.Leaderboard{UserScores: []domain.UserScore{
+			{UserID: "user-id-1", Username: "first", Score: 1700},
+			{UserID: "user-id-2", Username: "second", Score: 1600},
+		}}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{
+			"user-id-1": {UserID: "user-id-1", Mode: "duel", Rating: 1700, Deviation: 60, Volatility: 0.06, Matches: 30},
+			"user-id-2": {UserID: "user-id-2", Mode: "duel", Rating: 1600, Deviation: 90, Volatility: 0.06, Matches: 10},
+		}, nil)
+
+	ratedUserScores, err := suite.service.GetRatingLeaderboard(context.Background(), "duel")
+	suite.NoError(err)
+
+	suite.Len(ratedUserScores, 2)
+	suite.Equal(int64(1), ratedUserScores[0].UserScore.Rank)
+	suite.Equal(float64(100), ratedUserScores[0].UserScore.Percentile)
+	suite.Equal(float64(60), ratedUserScores[0].Rating.Deviation)
+	suite.Equal(int64(2), ratedUserScores[1].UserScore.Rank)
+	suite.Equal(int64(10), ratedUserScores[1].Rating.Matches)
+}
+
+func (suite *RatingServiceTestSuite) TestGetRatingLeaderboard_Truncated() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatingLeaderboard(mock.Anything, "duel", int64(100)).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-1", Score: 1700},
+				{UserID: "user-id-2", Score: 1600},
+			},
+			Total: 10,
+		}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.Rating{}, nil)
+
+	ratedUserScores, err := suite.service.GetRatingLeaderboard(context.Background(), "duel")
+	suite.NoError(err)
+
+	suite.Len(ratedUserScores, 2)
+	suite.Equal(float64(100), ratedUserScores[0].UserScore.Percentile)
+	suite.Equal(float64(90), ratedUserScores[1].UserScore.Percentile)
+}
+
+func (suite *RatingServiceTestSuite) TestGetRatingLeaderboard_InvalidMode() {
+	_, err := suite.service.GetRatingLeaderboard(context.Background(), "unknown")
+	suite.ErrorIs(err, ErrInvalidRatingMode)
+}
+
+func (suite *RatingServiceTestSuite) TestGetUserRating() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id"}).
+		Return(map[string]domain.Rating{
+			"user-id": {UserID: "user-id", Mode: "duel", Rating: 1650, Deviation: 70, Volatility: 0.06, Matches: 20},
+		}, nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatingRank(mock.Anything, "duel", "user-id").
+		Return(3, nil)
+
+	userRating, err := suite.service.GetUserRating(context.Background(), "duel", "user-id")
+	suite.NoError(err)
+
+	suite.Equal(float64(1650), userRating.Rating.Rating)
+	suite.Equal(int64(3), userRating.Rank)
+}
+
+func (suite *RatingServiceTestSuite) TestGetUserRating_Unrated() {
+	suite.mockRatingRepository.
+		EXPECT().
+		GetRatings(mock.Anything, "duel", []string{"user-id"}).
+		Return(map[string]domain.Rating{}, nil)
+
+	userRating, err := suite.service.GetUserRating(context.Background(), "duel", "user-id")
+	suite.NoError(err)
+
+	suite.Equal(domain.NewRating("user-id", "duel"), userRating.Rating)
+	suite.Zero(userRating.Rank)
+}
+
+func (suite *RatingServiceTestSuite) TestValidateRatingModes() {
+	suite.NoError(ValidateRatingModes([]string{"duel", "free_for_all"}))
+
+	for _, modes := range [][]string{
+		nil,
+		{"free for all"},
+		{"duel", "duel"},
+	} {
+		suite.ErrorIs(ValidateRatingModes(modes), ErrInvalidRatingMode, modes)
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidSeasonID   = errors.New("invalid season id")
+	ErrInvalidRewardRule = errors.New("invalid reward rule")
+)
+
+const maxIdentifierLength = 64
+
+//go:generate mockery --name RewardService --structname MockRewardService --outpkg mocks --filename reward_service_mock.go --output ./mocks/. --with-expecter
+type RewardService interface {
+	// EndSeason grants the rewards of the season to the users on the
+	// current leaderboard, ending a season again only grants the rewards
+	// the users do not have yet.
+	EndSeason(ctx context.Context, seasonID string) (SeasonResult, error)
+	ListRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error)
+	// ClaimRewards claims every unclaimed grant of the user and returns
+	// them, a grant is only ever returned by a single call. The reward of
+	// a grant is added once to the inventory of the user.
+	ClaimRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error)
+}
+
+type SeasonResult struct {
+	// Ranked is the number of users on the final leaderboard, Granted is
+	// the number of grants that have been created.
+	Ranked  int64
+	Granted int64
+}
+
+type RewardServiceDependencies struct {
+	LeaderboardService    LeaderboardService
+	RewardGrantRepository domain.RewardGrantRepository
+	WalletService         WalletService
+
+	// Rules are evaluated in order, see ParseRewardRules.
+	Rules []domain.RewardRule
+}
+
+type rewardService struct {
+	leaderboardService    LeaderboardService
+	rewardGrantRepository domain.RewardGrantRepository
+	walletService         WalletService
+
+	rules []domain.RewardRule
+}
+
+func NewRewardService(deps RewardServiceDependencies) *rewardService {
+	return &rewardService{
+		leaderboardService:    deps.LeaderboardService,
+		rewardGrantRepository: deps.RewardGrantRepository,
+		walletService:         deps.WalletService,
+		rules:                 deps.Rules,
+	}
+}
+
+func (service *rewardService) EndSeason(ctx context.Context, seasonID string) (SeasonResult, error) {
+	if !isIdentifier(seasonID) {
+		return SeasonResult{}, ErrInvalidSeasonID
+	}
+
+	leaderboard, err := service.leaderboardService.GetLeaderboard(ctx)
+	if err != nil {
+		return SeasonResult{}, err
+	}
+
+	now := time.Now()
+
+	var grants []domain.RewardGrant
+
+	for _, userScore := range leaderboard.UserScores {
+		if userScore.Missing {
+			continue
+		}
+
+		rule, ok := service.matchRule(userScore)
+		if !ok {
+			continue
+		}
+
+		grants = append(grants, domain.RewardGrant{
+			SeasonID:   seasonID,
+			UserID:     userScore.UserID,
+			Bracket:    rule.Bracket,
+			Reward:     rule.Reward,
+			Rank:       userScore.Rank,
+			Percentile: userScore.Percentile,
+			Score:      userScore.Score,
+			CreatedAt:  now,
+		})
+	}
+
+	granted, err := service.rewardGrantRepository.CreateMany(ctx, grants)
+	if err != nil {
+		return SeasonResult{}, err
+	}
+
+	return SeasonResult{
+		Ranked:  int64(len(leaderboard.UserScores)),
+		Granted: granted,
+	}, nil
+}
+
+func (service *rewardService) ListRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	return service.rewardGrantRepository.ListByUserID(ctx, userID)
+}
+
+func (service *rewardService) ClaimRewards(ctx context.Context, userID string) ([]domain.RewardGrant, error) {
+	grants, err := service.rewardGrantRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedAt := time.Now()
+	claimedGrants := []domain.RewardGrant{}
+
+	for _, grant := range grants {
+		if grant.ClaimedAt != nil {
+			continue
+		}
+
+		// the reward is granted before the grant is claimed, the
+		// idempotency key makes a retried claim not grant it twice.
+		_, err = service.walletService.Grant(ctx, domain.LedgerEntry{
+			UserID:         userID,
+			IdempotencyKey: "season-reward-" + grant.SeasonID,
+			Reason:         "reward of season " + grant.SeasonID,
+			Items:          map[string]int64{grant.Reward: 1},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		claimed, err := service.rewardGrantRepository.Claim(ctx, grant.ID, claimedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// the grant has been claimed by a concurrent call.
+		if !claimed {
+			continue
+		}
+
+		grant.ClaimedAt = &claimedAt
+		claimedGrants = append(claimedGrants, grant)
+	}
+
+	return claimedGrants, nil
+}
+
+func (service *rewardService) matchRule(userScore domain.UserScore) (domain.RewardRule, bool) {
+	for _, rule := range service.rules {
+		if rule.Matches(userScore) {
+			return rule, true
+		}
+	}
+
+	return domain.RewardRule{}, false
+}
+
+// ParseRewardRules parses the rules written as "bracket:kind:threshold:reward",
+// e.g. "gold:percentile:99:gold_chest" or "champion:rank:10:champion_chest".
+func ParseRewardRules(values []string) ([]domain.RewardRule, error) {
+	var rules []domain.RewardRule
+
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) != 4 || parts[0] == "" || parts[3] == "" {
+			return nil, fmt.Errorf("%w, %q is not bracket:kind:threshold:reward", ErrInvalidRewardRule, value)
+		}
+
+		// the reward is the item granted to the wallet of the user.
+		if !isIdentifier(parts[3]) {
+			return nil, fmt.Errorf("%w, invalid reward in %q", ErrInvalidRewardRule, value)
+		}
+
+		threshold, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w, invalid threshold in %q", ErrInvalidRewardRule, value)
+		}
+
+		switch parts[1] {
+		case domain.RewardRuleRank:
+			if threshold < 1 {
+				return nil, fmt.Errorf("%w, rank threshold in %q has to be at least 1", ErrInvalidRewardRule, value)
+			}
+		case domain.RewardRulePercentile:
+			if threshold <= 0 || threshold > 100 {
+				return nil, fmt.Errorf("%w, percentile threshold in %q has to be in (0, 100]", ErrInvalidRewardRule, value)
+			}
+		default:
+			return nil, fmt.Errorf("%w, unknown kind in %q", ErrInvalidRewardRule, value)
+		}
+
+		rules = append(rules, domain.RewardRule{
+			Bracket:   parts[0],
+			Kind:      parts[1],
+			Threshold: threshold,
+			Reward:    parts[3],
+		})
+	}
+
+	return rules, nil
+}
+
+// isIdentifier only allows letters, digits, dashes and underscores, the
+// season and the achievement IDs are a part of the IDs of the records.
+func isIdentifier(value string) bool {
+	return isToken(value, maxIdentifierLength)
+}
+
+// isIdempotencyKey allows longer identifiers, the keys of the granted
+// rewards are derived from the season IDs.
+func isIdempotencyKey(value string) bool {
+	return isToken(value, maxIdempotencyKeyLength)
+}
+
+func isToken(value string, maxLength int) bool {
+	if value == "" || len(value) > maxLength {
+		return false
+	}
+
+	for _, r := range value {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+
+		if !isLetter && !isDigit && r != '-' && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
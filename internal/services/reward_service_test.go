@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type RewardServiceTestSuite struct {
+	suite.Suite
+
+	service *rewardService
+
+	mockLeaderboardService    *nextLeaderboardService
+	mockRewardGrantRepository *mocks.MockRewardGrantRepository
+	mockWalletRepository      *mocks.MockWalletRepository
+}
+
+func TestRewardServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RewardServiceTestSuite))
+}
+
+func (suite *RewardServiceTestSuite) SetupTest() {
+	suite.mockLeaderboardService = &nextLeaderboardService{}
+	suite.mockLeaderboardService.Test(suite.T())
+	suite.mockRewardGrantRepository = mocks.NewMockRewardGrantRepository(suite.T())
+	suite.mockWalletRepository = mocks.NewMockWalletRepository(suite.T())
+
+	rules, err := ParseRewardRules([]string{
+		"champion:rank:1:champion_chest",
+		"gold:percentile:75:gold_chest",
+		"silver:percentile:50:silver_chest",
+	})
+	suite.Require().NoError(err)
+
+	suite.service = NewRewardService(RewardServiceDependencies{
+		LeaderboardService:    suite.mockLeaderboardService,
+		RewardGrantRepository: suite.mockRewardGrantRepository,
+		WalletService: NewWalletService(WalletServiceDependencies{
+			WalletRepository: suite.mockWalletRepository,
+			Currencies:       []string{"coins"},
+		}),
+		Rules: rules,
+	})
+}
+
+func (suite *RewardServiceTestSuite) TestEndSeason() {
+	suite.mockLeaderboardService.
+		On("GetLeaderboard", mock.Anything).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-1", Score: 900, Rank: 1, Percentile: 100},
+				{UserID: "user-id-2", Score: 800, Rank: 2, Percentile: 80},
+				{UserID: "user-id-3", Score: 700, Rank: 3, Percentile: 60, Missing: true},
+				{UserID: "user-id-4", Score: 600, Rank: 4, Percentile: 40},
+				{UserID: "user-id-5", Score: 500, Rank: 5, Percentile: 20},
+			},
+		}, nil).
+		Once()
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		CreateMany(mock.Anything, mock.MatchedBy(func(grants []domain.RewardGrant) bool {
+			return len(grants) == 2 &&
+				grants[0].SeasonID == "season-1" &&
+				grants[0].UserID == "user-id-1" &&
+				grants[0].Bracket == "champion" &&
+				grants[0].Reward == "champion_chest" &&
+				grants[1].UserID == "user-id-2" &&
+				grants[1].Bracket == "gold" &&
+				grants[1].Rank == 2
+		})).
+		Return(2, nil)
+
+	result, err := suite.service.EndSeason(context.Background(), "season-1")
+	suite.NoError(err)
+	suite.Equal(SeasonResult{Ranked: 5, Granted: 2}, result)
+
+	suite.mockLeaderboardService.AssertExpectations(suite.T())
+}
+
+func (suite *RewardServiceTestSuite) TestEndSeason_InvalidSeasonID() {
+	_, err := suite.service.EndSeason(context.Background(), "season:1")
+	suite.ErrorIs(err, ErrInvalidSeasonID)
+}
+
+func (suite *RewardServiceTestSuite) TestClaimRewards() {
+	claimedAt := time.Now().Add(-time.Hour)
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.RewardGrant{
+			{ID: "season-3:user-id", SeasonID: "season-3", Reward: "gold_chest"},
+			{ID: "season-2:user-id", SeasonID: "season-2", Reward: "silver_chest"},
+			{ID: "season-1:user-id", SeasonID: "season-1", Reward: "gold_chest", ClaimedAt: &claimedAt},
+		}, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.MatchedBy(func(entry domain.LedgerEntry) bool {
+			return entry.UserID == "user-id" &&
+				entry.IdempotencyKey == "season-reward-season-3" &&
+				entry.Type == domain.LedgerEntryGrant &&
+				entry.Items["gold_chest"] == 1
+		})).
+		Return(domain.LedgerEntry{ID: "user-id:season-reward-season-3"}, true, nil)
+
+	// granted by the concurrent call as well, the grant is not applied twice.
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.MatchedBy(func(entry domain.LedgerEntry) bool {
+			return entry.IdempotencyKey == "season-reward-season-2"
+		})).
+		Return(domain.LedgerEntry{
+			ID:     "user-id:season-reward-season-2",
+			Type:   domain.LedgerEntryGrant,
+			Reason: "reward of season season-2",
+			Items:  map[string]int64{"silver_chest": 1},
+		}, false, domain.ErrResourceExists)
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		Claim(mock.Anything, "season-3:user-id", mock.Anything).
+		Return(true, nil)
+
+	// claimed by a concurrent call in the meantime.
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		Claim(mock.Anything, "season-2:user-id", mock.Anything).
+		Return(false, nil)
+
+	grants, err := suite.service.ClaimRewards(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Len(grants, 1)
+	suite.Equal("season-3:user-id", grants[0].ID)
+	suite.NotNil(grants[0].ClaimedAt)
+}
+
+func (suite *RewardServiceTestSuite) TestClaimRewards_GrantFailed() {
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return([]domain.RewardGrant{
+			{ID: "season-1:user-id", SeasonID: "season-1", Reward: "gold_chest"},
+		}, nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, false, domain.ErrInternal)
+
+	grants, err := suite.service.ClaimRewards(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrInternal)
+	suite.Empty(grants)
+}
+
+func (suite *RewardServiceTestSuite) TestClaimRewards_NothingToClaim() {
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id").
+		Return(nil, nil)
+
+	grants, err := suite.service.ClaimRewards(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Empty(grants)
+}
+
+func (suite *RewardServiceTestSuite) TestParseRewardRules_Invalid() {
+	for _, value := range []string{
+		"gold",
+		"gold:percentile:0:gold_chest",
+		"gold:percentile:101:gold_chest",
+		"champion:rank:0:champion_chest",
+		"gold:score:100:gold_chest",
+		"gold:percentile:abc:gold_chest",
+		":rank:10:champion_chest",
+		"gold:percentile:75:gold.chest",
+	} {
+		_, err := ParseRewardRules([]string{value})
+		suite.ErrorIs(err, ErrInvalidRewardRule, value)
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidScore          = errors.New("invalid score")
+	ErrSubmissionTooFrequent = domain.ErrSubmissionTooFrequent
+)
+
+const (
+	SuspiciousReasonScoreGain = "score gain exceeds the allowed limit"
+	SuspiciousReasonOutlier   = "score is a statistical outlier"
+)
+
+// scoreValidator runs the validation pipeline of a leaderboard. Invalid
+// submissions are rejected with an error, suspicious ones are reported with
+// a reason so they can be quarantined instead of being published.
+type scoreValidator struct {
+	rules                     domain.ScoreValidationRules
+	scoreSubmissionRepository domain.ScoreSubmissionRepository
+}
+
+type scoreCheck func(ctx context.Context, submission domain.ScoreSubmission, lastSubmission *domain.ScoreSubmission) (string, error)
+
+func (validator *scoreValidator) validate(ctx context.Context, submission domain.ScoreSubmission) (string, error) {
+	err := validator.checkBounds(submission.Score)
+	if err != nil {
+		return "", err
+	}
+
+	// the interval is checked by the same atomic write that records the
+	// attempt, so concurrent submissions can not all pass it.
+	last, err := validator.scoreSubmissionRepository.ReserveSubmission(ctx, submission, validator.rules.MinSubmissionInterval)
+	if err != nil {
+		return "", err
+	}
+
+	checks := []scoreCheck{
+		validator.checkScoreGain,
+		validator.checkOutlier,
+	}
+
+	for _, check := range checks {
+		reason, err := check(ctx, submission, last)
+		if err != nil {
+			return "", err
+		}
+
+		if reason != "" {
+			return reason, nil
+		}
+	}
+
+	// only a submission that has passed every check becomes the one the
+	// next ones are checked against, a quarantined score is never the
+	// baseline of its own resubmission.
+	err = validator.scoreSubmissionRepository.SaveLastSubmission(ctx, submission)
+	if err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+func (validator *scoreValidator) checkBounds(score float64) error {
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return fmt.Errorf("%w, score is not a finite number", ErrInvalidScore)
+	}
+
+	if score < validator.rules.MinScore {
+		return fmt.Errorf("%w, score is lower than %v", ErrInvalidScore, validator.rules.MinScore)
+	}
+
+	if validator.rules.MaxScore > 0 && score > validator.rules.MaxScore {
+		return fmt.Errorf("%w, score is higher than %v", ErrInvalidScore, validator.rules.MaxScore)
+	}
+
+	return nil
+}
+
+func (validator *scoreValidator) checkScoreGain(
+	ctx context.Context, submission domain.ScoreSubmission, lastSubmission *domain.ScoreSubmission,
+) (string, error) {
+	if validator.rules.MaxScoreGain <= 0 || validator.rules.ScoreGainInterval <= 0 {
+		return "", nil
+	}
+
+	// the first submission of a user is checked against the lowest score,
+	// over a single interval.
+	lastScore, intervals := validator.rules.MinScore, 1.0
+
+	if lastSubmission != nil {
+		lastScore = lastSubmission.Score
+		intervals = math.Max(1, float64(submission.SubmittedAt.Sub(lastSubmission.SubmittedAt))/float64(validator.rules.ScoreGainInterval))
+	}
+
+	if submission.Score-lastScore > validator.rules.MaxScoreGain*intervals {
+		return SuspiciousReasonScoreGain, nil
+	}
+
+	return "", nil
+}
+
+func (validator *scoreValidator) checkOutlier(
+	ctx context.Context, submission domain.ScoreSubmission, lastSubmission *domain.ScoreSubmission,
+) (string, error) {
+	if validator.rules.OutlierZScore <= 0 {
+		return "", nil
+	}
+
+	statistics, err := validator.scoreSubmissionRepository.GetStatistics(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if statistics.Count < validator.rules.OutlierMinSamples || statistics.StdDev == 0 {
+		return "", nil
+	}
+
+	if (submission.Score-statistics.Mean)/statistics.StdDev > validator.rules.OutlierZScore {
+		return SuspiciousReasonOutlier, nil
+	}
+
+	return "", nil
+}
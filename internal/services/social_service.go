@@ -0,0 +1,416 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrSameUser              = errors.New("same user")
+	ErrUserBlocked           = errors.New("user blocked")
+	ErrUserNotBlocked        = errors.New("user not blocked")
+	ErrAlreadyFriends        = errors.New("already friends")
+	ErrFriendRequestExists   = errors.New("friend request exists")
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+	ErrFriendNotFound        = errors.New("friend not found")
+	ErrTooManyFriends        = errors.New("too many friends")
+)
+
+//go:generate mockery --name SocialService --structname MockSocialService --outpkg mocks --filename social_service_mock.go --output ./mocks/. --with-expecter
+type SocialService interface {
+	// SendFriendRequest accepts the request of the other user instead when
+	// it has already sent one, the returned friendship is accepted then.
+	SendFriendRequest(ctx context.Context, userID, friendID string) (domain.Friendship, error)
+	AcceptFriendRequest(ctx context.Context, userID, requesterID string) error
+	DeclineFriendRequest(ctx context.Context, userID, requesterID string) error
+	RemoveFriend(ctx context.Context, userID, friendID string) error
+	BlockUser(ctx context.Context, userID, blockedUserID string) error
+	UnblockUser(ctx context.Context, userID, blockedUserID string) error
+	ListFriends(ctx context.Context, userID string) ([]Friend, error)
+	// ListFriendRequests returns the pending requests the user has sent and
+	// received.
+	ListFriendRequests(ctx context.Context, userID string) ([]Friend, error)
+	// GetFriendsLeaderboard ranks the user and its friends among themselves.
+	GetFriendsLeaderboard(ctx context.Context, userID string) (domain.Leaderboard, error)
+}
+
+type Friend struct {
+	UserID string
+	User   domain.PublicUser
+	// Incoming is set on the friend requests the user has received.
+	Incoming bool
+	// Since is when the friendship has been accepted or when the friend
+	// request has been sent.
+	Since time.Time
+}
+
+type SocialServiceDependencies struct {
+	UserRepository       domain.UserRepository
+	UserScoreRepository  domain.UserScoreRepository
+	FriendshipRepository domain.FriendshipRepository
+
+	// MaxFriends bounds the friends of a user, so that the friends
+	// leaderboard stays cheap to read. Zero disables the limit.
+	MaxFriends int
+	// RankMode numbers the ranks on the friends leaderboard, see
+	// domain.RankModeStandard.
+	RankMode string
+}
+
+type socialService struct {
+	userRepository       domain.UserRepository
+	userScoreRepository  domain.UserScoreRepository
+	friendshipRepository domain.FriendshipRepository
+
+	maxFriends int
+	rankMode   string
+}
+
+func NewSocialService(deps SocialServiceDependencies) *socialService {
+	return &socialService{
+		userRepository:       deps.UserRepository,
+		userScoreRepository:  deps.UserScoreRepository,
+		friendshipRepository: deps.FriendshipRepository,
+		maxFriends:           deps.MaxFriends,
+		rankMode:             deps.RankMode,
+	}
+}
+
+// relations is how two users are related, from the point of view of the
+// first one.
+type relations struct {
+	// friendship is the pending or accepted friendship of the users.
+	friendship *domain.Friendship
+	// block is the block of the other user by the user.
+	block          *domain.Friendship
+	blockedByOther bool
+}
+
+func (service *socialService) SendFriendRequest(ctx context.Context, userID, friendID string) (domain.Friendship, error) {
+	if userID == friendID {
+		return domain.Friendship{}, ErrSameUser
+	}
+
+	_, err := service.userRepository.GetByID(ctx, friendID)
+	if err != nil {
+		return domain.Friendship{}, err
+	}
+
+	relations, err := service.getRelations(ctx, userID, friendID)
+	if err != nil {
+		return domain.Friendship{}, err
+	}
+
+	if relations.block != nil || relations.blockedByOther {
+		return domain.Friendship{}, ErrUserBlocked
+	}
+
+	if friendship := relations.friendship; friendship != nil {
+		switch {
+		case friendship.Status == domain.FriendshipStatusAccepted:
+			return domain.Friendship{}, ErrAlreadyFriends
+		case friendship.RequesterID == userID:
+			return domain.Friendship{}, ErrFriendRequestExists
+		}
+
+		err = service.accept(ctx, *friendship)
+		if err != nil {
+			return domain.Friendship{}, err
+		}
+
+		friendship.Status = domain.FriendshipStatusAccepted
+
+		return *friendship, nil
+	}
+
+	err = service.checkFriendCount(ctx, userID, service.maxFriends-1)
+	if err != nil {
+		return domain.Friendship{}, err
+	}
+
+	now := time.Now()
+
+	return service.friendshipRepository.Create(ctx, domain.Friendship{
+		RequesterID: userID,
+		AddresseeID: friendID,
+		Status:      domain.FriendshipStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+}
+
+func (service *socialService) AcceptFriendRequest(ctx context.Context, userID, requesterID string) error {
+	friendship, err := service.getReceivedRequest(ctx, userID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	return service.accept(ctx, friendship)
+}
+
+func (service *socialService) DeclineFriendRequest(ctx context.Context, userID, requesterID string) error {
+	friendship, err := service.getReceivedRequest(ctx, userID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	return service.friendshipRepository.Delete(ctx, friendship.ID)
+}
+
+func (service *socialService) RemoveFriend(ctx context.Context, userID, friendID string) error {
+	relations, err := service.getRelations(ctx, userID, friendID)
+	if err != nil {
+		return err
+	}
+
+	if relations.friendship == nil || relations.friendship.Status != domain.FriendshipStatusAccepted {
+		return ErrFriendNotFound
+	}
+
+	return service.friendshipRepository.Delete(ctx, relations.friendship.ID)
+}
+
+// BlockUser removes the friendship or the friend request of the users, the
+// blocked user can not send friend requests to the user until it is
+// unblocked.
+func (service *socialService) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	if userID == blockedUserID {
+		return ErrSameUser
+	}
+
+	_, err := service.userRepository.GetByID(ctx, blockedUserID)
+	if err != nil {
+		return err
+	}
+
+	relations, err := service.getRelations(ctx, userID, blockedUserID)
+	if err != nil {
+		return err
+	}
+
+	if relations.block != nil {
+		return nil
+	}
+
+	if relations.friendship != nil {
+		err = service.friendshipRepository.Delete(ctx, relations.friendship.ID)
+		if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+			return err
+		}
+	}
+
+	now := time.Now()
+
+	_, err = service.friendshipRepository.Create(ctx, domain.Friendship{
+		RequesterID: userID,
+		AddresseeID: blockedUserID,
+		Status:      domain.FriendshipStatusBlocked,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *socialService) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	relations, err := service.getRelations(ctx, userID, blockedUserID)
+	if err != nil {
+		return err
+	}
+
+	if relations.block == nil {
+		return ErrUserNotBlocked
+	}
+
+	return service.friendshipRepository.Delete(ctx, relations.block.ID)
+}
+
+func (service *socialService) ListFriends(ctx context.Context, userID string) ([]Friend, error) {
+	friendships, err := service.friendshipRepository.ListByUserID(ctx, userID, domain.FriendshipStatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.toFriends(ctx, userID, friendships)
+}
+
+func (service *socialService) ListFriendRequests(ctx context.Context, userID string) ([]Friend, error) {
+	friendships, err := service.friendshipRepository.ListByUserID(ctx, userID, domain.FriendshipStatusPending)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.toFriends(ctx, userID, friendships)
+}
+
+func (service *socialService) GetFriendsLeaderboard(ctx context.Context, userID string) (domain.Leaderboard, error) {
+	friendships, err := service.friendshipRepository.ListByUserID(ctx, userID, domain.FriendshipStatusAccepted)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	userIDs := []string{userID}
+
+	for _, friendship := range friendships {
+		userIDs = append(userIDs, friendship.OtherUserID(userID))
+	}
+
+	leaderboard, err := service.userScoreRepository.GetUsersLeaderboard(ctx, userIDs)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	rankLeaderboard(&leaderboard, service.rankMode)
+	leaderboard.GeneratedAt = time.Now()
+
+	return leaderboard, nil
+}
+
+func (service *socialService) getRelations(ctx context.Context, userID, otherUserID string) (relations, error) {
+	friendships, err := service.friendshipRepository.ListBetween(ctx, userID, otherUserID)
+	if err != nil {
+		return relations{}, err
+	}
+
+	var result relations
+
+	for i := range friendships {
+		friendship := friendships[i]
+
+		switch {
+		case friendship.Status != domain.FriendshipStatusBlocked:
+			result.friendship = &friendship
+		case friendship.RequesterID == userID:
+			result.block = &friendship
+		default:
+			result.blockedByOther = true
+		}
+	}
+
+	return result, nil
+}
+
+func (service *socialService) getReceivedRequest(ctx context.Context, userID, requesterID string) (domain.Friendship, error) {
+	relations, err := service.getRelations(ctx, userID, requesterID)
+	if err != nil {
+		return domain.Friendship{}, err
+	}
+
+	friendship := relations.friendship
+
+	if friendship == nil || friendship.Status != domain.FriendshipStatusPending || friendship.AddresseeID != userID {
+		return domain.Friendship{}, ErrFriendRequestNotFound
+	}
+
+	return *friendship, nil
+}
+
+// accept accepts the friend request, the requester is checked again as it
+// may have made other friends since it has sent the request. The counts are
+// checked once more after the update, as concurrent requests of the same
+// user may all pass the first check, and the request is put back to pending
+// if either user has gone over the limit.
+func (service *socialService) accept(ctx context.Context, friendship domain.Friendship) error {
+	userIDs := []string{friendship.AddresseeID, friendship.RequesterID}
+
+	for _, userID := range userIDs {
+		err := service.checkFriendCount(ctx, userID, service.maxFriends-1)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := service.friendshipRepository.UpdateStatus(ctx, friendship.ID, domain.FriendshipStatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		err = service.checkFriendCount(ctx, userID, service.maxFriends)
+		if errors.Is(err, ErrTooManyFriends) {
+			revertErr := service.friendshipRepository.UpdateStatus(ctx, friendship.ID, domain.FriendshipStatusPending)
+			if revertErr != nil {
+				return revertErr
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkFriendCount returns ErrTooManyFriends if the user has more than
+// allowed friends.
+func (service *socialService) checkFriendCount(ctx context.Context, userID string, allowed int) error {
+	if service.maxFriends <= 0 {
+		return nil
+	}
+
+	friendships, err := service.friendshipRepository.ListByUserID(ctx, userID, domain.FriendshipStatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	if len(friendships) > allowed {
+		return ErrTooManyFriends
+	}
+
+	return nil
+}
+
+// toFriends leaves out the users that do not exist anymore and the banned
+// ones, the same as the leaderboards.
+func (service *socialService) toFriends(ctx context.Context, userID string, friendships []domain.Friendship) ([]Friend, error) {
+	if len(friendships) == 0 {
+		return []Friend{}, nil
+	}
+
+	var userIDs []string
+
+	for _, friendship := range friendships {
+		userIDs = append(userIDs, friendship.OtherUserID(userID))
+	}
+
+	users, err := service.userRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userByID := make(map[string]domain.User)
+
+	for _, user := range users {
+		userByID[user.ID] = user
+	}
+
+	friends := []Friend{}
+
+	for _, friendship := range friendships {
+		user, ok := userByID[friendship.OtherUserID(userID)]
+		if !ok || user.Banned {
+			continue
+		}
+
+		since := friendship.UpdatedAt
+		if friendship.Status == domain.FriendshipStatusPending {
+			since = friendship.CreatedAt
+		}
+
+		friends = append(friends, Friend{
+			UserID:   user.ID,
+			User:     user.Public(),
+			Incoming: friendship.AddresseeID == userID,
+			Since:    since,
+		})
+	}
+
+	return friends, nil
+}
@@ -0,0 +1,424 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type SocialServiceTestSuite struct {
+	suite.Suite
+
+	service *socialService
+
+	mockUserRepository       *mocks.MockUserRepository
+	mockUserScoreRepository  *mocks.MockUserScoreRepository
+	mockFriendshipRepository *mocks.MockFriendshipRepository
+}
+
+func TestSocialServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SocialServiceTestSuite))
+}
+
+func (suite *SocialServiceTestSuite) SetupTest() {
+	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+	suite.mockFriendshipRepository = mocks.NewMockFriendshipRepository(suite.T())
+
+	suite.service = NewSocialService(SocialServiceDependencies{
+		UserRepository:       suite.mockUserRepository,
+		UserScoreRepository:  suite.mockUserScoreRepository,
+		FriendshipRepository: suite.mockFriendshipRepository,
+		MaxFriends:           2,
+	})
+}
+
+func (suite *SocialServiceTestSuite) expectFriend() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "friend-id").
+		Return(domain.User{ID: "friend-id", Name: "friend"}, nil)
+}
+
+func (suite *SocialServiceTestSuite) expectRelations(friendships ...domain.Friendship) {
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListBetween(mock.Anything, "user-id", "friend-id").
+		Return(friendships, nil)
+}
+
+func (suite *SocialServiceTestSuite) expectFriendCount(userID string, count int) {
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, userID, domain.FriendshipStatusAccepted).
+		Return(make([]domain.Friendship, count), nil)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest() {
+	suite.expectFriend()
+	suite.expectRelations()
+	suite.expectFriendCount("user-id", 1)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(friendship domain.Friendship) bool {
+			return friendship.RequesterID == "user-id" &&
+				friendship.AddresseeID == "friend-id" &&
+				friendship.Status == domain.FriendshipStatusPending
+		})).
+		RunAndReturn(func(ctx context.Context, friendship domain.Friendship) (domain.Friendship, error) {
+			friendship.ID = "friendship-id"
+			return friendship, nil
+		})
+
+	friendship, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+	suite.Equal("friendship-id", friendship.ID)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_SameUser() {
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "user-id")
+	suite.ErrorIs(err, ErrSameUser)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "friend-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
+
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_BlockedByOther() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "block-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusBlocked,
+	})
+
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrUserBlocked)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_AlreadyFriends() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusAccepted,
+	})
+
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrAlreadyFriends)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_RequestExists() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "user-id",
+		AddresseeID: "friend-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrFriendRequestExists)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_AcceptsReceivedRequest() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+	suite.expectFriendCount("user-id", 0)
+	suite.expectFriendCount("friend-id", 0)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		UpdateStatus(mock.Anything, "friendship-id", domain.FriendshipStatusAccepted).
+		Return(nil)
+
+	friendship, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+	suite.Equal(domain.FriendshipStatusAccepted, friendship.Status)
+}
+
+func (suite *SocialServiceTestSuite) TestSendFriendRequest_TooManyFriends() {
+	suite.expectFriend()
+	suite.expectRelations()
+	suite.expectFriendCount("user-id", 2)
+
+	_, err := suite.service.SendFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrTooManyFriends)
+}
+
+func (suite *SocialServiceTestSuite) TestAcceptFriendRequest() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+	suite.expectFriendCount("user-id", 0)
+	suite.expectFriendCount("friend-id", 1)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		UpdateStatus(mock.Anything, "friendship-id", domain.FriendshipStatusAccepted).
+		Return(nil)
+
+	err := suite.service.AcceptFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestAcceptFriendRequest_RequesterHasTooManyFriends() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+	suite.expectFriendCount("user-id", 0)
+	suite.expectFriendCount("friend-id", 2)
+
+	err := suite.service.AcceptFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrTooManyFriends)
+}
+
+func (suite *SocialServiceTestSuite) TestAcceptFriendRequest_ConcurrentlyTooManyFriends() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+	suite.expectFriendCount("friend-id", 0)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", domain.FriendshipStatusAccepted).
+		Return(make([]domain.Friendship, 1), nil).
+		Once()
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		UpdateStatus(mock.Anything, "friendship-id", domain.FriendshipStatusAccepted).
+		Return(nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", domain.FriendshipStatusAccepted).
+		Return(make([]domain.Friendship, 3), nil).
+		Once()
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		UpdateStatus(mock.Anything, "friendship-id", domain.FriendshipStatusPending).
+		Return(nil)
+
+	err := suite.service.AcceptFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrTooManyFriends)
+}
+
+func (suite *SocialServiceTestSuite) TestAcceptFriendRequest_SentRequest() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "user-id",
+		AddresseeID: "friend-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+
+	err := suite.service.AcceptFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrFriendRequestNotFound)
+}
+
+func (suite *SocialServiceTestSuite) TestDeclineFriendRequest() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusPending,
+	})
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Delete(mock.Anything, "friendship-id").
+		Return(nil)
+
+	err := suite.service.DeclineFriendRequest(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestRemoveFriend() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "user-id",
+		AddresseeID: "friend-id",
+		Status:      domain.FriendshipStatusAccepted,
+	})
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Delete(mock.Anything, "friendship-id").
+		Return(nil)
+
+	err := suite.service.RemoveFriend(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestRemoveFriend_NotFriends() {
+	suite.expectRelations()
+
+	err := suite.service.RemoveFriend(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrFriendNotFound)
+}
+
+func (suite *SocialServiceTestSuite) TestBlockUser() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "friendship-id",
+		RequesterID: "user-id",
+		AddresseeID: "friend-id",
+		Status:      domain.FriendshipStatusAccepted,
+	})
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Delete(mock.Anything, "friendship-id").
+		Return(nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(friendship domain.Friendship) bool {
+			return friendship.RequesterID == "user-id" &&
+				friendship.AddresseeID == "friend-id" &&
+				friendship.Status == domain.FriendshipStatusBlocked
+		})).
+		Return(domain.Friendship{ID: "block-id"}, nil)
+
+	err := suite.service.BlockUser(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestBlockUser_AlreadyBlocked() {
+	suite.expectFriend()
+	suite.expectRelations(domain.Friendship{
+		ID:          "block-id",
+		RequesterID: "user-id",
+		AddresseeID: "friend-id",
+		Status:      domain.FriendshipStatusBlocked,
+	})
+
+	err := suite.service.BlockUser(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestUnblockUser() {
+	suite.expectRelations(
+		domain.Friendship{
+			ID:          "block-id",
+			RequesterID: "user-id",
+			AddresseeID: "friend-id",
+			Status:      domain.FriendshipStatusBlocked,
+		},
+		domain.Friendship{
+			ID:          "other-block-id",
+			RequesterID: "friend-id",
+			AddresseeID: "user-id",
+			Status:      domain.FriendshipStatusBlocked,
+		},
+	)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		Delete(mock.Anything, "block-id").
+		Return(nil)
+
+	err := suite.service.UnblockUser(context.Background(), "user-id", "friend-id")
+	suite.NoError(err)
+}
+
+func (suite *SocialServiceTestSuite) TestUnblockUser_NotBlocked() {
+	suite.expectRelations(domain.Friendship{
+		ID:          "block-id",
+		RequesterID: "friend-id",
+		AddresseeID: "user-id",
+		Status:      domain.FriendshipStatusBlocked,
+	})
+
+	err := suite.service.UnblockUser(context.Background(), "user-id", "friend-id")
+	suite.ErrorIs(err, ErrUserNotBlocked)
+}
+
+func (suite *SocialServiceTestSuite) TestListFriends() {
+	since := time.Unix(1700000000, 0)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", domain.FriendshipStatusAccepted).
+		Return([]domain.Friendship{
+			{ID: "friendship-id", RequesterID: "friend-id", AddresseeID: "user-id", Status: domain.FriendshipStatusAccepted, UpdatedAt: since},
+			{ID: "friendship-id-2", RequesterID: "user-id", AddresseeID: "banned-id", Status: domain.FriendshipStatusAccepted, UpdatedAt: since},
+			{ID: "friendship-id-3", RequesterID: "user-id", AddresseeID: "deleted-id", Status: domain.FriendshipStatusAccepted, UpdatedAt: since},
+		}, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		GetUsersByIDs(mock.Anything, []string{"friend-id", "banned-id", "deleted-id"}).
+		Return([]domain.User{
+			{ID: "friend-id", Name: "friend", Profile: domain.UserProfile{CountryCode: "DE"}},
+			{ID: "banned-id", Name: "banned", Banned: true},
+		}, nil)
+
+	friends, err := suite.service.ListFriends(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.Equal([]Friend{
+		{
+			UserID:   "friend-id",
+			User:     domain.PublicUser{Name: "friend", Profile: domain.UserProfile{CountryCode: "DE"}},
+			Incoming: true,
+			Since:    since,
+		},
+	}, friends)
+}
+
+func (suite *SocialServiceTestSuite) TestGetFriendsLeaderboard() {
+	suite.mockFriendshipRepository.
+		EXPECT().
+		ListByUserID(mock.Anything, "user-id", domain.FriendshipStatusAccepted).
+		Return([]domain.Friendship{
+			{ID: "friendship-id", RequesterID: "friend-id", AddresseeID: "user-id", Status: domain.FriendshipStatusAccepted},
+		}, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUsersLeaderboard(mock.Anything, []string{"user-id", "friend-id"}).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "friend-id", Username: "friend", Score: 86},
+				{UserID: "user-id", Username: "username", Score: 82},
+			},
+		}, nil)
+
+	leaderboard, err := suite.service.GetFriendsLeaderboard(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Len(leaderboard.UserScores, 2)
+	suite.Equal(int64(2), leaderboard.UserScores[1].Rank)
+	suite.Equal(float64(50), leaderboard.UserScores[1].Percentile)
+	suite.False(leaderboard.GeneratedAt.IsZero())
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidStorageObject   = errors.New("invalid storage object")
+	ErrStorageObjectTooLarge  = errors.New("storage object too large")
+	ErrStorageQuotaExceeded   = errors.New("storage quota exceeded")
+	ErrStorageVersionConflict = errors.New("storage version conflict")
+)
+
+//go:generate mockery --name StorageService --structname MockStorageService --outpkg mocks --filename storage_service_mock.go --output ./mocks/. --with-expecter
+type StorageService interface {
+	// Put writes the object of object.UserID. Version 0 creates the object,
+	// any other version has to be the current version of the object.
+	Put(ctx context.Context, object domain.StorageObject, version int64) (domain.StorageObject, error)
+	// Get returns the object of the owner when the reader can read it, an
+	// object the reader can not read is not found.
+	Get(ctx context.Context, readerID, ownerID, namespace, key string) (domain.StorageObject, error)
+	// List returns the objects in the namespace of the owner the reader
+	// can read, ordered by key.
+	List(ctx context.Context, readerID, ownerID, namespace string) ([]domain.StorageObject, error)
+	// Delete removes the object of the user when it is at version.
+	Delete(ctx context.Context, userID, namespace, key string, version int64) error
+}
+
+type StorageServiceDependencies struct {
+	StorageRepository domain.StorageRepository
+
+	// MaxValueSize bounds the size of the value of an object in bytes.
+	MaxValueSize int
+	// MaxObjectsPerUser bounds the objects of a user in all its namespaces.
+	MaxObjectsPerUser int64
+}
+
+type storageService struct {
+	storageRepository domain.StorageRepository
+
+	maxValueSize      int
+	maxObjectsPerUser int64
+}
+
+func NewStorageService(deps StorageServiceDependencies) *storageService {
+	return &storageService{
+		storageRepository: deps.StorageRepository,
+		maxValueSize:      deps.MaxValueSize,
+		maxObjectsPerUser: deps.MaxObjectsPerUser,
+	}
+}
+
+func (service *storageService) Put(ctx context.Context, object domain.StorageObject, version int64) (domain.StorageObject, error) {
+	err := validateStorageKey(object.UserID, object.Namespace, object.Key)
+	if err != nil {
+		return domain.StorageObject{}, err
+	}
+
+	if version < 0 {
+		return domain.StorageObject{}, fmt.Errorf("%w, version can not be negative", ErrInvalidStorageObject)
+	}
+
+	if object.Permission == "" {
+		object.Permission = domain.StoragePermissionOwnerOnly
+	}
+
+	if object.Permission != domain.StoragePermissionOwnerOnly && object.Permission != domain.StoragePermissionPublicRead {
+		return domain.StorageObject{}, fmt.Errorf("%w, unknown permission %q", ErrInvalidStorageObject, object.Permission)
+	}
+
+	if len(object.Value) > service.maxValueSize {
+		return domain.StorageObject{}, fmt.Errorf("%w, the value is larger than %d bytes", ErrStorageObjectTooLarge, service.maxValueSize)
+	}
+
+	// only a new object counts against the quota, the count can be off by
+	// the objects created concurrently.
+	if version == 0 {
+		count, err := service.storageRepository.CountByUserID(ctx, object.UserID)
+		if err != nil {
+			return domain.StorageObject{}, err
+		}
+
+		if count >= service.maxObjectsPerUser {
+			return domain.StorageObject{}, ErrStorageQuotaExceeded
+		}
+	}
+
+	object.UpdatedAt = time.Now()
+
+	stored, ok, err := service.storageRepository.Put(ctx, object, version)
+	if err != nil {
+		return domain.StorageObject{}, err
+	}
+
+	if !ok {
+		return domain.StorageObject{}, ErrStorageVersionConflict
+	}
+
+	return stored, nil
+}
+
+func (service *storageService) Get(ctx context.Context, readerID, ownerID, namespace, key string) (domain.StorageObject, error) {
+	err := validateStorageKey(ownerID, namespace, key)
+	if err != nil {
+		return domain.StorageObject{}, err
+	}
+
+	object, err := service.storageRepository.Get(ctx, ownerID, namespace, key)
+	if err != nil {
+		return domain.StorageObject{}, err
+	}
+
+	if !object.Readable(readerID) {
+		return domain.StorageObject{}, domain.ErrResourceNotFound
+	}
+
+	return object, nil
+}
+
+func (service *storageService) List(ctx context.Context, readerID, ownerID, namespace string) ([]domain.StorageObject, error) {
+	if ownerID == "" || !isIdentifier(namespace) {
+		return nil, fmt.Errorf("%w, invalid namespace", ErrInvalidStorageObject)
+	}
+
+	objects, err := service.storageRepository.List(ctx, ownerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := []domain.StorageObject{}
+
+	for _, object := range objects {
+		if object.Readable(readerID) {
+			readable = append(readable, object)
+		}
+	}
+
+	return readable, nil
+}
+
+func (service *storageService) Delete(ctx context.Context, userID, namespace, key string, version int64) error {
+	err := validateStorageKey(userID, namespace, key)
+	if err != nil {
+		return err
+	}
+
+	if version <= 0 {
+		return fmt.Errorf("%w, the version of the object is required", ErrInvalidStorageObject)
+	}
+
+	deleted, err := service.storageRepository.Delete(ctx, userID, namespace, key, version)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return ErrStorageVersionConflict
+	}
+
+	return nil
+}
+
+// validateStorageKey checks the parts of the ID of an object, the namespace
+// and the key are identifiers so they can not be confused with each other.
+func validateStorageKey(userID, namespace, key string) error {
+	if userID == "" {
+		return fmt.Errorf("%w, user id is required", ErrInvalidStorageObject)
+	}
+
+	if !isIdentifier(namespace) {
+		return fmt.Errorf("%w, invalid namespace", ErrInvalidStorageObject)
+	}
+
+	if !isIdentifier(key) {
+		return fmt.Errorf("%w, invalid key", ErrInvalidStorageObject)
+	}
+
+	return nil
+}
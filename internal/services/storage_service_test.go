@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type StorageServiceTestSuite struct {
+	suite.Suite
+
+	service *storageService
+
+	mockStorageRepository *mocks.MockStorageRepository
+}
+
+func TestStorageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StorageServiceTestSuite))
+}
+
+func (suite *StorageServiceTestSuite) SetupTest() {
+	suite.mockStorageRepository = mocks.NewMockStorageRepository(suite.T())
+
+	suite.service = NewStorageService(StorageServiceDependencies{
+		StorageRepository: suite.mockStorageRepository,
+		MaxValueSize:      16,
+		MaxObjectsPerUser: 2,
+	})
+}
+
+func (suite *StorageServiceTestSuite) TestPut_Create() {
+	suite.mockStorageRepository.
+		EXPECT().
+		CountByUserID(mock.Anything, "user-id").
+		Return(1, nil)
+
+	suite.mockStorageRepository.
+		EXPECT().
+		Put(mock.Anything, mock.MatchedBy(func(object domain.StorageObject) bool {
+			return object.UserID == "user-id" &&
+				object.Namespace == "settings" &&
+				object.Key == "audio" &&
+				object.Permission == domain.StoragePermissionOwnerOnly &&
+				!object.UpdatedAt.IsZero()
+		}), int64(0)).
+		Return(domain.StorageObject{UserID: "user-id", Namespace: "settings", Key: "audio", Version: 1}, true, nil)
+
+	object, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:    "user-id",
+		Namespace: "settings",
+		Key:       "audio",
+		Value:     []byte(`{"volume":80}`),
+	}, 0)
+	suite.NoError(err)
+	suite.Equal(int64(1), object.Version)
+}
+
+func (suite *StorageServiceTestSuite) TestPut_Update() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Put(mock.Anything, mock.MatchedBy(func(object domain.StorageObject) bool {
+			return object.Permission == domain.StoragePermissionPublicRead
+		}), int64(3)).
+		Return(domain.StorageObject{Version: 4}, true, nil)
+
+	object, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:     "user-id",
+		Namespace:  "progress",
+		Key:        "level",
+		Value:      []byte(`12`),
+		Permission: domain.StoragePermissionPublicRead,
+	}, 3)
+	suite.NoError(err)
+	suite.Equal(int64(4), object.Version)
+}
+
+func (suite *StorageServiceTestSuite) TestPut_VersionConflict() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Put(mock.Anything, mock.Anything, int64(3)).
+		Return(domain.StorageObject{}, false, nil)
+
+	_, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:    "user-id",
+		Namespace: "progress",
+		Key:       "level",
+	}, 3)
+	suite.ErrorIs(err, ErrStorageVersionConflict)
+}
+
+func (suite *StorageServiceTestSuite) TestPut_QuotaExceeded() {
+	suite.mockStorageRepository.
+		EXPECT().
+		CountByUserID(mock.Anything, "user-id").
+		Return(2, nil)
+
+	_, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:    "user-id",
+		Namespace: "progress",
+		Key:       "level",
+	}, 0)
+	suite.ErrorIs(err, ErrStorageQuotaExceeded)
+}
+
+func (suite *StorageServiceTestSuite) TestPut_TooLarge() {
+	_, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:    "user-id",
+		Namespace: "progress",
+		Key:       "level",
+		Value:     []byte(strings.Repeat("a", 17)),
+	}, 0)
+	suite.ErrorIs(err, ErrStorageObjectTooLarge)
+}
+
+func (suite *StorageServiceTestSuite) TestPut_Invalid() {
+	for _, object := range []domain.StorageObject{
+		{Namespace: "settings", Key: "audio"},
+		{UserID: "user-id", Key: "audio"},
+		{UserID: "user-id", Namespace: "settings"},
+		{UserID: "user-id", Namespace: "settings", Key: "audio:volume"},
+		{UserID: "user-id", Namespace: "settings", Key: "audio", Permission: "friends_read"},
+	} {
+		_, err := suite.service.Put(context.Background(), object, 0)
+		suite.ErrorIs(err, ErrInvalidStorageObject, object)
+	}
+
+	_, err := suite.service.Put(context.Background(), domain.StorageObject{
+		UserID:    "user-id",
+		Namespace: "settings",
+		Key:       "audio",
+	}, -1)
+	suite.ErrorIs(err, ErrInvalidStorageObject)
+}
+
+func (suite *StorageServiceTestSuite) TestGet_Owner() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Get(mock.Anything, "user-id", "settings", "audio").
+		Return(domain.StorageObject{UserID: "user-id", Permission: domain.StoragePermissionOwnerOnly, Version: 2}, nil)
+
+	object, err := suite.service.Get(context.Background(), "user-id", "user-id", "settings", "audio")
+	suite.NoError(err)
+	suite.Equal(int64(2), object.Version)
+}
+
+func (suite *StorageServiceTestSuite) TestGet_PublicRead() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Get(mock.Anything, "user-id", "progress", "level").
+		Return(domain.StorageObject{UserID: "user-id", Permission: domain.StoragePermissionPublicRead}, nil)
+
+	_, err := suite.service.Get(context.Background(), "user-id-2", "user-id", "progress", "level")
+	suite.NoError(err)
+}
+
+func (suite *StorageServiceTestSuite) TestGet_OwnerOnly() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Get(mock.Anything, "user-id", "settings", "audio").
+		Return(domain.StorageObject{UserID: "user-id", Permission: domain.StoragePermissionOwnerOnly}, nil)
+
+	_, err := suite.service.Get(context.Background(), "user-id-2", "user-id", "settings", "audio")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *StorageServiceTestSuite) TestList() {
+	suite.mockStorageRepository.
+		EXPECT().
+		List(mock.Anything, "user-id", "progress").
+		Return([]domain.StorageObject{
+			{UserID: "user-id", Key: "checkpoint", Permission: domain.StoragePermissionOwnerOnly},
+			{UserID: "user-id", Key: "level", Permission: domain.StoragePermissionPublicRead},
+		}, nil).
+		Twice()
+
+	objects, err := suite.service.List(context.Background(), "user-id-2", "user-id", "progress")
+	suite.NoError(err)
+	suite.Len(objects, 1)
+	suite.Equal("level", objects[0].Key)
+
+	objects, err = suite.service.List(context.Background(), "user-id", "user-id", "progress")
+	suite.NoError(err)
+	suite.Len(objects, 2)
+}
+
+func (suite *StorageServiceTestSuite) TestDelete() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Delete(mock.Anything, "user-id", "settings", "audio", int64(2)).
+		Return(true, nil)
+
+	err := suite.service.Delete(context.Background(), "user-id", "settings", "audio", 2)
+	suite.NoError(err)
+}
+
+func (suite *StorageServiceTestSuite) TestDelete_VersionConflict() {
+	suite.mockStorageRepository.
+		EXPECT().
+		Delete(mock.Anything, "user-id", "settings", "audio", int64(1)).
+		Return(false, nil)
+
+	err := suite.service.Delete(context.Background(), "user-id", "settings", "audio", 1)
+	suite.ErrorIs(err, ErrStorageVersionConflict)
+}
+
+func (suite *StorageServiceTestSuite) TestDelete_VersionMissing() {
+	err := suite.service.Delete(context.Background(), "user-id", "settings", "audio", 0)
+	suite.ErrorIs(err, ErrInvalidStorageObject)
+}
@@ -0,0 +1,546 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"time"
+
+	"game/internal/domain"
+)
+
+// The points of a Swiss match, a bye counts as a win.
+const (
+	swissWinPoints  = 1
+	swissDrawPoints = 0.5
+)
+
+// startRounds pairs the first round of a tournament whose players have been
+// seeded.
+func startRounds(tournament *domain.Tournament, now time.Time) {
+	tournament.Status = domain.TournamentRunning
+
+	if tournament.Format == domain.TournamentSwiss && tournament.Rounds == 0 {
+		tournament.Rounds = int64(bits.Len(uint(len(tournament.Players) - 1)))
+	}
+
+	advanceRound(tournament, now)
+}
+
+// advanceRound pairs the next round once the current one is complete, or
+// finishes the tournament when there is no round left to play.
+func advanceRound(tournament *domain.Tournament, now time.Time) {
+	for tournament.Status == domain.TournamentRunning && roundCompleted(*tournament) {
+		var matches []domain.TournamentMatch
+
+		round := tournament.CurrentRound + 1
+
+		switch tournament.Format {
+		case domain.TournamentSingleElimination:
+			matches = pairEliminationRound(*tournament, round, 1)
+		case domain.TournamentDoubleElimination:
+			matches = pairEliminationRound(*tournament, round, 2)
+		case domain.TournamentSwiss:
+			if round <= tournament.Rounds {
+				matches = pairSwissRound(*tournament, round)
+			}
+		case domain.TournamentScoreAttack:
+			// the players submit their scores during the single round, it
+			// has no matches and is closed by its deadline.
+			if round == 1 {
+				tournament.CurrentRound = round
+				tournament.RoundDeadline = now.Add(tournament.RoundDuration)
+
+				return
+			}
+		}
+
+		if len(matches) == 0 {
+			finishTournament(tournament)
+
+			return
+		}
+
+		tournament.CurrentRound = round
+		tournament.RoundDeadline = now.Add(tournament.RoundDuration)
+
+		for _, match := range matches {
+			if len(match.PlayerIDs) == 1 {
+				match.WinnerID = match.PlayerIDs[0]
+				match.Completed = true
+
+				if tournament.Format == domain.TournamentSwiss {
+					tournament.Players[tournament.Player(match.WinnerID)].Points += swissWinPoints
+				}
+			}
+
+			tournament.Matches = append(tournament.Matches, match)
+		}
+	}
+}
+
+// roundCompleted reports whether every match of the current round has a
+// result. A tournament that has not started has completed its round 0.
+func roundCompleted(tournament domain.Tournament) bool {
+	if tournament.Format == domain.TournamentScoreAttack && tournament.CurrentRound > 0 {
+		return false
+	}
+
+	for _, match := range tournament.Matches {
+		if match.Round == tournament.CurrentRound && !match.Completed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// closeRound ends the current round once its deadline has passed. The
+// matches without a result are won by the player with the better seed.
+func closeRound(tournament *domain.Tournament, now time.Time) {
+	if tournament.Format == domain.TournamentScoreAttack {
+		finishTournament(tournament)
+
+		return
+	}
+
+	for i, match := range tournament.Matches {
+		if match.Round != tournament.CurrentRound || match.Completed {
+			continue
+		}
+
+		winnerID := match.PlayerIDs[0]
+
+		for _, playerID := range match.PlayerIDs[1:] {
+			if tournament.Players[tournament.Player(playerID)].Seed < tournament.Players[tournament.Player(winnerID)].Seed {
+				winnerID = playerID
+			}
+		}
+
+		completeMatch(tournament, i, winnerID)
+	}
+
+	advanceRound(tournament, now)
+}
+
+// recordMatchResult completes a match of the current round, winnerID is
+// empty for a draw, which only Swiss matches can end in.
+func recordMatchResult(tournament *domain.Tournament, matchID, winnerID string, now time.Time) error {
+	for i, match := range tournament.Matches {
+		if match.ID != matchID {
+			continue
+		}
+
+		if match.Round != tournament.CurrentRound || match.Completed {
+			return fmt.Errorf("%w, the match is not being played", ErrInvalidTournamentResult)
+		}
+
+		if winnerID == "" && tournament.Format != domain.TournamentSwiss {
+			return fmt.Errorf("%w, only swiss matches can be drawn", ErrInvalidTournamentResult)
+		}
+
+		if winnerID != "" && match.PlayerIDs[0] != winnerID && match.PlayerIDs[1] != winnerID {
+			return fmt.Errorf("%w, the winner is not a player of the match", ErrInvalidTournamentResult)
+		}
+
+		completeMatch(tournament, i, winnerID)
+		advanceRound(tournament, now)
+
+		return nil
+	}
+
+	return fmt.Errorf("%w, unknown match", ErrInvalidTournamentResult)
+}
+
+func completeMatch(tournament *domain.Tournament, index int, winnerID string) {
+	match := &tournament.Matches[index]
+
+	match.WinnerID = winnerID
+	match.Completed = true
+
+	for _, playerID := range match.PlayerIDs {
+		player := &tournament.Players[tournament.Player(playerID)]
+
+		switch {
+		case winnerID == "":
+			player.Points += swissDrawPoints
+		case playerID == winnerID:
+			player.Points += swissWinPoints
+		default:
+			player.Losses++
+
+			if maxLosses(tournament.Format) > 0 && player.Losses >= maxLosses(tournament.Format) {
+				player.EliminatedIn = match.Round
+			}
+		}
+	}
+}
+
+// maxLosses is the number of losses that eliminate a player, 0 when the
+// players are never eliminated.
+func maxLosses(format string) int64 {
+	switch format {
+	case domain.TournamentSingleElimination:
+		return 1
+	case domain.TournamentDoubleElimination:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// pairEliminationRound pairs the players that have not lost in the winners
+// bracket and the players that have lost once in the losers bracket. The
+// first round places the players by seed so that the best seeds meet last
+// and get the byes, the winners of adjacent matches meet in the following
+// rounds. Once a single player has not lost, it meets the last player of
+// the losers bracket in the final, which is played again when the player
+// that had not lost loses it.
+func pairEliminationRound(tournament domain.Tournament, round, losses int64) []domain.TournamentMatch {
+	if round == 1 {
+		return pairFirstEliminationRound(tournament)
+	}
+
+	var winners, dropped, survivors []string
+
+	for _, match := range tournament.Matches {
+		if match.Round != round-1 {
+			continue
+		}
+
+		for _, playerID := range match.PlayerIDs {
+			player := tournament.Players[tournament.Player(playerID)]
+
+			switch {
+			case player.Losses >= losses:
+				continue
+			case player.Losses == 0:
+				winners = append(winners, playerID)
+			case match.Bracket == domain.TournamentBracketWinners:
+				dropped = append(dropped, playerID)
+			default:
+				survivors = append(survivors, playerID)
+			}
+		}
+	}
+
+	// the players that have not played the previous round are waiting for
+	// the losers bracket to end.
+	for _, player := range tournament.Players {
+		if player.Losses < losses && !contains(winners, player.UserID) && !contains(dropped, player.UserID) && !contains(survivors, player.UserID) {
+			if player.Losses == 0 {
+				winners = append(winners, player.UserID)
+			} else {
+				survivors = append(survivors, player.UserID)
+			}
+		}
+	}
+
+	losers := interleave(survivors, dropped)
+
+	if len(winners)+len(losers) <= 1 {
+		return nil
+	}
+
+	if len(winners) <= 1 && len(losers) <= 1 || len(winners) == 0 && len(losers) == 2 && lastBracket(tournament) == domain.TournamentBracketFinal {
+		return []domain.TournamentMatch{
+			newTournamentMatch(round, 0, domain.TournamentBracketFinal, append(winners, losers...)...),
+		}
+	}
+
+	var matches []domain.TournamentMatch
+
+	if len(winners) > 1 {
+		matches = append(matches, pairAdjacent(round, len(matches), domain.TournamentBracketWinners, winners)...)
+	}
+
+	if len(losers) > 1 {
+		matches = append(matches, pairAdjacent(round, len(matches), domain.TournamentBracketLosers, losers)...)
+	}
+
+	return matches
+}
+
+func pairFirstEliminationRound(tournament domain.Tournament) []domain.TournamentMatch {
+	players := make([]string, len(tournament.Players))
+
+	for _, player := range tournament.Players {
+		players[player.Seed-1] = player.UserID
+	}
+
+	size := 1 << bits.Len(uint(len(players)-1))
+
+	var positions []string
+
+	for _, seed := range bracketSeeds(size) {
+		if seed <= len(players) {
+			positions = append(positions, players[seed-1])
+		} else {
+			positions = append(positions, "")
+		}
+	}
+
+	var matches []domain.TournamentMatch
+
+	for i := 0; i < len(positions); i += 2 {
+		var playerIDs []string
+
+		for _, playerID := range positions[i : i+2] {
+			if playerID != "" {
+				playerIDs = append(playerIDs, playerID)
+			}
+		}
+
+		matches = append(matches, newTournamentMatch(1, len(matches), domain.TournamentBracketWinners, playerIDs...))
+	}
+
+	return matches
+}
+
+// bracketSeeds returns the seeds in the order they are placed in a bracket
+// of the size, a power of 2, so that seed 1 and seed 2 can only meet in the
+// final.
+func bracketSeeds(size int) []int {
+	seeds := []int{1}
+
+	for len(seeds) < size {
+		next := make([]int, 0, len(seeds)*2)
+
+		for _, seed := range seeds {
+			next = append(next, seed, len(seeds)*2+1-seed)
+		}
+
+		seeds = next
+	}
+
+	return seeds
+}
+
+// pairSwissRound pairs the players with the closest points that have not
+// met yet, the players with the same points by seed. When the number of
+// players is odd, the lowest placed player without a bye gets one.
+func pairSwissRound(tournament domain.Tournament, round int64) []domain.TournamentMatch {
+	players := append([]domain.TournamentPlayer(nil), tournament.Players...)
+
+	sort.SliceStable(players, func(i, j int) bool {
+		if players[i].Points != players[j].Points {
+			return players[i].Points > players[j].Points
+		}
+
+		return players[i].Seed < players[j].Seed
+	})
+
+	met := make(map[string]bool)
+	hadBye := make(map[string]bool)
+
+	for _, match := range tournament.Matches {
+		if len(match.PlayerIDs) == 1 {
+			hadBye[match.PlayerIDs[0]] = true
+
+			continue
+		}
+
+		met[match.PlayerIDs[0]+"\n"+match.PlayerIDs[1]] = true
+		met[match.PlayerIDs[1]+"\n"+match.PlayerIDs[0]] = true
+	}
+
+	paired := make(map[string]bool)
+
+	bye := -1
+
+	if len(players)%2 == 1 {
+		bye = len(players) - 1
+
+		for i := len(players) - 1; i >= 0; i-- {
+			if !hadBye[players[i].UserID] {
+				bye = i
+
+				break
+			}
+		}
+
+		paired[players[bye].UserID] = true
+	}
+
+	var matches []domain.TournamentMatch
+
+	for i, player := range players {
+		if paired[player.UserID] {
+			continue
+		}
+
+		opponent := -1
+
+		for j := i + 1; j < len(players); j++ {
+			if paired[players[j].UserID] {
+				continue
+			}
+
+			if opponent == -1 {
+				opponent = j
+			}
+
+			if !met[player.UserID+"\n"+players[j].UserID] {
+				opponent = j
+
+				break
+			}
+		}
+
+		paired[player.UserID] = true
+		paired[players[opponent].UserID] = true
+
+		matches = append(matches, newTournamentMatch(round, len(matches), domain.TournamentBracketSwiss, player.UserID, players[opponent].UserID))
+	}
+
+	if bye != -1 {
+		matches = append(matches, newTournamentMatch(round, len(matches), domain.TournamentBracketSwiss, players[bye].UserID))
+	}
+
+	return matches
+}
+
+// finishTournament places the players. The elimination formats place the
+// players by the round they have been eliminated in, Swiss by their points
+// and then by the points of the players they have met, and score-attack by
+// their scores.
+func finishTournament(tournament *domain.Tournament) {
+	tournament.Status = domain.TournamentFinished
+
+	type ranking struct {
+		userID string
+		keys   []float64
+		points float64
+	}
+
+	opponentPoints := make(map[string]float64)
+
+	for _, match := range tournament.Matches {
+		if len(match.PlayerIDs) != 2 {
+			continue
+		}
+
+		for i, playerID := range match.PlayerIDs {
+			opponent := tournament.Players[tournament.Player(match.PlayerIDs[1-i])]
+			opponentPoints[playerID] += opponent.Points
+		}
+	}
+
+	rankings := make([]ranking, 0, len(tournament.Players))
+
+	for _, player := range tournament.Players {
+		switch tournament.Format {
+		case domain.TournamentSwiss:
+			rankings = append(rankings, ranking{player.UserID, []float64{player.Points, opponentPoints[player.UserID]}, player.Points})
+		case domain.TournamentScoreAttack:
+			score := math.Inf(-1)
+			if player.Scored {
+				score = player.Score
+			}
+
+			rankings = append(rankings, ranking{player.UserID, []float64{score}, player.Score})
+		default:
+			eliminatedIn := float64(player.EliminatedIn)
+			if player.EliminatedIn == 0 {
+				eliminatedIn = math.Inf(1)
+			}
+
+			rankings = append(rankings, ranking{player.UserID, []float64{eliminatedIn}, 0})
+		}
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return compareKeys(rankings[i].keys, rankings[j].keys) > 0
+	})
+
+	tournament.Standings = nil
+
+	for i, ranking := range rankings {
+		place := int64(i + 1)
+
+		if i > 0 && compareKeys(rankings[i-1].keys, ranking.keys) == 0 {
+			place = tournament.Standings[i-1].Place
+		}
+
+		tournament.Standings = append(tournament.Standings, domain.TournamentStanding{
+			UserID: ranking.userID,
+			Place:  place,
+			Points: ranking.points,
+		})
+	}
+}
+
+func compareKeys(a, b []float64) int {
+	for i := range a {
+		switch {
+		case a[i] > b[i]:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		}
+	}
+
+	return 0
+}
+
+func pairAdjacent(round int64, offset int, bracket string, playerIDs []string) []domain.TournamentMatch {
+	var matches []domain.TournamentMatch
+
+	for i := 0; i < len(playerIDs); i += 2 {
+		end := i + 2
+		if end > len(playerIDs) {
+			end = len(playerIDs)
+		}
+
+		matches = append(matches, newTournamentMatch(round, offset+len(matches), bracket, playerIDs[i:end]...))
+	}
+
+	return matches
+}
+
+func newTournamentMatch(round int64, position int, bracket string, playerIDs ...string) domain.TournamentMatch {
+	return domain.TournamentMatch{
+		ID:        fmt.Sprintf("%d-%d", round, position+1),
+		Round:     round,
+		Bracket:   bracket,
+		PlayerIDs: playerIDs,
+	}
+}
+
+func lastBracket(tournament domain.Tournament) string {
+	if len(tournament.Matches) == 0 {
+		return ""
+	}
+
+	return tournament.Matches[len(tournament.Matches)-1].Bracket
+}
+
+// interleave alternates the players of a and b, so that the players that
+// have dropped from the winners bracket meet the ones that have survived
+// the losers bracket.
+func interleave(a, b []string) []string {
+	result := make([]string, 0, len(a)+len(b))
+
+	for i := 0; i < len(a) || i < len(b); i++ {
+		if i < len(a) {
+			result = append(result, a[i])
+		}
+
+		if i < len(b) {
+			result = append(result, b[i])
+		}
+	}
+
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
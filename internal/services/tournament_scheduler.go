@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name TournamentScheduler --structname MockTournamentScheduler --outpkg mocks --filename tournament_scheduler_mock.go --output ./mocks/. --with-expecter
+type TournamentScheduler interface {
+	Run(ctx context.Context)
+}
+
+type TournamentSchedulerDependencies struct {
+	TournamentService TournamentService
+
+	Interval time.Duration
+	// Metrics receives the outcome of every run: runs, failures,
+	// advanced_tournaments and last_run_timestamp.
+	Metrics *expvar.Map
+
+	Logger *logrus.Logger
+}
+
+type tournamentScheduler struct {
+	*periodicJob
+}
+
+// NewTournamentScheduler returns a TournamentScheduler that starts the due
+// tournaments and closes the rounds whose deadline has passed every interval
+// until the context of Run is done.
+func NewTournamentScheduler(deps TournamentSchedulerDependencies) *tournamentScheduler {
+	return &tournamentScheduler{
+		periodicJob: &periodicJob{
+			interval:      deps.Interval,
+			metrics:       deps.Metrics,
+			logger:        deps.Logger,
+			do:            deps.TournamentService.AdvanceDueTournaments,
+			countMetric:   "advanced_tournaments",
+			countField:    "advanced",
+			failedMessage: "failed to advance the tournaments",
+			doneMessage:   "tournaments have been advanced",
+		},
+	}
+}
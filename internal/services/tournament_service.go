@@ -0,0 +1,340 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidTournament       = errors.New("invalid tournament")
+	ErrRegistrationClosed      = errors.New("registration closed")
+	ErrTournamentFull          = errors.New("tournament full")
+	ErrAlreadyRegistered       = errors.New("already registered")
+	ErrNotRegistered           = errors.New("not registered")
+	ErrInvalidTournamentResult = errors.New("invalid tournament result")
+	ErrTournamentNotRunning    = errors.New("tournament not running")
+	ErrTournamentConflict      = errors.New("tournament conflict")
+
+	// errTournamentNotDue is returned by the mutation of a tournament that
+	// has been advanced by another scheduler meanwhile.
+	errTournamentNotDue = errors.New("tournament not due")
+)
+
+const (
+	maxTournamentNameLength = 64
+	maxSwissRounds          = 20
+	minTournamentPlayers    = 2
+	// maxTournamentAttempts is how many times a change to a tournament is
+	// made again when the tournament has been updated meanwhile.
+	maxTournamentAttempts = 3
+)
+
+//go:generate mockery --name TournamentService --structname MockTournamentService --outpkg mocks --filename tournament_service_mock.go --output ./mocks/. --with-expecter
+type TournamentService interface {
+	CreateTournament(ctx context.Context, tournament domain.Tournament) (domain.Tournament, error)
+	GetTournament(ctx context.Context, id string) (domain.Tournament, error)
+	// ListTournaments returns the tournaments with the status, the ones that
+	// start first come first.
+	ListTournaments(ctx context.Context, status string) ([]domain.Tournament, error)
+	// Register adds the user to the players of a tournament that has not
+	// started yet.
+	Register(ctx context.Context, tournamentID, userID string) (domain.Tournament, error)
+	// RecordResult completes a match of the current round, winnerID is empty
+	// when a Swiss match is drawn. The next round is paired once every
+	// match of the round has been completed.
+	RecordResult(ctx context.Context, tournamentID, matchID, winnerID string) (domain.Tournament, error)
+	// RecordScore submits a score of a player of a score-attack tournament,
+	// the best score of the player counts.
+	RecordScore(ctx context.Context, tournamentID, userID string, score float64) (domain.Tournament, error)
+	// AdvanceDueTournaments starts the tournaments whose start time has
+	// passed and closes the rounds whose deadline has passed, it returns the
+	// number of tournaments that have been advanced.
+	AdvanceDueTournaments(ctx context.Context) (int, error)
+}
+
+type TournamentServiceDependencies struct {
+	TournamentRepository domain.TournamentRepository
+	UserScoreRepository  domain.UserScoreRepository
+
+	// MaxPlayers is the highest number of players a tournament can be
+	// created for.
+	MaxPlayers int64
+}
+
+type tournamentService struct {
+	tournamentRepository domain.TournamentRepository
+	userScoreRepository  domain.UserScoreRepository
+
+	maxPlayers int64
+}
+
+func NewTournamentService(deps TournamentServiceDependencies) *tournamentService {
+	return &tournamentService{
+		tournamentRepository: deps.TournamentRepository,
+		userScoreRepository:  deps.UserScoreRepository,
+		maxPlayers:           deps.MaxPlayers,
+	}
+}
+
+func (service *tournamentService) CreateTournament(ctx context.Context, tournament domain.Tournament) (domain.Tournament, error) {
+	tournament, err := service.validateTournament(tournament)
+	if err != nil {
+		return domain.Tournament{}, err
+	}
+
+	return service.tournamentRepository.Create(ctx, domain.Tournament{
+		Name:          tournament.Name,
+		Format:        tournament.Format,
+		Status:        domain.TournamentRegistering,
+		MaxPlayers:    tournament.MaxPlayers,
+		Rounds:        tournament.Rounds,
+		RoundDuration: tournament.RoundDuration,
+		StartsAt:      tournament.StartsAt,
+		CreatedAt:     time.Now(),
+	})
+}
+
+func (service *tournamentService) GetTournament(ctx context.Context, id string) (domain.Tournament, error) {
+	return service.tournamentRepository.GetByID(ctx, id)
+}
+
+func (service *tournamentService) ListTournaments(ctx context.Context, status string) ([]domain.Tournament, error) {
+	switch status {
+	case domain.TournamentRegistering, domain.TournamentRunning, domain.TournamentFinished, domain.TournamentCancelled:
+	default:
+		return nil, fmt.Errorf("%w, unknown status %q", ErrInvalidTournament, status)
+	}
+
+	return service.tournamentRepository.List(ctx, status)
+}
+
+func (service *tournamentService) Register(ctx context.Context, tournamentID, userID string) (domain.Tournament, error) {
+	return service.update(ctx, tournamentID, func(tournament *domain.Tournament) error {
+		now := time.Now()
+
+		if tournament.Status != domain.TournamentRegistering || !now.Before(tournament.StartsAt) {
+			return ErrRegistrationClosed
+		}
+
+		if tournament.Player(userID) != -1 {
+			return ErrAlreadyRegistered
+		}
+
+		if int64(len(tournament.Players)) >= tournament.MaxPlayers {
+			return ErrTournamentFull
+		}
+
+		tournament.Players = append(tournament.Players, domain.TournamentPlayer{
+			UserID:       userID,
+			RegisteredAt: now,
+		})
+
+		return nil
+	})
+}
+
+func (service *tournamentService) RecordResult(ctx context.Context, tournamentID, matchID, winnerID string) (domain.Tournament, error) {
+	return service.update(ctx, tournamentID, func(tournament *domain.Tournament) error {
+		if tournament.Status != domain.TournamentRunning {
+			return ErrTournamentNotRunning
+		}
+
+		return recordMatchResult(tournament, matchID, winnerID, time.Now())
+	})
+}
+
+func (service *tournamentService) RecordScore(ctx context.Context, tournamentID, userID string, score float64) (domain.Tournament, error) {
+	return service.update(ctx, tournamentID, func(tournament *domain.Tournament) error {
+		if tournament.Format != domain.TournamentScoreAttack {
+			return fmt.Errorf("%w, only score-attack tournaments take scores", ErrInvalidTournamentResult)
+		}
+
+		if tournament.Status != domain.TournamentRunning || !time.Now().Before(tournament.RoundDeadline) {
+			return ErrTournamentNotRunning
+		}
+
+		index := tournament.Player(userID)
+		if index == -1 {
+			return ErrNotRegistered
+		}
+
+		player := &tournament.Players[index]
+
+		if !player.Scored || score > player.Score {
+			player.Score = score
+			player.Scored = true
+		}
+
+		return nil
+	})
+}
+
+func (service *tournamentService) AdvanceDueTournaments(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	tournaments, err := service.tournamentRepository.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		advanced int
+		errs     []error
+	)
+
+	for _, tournament := range tournaments {
+		_, err := service.update(ctx, tournament.ID, func(tournament *domain.Tournament) error {
+			switch {
+			case tournament.Status == domain.TournamentRegistering && !now.Before(tournament.StartsAt):
+				return service.startTournament(ctx, tournament, now)
+			case tournament.Status == domain.TournamentRunning && !now.Before(tournament.RoundDeadline):
+				closeRound(tournament, now)
+
+				return nil
+			}
+
+			return errTournamentNotDue
+		})
+		if err != nil {
+			if !errors.Is(err, errTournamentNotDue) {
+				errs = append(errs, fmt.Errorf("tournament %s: %w", tournament.ID, err))
+			}
+
+			continue
+		}
+
+		advanced++
+	}
+
+	return advanced, errors.Join(errs...)
+}
+
+// startTournament seeds the players by their rank on the leaderboard, the
+// players without a score by the time they have registered, and pairs the
+// first round. A tournament without enough players is cancelled.
+func (service *tournamentService) startTournament(ctx context.Context, tournament *domain.Tournament, now time.Time) error {
+	if len(tournament.Players) < minTournamentPlayers {
+		tournament.Status = domain.TournamentCancelled
+
+		return nil
+	}
+
+	userIDs := make([]string, 0, len(tournament.Players))
+
+	for _, player := range tournament.Players {
+		userIDs = append(userIDs, player.UserID)
+	}
+
+	leaderboard, err := service.userScoreRepository.GetUsersLeaderboard(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	ranks := make(map[string]int, len(leaderboard.UserScores))
+
+	for i, userScore := range leaderboard.UserScores {
+		ranks[userScore.UserID] = i + 1
+	}
+
+	players := append([]domain.TournamentPlayer(nil), tournament.Players...)
+
+	sort.SliceStable(players, func(i, j int) bool {
+		rankI, rankJ := ranks[players[i].UserID], ranks[players[j].UserID]
+
+		switch {
+		case rankI != 0 && rankJ != 0:
+			return rankI < rankJ
+		case rankI != 0 || rankJ != 0:
+			return rankI != 0
+		default:
+			return players[i].RegisteredAt.Before(players[j].RegisteredAt)
+		}
+	})
+
+	for seed, player := range players {
+		tournament.Players[tournament.Player(player.UserID)].Seed = int64(seed + 1)
+	}
+
+	startRounds(tournament, now)
+
+	return nil
+}
+
+// update applies the mutation to the stored tournament, it is applied again
+// to the new version when the tournament has been updated meanwhile.
+func (service *tournamentService) update(ctx context.Context, id string, mutate func(tournament *domain.Tournament) error) (domain.Tournament, error) {
+	for attempt := 0; attempt < maxTournamentAttempts; attempt++ {
+		tournament, err := service.tournamentRepository.GetByID(ctx, id)
+		if err != nil {
+			return domain.Tournament{}, err
+		}
+
+		err = mutate(&tournament)
+		if err != nil {
+			return domain.Tournament{}, err
+		}
+
+		updated, err := service.tournamentRepository.Update(ctx, tournament)
+		if err != nil {
+			return domain.Tournament{}, err
+		}
+
+		if updated {
+			tournament.Version++
+
+			return tournament, nil
+		}
+	}
+
+	return domain.Tournament{}, ErrTournamentConflict
+}
+
+// validateTournament returns the tournament with its name trimmed.
+func (service *tournamentService) validateTournament(tournament domain.Tournament) (domain.Tournament, error) {
+	tournament.Name = strings.TrimSpace(tournament.Name)
+
+	length := utf8.RuneCountInString(tournament.Name)
+
+	if length == 0 || length > maxTournamentNameLength {
+		return domain.Tournament{}, fmt.Errorf("%w, name has to be 1 to %d characters long", ErrInvalidTournament, maxTournamentNameLength)
+	}
+
+	if strings.IndexFunc(tournament.Name, unicode.IsControl) >= 0 {
+		return domain.Tournament{}, fmt.Errorf("%w, name contains control characters", ErrInvalidTournament)
+	}
+
+	switch tournament.Format {
+	case domain.TournamentSingleElimination, domain.TournamentDoubleElimination, domain.TournamentScoreAttack:
+		if tournament.Rounds != 0 {
+			return domain.Tournament{}, fmt.Errorf("%w, only swiss tournaments have a number of rounds", ErrInvalidTournament)
+		}
+	case domain.TournamentSwiss:
+		if tournament.Rounds < 0 || tournament.Rounds > maxSwissRounds {
+			return domain.Tournament{}, fmt.Errorf("%w, rounds has to be 0 to %d", ErrInvalidTournament, maxSwissRounds)
+		}
+	default:
+		return domain.Tournament{}, fmt.Errorf("%w, unknown format %q", ErrInvalidTournament, tournament.Format)
+	}
+
+	if tournament.MaxPlayers < minTournamentPlayers || tournament.MaxPlayers > service.maxPlayers {
+		return domain.Tournament{}, fmt.Errorf("%w, max players has to be %d to %d", ErrInvalidTournament, minTournamentPlayers, service.maxPlayers)
+	}
+
+	if tournament.RoundDuration <= 0 {
+		return domain.Tournament{}, fmt.Errorf("%w, round duration has to be positive", ErrInvalidTournament)
+	}
+
+	if !tournament.StartsAt.After(time.Now()) {
+		return domain.Tournament{}, fmt.Errorf("%w, start time has to be in the future", ErrInvalidTournament)
+	}
+
+	return tournament, nil
+}
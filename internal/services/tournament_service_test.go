@@ -0,0 +1,776 @@
+package services
+
+import (
+	"context"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type TournamentServiceTestSuite struct {
+	suite.Suite
+
+	service *tournamentService
+
+	mockTournamentRepository *mocks.MockTournamentRepository
+	mockUserScoreRepository  *mocks.MockUserScoreRepository
+}
+
+func TestTournamentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TournamentServiceTestSuite))
+}
+
+func (suite *TournamentServiceTestSuite) SetupTest() {
+	suite.mockTournamentRepository = mocks.NewMockTournamentRepository(suite.T())
+	suite.mockUserScoreRepository = mocks.NewMockUserScoreRepository(suite.T())
+
+	suite.service = NewTournamentService(TournamentServiceDependencies{
+		TournamentRepository: suite.mockTournamentRepository,
+		UserScoreRepository:  suite.mockUserScoreRepository,
+		MaxPlayers:           64,
+	})
+}
+
+// seeded returns a tournament that is about to start, the players are
+// seeded in the given order.
+func (suite *TournamentServiceTestSuite) seeded(format string, userIDs ...string) domain.Tournament {
+	tournament := domain.Tournament{
+		ID:            "tournament-id",
+		Format:        format,
+		Status:        domain.TournamentRegistering,
+		MaxPlayers:    64,
+		RoundDuration: time.Hour,
+		Version:       1,
+	}
+
+	for i, userID := range userIDs {
+		tournament.Players = append(tournament.Players, domain.TournamentPlayer{
+			UserID: userID,
+			Seed:   int64(i + 1),
+		})
+	}
+
+	return tournament
+}
+
+// play records the results of the current round, winners maps the IDs of
+// the matches to their winners.
+func (suite *TournamentServiceTestSuite) play(tournament *domain.Tournament, winners map[string]string) {
+	for matchID, winnerID := range winners {
+		suite.NoError(recordMatchResult(tournament, matchID, winnerID, time.Now()))
+	}
+}
+
+func (suite *TournamentServiceTestSuite) matches(tournament domain.Tournament, round int64) [][]string {
+	var matches [][]string
+
+	for _, match := range tournament.Matches {
+		if match.Round == round {
+			matches = append(matches, match.PlayerIDs)
+		}
+	}
+
+	return matches
+}
+
+func (suite *TournamentServiceTestSuite) places(tournament domain.Tournament) map[string]int64 {
+	places := make(map[string]int64, len(tournament.Standings))
+
+	for _, standing := range tournament.Standings {
+		places[standing.UserID] = standing.Place
+	}
+
+	return places
+}
+
+func (suite *TournamentServiceTestSuite) TestCreateTournament() {
+	startsAt := time.Now().Add(time.Hour)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(tournament domain.Tournament) bool {
+			return tournament.Name == "Weekly Cup" &&
+				tournament.Status == domain.TournamentRegistering &&
+				tournament.Format == domain.TournamentSwiss &&
+				tournament.Rounds == 5 &&
+				tournament.StartsAt.Equal(startsAt) &&
+				len(tournament.Players) == 0
+		})).
+		Return(domain.Tournament{ID: "tournament-id"}, nil)
+
+	tournament, err := suite.service.CreateTournament(context.Background(), domain.Tournament{
+		Name:          "  Weekly Cup ",
+		Format:        domain.TournamentSwiss,
+		MaxPlayers:    32,
+		Rounds:        5,
+		RoundDuration: time.Hour,
+		StartsAt:      startsAt,
+		// the status and the players are set by the service.
+		Status:  domain.TournamentFinished,
+		Players: []domain.TournamentPlayer{{UserID: "user-id"}},
+	})
+	suite.NoError(err)
+	suite.Equal("tournament-id", tournament.ID)
+}
+
+func (suite *TournamentServiceTestSuite) TestCreateTournament_Invalid() {
+	valid := domain.Tournament{
+		Name:          "Weekly Cup",
+		Format:        domain.TournamentSingleElimination,
+		MaxPlayers:    32,
+		RoundDuration: time.Hour,
+		StartsAt:      time.Now().Add(time.Hour),
+	}
+
+	invalid := []func(tournament *domain.Tournament){
+		func(tournament *domain.Tournament) { tournament.Name = "   " },
+		func(tournament *domain.Tournament) { tournament.Name = "Weekly\nCup" },
+		func(tournament *domain.Tournament) { tournament.Format = "round_robin" },
+		func(tournament *domain.Tournament) { tournament.Rounds = 3 },
+		func(tournament *domain.Tournament) { tournament.MaxPlayers = 1 },
+		func(tournament *domain.Tournament) { tournament.MaxPlayers = 65 },
+		func(tournament *domain.Tournament) { tournament.RoundDuration = 0 },
+		func(tournament *domain.Tournament) { tournament.StartsAt = time.Now().Add(-time.Minute) },
+		func(tournament *domain.Tournament) {
+			tournament.Format = domain.TournamentSwiss
+			tournament.Rounds = maxSwissRounds + 1
+		},
+	}
+
+	for _, change := range invalid {
+		tournament := valid
+		change(&tournament)
+
+		_, err := suite.service.CreateTournament(context.Background(), tournament)
+		suite.ErrorIs(err, ErrInvalidTournament)
+	}
+}
+
+func (suite *TournamentServiceTestSuite) TestListTournaments_InvalidStatus() {
+	_, err := suite.service.ListTournaments(context.Background(), "paused")
+	suite.ErrorIs(err, ErrInvalidTournament)
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister() {
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(domain.Tournament{
+			ID:         "tournament-id",
+			Status:     domain.TournamentRegistering,
+			MaxPlayers: 2,
+			StartsAt:   time.Now().Add(time.Hour),
+			Players:    []domain.TournamentPlayer{{UserID: "user-id-1"}},
+			Version:    3,
+		}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(tournament domain.Tournament) bool {
+			return tournament.Version == 3 && tournament.Player("user-id-2") == 1
+		})).
+		Return(true, nil)
+
+	tournament, err := suite.service.Register(context.Background(), "tournament-id", "user-id-2")
+	suite.NoError(err)
+
+	suite.Equal(int64(4), tournament.Version)
+	suite.Len(tournament.Players, 2)
+	suite.WithinDuration(time.Now(), tournament.Players[1].RegisteredAt, time.Second)
+}
+
+func (suite *TournamentServiceTestSuite) registering() domain.Tournament {
+	return domain.Tournament{
+		ID:         "tournament-id",
+		Status:     domain.TournamentRegistering,
+		MaxPlayers: 2,
+		StartsAt:   time.Now().Add(time.Hour),
+		Players:    []domain.TournamentPlayer{{UserID: "user-id-1"}},
+	}
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister_Started() {
+	tournament := suite.registering()
+	tournament.StartsAt = time.Now().Add(-time.Second)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.Register(context.Background(), "tournament-id", "user-id-2")
+	suite.ErrorIs(err, ErrRegistrationClosed)
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister_Running() {
+	tournament := suite.registering()
+	tournament.Status = domain.TournamentRunning
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.Register(context.Background(), "tournament-id", "user-id-2")
+	suite.ErrorIs(err, ErrRegistrationClosed)
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister_Full() {
+	tournament := suite.registering()
+	tournament.MaxPlayers = 1
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.Register(context.Background(), "tournament-id", "user-id-2")
+	suite.ErrorIs(err, ErrTournamentFull)
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister_AlreadyRegistered() {
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(suite.registering(), nil)
+
+	_, err := suite.service.Register(context.Background(), "tournament-id", "user-id-1")
+	suite.ErrorIs(err, ErrAlreadyRegistered)
+}
+
+func (suite *TournamentServiceTestSuite) TestRegister_Conflict() {
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(domain.Tournament{
+			ID:         "tournament-id",
+			Status:     domain.TournamentRegistering,
+			MaxPlayers: 2,
+			StartsAt:   time.Now().Add(time.Hour),
+		}, nil).
+		Times(maxTournamentAttempts)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(false, nil).
+		Times(maxTournamentAttempts)
+
+	_, err := suite.service.Register(context.Background(), "tournament-id", "user-id")
+	suite.ErrorIs(err, ErrTournamentConflict)
+}
+
+func (suite *TournamentServiceTestSuite) TestAdvanceDueTournaments_Start() {
+	registering := domain.Tournament{
+		ID:            "tournament-id",
+		Format:        domain.TournamentSingleElimination,
+		Status:        domain.TournamentRegistering,
+		MaxPlayers:    8,
+		RoundDuration: time.Hour,
+		StartsAt:      time.Now().Add(-time.Second),
+		Players: []domain.TournamentPlayer{
+			{UserID: "user-id-1", RegisteredAt: time.Unix(1700000000, 0)},
+			{UserID: "user-id-2", RegisteredAt: time.Unix(1700000001, 0)},
+			{UserID: "user-id-3", RegisteredAt: time.Unix(1700000002, 0)},
+			{UserID: "user-id-4", RegisteredAt: time.Unix(1700000003, 0)},
+			{UserID: "user-id-5", RegisteredAt: time.Unix(1700000004, 0)},
+		},
+	}
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return([]domain.Tournament{registering}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(registering, nil)
+
+	// user-id-1 and user-id-4 have no score, they are seeded last in the
+	// order they have registered.
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUsersLeaderboard(mock.Anything, []string{"user-id-1", "user-id-2", "user-id-3", "user-id-4", "user-id-5"}).
+		Return(domain.Leaderboard{
+			UserScores: []domain.UserScore{
+				{UserID: "user-id-3", Score: 300},
+				{UserID: "user-id-5", Score: 200},
+				{UserID: "user-id-2", Score: 100},
+			},
+		}, nil)
+
+	var started domain.Tournament
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, tournament domain.Tournament) {
+			started = tournament
+		}).
+		Return(true, nil)
+
+	advanced, err := suite.service.AdvanceDueTournaments(context.Background())
+	suite.NoError(err)
+	suite.Equal(1, advanced)
+
+	suite.Equal(domain.TournamentRunning, started.Status)
+	suite.Equal(int64(1), started.CurrentRound)
+	suite.WithinDuration(time.Now().Add(time.Hour), started.RoundDeadline, time.Second)
+
+	seeds := make(map[string]int64)
+
+	for _, player := range started.Players {
+		seeds[player.UserID] = player.Seed
+	}
+
+	suite.Equal(map[string]int64{
+		"user-id-3": 1,
+		"user-id-5": 2,
+		"user-id-2": 3,
+		"user-id-1": 4,
+		"user-id-4": 5,
+	}, seeds)
+
+	// the bracket of 8 places the seeds 1, 8, 4, 5, 2, 7, 3, 6 so the three
+	// best seeds get a bye.
+	suite.Equal([][]string{
+		{"user-id-3"},
+		{"user-id-1", "user-id-4"},
+		{"user-id-5"},
+		{"user-id-2"},
+	}, suite.matches(started, 1))
+
+	suite.True(started.Matches[0].Completed)
+	suite.Equal("user-id-3", started.Matches[0].WinnerID)
+	suite.False(started.Matches[1].Completed)
+}
+
+func (suite *TournamentServiceTestSuite) TestAdvanceDueTournaments_Cancelled() {
+	registering := domain.Tournament{
+		ID:       "tournament-id",
+		Format:   domain.TournamentSwiss,
+		Status:   domain.TournamentRegistering,
+		StartsAt: time.Now().Add(-time.Second),
+		Players:  []domain.TournamentPlayer{{UserID: "user-id-1"}},
+	}
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return([]domain.Tournament{registering}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(registering, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(tournament domain.Tournament) bool {
+			return tournament.Status == domain.TournamentCancelled && len(tournament.Matches) == 0
+		})).
+		Return(true, nil)
+
+	advanced, err := suite.service.AdvanceDueTournaments(context.Background())
+	suite.NoError(err)
+	suite.Equal(1, advanced)
+}
+
+func (suite *TournamentServiceTestSuite) TestAdvanceDueTournaments_Deadline() {
+	running := suite.seeded(domain.TournamentSingleElimination, "user-id-1", "user-id-2", "user-id-3", "user-id-4")
+	startRounds(&running, time.Now().Add(-2*time.Hour))
+	suite.play(&running, map[string]string{"1-1": "user-id-4"})
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return([]domain.Tournament{running}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(running, nil)
+
+	var advancedTournament domain.Tournament
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, tournament domain.Tournament) {
+			advancedTournament = tournament
+		}).
+		Return(true, nil)
+
+	advanced, err := suite.service.AdvanceDueTournaments(context.Background())
+	suite.NoError(err)
+	suite.Equal(1, advanced)
+
+	// the unreported match 1-2 is won by the better seed.
+	suite.Equal("user-id-2", advancedTournament.Matches[1].WinnerID)
+	suite.Equal(int64(2), advancedTournament.CurrentRound)
+	suite.Equal([][]string{{"user-id-4", "user-id-2"}}, suite.matches(advancedTournament, 2))
+}
+
+func (suite *TournamentServiceTestSuite) TestAdvanceDueTournaments_NotDue() {
+	registering := domain.Tournament{
+		ID:       "tournament-id",
+		Status:   domain.TournamentRegistering,
+		StartsAt: time.Now().Add(-time.Second),
+	}
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return([]domain.Tournament{registering}, nil)
+
+	// another scheduler has started the tournament meanwhile.
+	running := registering
+	running.Status = domain.TournamentRunning
+	running.RoundDeadline = time.Now().Add(time.Hour)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(running, nil)
+
+	advanced, err := suite.service.AdvanceDueTournaments(context.Background())
+	suite.NoError(err)
+	suite.Equal(0, advanced)
+}
+
+func (suite *TournamentServiceTestSuite) TestAdvanceDueTournaments_Failed() {
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return([]domain.Tournament{{ID: "tournament-id-1"}, {ID: "tournament-id-2"}}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id-1").
+		Return(domain.Tournament{}, domain.ErrInternal)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id-2").
+		Return(domain.Tournament{
+			ID:       "tournament-id-2",
+			Status:   domain.TournamentRegistering,
+			StartsAt: time.Now().Add(-time.Second),
+		}, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(true, nil)
+
+	advanced, err := suite.service.AdvanceDueTournaments(context.Background())
+	suite.ErrorIs(err, domain.ErrInternal)
+	suite.Equal(1, advanced)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordResult() {
+	running := suite.seeded(domain.TournamentSingleElimination, "user-id-1", "user-id-2")
+	startRounds(&running, time.Now())
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(running, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(true, nil)
+
+	tournament, err := suite.service.RecordResult(context.Background(), "tournament-id", "1-1", "user-id-2")
+	suite.NoError(err)
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal(map[string]int64{"user-id-2": 1, "user-id-1": 2}, suite.places(tournament))
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordResult_NotRunning() {
+	tournament := suite.seeded(domain.TournamentSingleElimination, "user-id-1", "user-id-2")
+	startRounds(&tournament, time.Now())
+	tournament.Status = domain.TournamentFinished
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.RecordResult(context.Background(), "tournament-id", "1-1", "user-id-1")
+	suite.ErrorIs(err, ErrTournamentNotRunning)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordResult_Invalid() {
+	tournament := suite.seeded(domain.TournamentDoubleElimination, "user-id-1", "user-id-2", "user-id-3", "user-id-4")
+	startRounds(&tournament, time.Now())
+
+	// an unknown match, a winner that has not played the match and a draw
+	// outside of a Swiss tournament.
+	results := [][2]string{
+		{"2-1", "user-id-1"},
+		{"1-1", "user-id-2"},
+		{"1-1", ""},
+	}
+
+	for _, result := range results {
+		suite.ErrorIs(recordMatchResult(&tournament, result[0], result[1], time.Now()), ErrInvalidTournamentResult)
+	}
+
+	suite.play(&tournament, map[string]string{"1-1": "user-id-1"})
+
+	suite.ErrorIs(recordMatchResult(&tournament, "1-1", "user-id-1", time.Now()), ErrInvalidTournamentResult)
+}
+
+func (suite *TournamentServiceTestSuite) TestSingleElimination() {
+	tournament := suite.seeded(domain.TournamentSingleElimination, "user-id-1", "user-id-2", "user-id-3", "user-id-4", "user-id-5", "user-id-6")
+	startRounds(&tournament, time.Now())
+
+	suite.Equal([][]string{
+		{"user-id-1"},
+		{"user-id-4", "user-id-5"},
+		{"user-id-2"},
+		{"user-id-3", "user-id-6"},
+	}, suite.matches(tournament, 1))
+
+	suite.play(&tournament, map[string]string{"1-2": "user-id-5", "1-4": "user-id-3"})
+
+	suite.Equal([][]string{
+		{"user-id-1", "user-id-5"},
+		{"user-id-2", "user-id-3"},
+	}, suite.matches(tournament, 2))
+
+	suite.play(&tournament, map[string]string{"2-1": "user-id-1", "2-2": "user-id-3"})
+	suite.play(&tournament, map[string]string{"3-1": "user-id-3"})
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal(map[string]int64{
+		"user-id-3": 1,
+		"user-id-1": 2,
+		"user-id-5": 3,
+		"user-id-2": 3,
+		"user-id-4": 5,
+		"user-id-6": 5,
+	}, suite.places(tournament))
+}
+
+func (suite *TournamentServiceTestSuite) TestDoubleElimination() {
+	tournament := suite.seeded(domain.TournamentDoubleElimination, "user-id-1", "user-id-2", "user-id-3", "user-id-4")
+	startRounds(&tournament, time.Now())
+
+	suite.Equal([][]string{{"user-id-1", "user-id-4"}, {"user-id-2", "user-id-3"}}, suite.matches(tournament, 1))
+	suite.play(&tournament, map[string]string{"1-1": "user-id-1", "1-2": "user-id-2"})
+
+	suite.Equal([][]string{{"user-id-1", "user-id-2"}, {"user-id-4", "user-id-3"}}, suite.matches(tournament, 2))
+	suite.play(&tournament, map[string]string{"2-1": "user-id-1", "2-2": "user-id-3"})
+
+	// user-id-1 waits for the losers bracket to end.
+	suite.Equal([][]string{{"user-id-3", "user-id-2"}}, suite.matches(tournament, 3))
+	suite.play(&tournament, map[string]string{"3-1": "user-id-2"})
+
+	suite.Equal([][]string{{"user-id-1", "user-id-2"}}, suite.matches(tournament, 4))
+	suite.Equal(domain.TournamentBracketFinal, tournament.Matches[len(tournament.Matches)-1].Bracket)
+	suite.play(&tournament, map[string]string{"4-1": "user-id-2"})
+
+	// user-id-1 has lost for the first time, the final is played again.
+	suite.Equal([][]string{{"user-id-1", "user-id-2"}}, suite.matches(tournament, 5))
+	suite.play(&tournament, map[string]string{"5-1": "user-id-2"})
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal(map[string]int64{
+		"user-id-2": 1,
+		"user-id-1": 2,
+		"user-id-3": 3,
+		"user-id-4": 4,
+	}, suite.places(tournament))
+}
+
+func (suite *TournamentServiceTestSuite) TestDoubleElimination_FinalWon() {
+	tournament := suite.seeded(domain.TournamentDoubleElimination, "user-id-1", "user-id-2")
+	startRounds(&tournament, time.Now())
+
+	suite.play(&tournament, map[string]string{"1-1": "user-id-1"})
+	suite.Equal([][]string{{"user-id-1", "user-id-2"}}, suite.matches(tournament, 2))
+
+	suite.play(&tournament, map[string]string{"2-1": "user-id-1"})
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal(map[string]int64{"user-id-1": 1, "user-id-2": 2}, suite.places(tournament))
+}
+
+func (suite *TournamentServiceTestSuite) TestSwiss() {
+	tournament := suite.seeded(domain.TournamentSwiss, "user-id-1", "user-id-2", "user-id-3", "user-id-4", "user-id-5")
+	startRounds(&tournament, time.Now())
+
+	suite.Equal(int64(3), tournament.Rounds)
+	suite.Equal([][]string{
+		{"user-id-1", "user-id-2"},
+		{"user-id-3", "user-id-4"},
+		{"user-id-5"},
+	}, suite.matches(tournament, 1))
+
+	suite.play(&tournament, map[string]string{"1-1": "user-id-1", "1-2": ""})
+
+	// user-id-5 has had a bye, the lowest placed player without one gets it.
+	suite.Equal([][]string{
+		{"user-id-1", "user-id-5"},
+		{"user-id-3", "user-id-4"},
+		{"user-id-2"},
+	}, suite.matches(tournament, 2))
+
+	suite.play(&tournament, map[string]string{"2-1": "user-id-1", "2-2": "user-id-3"})
+
+	// user-id-4 is the lowest placed player without a bye.
+	suite.Equal([][]string{
+		{"user-id-1", "user-id-3"},
+		{"user-id-2", "user-id-5"},
+		{"user-id-4"},
+	}, suite.matches(tournament, 3))
+
+	suite.play(&tournament, map[string]string{"3-1": "user-id-1", "3-2": "user-id-5"})
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal([]domain.TournamentStanding{
+		{UserID: "user-id-1", Place: 1, Points: 3},
+		{UserID: "user-id-5", Place: 2, Points: 2},
+		// user-id-3 has met user-id-4 twice and user-id-1, user-id-4 has
+		// only met user-id-3.
+		{UserID: "user-id-3", Place: 3, Points: 1.5},
+		{UserID: "user-id-4", Place: 4, Points: 1.5},
+		{UserID: "user-id-2", Place: 5, Points: 1},
+	}, tournament.Standings)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordScore() {
+	running := suite.seeded(domain.TournamentScoreAttack, "user-id-1", "user-id-2")
+	startRounds(&running, time.Now())
+	running.Players[0].Score = 500
+	running.Players[0].Scored = true
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(running, nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(true, nil)
+
+	tournament, err := suite.service.RecordScore(context.Background(), "tournament-id", "user-id-1", 400)
+	suite.NoError(err)
+
+	// only the best score of a player counts.
+	suite.Equal(500.0, tournament.Players[0].Score)
+
+	tournament, err = suite.service.RecordScore(context.Background(), "tournament-id", "user-id-2", -10)
+	suite.NoError(err)
+
+	suite.Equal(-10.0, tournament.Players[1].Score)
+	suite.True(tournament.Players[1].Scored)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordScore_RoundEnded() {
+	tournament := suite.seeded(domain.TournamentScoreAttack, "user-id-1", "user-id-2")
+	startRounds(&tournament, time.Now().Add(-2*time.Hour))
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.RecordScore(context.Background(), "tournament-id", "user-id-1", 100)
+	suite.ErrorIs(err, ErrTournamentNotRunning)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordScore_NotRegistered() {
+	tournament := suite.seeded(domain.TournamentScoreAttack, "user-id-1", "user-id-2")
+	startRounds(&tournament, time.Now())
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.RecordScore(context.Background(), "tournament-id", "user-id-3", 100)
+	suite.ErrorIs(err, ErrNotRegistered)
+}
+
+func (suite *TournamentServiceTestSuite) TestRecordScore_NotScoreAttack() {
+	tournament := suite.seeded(domain.TournamentSwiss, "user-id-1", "user-id-2")
+	startRounds(&tournament, time.Now())
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		GetByID(mock.Anything, "tournament-id").
+		Return(tournament, nil)
+
+	_, err := suite.service.RecordScore(context.Background(), "tournament-id", "user-id-1", 100)
+	suite.ErrorIs(err, ErrInvalidTournamentResult)
+}
+
+func (suite *TournamentServiceTestSuite) TestScoreAttack() {
+	tournament := suite.seeded(domain.TournamentScoreAttack, "user-id-1", "user-id-2", "user-id-3", "user-id-4")
+	startRounds(&tournament, time.Now())
+
+	suite.Equal(int64(1), tournament.CurrentRound)
+	suite.Empty(tournament.Matches)
+
+	tournament.Players[1].Score, tournament.Players[1].Scored = 300, true
+	tournament.Players[2].Score, tournament.Players[2].Scored = 300, true
+	tournament.Players[3].Score, tournament.Players[3].Scored = 500, true
+
+	closeRound(&tournament, time.Now())
+
+	suite.Equal(domain.TournamentFinished, tournament.Status)
+	suite.Equal([]domain.TournamentStanding{
+		{UserID: "user-id-4", Place: 1, Points: 500},
+		{UserID: "user-id-2", Place: 2, Points: 300},
+		{UserID: "user-id-3", Place: 2, Points: 300},
+		{UserID: "user-id-1", Place: 4, Points: 0},
+	}, tournament.Standings)
+}
+
+func (suite *TournamentServiceTestSuite) TestSchedulerRunOnce_ReportsMetrics() {
+	metrics := new(expvar.Map)
+
+	scheduler := NewTournamentScheduler(TournamentSchedulerDependencies{
+		TournamentService: suite.service,
+		Metrics:           metrics,
+		Logger:            logrus.New(),
+	})
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Once()
+
+	scheduler.runOnce(context.Background())
+
+	suite.Equal("1", metrics.Get("runs").String())
+	suite.Equal("0", metrics.Get("advanced_tournaments").String())
+	suite.Nil(metrics.Get("failures"))
+	suite.NotNil(metrics.Get("last_run_timestamp"))
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		ListDue(mock.Anything, mock.Anything).
+		Return(nil, domain.ErrInternal).
+		Once()
+
+	scheduler.runOnce(context.Background())
+
+	suite.Equal("2", metrics.Get("runs").String())
+	suite.Equal("1", metrics.Get("failures").String())
+}
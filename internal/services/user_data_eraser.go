@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"game/internal/domain"
+)
+
+//go:generate mockery --name UserDataEraser --structname MockUserDataEraser --outpkg mocks --filename user_data_eraser_mock.go --output ./mocks/. --with-expecter
+type UserDataEraser interface {
+	// Erase runs the steps in order and returns the names of the steps that
+	// have been completed, also when a step fails. The user is removed last
+	// so that a failed erasure can be retried.
+	Erase(ctx context.Context, user domain.User, anonymousID string) ([]string, error)
+}
+
+// UserDataEraserDependencies holds every store the data of a user is kept
+// in, a new store only has to be added here.
+type UserDataEraserDependencies struct {
+	UserRepository             domain.UserRepository
+	UserScoreRepository        domain.UserScoreRepository
+	ScoreSubmissionRepository  domain.ScoreSubmissionRepository
+	QuarantinedScoreRepository domain.QuarantinedScoreRepository
+	FriendshipRepository       domain.FriendshipRepository
+	ClanRepository             domain.ClanRepository
+	RewardGrantRepository      domain.RewardGrantRepository
+	AchievementRepository      domain.AchievementRepository
+	RatingRepository           domain.RatingRepository
+	TournamentRepository       domain.TournamentRepository
+	EventBoardRepository       domain.EventBoardRepository
+	WalletRepository           domain.WalletRepository
+	StorageRepository          domain.StorageRepository
+	AuditLog                   domain.AuditLog
+	TokenManager               domain.TokenManager
+	UserCache                  domain.UserCache
+}
+
+// userDataEraser removes the data of a user from every store. The history
+// of the user is kept but pseudonymized with an anonymous ID so it can not
+// be linked back to the user.
+type userDataEraser struct {
+	userRepository             domain.UserRepository
+	userScoreRepository        domain.UserScoreRepository
+	scoreSubmissionRepository  domain.ScoreSubmissionRepository
+	quarantinedScoreRepository domain.QuarantinedScoreRepository
+	friendshipRepository       domain.FriendshipRepository
+	rewardGrantRepository      domain.RewardGrantRepository
+	achievementRepository      domain.AchievementRepository
+	ratingRepository           domain.RatingRepository
+	tournamentRepository       domain.TournamentRepository
+	eventBoardRepository       domain.EventBoardRepository
+	walletRepository           domain.WalletRepository
+	storageRepository          domain.StorageRepository
+	auditLog                   domain.AuditLog
+	tokenManager               domain.TokenManager
+	userCache                  domain.UserCache
+
+	clanMembership *clanMembership
+}
+
+func NewUserDataEraser(deps UserDataEraserDependencies) *userDataEraser {
+	return &userDataEraser{
+		userRepository:             deps.UserRepository,
+		userScoreRepository:        deps.UserScoreRepository,
+		scoreSubmissionRepository:  deps.ScoreSubmissionRepository,
+		quarantinedScoreRepository: deps.QuarantinedScoreRepository,
+		friendshipRepository:       deps.FriendshipRepository,
+		rewardGrantRepository:      deps.RewardGrantRepository,
+		achievementRepository:      deps.AchievementRepository,
+		ratingRepository:           deps.RatingRepository,
+		tournamentRepository:       deps.TournamentRepository,
+		eventBoardRepository:       deps.EventBoardRepository,
+		walletRepository:           deps.WalletRepository,
+		storageRepository:          deps.StorageRepository,
+		auditLog:                   deps.AuditLog,
+		tokenManager:               deps.TokenManager,
+		userCache:                  deps.UserCache,
+
+		clanMembership: &clanMembership{
+			clanRepository:      deps.ClanRepository,
+			userScoreRepository: deps.UserScoreRepository,
+		},
+	}
+}
+
+type erasureStep struct {
+	name  string
+	erase func(ctx context.Context, user domain.User, anonymousID string) error
+}
+
+func (eraser *userDataEraser) Erase(ctx context.Context, user domain.User, anonymousID string) ([]string, error) {
+	steps := []erasureStep{
+		{domain.ErasureStepScoreRemoved, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.userScoreRepository.RemoveUserScore(ctx, user.ID)
+		}},
+		{domain.ErasureStepSubmissionDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.scoreSubmissionRepository.DeleteLastSubmission(ctx, user.ID)
+		}},
+		{domain.ErasureStepFriendshipsDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.friendshipRepository.DeleteByUserID(ctx, user.ID)
+		}},
+		{domain.ErasureStepClanLeft, func(ctx context.Context, user domain.User, anonymousID string) error {
+			err := eraser.clanMembership.leave(ctx, user.ID)
+			if err != nil && !errors.Is(err, ErrNotInClan) {
+				return err
+			}
+
+			return nil
+		}},
+		{domain.ErasureStepRewardGrantsDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.rewardGrantRepository.DeleteByUserID(ctx, user.ID)
+		}},
+		{domain.ErasureStepAchievementsDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.achievementRepository.DeleteByUserID(ctx, user.ID)
+		}},
+		{domain.ErasureStepRatingsRemoved, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.ratingRepository.RemoveUserRatings(ctx, user.ID)
+		}},
+		{domain.ErasureStepEventScoresRemoved, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.eventBoardRepository.RemoveUserEventScores(ctx, user.ID)
+		}},
+		{domain.ErasureStepWalletDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.walletRepository.DeleteByUserID(ctx, user.ID)
+		}},
+		{domain.ErasureStepStorageDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.storageRepository.DeleteByUserID(ctx, user.ID)
+		}},
+		{domain.ErasureStepQuarantinePseudonymized, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.quarantinedScoreRepository.AnonymizeUser(ctx, user.ID, anonymousID)
+		}},
+		{domain.ErasureStepTournamentsPseudonymized, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.tournamentRepository.AnonymizeUser(ctx, user.ID, anonymousID)
+		}},
+		{domain.ErasureStepAuditLogPseudonymized, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.auditLog.AnonymizeUser(ctx, user.ID, anonymousID)
+		}},
+		{domain.ErasureStepTokensRevoked, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.tokenManager.RevokeUserTokens(ctx, user.ID)
+		}},
+		{domain.ErasureStepUserCacheCleared, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.userCache.Delete(ctx, user.ID)
+		}},
+		{domain.ErasureStepUserDeleted, func(ctx context.Context, user domain.User, anonymousID string) error {
+			return eraser.userRepository.Delete(ctx, user.ID)
+		}},
+	}
+
+	var completed []string
+
+	for _, step := range steps {
+		err := step.erase(ctx, user, anonymousID)
+		if err != nil {
+			return completed, err
+		}
+
+		completed = append(completed, step.name)
+	}
+
+	return completed, nil
+}
+
+// newAnonymousID returns the ID that replaces the user ID of a deleted user
+// in its history, the events of the user stay linked to each other.
+func newAnonymousID() (string, error) {
+	bytes := make([]byte, 8)
+
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+
+	return "deleted-user:" + hex.EncodeToString(bytes), nil
+}
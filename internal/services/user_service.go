@@ -3,19 +3,33 @@ package services
 import (
 	"context"
 	"errors"
+	"expvar"
+
+	"github.com/sirupsen/logrus"
 
 	"game/internal/domain"
 )
 
 var (
-	ErrInvalidPassword = errors.New("invalid password")
-	ErrUsernameExists  = errors.New("username exists")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUsernameExists     = errors.New("username exists")
 )
 
+// dummyPassword is hashed when the service is created and compared against
+// when a login is attempted for a username that does not exist, so that the
+// response time of a failed login does not reveal whether the username is
+// registered.
+const dummyPassword = "dummy-password"
+
 //go:generate mockery --name UserService --structname MockUserService --outpkg mocks --filename user_service_mock.go --output ./mocks/. --with-expecter
 type UserService interface {
 	Login(ctx context.Context, username string, password string) (LoginResult, error)
 	Register(ctx context.Context, username string, password string) (domain.User, error)
+	GetProfile(ctx context.Context, userID string) (Profile, error)
+	UpdateUsername(ctx context.Context, userID, username string) error
+	UpdateProfile(ctx context.Context, userID string, profile domain.UserProfile) error
+	ChangePassword(ctx context.Context, userID, oldPassword, newPassword string) error
+	DeleteAccount(ctx context.Context, userID, password string) error
 }
 
 type LoginResult struct {
@@ -24,11 +38,31 @@ type LoginResult struct {
 	Token    string
 }
 
+type Profile struct {
+	UserID      string
+	Username    string
+	UserProfile domain.UserProfile
+	// Score is the top score of the user, it is nil when the user is not on
+	// the leaderboard.
+	Score *float64
+}
+
 type UserServiceDependencies struct {
 	UserRepository      domain.UserRepository
 	UserScoreRepository domain.UserScoreRepository
 	TokenManager        domain.TokenManager
 	PasswordHasher      domain.PasswordHasher
+	AuditLog            domain.AuditLog
+	UserCache           domain.UserCache
+
+	// AuditMetrics counts the audit events that have failed to be recorded,
+	// the failures are logged with Logger.
+	AuditMetrics *expvar.Map
+	Logger       *logrus.Logger
+
+	// UserDataEraser removes the data of the users that delete their
+	// account.
+	UserDataEraser UserDataEraser
 }
 
 type userService struct {
@@ -36,23 +70,54 @@ type userService struct {
 	userScoreRepository domain.UserScoreRepository
 	tokenManager        domain.TokenManager
 	passwordHasher      domain.PasswordHasher
+	userCache           domain.UserCache
+
+	auditRecorder *auditRecorder
+	eraser        UserDataEraser
+
+	dummyPasswordHash    string
+	dummyPasswordHashErr error
 }
 
 func NewUserService(
 	deps UserServiceDependencies,
 ) *userService {
+	// the hash is computed upfront, hashing it on the first failed login
+	// would make that login take twice as long as the others.
+	dummyPasswordHash, dummyPasswordHashErr := deps.PasswordHasher.HashPassword(dummyPassword)
+
 	return &userService{
 		userRepository:      deps.UserRepository,
 		userScoreRepository: deps.UserScoreRepository,
 		tokenManager:        deps.TokenManager,
 		passwordHasher:      deps.PasswordHasher,
+		userCache:           deps.UserCache,
+
+		auditRecorder: &auditRecorder{
+			auditLog: deps.AuditLog,
+			metrics:  deps.AuditMetrics,
+			logger:   deps.Logger,
+		},
+		eraser: deps.UserDataEraser,
+
+		dummyPasswordHash:    dummyPasswordHash,
+		dummyPasswordHashErr: dummyPasswordHashErr,
 	}
 }
 
 func (service *userService) Login(ctx context.Context, username string, password string) (LoginResult, error) {
 	user, err := service.userRepository.GetByName(ctx, username)
 	if err != nil {
-		return LoginResult{}, err
+		if !errors.Is(err, domain.ErrResourceNotFound) {
+			return LoginResult{}, err
+		}
+
+		err = service.compareDummyPassword(password)
+		if err != nil {
+			return LoginResult{}, err
+		}
+
+		return LoginResult{}, service.recordLoginFailure(ctx, username, "", "user not found")
 	}
 
 	isMatch, err := service.passwordHasher.ComparePasswordAndHash(password, user.PasswordHash)
@@ -61,7 +126,7 @@ func (service *userService) Login(ctx context.Context, username string, password
 	}
 
 	if !isMatch {
-		return LoginResult{}, ErrInvalidPassword
+		return LoginResult{}, service.recordLoginFailure(ctx, username, user.ID, "invalid password")
 	}
 
 	token, err := service.tokenManager.Create(ctx, user.ID)
@@ -69,6 +134,8 @@ func (service *userService) Login(ctx context.Context, username string, password
 		return LoginResult{}, err
 	}
 
+	service.auditRecorder.record(ctx, domain.NewAuditEvent(ctx, domain.AuditEventLoginSucceeded, user.ID, user.ID))
+
 	return LoginResult{
 		UserID:   user.ID,
 		UserName: user.Name,
@@ -76,6 +143,35 @@ func (service *userService) Login(ctx context.Context, username string, password
 	}, nil
 }
 
+// recordLoginFailure records the failed login attempt and returns the error
+// the caller should respond with, the reason is only kept in the audit log.
+func (service *userService) recordLoginFailure(ctx context.Context, username, userID, reason string) error {
+	// the actor of a failed login is unknown, the username that has been
+	// tried is kept with the reason.
+	event := domain.NewAuditEvent(ctx, domain.AuditEventLoginFailed, "", userID)
+	event.Details = map[string]string{
+		"username": username,
+		"reason":   reason,
+	}
+
+	service.auditRecorder.record(ctx, event)
+
+	return ErrInvalidCredentials
+}
+
+func (service *userService) compareDummyPassword(password string) error {
+	if service.dummyPasswordHashErr != nil {
+		return service.dummyPasswordHashErr
+	}
+
+	_, err := service.passwordHasher.ComparePasswordAndHash(password, service.dummyPasswordHash)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (service *userService) Register(ctx context.Context, username string, password string) (domain.User, error) {
 	exists, err := service.userRepository.CheckExistsByName(ctx, username)
 	if err != nil {
@@ -96,5 +192,187 @@ func (service *userService) Register(ctx context.Context, username string, passw
 		return domain.User{}, err
 	}
 
+	err = service.userCache.Set(ctx, map[string]domain.PublicUser{user.ID: user.Public()})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	service.auditRecorder.record(ctx, domain.NewAuditEvent(ctx, domain.AuditEventUserRegistered, user.ID, user.ID))
+
+	return user, nil
+}
+
+func (service *userService) GetProfile(ctx context.Context, userID string) (Profile, error) {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profile := Profile{
+		UserID:      user.ID,
+		Username:    user.Name,
+		UserProfile: user.Profile,
+	}
+
+	userScore, err := service.userScoreRepository.GetUserTopScore(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+		return Profile{}, err
+	}
+
+	if err == nil {
+		profile.Score = &userScore.Score
+	}
+
+	return profile, nil
+}
+
+func (service *userService) UpdateUsername(ctx context.Context, userID, username string) error {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Name == username {
+		return nil
+	}
+
+	exists, err := service.userRepository.CheckExistsByName(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return ErrUsernameExists
+	}
+
+	err = service.userRepository.UpdateUsername(ctx, userID, username)
+	if err != nil {
+		return err
+	}
+
+	event := domain.NewAuditEvent(ctx, domain.AuditEventUsernameUpdated, userID, userID)
+	event.Details = map[string]string{
+		"oldUsername": user.Name,
+		"newUsername": username,
+	}
+
+	user.Name = username
+
+	err = service.userCache.Set(ctx, map[string]domain.PublicUser{userID: user.Public()})
+	if err != nil {
+		return err
+	}
+
+	service.auditRecorder.record(ctx, event)
+
+	return nil
+}
+
+// UpdateProfile replaces the display data of the user, empty fields clear
+// the previous values.
+func (service *userService) UpdateProfile(ctx context.Context, userID string, profile domain.UserProfile) error {
+	profile, err := validateProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = service.userRepository.UpdateProfile(ctx, userID, profile)
+	if err != nil {
+		return err
+	}
+
+	if user.Profile.CountryCode != profile.CountryCode {
+		err = service.userScoreRepository.SetUserCountry(ctx, userID, profile.CountryCode)
+		if err != nil {
+			return err
+		}
+	}
+
+	user.Profile = profile
+
+	err = service.userCache.Set(ctx, map[string]domain.PublicUser{userID: user.Public()})
+	if err != nil {
+		return err
+	}
+
+	service.auditRecorder.record(ctx, domain.NewAuditEvent(ctx, domain.AuditEventProfileUpdated, userID, userID))
+
+	return nil
+}
+
+// ChangePassword replaces the password of the user and revokes every token
+// of the user, so other sessions have to login again.
+func (service *userService) ChangePassword(ctx context.Context, userID, oldPassword, newPassword string) error {
+	user, err := service.getUserWithPassword(ctx, userID, oldPassword)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := service.passwordHasher.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	err = service.userRepository.UpdatePasswordHash(ctx, user.ID, passwordHash)
+	if err != nil {
+		return err
+	}
+
+	err = service.tokenManager.RevokeUserTokens(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	service.auditRecorder.record(ctx, domain.NewAuditEvent(ctx, domain.AuditEventPasswordChanged, user.ID, user.ID))
+
+	return nil
+}
+
+// DeleteAccount removes the user and its scores, the history of the user is
+// kept but anonymized so it can not be linked back to the user.
+func (service *userService) DeleteAccount(ctx context.Context, userID, password string) error {
+	user, err := service.getUserWithPassword(ctx, userID, password)
+	if err != nil {
+		return err
+	}
+
+	anonymousID, err := newAnonymousID()
+	if err != nil {
+		return err
+	}
+
+	_, err = service.eraser.Erase(ctx, user, anonymousID)
+	if err != nil {
+		return err
+	}
+
+	event := domain.NewAuditEvent(ctx, domain.AuditEventAccountDeleted, anonymousID, anonymousID)
+	event.PeerIP = ""
+
+	service.auditRecorder.record(ctx, event)
+
+	return nil
+}
+
+func (service *userService) getUserWithPassword(ctx context.Context, userID, password string) (domain.User, error) {
+	user, err := service.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	isMatch, err := service.passwordHasher.ComparePasswordAndHash(password, user.PasswordHash)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !isMatch {
+		return domain.User{}, ErrInvalidCredentials
+	}
+
 	return user, nil
 }
@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"errors"
+	"expvar"
+	"strings"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -20,6 +24,22 @@ type UserServiceTestSuite struct {
 	mockUserRepository      *mocks.MockUserRepository
 	mockTokenManager        *mocks.MockTokenManager
 	mockPasswordHasher      *mocks.MockPasswordHasher
+	mockAuditLog            *mocks.MockAuditLog
+	mockUserCache           *mocks.MockUserCache
+
+	mockScoreSubmissionRepository  *mocks.MockScoreSubmissionRepository
+	mockQuarantinedScoreRepository *mocks.MockQuarantinedScoreRepository
+	mockFriendshipRepository       *mocks.MockFriendshipRepository
+	mockClanRepository             *mocks.MockClanRepository
+	mockRewardGrantRepository      *mocks.MockRewardGrantRepository
+	mockAchievementRepository      *mocks.MockAchievementRepository
+	mockRatingRepository           *mocks.MockRatingRepository
+	mockTournamentRepository       *mocks.MockTournamentRepository
+	mockEventBoardRepository       *mocks.MockEventBoardRepository
+	mockWalletRepository           *mocks.MockWalletRepository
+	mockStorageRepository          *mocks.MockStorageRepository
+
+	auditMetrics *expvar.Map
 }
 
 func TestUserServiceTestSuite(t *testing.T) {
@@ -31,15 +51,69 @@ func (suite *UserServiceTestSuite) SetupTest() {
 	suite.mockUserRepository = mocks.NewMockUserRepository(suite.T())
 	suite.mockTokenManager = mocks.NewMockTokenManager(suite.T())
 	suite.mockPasswordHasher = mocks.NewMockPasswordHasher(suite.T())
+	suite.mockAuditLog = mocks.NewMockAuditLog(suite.T())
+	suite.mockUserCache = mocks.NewMockUserCache(suite.T())
+	suite.mockScoreSubmissionRepository = mocks.NewMockScoreSubmissionRepository(suite.T())
+	suite.mockQuarantinedScoreRepository = mocks.NewMockQuarantinedScoreRepository(suite.T())
+	suite.mockFriendshipRepository = mocks.NewMockFriendshipRepository(suite.T())
+	suite.mockClanRepository = mocks.NewMockClanRepository(suite.T())
+	suite.mockRewardGrantRepository = mocks.NewMockRewardGrantRepository(suite.T())
+	suite.mockAchievementRepository = mocks.NewMockAchievementRepository(suite.T())
+	suite.mockRatingRepository = mocks.NewMockRatingRepository(suite.T())
+	suite.mockTournamentRepository = mocks.NewMockTournamentRepository(suite.T())
+	suite.mockEventBoardRepository = mocks.NewMockEventBoardRepository(suite.T())
+	suite.mockWalletRepository = mocks.NewMockWalletRepository(suite.T())
+	suite.mockStorageRepository = mocks.NewMockStorageRepository(suite.T())
+	suite.auditMetrics = new(expvar.Map)
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		HashPassword(dummyPassword).
+		Return("dummy-password-hash", nil).
+		Once()
 
 	suite.service = NewUserService(UserServiceDependencies{
 		UserRepository:      suite.mockUserRepository,
 		UserScoreRepository: suite.mockUserScoreRepository,
 		TokenManager:        suite.mockTokenManager,
 		PasswordHasher:      suite.mockPasswordHasher,
+		AuditLog:            suite.mockAuditLog,
+		AuditMetrics:        suite.auditMetrics,
+		Logger:              logrus.New(),
+		UserCache:           suite.mockUserCache,
+
+		// the eraser runs on the mocks, so DeleteAccount is tested with
+		// every step of the erasure.
+		UserDataEraser: NewUserDataEraser(UserDataEraserDependencies{
+			UserRepository:             suite.mockUserRepository,
+			UserScoreRepository:        suite.mockUserScoreRepository,
+			ScoreSubmissionRepository:  suite.mockScoreSubmissionRepository,
+			QuarantinedScoreRepository: suite.mockQuarantinedScoreRepository,
+			FriendshipRepository:       suite.mockFriendshipRepository,
+			ClanRepository:             suite.mockClanRepository,
+			RewardGrantRepository:      suite.mockRewardGrantRepository,
+			AchievementRepository:      suite.mockAchievementRepository,
+			RatingRepository:           suite.mockRatingRepository,
+			TournamentRepository:       suite.mockTournamentRepository,
+			EventBoardRepository:       suite.mockEventBoardRepository,
+			WalletRepository:           suite.mockWalletRepository,
+			StorageRepository:          suite.mockStorageRepository,
+			AuditLog:                   suite.mockAuditLog,
+			TokenManager:               suite.mockTokenManager,
+			UserCache:                  suite.mockUserCache,
+		}),
 	})
 }
 
+func (suite *UserServiceTestSuite) expectAuditEvent(eventType, actor, userID string) *mocks.MockAuditLog_Record_Call {
+	return suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == eventType && event.Actor == actor && event.UserID == userID
+		})).
+		Return(nil)
+}
+
 func (suite *UserServiceTestSuite) TestLogin() {
 	suite.mockUserRepository.
 		EXPECT().
@@ -60,6 +134,8 @@ func (suite *UserServiceTestSuite) TestLogin() {
 		Create(mock.Anything, "user-id").
 		Return("token", nil)
 
+	suite.expectAuditEvent(domain.AuditEventLoginSucceeded, "user-id", "user-id")
+
 	result, err := suite.service.Login(context.Background(), "username", "password")
 	suite.NoError(err)
 
@@ -68,6 +144,36 @@ func (suite *UserServiceTestSuite) TestLogin() {
 	suite.Equal("username", result.UserName)
 }
 
+func (suite *UserServiceTestSuite) TestLogin_AuditLogFailed() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByName(mock.Anything, "username").
+		Return(domain.User{
+			ID:           "user-id",
+			Name:         "username",
+			PasswordHash: "password-hash",
+		}, nil)
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("password", "password-hash").
+		Return(true, nil)
+
+	suite.mockTokenManager.
+		EXPECT().
+		Create(mock.Anything, "user-id").
+		Return("token", nil)
+
+	suite.expectAuditEvent(domain.AuditEventLoginSucceeded, "user-id", "user-id").
+		Return(errors.New("audit log error"))
+
+	result, err := suite.service.Login(context.Background(), "username", "password")
+	suite.NoError(err)
+	suite.Equal("token", result.Token)
+
+	suite.Equal("1", suite.auditMetrics.Get("failures").String())
+}
+
 func (suite *UserServiceTestSuite) TestLogin_InvalidPassword() {
 	suite.mockUserRepository.
 		EXPECT().
@@ -83,8 +189,10 @@ func (suite *UserServiceTestSuite) TestLogin_InvalidPassword() {
 		ComparePasswordAndHash("password", "password-hash").
 		Return(false, nil)
 
+	suite.expectAuditEvent(domain.AuditEventLoginFailed, "", "user-id")
+
 	result, err := suite.service.Login(context.Background(), "username", "password")
-	suite.Equal(ErrInvalidPassword, err)
+	suite.Equal(ErrInvalidCredentials, err)
 	suite.Equal(LoginResult{}, result)
 }
 
@@ -94,9 +202,34 @@ func (suite *UserServiceTestSuite) TestLogin_UserNotFound() {
 		GetByName(mock.Anything, "username").
 		Return(domain.User{}, domain.ErrResourceNotFound)
 
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("password", "dummy-password-hash").
+		Return(false, nil).
+		Twice()
+
+	suite.expectAuditEvent(domain.AuditEventLoginFailed, "", "").Twice()
+
+	result, err := suite.service.Login(context.Background(), "username", "password")
+
+	suite.Equal(ErrInvalidCredentials, err)
+	suite.Empty(result)
+
+	result, err = suite.service.Login(context.Background(), "username", "password")
+
+	suite.Equal(ErrInvalidCredentials, err)
+	suite.Empty(result)
+}
+
+func (suite *UserServiceTestSuite) TestLogin_GetByNameFailed() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByName(mock.Anything, "username").
+		Return(domain.User{}, domain.ErrInternal)
+
 	result, err := suite.service.Login(context.Background(), "username", "password")
 
-	suite.Equal(err, domain.ErrResourceNotFound)
+	suite.Equal(err, domain.ErrInternal)
 	suite.Empty(result)
 }
 
@@ -167,6 +300,13 @@ func (suite *UserServiceTestSuite) TestRegister() {
 			PasswordHash: "password-hash",
 		}, nil)
 
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id": {Name: "username"}}).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventUserRegistered, "user-id", "user-id")
+
 	user, err := suite.service.Register(context.Background(), "username", "password")
 
 	expectedResult := domain.User{
@@ -241,3 +381,354 @@ func (suite *UserServiceTestSuite) TestRegister_UserRepositoryFailed() {
 	suite.ErrorIs(err, domain.ErrInternal)
 	suite.Empty(user)
 }
+
+func (suite *UserServiceTestSuite) expectUser() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{
+			ID:           "user-id",
+			Name:         "username",
+			PasswordHash: "password-hash",
+		}, nil)
+}
+
+func (suite *UserServiceTestSuite) TestGetProfile() {
+	suite.expectUser()
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{UserID: "user-id", Score: 100}, nil)
+
+	profile, err := suite.service.GetProfile(context.Background(), "user-id")
+	suite.NoError(err)
+
+	score := float64(100)
+
+	suite.Equal(Profile{
+		UserID:   "user-id",
+		Username: "username",
+		Score:    &score,
+	}, profile)
+}
+
+func (suite *UserServiceTestSuite) TestGetProfile_NoScore() {
+	suite.expectUser()
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		GetUserTopScore(mock.Anything, "user-id").
+		Return(domain.UserScore{}, domain.ErrResourceNotFound)
+
+	profile, err := suite.service.GetProfile(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Nil(profile.Score)
+}
+
+func (suite *UserServiceTestSuite) TestGetProfile_UserNotFound() {
+	suite.mockUserRepository.
+		EXPECT().
+		GetByID(mock.Anything, "user-id").
+		Return(domain.User{}, domain.ErrResourceNotFound)
+
+	_, err := suite.service.GetProfile(context.Background(), "user-id")
+	suite.ErrorIs(err, domain.ErrResourceNotFound)
+}
+
+func (suite *UserServiceTestSuite) TestUpdateUsername() {
+	suite.expectUser()
+
+	suite.mockUserRepository.
+		EXPECT().
+		CheckExistsByName(mock.Anything, "new-username").
+		Return(false, nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		UpdateUsername(mock.Anything, "user-id", "new-username").
+		Return(nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id": {Name: "new-username"}}).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventUsernameUpdated, "user-id", "user-id")
+
+	err := suite.service.UpdateUsername(context.Background(), "user-id", "new-username")
+	suite.NoError(err)
+}
+
+func (suite *UserServiceTestSuite) TestUpdateUsername_Unchanged() {
+	suite.expectUser()
+
+	err := suite.service.UpdateUsername(context.Background(), "user-id", "username")
+	suite.NoError(err)
+}
+
+func (suite *UserServiceTestSuite) TestUpdateUsername_UsernameExists() {
+	suite.expectUser()
+
+	suite.mockUserRepository.
+		EXPECT().
+		CheckExistsByName(mock.Anything, "new-username").
+		Return(true, nil)
+
+	err := suite.service.UpdateUsername(context.Background(), "user-id", "new-username")
+	suite.ErrorIs(err, ErrUsernameExists)
+}
+
+func (suite *UserServiceTestSuite) TestUpdateProfile() {
+	suite.expectUser()
+
+	profile := domain.UserProfile{
+		DisplayName: "Player One",
+		CountryCode: "DE",
+		AvatarURL:   "https://example.com/avatar.png",
+		Metadata:    map[string]string{"title": "champion"},
+	}
+
+	suite.mockUserRepository.
+		EXPECT().
+		UpdateProfile(mock.Anything, "user-id", profile).
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserCountry(mock.Anything, "user-id", "DE").
+		Return(nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id": {Name: "username", Profile: profile}}).
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventProfileUpdated, "user-id", "user-id")
+
+	err := suite.service.UpdateProfile(context.Background(), "user-id", domain.UserProfile{
+		DisplayName: "  Player One ",
+		CountryCode: "de",
+		AvatarURL:   "https://example.com/avatar.png",
+		Metadata:    map[string]string{"title": "champion"},
+	})
+	suite.NoError(err)
+}
+
+func (suite *UserServiceTestSuite) TestUpdateProfile_Invalid() {
+	profiles := []domain.UserProfile{
+		{DisplayName: strings.Repeat("a", maxDisplayNameLength+1)},
+		{DisplayName: "new\nline"},
+		{CountryCode: "DEU"},
+		{CountryCode: "D1"},
+		{CountryCode: "ZZ"},
+		{CountryCode: "EU"},
+		{AvatarURL: "http://example.com/avatar.png"},
+		{AvatarURL: "avatar.png"},
+		{Metadata: map[string]string{"": "value"}},
+		{Metadata: map[string]string{"key": strings.Repeat("a", maxMetadataValueLength+1)}},
+	}
+
+	for _, profile := range profiles {
+		err := suite.service.UpdateProfile(context.Background(), "user-id", profile)
+		suite.ErrorIs(err, ErrInvalidProfile, "%+v", profile)
+	}
+}
+
+func (suite *UserServiceTestSuite) TestChangePassword() {
+	suite.expectUser()
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("old-password", "password-hash").
+		Return(true, nil)
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		HashPassword("new-password").
+		Return("new-password-hash", nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		UpdatePasswordHash(mock.Anything, "user-id", "new-password-hash").
+		Return(nil)
+
+	suite.mockTokenManager.
+		EXPECT().
+		RevokeUserTokens(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.expectAuditEvent(domain.AuditEventPasswordChanged, "user-id", "user-id")
+
+	err := suite.service.ChangePassword(context.Background(), "user-id", "old-password", "new-password")
+	suite.NoError(err)
+}
+
+func (suite *UserServiceTestSuite) TestChangePassword_InvalidPassword() {
+	suite.expectUser()
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("old-password", "password-hash").
+		Return(false, nil)
+
+	err := suite.service.ChangePassword(context.Background(), "user-id", "old-password", "new-password")
+	suite.ErrorIs(err, ErrInvalidCredentials)
+}
+
+func (suite *UserServiceTestSuite) TestDeleteAccount() {
+	suite.expectUser()
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("password", "password-hash").
+		Return(true, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockScoreSubmissionRepository.
+		EXPECT().
+		DeleteLastSubmission(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockFriendshipRepository.
+		EXPECT().
+		DeleteByUserID(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		GetMember(mock.Anything, "user-id").
+		Return(domain.ClanMember{ClanID: "clan-id", UserID: "user-id", Role: domain.ClanRoleMember}, nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		RemoveMember(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		SetUserClan(mock.Anything, "user-id", "").
+		Return(nil)
+
+	suite.mockClanRepository.
+		EXPECT().
+		ListMembers(mock.Anything, "clan-id").
+		Return([]domain.ClanMember{{ClanID: "clan-id", UserID: "user-id-2", Role: domain.ClanRoleLeader}}, nil)
+
+	suite.mockRewardGrantRepository.
+		EXPECT().
+		DeleteByUserID(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockAchievementRepository.
+		EXPECT().
+		DeleteByUserID(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockRatingRepository.
+		EXPECT().
+		RemoveUserRatings(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockEventBoardRepository.
+		EXPECT().
+		RemoveUserEventScores(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockWalletRepository.
+		EXPECT().
+		DeleteByUserID(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockStorageRepository.
+		EXPECT().
+		DeleteByUserID(mock.Anything, "user-id").
+		Return(nil)
+
+	var anonymousID string
+
+	suite.mockQuarantinedScoreRepository.
+		EXPECT().
+		AnonymizeUser(mock.Anything, "user-id", mock.Anything).
+		Run(func(ctx context.Context, userID, id string) {
+			anonymousID = id
+		}).
+		Return(nil)
+
+	suite.mockTournamentRepository.
+		EXPECT().
+		AnonymizeUser(mock.Anything, "user-id", mock.Anything).
+		Run(func(ctx context.Context, userID, id string) {
+			suite.Equal(anonymousID, id)
+		}).
+		Return(nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		AnonymizeUser(mock.Anything, "user-id", mock.Anything).
+		Run(func(ctx context.Context, userID, id string) {
+			suite.Equal(anonymousID, id)
+		}).
+		Return(nil)
+
+	suite.mockTokenManager.
+		EXPECT().
+		RevokeUserTokens(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserCache.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockUserRepository.
+		EXPECT().
+		Delete(mock.Anything, "user-id").
+		Return(nil)
+
+	suite.mockAuditLog.
+		EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(event domain.AuditEvent) bool {
+			return event.Type == domain.AuditEventAccountDeleted &&
+				event.UserID == anonymousID &&
+				event.PeerIP == ""
+		})).
+		Return(nil)
+
+	err := suite.service.DeleteAccount(context.Background(), "user-id", "password")
+	suite.NoError(err)
+	suite.Contains(anonymousID, "deleted-user:")
+}
+
+func (suite *UserServiceTestSuite) TestDeleteAccount_InvalidPassword() {
+	suite.expectUser()
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("password", "password-hash").
+		Return(false, nil)
+
+	err := suite.service.DeleteAccount(context.Background(), "user-id", "password")
+	suite.ErrorIs(err, ErrInvalidCredentials)
+}
+
+func (suite *UserServiceTestSuite) TestDeleteAccount_RemoveUserScoreFailed() {
+	suite.expectUser()
+
+	suite.mockPasswordHasher.
+		EXPECT().
+		ComparePasswordAndHash("password", "password-hash").
+		Return(true, nil)
+
+	suite.mockUserScoreRepository.
+		EXPECT().
+		RemoveUserScore(mock.Anything, "user-id").
+		Return(domain.ErrInternal)
+
+	err := suite.service.DeleteAccount(context.Background(), "user-id", "password")
+	suite.ErrorIs(err, domain.ErrInternal)
+}
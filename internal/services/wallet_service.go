@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"game/internal/domain"
+)
+
+var (
+	ErrInvalidWalletOperation = errors.New("invalid wallet operation")
+	ErrInvalidCurrency        = errors.New("invalid currency")
+	ErrInsufficientFunds      = errors.New("insufficient funds")
+	ErrIdempotencyKeyReused   = errors.New("idempotency key reused")
+)
+
+const (
+	maxIdempotencyKeyLength = 128
+	maxLedgerReasonLength   = 128
+	maxLedgerEntryLines     = 32
+)
+
+//go:generate mockery --name WalletService --structname MockWalletService --outpkg mocks --filename wallet_service_mock.go --output ./mocks/. --with-expecter
+type WalletService interface {
+	// Grant adds the currencies and the items of the entry to the wallet of
+	// the user. Granting again with the same idempotency key returns the
+	// entry of the first grant and grants nothing.
+	Grant(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error)
+	// Spend takes the currencies and the items of the entry from the wallet
+	// of the user, nothing is taken unless the wallet holds enough of all
+	// of them. Spending again with the same idempotency key returns the
+	// entry of the first spend and takes nothing.
+	Spend(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error)
+	GetBalance(ctx context.Context, userID string) (domain.Wallet, error)
+}
+
+type WalletServiceDependencies struct {
+	WalletRepository domain.WalletRepository
+
+	// Currencies are the soft currencies a wallet can hold.
+	Currencies []string
+}
+
+type walletService struct {
+	walletRepository domain.WalletRepository
+
+	currencies map[string]bool
+}
+
+func NewWalletService(deps WalletServiceDependencies) *walletService {
+	currencies := make(map[string]bool, len(deps.Currencies))
+
+	for _, currency := range deps.Currencies {
+		currencies[currency] = true
+	}
+
+	return &walletService{
+		walletRepository: deps.WalletRepository,
+		currencies:       currencies,
+	}
+}
+
+func (service *walletService) Grant(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error) {
+	entry.Type = domain.LedgerEntryGrant
+
+	return service.apply(ctx, entry)
+}
+
+func (service *walletService) Spend(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error) {
+	entry.Type = domain.LedgerEntrySpend
+
+	return service.apply(ctx, entry)
+}
+
+func (service *walletService) GetBalance(ctx context.Context, userID string) (domain.Wallet, error) {
+	return service.walletRepository.GetWallet(ctx, userID)
+}
+
+func (service *walletService) apply(ctx context.Context, entry domain.LedgerEntry) (domain.LedgerEntry, error) {
+	err := service.validateEntry(entry)
+	if err != nil {
+		return domain.LedgerEntry{}, err
+	}
+
+	entry.CreatedAt = time.Now()
+
+	applied, ok, err := service.walletRepository.Apply(ctx, entry)
+	if err != nil {
+		if !errors.Is(err, domain.ErrResourceExists) {
+			return domain.LedgerEntry{}, err
+		}
+
+		// a retried request gets the entry of the first one, the key can
+		// not be used for another operation.
+		if !sameOperation(applied, entry) {
+			return domain.LedgerEntry{}, ErrIdempotencyKeyReused
+		}
+
+		return applied, nil
+	}
+
+	if !ok {
+		return domain.LedgerEntry{}, ErrInsufficientFunds
+	}
+
+	return applied, nil
+}
+
+func (service *walletService) validateEntry(entry domain.LedgerEntry) error {
+	if entry.UserID == "" {
+		return fmt.Errorf("%w, user id is required", ErrInvalidWalletOperation)
+	}
+
+	if !isIdempotencyKey(entry.IdempotencyKey) {
+		return fmt.Errorf("%w, invalid idempotency key", ErrInvalidWalletOperation)
+	}
+
+	if utf8.RuneCountInString(entry.Reason) > maxLedgerReasonLength {
+		return fmt.Errorf("%w, reason is longer than %d characters", ErrInvalidWalletOperation, maxLedgerReasonLength)
+	}
+
+	lines := len(entry.Currencies) + len(entry.Items)
+	if lines == 0 || lines > maxLedgerEntryLines {
+		return fmt.Errorf("%w, between 1 and %d currencies and items are required", ErrInvalidWalletOperation, maxLedgerEntryLines)
+	}
+
+	for currency, amount := range entry.Currencies {
+		if !service.currencies[currency] {
+			return fmt.Errorf("%w, unknown currency %q", ErrInvalidWalletOperation, currency)
+		}
+
+		if amount <= 0 {
+			return fmt.Errorf("%w, amount of %q has to be positive", ErrInvalidWalletOperation, currency)
+		}
+	}
+
+	for itemID, quantity := range entry.Items {
+		if !isIdentifier(itemID) {
+			return fmt.Errorf("%w, invalid item id %q", ErrInvalidWalletOperation, itemID)
+		}
+
+		if quantity <= 0 {
+			return fmt.Errorf("%w, quantity of %q has to be positive", ErrInvalidWalletOperation, itemID)
+		}
+	}
+
+	return nil
+}
+
+// ValidateWalletCurrencies checks the currencies the wallets are configured
+// with, they are a part of the field names of the wallet records.
+func ValidateWalletCurrencies(currencies []string) error {
+	return validateIdentifiers(currencies, ErrInvalidCurrency)
+}
+
+// sameOperation reports whether the entry that has been applied with the
+// idempotency key is the one being applied again.
+func sameOperation(applied, entry domain.LedgerEntry) bool {
+	return applied.Type == entry.Type &&
+		applied.Reason == entry.Reason &&
+		sameQuantities(applied.Currencies, entry.Currencies) &&
+		sameQuantities(applied.Items, entry.Items)
+}
+
+func sameQuantities(a, b map[string]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, quantity := range a {
+		other, ok := b[key]
+		if !ok || other != quantity {
+			return false
+		}
+	}
+
+	return true
+}
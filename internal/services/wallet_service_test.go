@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type WalletServiceTestSuite struct {
+	suite.Suite
+
+	service *walletService
+
+	mockWalletRepository *mocks.MockWalletRepository
+}
+
+func TestWalletServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WalletServiceTestSuite))
+}
+
+func (suite *WalletServiceTestSuite) SetupTest() {
+	suite.mockWalletRepository = mocks.NewMockWalletRepository(suite.T())
+
+	suite.service = NewWalletService(WalletServiceDependencies{
+		WalletRepository: suite.mockWalletRepository,
+		Currencies:       []string{"coins", "gems"},
+	})
+}
+
+func (suite *WalletServiceTestSuite) TestGrant() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.MatchedBy(func(entry domain.LedgerEntry) bool {
+			return entry.UserID == "user-id" &&
+				entry.IdempotencyKey == "grant-1" &&
+				entry.Type == domain.LedgerEntryGrant &&
+				entry.Currencies["coins"] == 100 &&
+				entry.Items["sword"] == 1 &&
+				!entry.CreatedAt.IsZero()
+		})).
+		Return(domain.LedgerEntry{
+			ID:   "user-id:grant-1",
+			Type: domain.LedgerEntryGrant,
+			Wallet: domain.Wallet{
+				Balances: map[string]int64{"coins": 150},
+				Items:    map[string]int64{"sword": 1},
+			},
+		}, true, nil)
+
+	entry, err := suite.service.Grant(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Currencies:     map[string]int64{"coins": 100},
+		Items:          map[string]int64{"sword": 1},
+	})
+	suite.NoError(err)
+	suite.Equal("user-id:grant-1", entry.ID)
+	suite.Equal(int64(150), entry.Wallet.Balances["coins"])
+}
+
+func (suite *WalletServiceTestSuite) TestGrant_Invalid() {
+	for _, entry := range []domain.LedgerEntry{
+		{IdempotencyKey: "grant-1", Currencies: map[string]int64{"coins": 100}},
+		{UserID: "user-id", Currencies: map[string]int64{"coins": 100}},
+		{UserID: "user-id", IdempotencyKey: "grant:1", Currencies: map[string]int64{"coins": 100}},
+		{UserID: "user-id", IdempotencyKey: "grant-1"},
+		{UserID: "user-id", IdempotencyKey: "grant-1", Currencies: map[string]int64{"gold": 100}},
+		{UserID: "user-id", IdempotencyKey: "grant-1", Currencies: map[string]int64{"coins": 0}},
+		{UserID: "user-id", IdempotencyKey: "grant-1", Items: map[string]int64{"sword": -1}},
+		{UserID: "user-id", IdempotencyKey: "grant-1", Items: map[string]int64{"sword.gold": 1}},
+	} {
+		_, err := suite.service.Grant(context.Background(), entry)
+		suite.ErrorIs(err, ErrInvalidWalletOperation, entry)
+	}
+}
+
+func (suite *WalletServiceTestSuite) TestGrant_Retried() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{
+			ID:         "user-id:grant-1",
+			Type:       domain.LedgerEntryGrant,
+			Currencies: map[string]int64{"coins": 100},
+		}, false, domain.ErrResourceExists)
+
+	entry, err := suite.service.Grant(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Currencies:     map[string]int64{"coins": 100},
+	})
+	suite.NoError(err)
+	suite.Equal("user-id:grant-1", entry.ID)
+}
+
+func (suite *WalletServiceTestSuite) TestGrant_IdempotencyKeyReused() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{
+			ID:         "user-id:grant-1",
+			Type:       domain.LedgerEntryGrant,
+			Currencies: map[string]int64{"coins": 100},
+		}, false, domain.ErrResourceExists)
+
+	_, err := suite.service.Grant(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Currencies:     map[string]int64{"coins": 200},
+	})
+	suite.ErrorIs(err, ErrIdempotencyKeyReused)
+}
+
+func (suite *WalletServiceTestSuite) TestSpend() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.MatchedBy(func(entry domain.LedgerEntry) bool {
+			return entry.Type == domain.LedgerEntrySpend &&
+				entry.Currencies["gems"] == 5 &&
+				entry.Reason == "shop"
+		})).
+		Return(domain.LedgerEntry{
+			ID:     "user-id:spend-1",
+			Type:   domain.LedgerEntrySpend,
+			Wallet: domain.Wallet{Balances: map[string]int64{"gems": 0}},
+		}, true, nil)
+
+	entry, err := suite.service.Spend(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "spend-1",
+		Reason:         "shop",
+		Currencies:     map[string]int64{"gems": 5},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(0), entry.Wallet.Balances["gems"])
+}
+
+func (suite *WalletServiceTestSuite) TestSpend_InsufficientFunds() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{}, false, nil)
+
+	_, err := suite.service.Spend(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "spend-1",
+		Currencies:     map[string]int64{"coins": 1000},
+	})
+	suite.ErrorIs(err, ErrInsufficientFunds)
+}
+
+func (suite *WalletServiceTestSuite) TestSpend_RetriedAsGrant() {
+	suite.mockWalletRepository.
+		EXPECT().
+		Apply(mock.Anything, mock.Anything).
+		Return(domain.LedgerEntry{
+			Type:       domain.LedgerEntryGrant,
+			Currencies: map[string]int64{"coins": 100},
+		}, false, domain.ErrResourceExists)
+
+	_, err := suite.service.Spend(context.Background(), domain.LedgerEntry{
+		UserID:         "user-id",
+		IdempotencyKey: "grant-1",
+		Currencies:     map[string]int64{"coins": 100},
+	})
+	suite.ErrorIs(err, ErrIdempotencyKeyReused)
+}
+
+func (suite *WalletServiceTestSuite) TestGetBalance() {
+	suite.mockWalletRepository.
+		EXPECT().
+		GetWallet(mock.Anything, "user-id").
+		Return(domain.Wallet{UserID: "user-id", Balances: map[string]int64{"coins": 150}}, nil)
+
+	wallet, err := suite.service.GetBalance(context.Background(), "user-id")
+	suite.NoError(err)
+	suite.Equal(int64(150), wallet.Balances["coins"])
+}
+
+func (suite *WalletServiceTestSuite) TestValidateWalletCurrencies() {
+	suite.NoError(ValidateWalletCurrencies([]string{"coins", "gems"}))
+	suite.ErrorIs(ValidateWalletCurrencies(nil), ErrInvalidCurrency)
+	suite.ErrorIs(ValidateWalletCurrencies([]string{"coins", "coins"}), ErrInvalidCurrency)
+	suite.ErrorIs(ValidateWalletCurrencies([]string{"soft.coins"}), ErrInvalidCurrency)
+}
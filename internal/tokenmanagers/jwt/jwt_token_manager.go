@@ -6,33 +6,49 @@ import (
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+
+	"game/internal/domain"
 )
 
 type JWTTokenCreatorDependencies struct {
 	SecretKey string
 	TokenTTL  time.Duration
+
+	TokenVersionRepository domain.TokenVersionRepository
 }
 
 type JWTTokenManager struct {
 	secretKey string
 	tokenTTL  time.Duration
+
+	tokenVersionRepository domain.TokenVersionRepository
 }
 
 type claims struct {
 	UserID string `json:"userID"`
+	// Version is the token version of the user when the token is created,
+	// the token is revoked once the version of the user is increased.
+	Version int64 `json:"version,omitempty"`
 	jwt.StandardClaims
 }
 
 func NewJWTTokenManager(deps JWTTokenCreatorDependencies) *JWTTokenManager {
 	return &JWTTokenManager{
-		secretKey: deps.SecretKey,
-		tokenTTL:  deps.TokenTTL,
+		secretKey:              deps.SecretKey,
+		tokenTTL:               deps.TokenTTL,
+		tokenVersionRepository: deps.TokenVersionRepository,
 	}
 }
 
 func (creator *JWTTokenManager) Create(ctx context.Context, userID string) (string, error) {
+	version, err := creator.tokenVersionRepository.GetTokenVersion(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
-		UserID: userID,
+		UserID:  userID,
+		Version: version,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(creator.tokenTTL).Unix(),
 		},
@@ -63,5 +79,23 @@ func (creator *JWTTokenManager) ExtractUserID(ctx context.Context, tokenString s
 		return "", fmt.Errorf("invalid token claims, user id not found")
 	}
 
+	version, err := creator.tokenVersionRepository.GetTokenVersion(ctx, claims.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if claims.Version != version {
+		return "", domain.ErrTokenRevoked
+	}
+
 	return claims.UserID, nil
 }
+
+func (creator *JWTTokenManager) RevokeUserTokens(ctx context.Context, userID string) error {
+	_, err := creator.tokenVersionRepository.IncrementTokenVersion(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,13 +5,19 @@ import (
 	"testing"
 
 	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
 )
 
 type JWTTokenManagerTestSuite struct {
 	suite.Suite
 
 	tokenManager *JWTTokenManager
+
+	mockTokenVersionRepository *mocks.MockTokenVersionRepository
 }
 
 func TestJWTTokenManagerTestSuite(t *testing.T) {
@@ -19,18 +25,32 @@ func TestJWTTokenManagerTestSuite(t *testing.T) {
 }
 
 func (suite *JWTTokenManagerTestSuite) SetupTest() {
+	suite.mockTokenVersionRepository = mocks.NewMockTokenVersionRepository(suite.T())
+
 	suite.tokenManager = NewJWTTokenManager(JWTTokenCreatorDependencies{
-		SecretKey: "secret-key",
+		SecretKey:              "secret-key",
+		TokenVersionRepository: suite.mockTokenVersionRepository,
 	})
 }
 
 func (suite *JWTTokenManagerTestSuite) TestCreate() {
+	suite.mockTokenVersionRepository.
+		EXPECT().
+		GetTokenVersion(mock.Anything, "user-id").
+		Return(0, nil)
+
 	token, err := suite.tokenManager.Create(context.Background(), "user-id")
 	suite.NoError(err)
 	suite.NotEmpty(token)
 }
 
 func (suite *JWTTokenManagerTestSuite) TestExtractUserID() {
+	suite.mockTokenVersionRepository.
+		EXPECT().
+		GetTokenVersion(mock.Anything, "user-id").
+		Return(2, nil).
+		Twice()
+
 	token, err := suite.tokenManager.Create(context.Background(), "user-id")
 	suite.NoError(err)
 	suite.NotEmpty(token)
@@ -88,3 +108,31 @@ func (suite *JWTTokenManagerTestSuite) TestExtractUserID_NoUserID() {
 	_, err = suite.tokenManager.ExtractUserID(context.Background(), tokenString)
 	suite.ErrorContains(err, "invalid token")
 }
+
+func (suite *JWTTokenManagerTestSuite) TestExtractUserID_Revoked() {
+	suite.mockTokenVersionRepository.
+		EXPECT().
+		GetTokenVersion(mock.Anything, "user-id").
+		Return(0, nil).
+		Once()
+
+	token, err := suite.tokenManager.Create(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.mockTokenVersionRepository.
+		EXPECT().
+		IncrementTokenVersion(mock.Anything, "user-id").
+		Return(1, nil)
+
+	err = suite.tokenManager.RevokeUserTokens(context.Background(), "user-id")
+	suite.NoError(err)
+
+	suite.mockTokenVersionRepository.
+		EXPECT().
+		GetTokenVersion(mock.Anything, "user-id").
+		Return(1, nil).
+		Once()
+
+	_, err = suite.tokenManager.ExtractUserID(context.Background(), token)
+	suite.ErrorIs(err, domain.ErrTokenRevoked)
+}
@@ -0,0 +1,146 @@
+package lru
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"game/internal/domain"
+)
+
+type LRUUserCacheDependencies struct {
+	// Next is the shared cache that is read on a miss and written through.
+	Next domain.UserCache
+
+	Size int
+	// TTL bounds how long a user can be served from memory after it has
+	// been changed by another replica.
+	TTL time.Duration
+}
+
+// LRUUserCache keeps the most recently read users in memory in front of a
+// shared user cache.
+type LRUUserCache struct {
+	next domain.UserCache
+
+	size int
+	ttl  time.Duration
+
+	mu       sync.Mutex
+	entries  *list.List
+	elements map[string]*list.Element
+}
+
+type entry struct {
+	userID    string
+	user      domain.PublicUser
+	expiresAt time.Time
+}
+
+func NewLRUUserCache(deps LRUUserCacheDependencies) *LRUUserCache {
+	return &LRUUserCache{
+		next:     deps.Next,
+		size:     deps.Size,
+		ttl:      deps.TTL,
+		entries:  list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+func (cache *LRUUserCache) Get(ctx context.Context, userIDs []string) (map[string]domain.PublicUser, error) {
+	users := make(map[string]domain.PublicUser, len(userIDs))
+
+	var missingUserIDs []string
+
+	cache.mu.Lock()
+
+	now := time.Now()
+
+	for _, userID := range userIDs {
+		element, ok := cache.elements[userID]
+		if !ok {
+			missingUserIDs = append(missingUserIDs, userID)
+			continue
+		}
+
+		entry := element.Value.(*entry)
+
+		if now.After(entry.expiresAt) {
+			cache.remove(element)
+			missingUserIDs = append(missingUserIDs, userID)
+			continue
+		}
+
+		cache.entries.MoveToFront(element)
+		users[userID] = entry.user
+	}
+
+	cache.mu.Unlock()
+
+	if len(missingUserIDs) == 0 {
+		return users, nil
+	}
+
+	nextUsers, err := cache.next.Get(ctx, missingUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.add(nextUsers)
+
+	for userID, user := range nextUsers {
+		users[userID] = user
+	}
+
+	return users, nil
+}
+
+func (cache *LRUUserCache) Set(ctx context.Context, users map[string]domain.PublicUser) error {
+	err := cache.next.Set(ctx, users)
+	if err != nil {
+		return err
+	}
+
+	cache.add(users)
+
+	return nil
+}
+
+func (cache *LRUUserCache) Delete(ctx context.Context, userID string) error {
+	cache.mu.Lock()
+
+	if element, ok := cache.elements[userID]; ok {
+		cache.remove(element)
+	}
+
+	cache.mu.Unlock()
+
+	return cache.next.Delete(ctx, userID)
+}
+
+func (cache *LRUUserCache) add(users map[string]domain.PublicUser) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	expiresAt := time.Now().Add(cache.ttl)
+
+	for userID, user := range users {
+		if element, ok := cache.elements[userID]; ok {
+			element.Value = &entry{userID: userID, user: user, expiresAt: expiresAt}
+			cache.entries.MoveToFront(element)
+			continue
+		}
+
+		cache.elements[userID] = cache.entries.PushFront(&entry{userID: userID, user: user, expiresAt: expiresAt})
+
+		for cache.entries.Len() > cache.size {
+			cache.remove(cache.entries.Back())
+		}
+	}
+}
+
+func (cache *LRUUserCache) remove(element *list.Element) {
+	cache.entries.Remove(element)
+	delete(cache.elements, element.Value.(*entry).userID)
+}
@@ -0,0 +1,140 @@
+package lru
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+	"game/internal/domain/mocks"
+)
+
+type LRUUserCacheTestSuite struct {
+	suite.Suite
+
+	cache *LRUUserCache
+
+	mockUserCache *mocks.MockUserCache
+}
+
+func TestLRUUserCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(LRUUserCacheTestSuite))
+}
+
+func (suite *LRUUserCacheTestSuite) SetupTest() {
+	suite.mockUserCache = mocks.NewMockUserCache(suite.T())
+
+	suite.cache = NewLRUUserCache(LRUUserCacheDependencies{
+		Next: suite.mockUserCache,
+		Size: 2,
+		TTL:  time.Minute,
+	})
+}
+
+func (suite *LRUUserCacheTestSuite) TestGet() {
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1", "user-id-2"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, nil).
+		Once()
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1", "user-id-2"})
+	suite.NoError(err)
+	suite.Equal(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, users)
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-2"}).
+		Return(map[string]domain.PublicUser{}, nil).
+		Once()
+
+	users, err = suite.cache.Get(context.Background(), []string{"user-id-1", "user-id-2"})
+	suite.NoError(err)
+	suite.Equal(map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}, users)
+}
+
+func (suite *LRUUserCacheTestSuite) TestGet_NextFailed() {
+	someError := errors.New("some error")
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(nil, someError)
+
+	_, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *LRUUserCacheTestSuite) TestGet_Expired() {
+	suite.cache.ttl = -time.Second
+
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}).
+		Return(nil)
+
+	suite.NoError(suite.cache.Set(context.Background(), map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}))
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]domain.PublicUser{"user-id-1": {Name: "renamed-user-1"}}, nil)
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.NoError(err)
+	suite.Equal(map[string]domain.PublicUser{"user-id-1": {Name: "renamed-user-1"}}, users)
+}
+
+func (suite *LRUUserCacheTestSuite) TestSet_EvictsLeastRecentlyUsed() {
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, mock.Anything).
+		Return(nil)
+
+	suite.NoError(suite.cache.Set(context.Background(), map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}))
+	suite.NoError(suite.cache.Set(context.Background(), map[string]domain.PublicUser{"user-id-2": {Name: "user-2"}}))
+
+	_, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.NoError(err)
+
+	suite.NoError(suite.cache.Set(context.Background(), map[string]domain.PublicUser{"user-id-3": {Name: "user-3"}}))
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-2"}).
+		Return(map[string]domain.PublicUser{"user-id-2": {Name: "user-2"}}, nil).
+		Once()
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1", "user-id-2", "user-id-3"})
+	suite.NoError(err)
+	suite.Len(users, 3)
+}
+
+func (suite *LRUUserCacheTestSuite) TestDelete() {
+	suite.mockUserCache.
+		EXPECT().
+		Set(mock.Anything, map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}).
+		Return(nil)
+
+	suite.NoError(suite.cache.Set(context.Background(), map[string]domain.PublicUser{"user-id-1": {Name: "user-1"}}))
+
+	suite.mockUserCache.
+		EXPECT().
+		Delete(mock.Anything, "user-id-1").
+		Return(nil)
+
+	suite.NoError(suite.cache.Delete(context.Background(), "user-id-1"))
+
+	suite.mockUserCache.
+		EXPECT().
+		Get(mock.Anything, []string{"user-id-1"}).
+		Return(map[string]domain.PublicUser{}, nil)
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.NoError(err)
+	suite.Empty(users)
+}
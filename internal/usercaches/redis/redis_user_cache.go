@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-redis/redis/v8"
+
+	"game/internal/domain"
+)
+
+const usersKey = "public_users"
+
+type RedisUserCacheDependencies struct {
+	Client *redis.Client
+}
+
+// RedisUserCache keeps the public users in a single redis hash, keyed by the
+// user ID and encoded as JSON.
+type RedisUserCache struct {
+	client *redis.Client
+}
+
+type publicUserRecord struct {
+	Name        string            `json:"name"`
+	DisplayName string            `json:"displayName,omitempty"`
+	CountryCode string            `json:"countryCode,omitempty"`
+	AvatarURL   string            `json:"avatarURL,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+func NewRedisUserCache(deps RedisUserCacheDependencies) *RedisUserCache {
+	return &RedisUserCache{
+		client: deps.Client,
+	}
+}
+
+func (cache *RedisUserCache) Get(ctx context.Context, userIDs []string) (map[string]domain.PublicUser, error) {
+	users := make(map[string]domain.PublicUser, len(userIDs))
+
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	values, err := cache.client.HMGet(ctx, usersKey, userIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var record publicUserRecord
+
+		// entries that can not be decoded are treated as misses, they are
+		// overwritten once the user is read from the users collection.
+		err := json.Unmarshal([]byte(data), &record)
+		if err != nil {
+			continue
+		}
+
+		users[userIDs[i]] = domain.PublicUser{
+			Name: record.Name,
+			Profile: domain.UserProfile{
+				DisplayName: record.DisplayName,
+				CountryCode: record.CountryCode,
+				AvatarURL:   record.AvatarURL,
+				Metadata:    record.Metadata,
+			},
+		}
+	}
+
+	return users, nil
+}
+
+func (cache *RedisUserCache) Set(ctx context.Context, users map[string]domain.PublicUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	values := make(map[string]string, len(users))
+
+	for userID, user := range users {
+		data, err := json.Marshal(publicUserRecord{
+			Name:        user.Name,
+			DisplayName: user.Profile.DisplayName,
+			CountryCode: user.Profile.CountryCode,
+			AvatarURL:   user.Profile.AvatarURL,
+			Metadata:    user.Profile.Metadata,
+		})
+		if err != nil {
+			return err
+		}
+
+		values[userID] = string(data)
+	}
+
+	_, err := cache.client.HSet(ctx, usersKey, values).Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cache *RedisUserCache) Delete(ctx context.Context, userID string) error {
+	_, err := cache.client.HDel(ctx, usersKey, userID).Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
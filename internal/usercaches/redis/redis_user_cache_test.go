@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/suite"
+
+	"game/internal/domain"
+)
+
+type RedisUserCacheTestSuite struct {
+	suite.Suite
+
+	cache *RedisUserCache
+
+	redisMock redismock.ClientMock
+}
+
+func TestRedisUserCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisUserCacheTestSuite))
+}
+
+func (suite *RedisUserCacheTestSuite) SetupTest() {
+	db, mock := redismock.NewClientMock()
+
+	suite.redisMock = mock
+
+	suite.cache = NewRedisUserCache(RedisUserCacheDependencies{
+		Client: db,
+	})
+}
+
+func (suite *RedisUserCacheTestSuite) TearDownTest() {
+	suite.NoError(suite.redisMock.ExpectationsWereMet())
+}
+
+func (suite *RedisUserCacheTestSuite) TestGet() {
+	suite.redisMock.
+		ExpectHMGet("public_users", "user-id-1", "user-id-2").
+		SetVal([]interface{}{`{"name":"user-1","countryCode":"DE"}`, nil})
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1", "user-id-2"})
+	suite.NoError(err)
+	suite.Equal(map[string]domain.PublicUser{
+		"user-id-1": {Name: "user-1", Profile: domain.UserProfile{CountryCode: "DE"}},
+	}, users)
+}
+
+func (suite *RedisUserCacheTestSuite) TestGet_InvalidEntry() {
+	suite.redisMock.
+		ExpectHMGet("public_users", "user-id-1").
+		SetVal([]interface{}{"user-1"})
+
+	users, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.NoError(err)
+	suite.Empty(users)
+}
+
+func (suite *RedisUserCacheTestSuite) TestGet_NoUsers() {
+	users, err := suite.cache.Get(context.Background(), nil)
+	suite.NoError(err)
+	suite.Empty(users)
+}
+
+func (suite *RedisUserCacheTestSuite) TestGet_HMGetFailed() {
+	someError := errors.New("some error")
+
+	suite.redisMock.
+		ExpectHMGet("public_users", "user-id-1").
+		SetErr(someError)
+
+	_, err := suite.cache.Get(context.Background(), []string{"user-id-1"})
+	suite.ErrorIs(err, someError)
+}
+
+func (suite *RedisUserCacheTestSuite) TestSet() {
+	suite.redisMock.
+		ExpectHSet("public_users", map[string]string{"user-id-1": `{"name":"user-1","avatarURL":"https://example.com/a.png"}`}).
+		SetVal(1)
+
+	err := suite.cache.Set(context.Background(), map[string]domain.PublicUser{
+		"user-id-1": {Name: "user-1", Profile: domain.UserProfile{AvatarURL: "https://example.com/a.png"}},
+	})
+	suite.NoError(err)
+}
+
+func (suite *RedisUserCacheTestSuite) TestDelete() {
+	suite.redisMock.
+		ExpectHDel("public_users", "user-id-1").
+		SetVal(1)
+
+	err := suite.cache.Delete(context.Background(), "user-id-1")
+	suite.NoError(err)
+}